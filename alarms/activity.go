@@ -0,0 +1,102 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// maxCommentLength is the maximum number of characters of the comment body.
+const maxCommentLength = 4096
+
+var (
+	errEmptyComment   = errors.NewRequestError("comment body is required")
+	errCommentLength  = errors.NewRequestError("comment body is too long")
+	errCommentParent  = errors.NewRequestError("comment parent must be a comment of the same alarm")
+	errCommentReplies = errors.NewRequestError("comment replies can't be replied to")
+)
+
+// ActivityType is the kind of the change recorded in the alarm timeline.
+type ActivityType string
+
+const (
+	AssignedActivity        ActivityType = "assigned"
+	AcknowledgedActivity    ActivityType = "acknowledged"
+	ResolvedActivity        ActivityType = "resolved"
+	ReopenedActivity        ActivityType = "reopened"
+	SnoozedActivity         ActivityType = "snoozed"
+	UpdatedActivity         ActivityType = "updated"
+	EscalatedActivity       ActivityType = "escalated"
+	SeverityChangedActivity ActivityType = "severity_changed"
+	CommentedActivity       ActivityType = "commented"
+)
+
+// Activity is an entry of the alarm timeline. The activities are only
+// appended, and the entries of the changes made by the service itself,
+// such as the escalations, have no actor.
+type Activity struct {
+	ID        string         `json:"id"`
+	AlarmID   string         `json:"alarm_id"`
+	DomainID  string         `json:"domain_id"`
+	Type      ActivityType   `json:"type"`
+	ActorID   string         `json:"actor_id,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type ActivityPageMeta struct {
+	Offset  uint64 `json:"offset"`
+	Limit   uint64 `json:"limit"`
+	Total   uint64 `json:"total"`
+	AlarmID string `json:"alarm_id"`
+}
+
+type ActivityPage struct {
+	Offset     uint64     `json:"offset"`
+	Limit      uint64     `json:"limit"`
+	Total      uint64     `json:"total"`
+	Activities []Activity `json:"activities"`
+}
+
+// Comment is a finding an operator shares on the alarm. The replies refer
+// to the comment they answer with ParentID, and the threads are one level
+// deep.
+type Comment struct {
+	ID        string    `json:"id"`
+	AlarmID   string    `json:"alarm_id"`
+	DomainID  string    `json:"domain_id"`
+	ParentID  string    `json:"parent_id,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+func (c Comment) Validate() error {
+	body := strings.TrimSpace(c.Body)
+	switch {
+	case body == "":
+		return errEmptyComment
+	case len([]rune(body)) > maxCommentLength:
+		return errCommentLength
+	}
+
+	return nil
+}
+
+type CommentPageMeta struct {
+	Offset  uint64 `json:"offset"`
+	Limit   uint64 `json:"limit"`
+	Total   uint64 `json:"total"`
+	AlarmID string `json:"alarm_id"`
+}
+
+type CommentsPage struct {
+	Offset   uint64    `json:"offset"`
+	Limit    uint64    `json:"limit"`
+	Total    uint64    `json:"total"`
+	Comments []Comment `json:"comments"`
+}
@@ -13,36 +13,47 @@ import (
 
 const SeverityMax uint8 = 100
 
-var ErrInvalidSeverity = errors.New("invalid severity. Must be between 0 and 100")
+var (
+	ErrInvalidSeverity = errors.New("invalid severity. Must be between 0 and 100")
+	ErrRaisedStatus    = errors.New("invalid status. Raised alarms must be active or cleared")
+)
 
 type Metadata map[string]any
 
 // Alarm represents an alarm instance.
 type Alarm struct {
-	ID             string    `json:"id"`
-	RuleID         string    `json:"rule_id"`
-	DomainID       string    `json:"domain_id"`
-	ChannelID      string    `json:"channel_id"`
-	ClientID       string    `json:"client_id"`
-	Subtopic       string    `json:"subtopic"`
-	Status         Status    `json:"status"`
-	Measurement    string    `json:"measurement"`
-	Value          string    `json:"value"`
-	Unit           string    `json:"unit"`
-	Threshold      string    `json:"threshold"`
-	Cause          string    `json:"cause"`
-	Severity       uint8     `json:"severity"`
-	AssigneeID     string    `json:"assignee_id"`
-	CreatedAt      time.Time `json:"created_at"`
-	UpdatedAt      time.Time `json:"updated_at"`
-	UpdatedBy      string    `json:"updated_by"`
-	AssignedAt     time.Time `json:"assigned_at,omitempty"`
-	AssignedBy     string    `json:"assigned_by,omitempty"`
-	AcknowledgedAt time.Time `json:"acknowledged_at,omitempty"`
-	AcknowledgedBy string    `json:"acknowledged_by,omitempty"`
-	ResolvedAt     time.Time `json:"resolved_at,omitempty"`
-	ResolvedBy     string    `json:"resolved_by,omitempty"`
-	Metadata       Metadata  `json:"metadata,omitempty"`
+	ID              string    `json:"id"`
+	RuleID          string    `json:"rule_id"`
+	DomainID        string    `json:"domain_id"`
+	ChannelID       string    `json:"channel_id"`
+	ClientID        string    `json:"client_id"`
+	Subtopic        string    `json:"subtopic"`
+	Status          Status    `json:"status"`
+	Measurement     string    `json:"measurement"`
+	Value           string    `json:"value"`
+	Unit            string    `json:"unit"`
+	Threshold       string    `json:"threshold"`
+	Cause           string    `json:"cause"`
+	Severity        uint8     `json:"severity"`
+	AssigneeID      string    `json:"assignee_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	UpdatedBy       string    `json:"updated_by"`
+	AssignedAt      time.Time `json:"assigned_at,omitempty"`
+	AssignedBy      string    `json:"assigned_by,omitempty"`
+	AcknowledgedAt  time.Time `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy  string    `json:"acknowledged_by,omitempty"`
+	ResolvedAt      time.Time `json:"resolved_at,omitempty"`
+	ResolvedBy      string    `json:"resolved_by,omitempty"`
+	SnoozedUntil    time.Time `json:"snoozed_until,omitempty"`
+	SnoozedBy       string    `json:"snoozed_by,omitempty"`
+	EscalationLevel uint8     `json:"escalation_level,omitempty"`
+	EscalatedAt     time.Time `json:"escalated_at,omitempty"`
+	DedupKey        string    `json:"dedup_key,omitempty"`
+	Flapping        bool      `json:"flapping,omitempty"`
+	Suppressed      bool      `json:"suppressed,omitempty"`
+	SuppressionID   string    `json:"suppression_id,omitempty"`
+	Metadata        Metadata  `json:"metadata,omitempty"`
 }
 
 type AlarmsPage struct {
@@ -73,6 +84,7 @@ type PageMetadata struct {
 	AcknowledgedBy string    `json:"acknowledged_by" db:"acknowledged_by"`
 	ResolvedBy     string    `json:"resolved_by"     db:"resolved_by"`
 	UserID         string    `json:"user_id"         db:"user_id"`
+	IDs            []string  `json:"ids,omitempty"   db:"-"`
 }
 
 func (a Alarm) Validate() error {
@@ -100,6 +112,9 @@ func (a Alarm) Validate() error {
 	if a.Severity > SeverityMax {
 		return ErrInvalidSeverity
 	}
+	if a.Status != ActiveStatus && a.Status != ClearedStatus {
+		return ErrRaisedStatus
+	}
 
 	return nil
 }
@@ -110,14 +125,131 @@ type Service interface {
 	UpdateAlarm(ctx context.Context, session authn.Session, alarm Alarm) (Alarm, error)
 	ViewAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error)
 	ListAlarms(ctx context.Context, session authn.Session, pm PageMetadata) (AlarmsPage, error)
+	// AlarmStats aggregates the alarms the session can list.
+	AlarmStats(ctx context.Context, session authn.Session, q StatsQuery) (Stats, error)
 	DeleteAlarm(ctx context.Context, session authn.Session, id string) error
+
+	// AcknowledgeAlarm marks the alarm as seen, which stops its escalation.
+	AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error)
+	// AssignAlarm assigns the alarm to the domain member.
+	AssignAlarm(ctx context.Context, session authn.Session, id, assigneeID string) (Alarm, error)
+	// ResolveAlarm closes the alarm.
+	ResolveAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error)
+	// ReopenAlarm makes the resolved alarm active again.
+	ReopenAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error)
+	// SnoozeAlarm pauses the alarm escalation until the given time, when
+	// the alarm becomes active again.
+	SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (Alarm, error)
+	// BulkAlarms applies the action to the selected alarms the session can
+	// list and reports the outcome for each alarm.
+	BulkAlarms(ctx context.Context, session authn.Session, req BulkRequest) (BulkReport, error)
+
+	// AddComment adds the comment, or the reply to the parent comment, to
+	// the alarm.
+	AddComment(ctx context.Context, session authn.Session, comment Comment) (Comment, error)
+	ListComments(ctx context.Context, session authn.Session, pm CommentPageMeta) (CommentsPage, error)
+	// RemoveComment removes the comment and its replies. Only the author
+	// and the super admins can remove the comment.
+	RemoveComment(ctx context.Context, session authn.Session, alarmID, id string) error
+	// ListActivities lists the alarm timeline from the oldest activity.
+	ListActivities(ctx context.Context, session authn.Session, pm ActivityPageMeta) (ActivityPage, error)
+
+	CreateEscalationPolicy(ctx context.Context, session authn.Session, policy EscalationPolicy) (EscalationPolicy, error)
+	ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (EscalationPolicy, error)
+	UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy EscalationPolicy) (EscalationPolicy, error)
+	ListEscalationPolicies(ctx context.Context, session authn.Session, pm EscalationPolicyPageMeta) (EscalationPolicyPage, error)
+	RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) error
+
+	CreateNotificationPolicy(ctx context.Context, session authn.Session, policy NotificationPolicy) (NotificationPolicy, error)
+	ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (NotificationPolicy, error)
+	UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy NotificationPolicy) (NotificationPolicy, error)
+	ListNotificationPolicies(ctx context.Context, session authn.Session, pm NotificationPolicyPageMeta) (NotificationPolicyPage, error)
+	RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) error
+
+	CreateSuppressionWindow(ctx context.Context, session authn.Session, window SuppressionWindow) (SuppressionWindow, error)
+	ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (SuppressionWindow, error)
+	UpdateSuppressionWindow(ctx context.Context, session authn.Session, window SuppressionWindow) (SuppressionWindow, error)
+	ListSuppressionWindows(ctx context.Context, session authn.Session, pm SuppressionWindowPageMeta) (SuppressionWindowPage, error)
+	RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) error
+
+	// StreamAlarms streams the alarms created in the session domain from
+	// now on until the context is canceled, when the channel is closed.
+	// Like ListAlarms, it streams only the user alarms unless the session
+	// is a super admin.
+	StreamAlarms(ctx context.Context, session authn.Session, filter StreamFilter) (<-chan Alarm, error)
+
+	// StartScheduler wakes the snoozed alarms, escalates the alarms that
+	// aren't acknowledged in time and delivers the alarm notifications.
+	StartScheduler(ctx context.Context) error
 }
 
 type Repository interface {
 	CreateAlarm(ctx context.Context, alarm Alarm) (Alarm, error)
+	// UpdateAlarm saves the set fields of the alarm of the alarm domain.
 	UpdateAlarm(ctx context.Context, alarm Alarm) (Alarm, error)
 	ViewAlarm(ctx context.Context, alarmID, domainID string) (Alarm, error)
 	ListAllAlarms(ctx context.Context, pm PageMetadata) (AlarmsPage, error)
 	ListUserAlarms(ctx context.Context, userID string, pm PageMetadata) (AlarmsPage, error)
-	DeleteAlarm(ctx context.Context, id string) error
+	// IsUserAlarm reports whether the alarm is one of the user alarms, the
+	// ones ListUserAlarms lists.
+	IsUserAlarm(ctx context.Context, userID string, alarm Alarm) (bool, error)
+	AlarmStats(ctx context.Context, q StatsQuery) (Stats, error)
+	UserAlarmStats(ctx context.Context, userID string, q StatsQuery) (Stats, error)
+	DeleteAlarm(ctx context.Context, id, domainID string) error
+
+	// UpdateAlarmStatus saves the lifecycle fields of the alarm if the
+	// alarm status is still the from status.
+	UpdateAlarmStatus(ctx context.Context, alarm Alarm, from Status) (Alarm, error)
+	// UpdateAlarms saves the lifecycle and the assignment fields of the
+	// alarms in one transaction. Each alarm is saved only if its status is
+	// still the change from status, and the saved alarms are returned.
+	UpdateAlarms(ctx context.Context, changes []AlarmChange) ([]Alarm, error)
+	// DeleteAlarms removes the domain alarms in one transaction and returns
+	// the IDs of the removed alarms.
+	DeleteAlarms(ctx context.Context, domainID string, ids []string) ([]string, error)
+	// WakeSnoozedAlarms activates the alarms snoozed until before the time.
+	WakeSnoozedAlarms(ctx context.Context, before time.Time) error
+	// ListAlarmsToEscalate lists the active alarms the policy matches that
+	// were raised before the time and are below the escalation level.
+	ListAlarmsToEscalate(ctx context.Context, policy EscalationPolicy, level uint8, raisedBefore time.Time) ([]Alarm, error)
+	// EscalateAlarm raises the alarm severity and escalation level if the
+	// alarm is still active and below the level.
+	EscalateAlarm(ctx context.Context, alarm Alarm) (Alarm, error)
+
+	AddComment(ctx context.Context, comment Comment) (Comment, error)
+	ViewComment(ctx context.Context, domainID, id string) (Comment, error)
+	ListComments(ctx context.Context, domainID string, pm CommentPageMeta) (CommentsPage, error)
+	RemoveComment(ctx context.Context, domainID, id string) error
+	// AddActivity appends the activity to the alarm timeline. The
+	// activities can't be changed or removed.
+	AddActivity(ctx context.Context, activity Activity) error
+	ListActivities(ctx context.Context, domainID string, pm ActivityPageMeta) (ActivityPage, error)
+
+	AddEscalationPolicy(ctx context.Context, policy EscalationPolicy) (EscalationPolicy, error)
+	ViewEscalationPolicy(ctx context.Context, domainID, id string) (EscalationPolicy, error)
+	UpdateEscalationPolicy(ctx context.Context, policy EscalationPolicy) (EscalationPolicy, error)
+	ListEscalationPolicies(ctx context.Context, pm EscalationPolicyPageMeta) (EscalationPolicyPage, error)
+	RemoveEscalationPolicy(ctx context.Context, domainID, id string) error
+
+	AddNotificationPolicy(ctx context.Context, policy NotificationPolicy) (NotificationPolicy, error)
+	ViewNotificationPolicy(ctx context.Context, domainID, id string) (NotificationPolicy, error)
+	UpdateNotificationPolicy(ctx context.Context, policy NotificationPolicy) (NotificationPolicy, error)
+	ListNotificationPolicies(ctx context.Context, pm NotificationPolicyPageMeta) (NotificationPolicyPage, error)
+	RemoveNotificationPolicy(ctx context.Context, domainID, id string) error
+	// MatchNotificationPolicies lists the domain policies whose severity,
+	// rule, channel and measurement match the alarm.
+	MatchNotificationPolicies(ctx context.Context, alarm Alarm) ([]NotificationPolicy, error)
+
+	// CountAlarmChanges counts the alarms saved with the dedup key since
+	// the time, which are the state changes of the key.
+	CountAlarmChanges(ctx context.Context, domainID, dedupKey string, since time.Time) (uint64, error)
+
+	AddSuppressionWindow(ctx context.Context, window SuppressionWindow) (SuppressionWindow, error)
+	ViewSuppressionWindow(ctx context.Context, domainID, id string) (SuppressionWindow, error)
+	UpdateSuppressionWindow(ctx context.Context, window SuppressionWindow) (SuppressionWindow, error)
+	ListSuppressionWindows(ctx context.Context, pm SuppressionWindowPageMeta) (SuppressionWindowPage, error)
+	RemoveSuppressionWindow(ctx context.Context, domainID, id string) error
+	// MatchSuppressionWindow returns the domain window that suppresses the
+	// alarm, or the not found error if there is none.
+	MatchSuppressionWindow(ctx context.Context, alarm Alarm) (SuppressionWindow, error)
 }
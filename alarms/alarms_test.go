@@ -6,6 +6,7 @@ package alarms_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	"github.com/absmach/magistrala/internal/testsutil"
@@ -171,3 +172,379 @@ func TestValidateAlarms(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusTransitions(t *testing.T) {
+	cases := []struct {
+		from  alarms.Status
+		to    alarms.Status
+		valid bool
+	}{
+		{from: alarms.ActiveStatus, to: alarms.AcknowledgedStatus, valid: true},
+		{from: alarms.ActiveStatus, to: alarms.ResolvedStatus, valid: true},
+		{from: alarms.ActiveStatus, to: alarms.SnoozedStatus, valid: true},
+		{from: alarms.AcknowledgedStatus, to: alarms.ResolvedStatus, valid: true},
+		{from: alarms.AcknowledgedStatus, to: alarms.ActiveStatus, valid: false},
+		{from: alarms.SnoozedStatus, to: alarms.AcknowledgedStatus, valid: true},
+		{from: alarms.ClearedStatus, to: alarms.ResolvedStatus, valid: true},
+		{from: alarms.ClearedStatus, to: alarms.AcknowledgedStatus, valid: false},
+		{from: alarms.ResolvedStatus, to: alarms.ActiveStatus, valid: true},
+		{from: alarms.ResolvedStatus, to: alarms.SnoozedStatus, valid: false},
+	}
+
+	for _, tc := range cases {
+		desc := fmt.Sprintf("%s to %s", tc.from, tc.to)
+		t.Run(desc, func(t *testing.T) {
+			assert.Equal(t, tc.valid, tc.from.CanTransition(tc.to), desc)
+		})
+	}
+}
+
+func TestValidateEscalationPolicy(t *testing.T) {
+	cases := []struct {
+		desc   string
+		levels []alarms.EscalationLevel
+		valid  bool
+	}{
+		{
+			desc:   "valid levels",
+			levels: []alarms.EscalationLevel{{After: 5, Severity: 50}, {After: 10, GroupID: "group-id"}},
+			valid:  true,
+		},
+		{
+			desc:  "no levels",
+			valid: false,
+		},
+		{
+			desc:   "zero after",
+			levels: []alarms.EscalationLevel{{After: 0, Severity: 50}},
+			valid:  false,
+		},
+		{
+			desc:   "unordered levels",
+			levels: []alarms.EscalationLevel{{After: 10, Severity: 50}, {After: 5, Severity: 60}},
+			valid:  false,
+		},
+		{
+			desc:   "level without action",
+			levels: []alarms.EscalationLevel{{After: 5}},
+			valid:  false,
+		},
+		{
+			desc:   "level severity too high",
+			levels: []alarms.EscalationLevel{{After: 5, Severity: 101}},
+			valid:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := alarms.EscalationPolicy{Name: "policy", Levels: tc.levels}.Validate()
+			assert.Equal(t, tc.valid, err == nil, fmt.Sprintf("%s: unexpected error %v", tc.desc, err))
+		})
+	}
+}
+
+func TestValidateNotificationPolicy(t *testing.T) {
+	email := alarms.Receiver{Type: alarms.EmailReceiver, To: []string{"ops@example.com"}}
+	cases := []struct {
+		desc   string
+		policy alarms.NotificationPolicy
+		valid  bool
+	}{
+		{
+			desc: "valid policy",
+			policy: alarms.NotificationPolicy{
+				Triggers: []alarms.Trigger{alarms.CreateTrigger, alarms.ClearTrigger},
+				Receivers: []alarms.Receiver{
+					email,
+					{Type: alarms.SlackReceiver, Token: "token", ChannelID: "C123"},
+					{Type: alarms.WebhookReceiver, URL: "https://example.com/hook"},
+					{Type: alarms.SMSReceiver, To: []string{"+381600000000"}},
+				},
+				QuietHours: &alarms.QuietHours{Start: "22:00", End: "07:00", TimeZone: "Europe/Belgrade"},
+				RateLimit:  &alarms.RateLimit{Count: 10, Period: 60},
+			},
+			valid: true,
+		},
+		{
+			desc:   "missing triggers",
+			policy: alarms.NotificationPolicy{Receivers: []alarms.Receiver{email}},
+			valid:  false,
+		},
+		{
+			desc:   "invalid trigger",
+			policy: alarms.NotificationPolicy{Triggers: []alarms.Trigger{"update"}, Receivers: []alarms.Receiver{email}},
+			valid:  false,
+		},
+		{
+			desc:   "missing receivers",
+			policy: alarms.NotificationPolicy{Triggers: []alarms.Trigger{alarms.CreateTrigger}},
+			valid:  false,
+		},
+		{
+			desc: "invalid receiver type",
+			policy: alarms.NotificationPolicy{
+				Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+				Receivers: []alarms.Receiver{{Type: "pager", To: []string{"ops"}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "email receiver without recipients",
+			policy: alarms.NotificationPolicy{
+				Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+				Receivers: []alarms.Receiver{{Type: alarms.EmailReceiver}},
+			},
+			valid: false,
+		},
+		{
+			desc: "slack receiver without token",
+			policy: alarms.NotificationPolicy{
+				Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+				Receivers: []alarms.Receiver{{Type: alarms.SlackReceiver, ChannelID: "C123"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "webhook receiver with invalid url",
+			policy: alarms.NotificationPolicy{
+				Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+				Receivers: []alarms.Receiver{{Type: alarms.WebhookReceiver, URL: "ftp://example.com"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid quiet hours",
+			policy: alarms.NotificationPolicy{
+				Triggers:   []alarms.Trigger{alarms.CreateTrigger},
+				Receivers:  []alarms.Receiver{email},
+				QuietHours: &alarms.QuietHours{Start: "25:00", End: "07:00"},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid quiet hours time zone",
+			policy: alarms.NotificationPolicy{
+				Triggers:   []alarms.Trigger{alarms.CreateTrigger},
+				Receivers:  []alarms.Receiver{email},
+				QuietHours: &alarms.QuietHours{Start: "22:00", End: "07:00", TimeZone: "Mars/Olympus"},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero rate limit",
+			policy: alarms.NotificationPolicy{
+				Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+				Receivers: []alarms.Receiver{email},
+				RateLimit: &alarms.RateLimit{Count: 0, Period: 60},
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.policy.Validate()
+			assert.Equal(t, tc.valid, err == nil, fmt.Sprintf("%s: unexpected error %v", tc.desc, err))
+		})
+	}
+}
+
+func TestQuietHoursContains(t *testing.T) {
+	cases := []struct {
+		desc     string
+		quiet    alarms.QuietHours
+		time     time.Time
+		contains bool
+	}{
+		{
+			desc:     "inside same day period",
+			quiet:    alarms.QuietHours{Start: "12:00", End: "14:00"},
+			time:     time.Date(2025, 1, 1, 13, 0, 0, 0, time.UTC),
+			contains: true,
+		},
+		{
+			desc:     "at the end of the period",
+			quiet:    alarms.QuietHours{Start: "12:00", End: "14:00"},
+			time:     time.Date(2025, 1, 1, 14, 0, 0, 0, time.UTC),
+			contains: false,
+		},
+		{
+			desc:     "after midnight in overnight period",
+			quiet:    alarms.QuietHours{Start: "22:00", End: "07:00"},
+			time:     time.Date(2025, 1, 1, 3, 0, 0, 0, time.UTC),
+			contains: true,
+		},
+		{
+			desc:     "outside overnight period",
+			quiet:    alarms.QuietHours{Start: "22:00", End: "07:00"},
+			time:     time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			contains: false,
+		},
+		{
+			desc:     "period in time zone",
+			quiet:    alarms.QuietHours{Start: "22:00", End: "07:00", TimeZone: "Asia/Tokyo"},
+			time:     time.Date(2025, 1, 1, 14, 0, 0, 0, time.UTC),
+			contains: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.contains, tc.quiet.Contains(tc.time), tc.desc)
+		})
+	}
+}
+
+func TestStreamFilterMatches(t *testing.T) {
+	alarm := alarms.Alarm{
+		DomainID:  "domain-id",
+		ChannelID: "channel-id",
+		ClientID:  "client-id",
+		Subtopic:  "temperature",
+		Severity:  50,
+	}
+
+	cases := []struct {
+		desc   string
+		filter alarms.StreamFilter
+		match  bool
+	}{
+		{
+			desc:   "empty filter",
+			filter: alarms.StreamFilter{},
+			match:  true,
+		},
+		{
+			desc:   "matching filter",
+			filter: alarms.StreamFilter{ChannelID: "channel-id", ClientID: "client-id", Subtopic: "temperature", MinSeverity: 50},
+			match:  true,
+		},
+		{
+			desc:   "other channel",
+			filter: alarms.StreamFilter{ChannelID: "other-channel-id"},
+			match:  false,
+		},
+		{
+			desc:   "other client",
+			filter: alarms.StreamFilter{ClientID: "other-client-id"},
+			match:  false,
+		},
+		{
+			desc:   "other subtopic",
+			filter: alarms.StreamFilter{Subtopic: "humidity"},
+			match:  false,
+		},
+		{
+			desc:   "higher severity",
+			filter: alarms.StreamFilter{MinSeverity: 51},
+			match:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.match, tc.filter.Matches(alarm))
+		})
+	}
+}
+
+func TestDedupKey(t *testing.T) {
+	alarm := alarms.Alarm{
+		RuleID:      "rule-id",
+		ChannelID:   "channel-id",
+		ClientID:    "client-id",
+		Subtopic:    "temperature",
+		Measurement: "celsius",
+	}
+
+	cases := []struct {
+		desc   string
+		fields []string
+		key    string
+		err    error
+	}{
+		{
+			desc: "default fields",
+			key:  "rule-id/channel-id/client-id/temperature/celsius",
+		},
+		{
+			desc:   "rule and channel",
+			fields: []string{"rule_id", "channel_id"},
+			key:    "rule-id/channel-id",
+		},
+		{
+			desc:   "invalid field",
+			fields: []string{"rule_id", "severity"},
+			err:    alarms.ErrInvalidDedupField,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := alarms.ValidateDedupFields(tc.fields)
+			assert.Equal(t, tc.err, err)
+			if err == nil {
+				assert.Equal(t, tc.key, alarms.DedupKey(alarm, tc.fields))
+			}
+		})
+	}
+}
+
+func TestSuppressionWindowMatches(t *testing.T) {
+	now := time.Now()
+	alarm := alarms.Alarm{
+		DomainID:  "domain-id",
+		ChannelID: "channel-id",
+		ClientID:  "client-id",
+		CreatedAt: now,
+	}
+
+	cases := []struct {
+		desc   string
+		window alarms.SuppressionWindow
+		match  bool
+	}{
+		{
+			desc:   "domain window",
+			window: alarms.SuppressionWindow{DomainID: "domain-id", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			match:  true,
+		},
+		{
+			desc:   "channel and client window",
+			window: alarms.SuppressionWindow{DomainID: "domain-id", ChannelID: "channel-id", ClientID: "client-id", StartsAt: now, EndsAt: now.Add(time.Hour)},
+			match:  true,
+		},
+		{
+			desc:   "other domain",
+			window: alarms.SuppressionWindow{DomainID: "other-domain-id", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			match:  false,
+		},
+		{
+			desc:   "other channel",
+			window: alarms.SuppressionWindow{DomainID: "domain-id", ChannelID: "other-channel-id", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			match:  false,
+		},
+		{
+			desc:   "other client",
+			window: alarms.SuppressionWindow{DomainID: "domain-id", ClientID: "other-client-id", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			match:  false,
+		},
+		{
+			desc:   "ended window",
+			window: alarms.SuppressionWindow{DomainID: "domain-id", StartsAt: now.Add(-time.Hour), EndsAt: now},
+			match:  false,
+		},
+		{
+			desc:   "future window",
+			window: alarms.SuppressionWindow{DomainID: "domain-id", StartsAt: now.Add(time.Minute), EndsAt: now.Add(time.Hour)},
+			match:  false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.match, tc.window.Matches(alarm))
+		})
+	}
+}
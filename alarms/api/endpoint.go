@@ -83,6 +83,29 @@ func listAlarmsEndpoint(svc alarms.Service) endpoint.Endpoint {
 	}
 }
 
+func alarmStatsEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(alarmStatsReq)
+		if err := req.validate(); err != nil {
+			return alarmStatsRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return alarmStatsRes{}, svcerr.ErrAuthorization
+		}
+
+		stats, err := svc.AlarmStats(ctx, session, req.StatsQuery)
+		if err != nil {
+			return alarmStatsRes{}, err
+		}
+
+		return alarmStatsRes{
+			Stats: stats,
+		}, nil
+	}
+}
+
 func deleteAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		req := request.(alarmReq)
@@ -102,3 +125,581 @@ func deleteAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
 		return alarmRes{deleted: true}, nil
 	}
 }
+
+func acknowledgeAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(alarmReq)
+		if err := req.validate(); err != nil {
+			return alarmRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return alarmRes{}, svcerr.ErrAuthorization
+		}
+
+		alarm, err := svc.AcknowledgeAlarm(ctx, session, req.ID)
+		if err != nil {
+			return alarmRes{}, err
+		}
+
+		return alarmRes{
+			Alarm: alarm,
+		}, nil
+	}
+}
+
+func assignAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(assignAlarmReq)
+		if err := req.validate(); err != nil {
+			return alarmRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return alarmRes{}, svcerr.ErrAuthorization
+		}
+
+		alarm, err := svc.AssignAlarm(ctx, session, req.id, req.AssigneeID)
+		if err != nil {
+			return alarmRes{}, err
+		}
+
+		return alarmRes{
+			Alarm: alarm,
+		}, nil
+	}
+}
+
+func resolveAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(alarmReq)
+		if err := req.validate(); err != nil {
+			return alarmRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return alarmRes{}, svcerr.ErrAuthorization
+		}
+
+		alarm, err := svc.ResolveAlarm(ctx, session, req.ID)
+		if err != nil {
+			return alarmRes{}, err
+		}
+
+		return alarmRes{
+			Alarm: alarm,
+		}, nil
+	}
+}
+
+func bulkAlarmsEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(bulkAlarmsReq)
+		if err := req.validate(); err != nil {
+			return bulkAlarmsRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return bulkAlarmsRes{}, svcerr.ErrAuthorization
+		}
+
+		report, err := svc.BulkAlarms(ctx, session, req.BulkRequest)
+		if err != nil {
+			return bulkAlarmsRes{}, err
+		}
+
+		res := bulkAlarmsRes{
+			Action:    report.Action,
+			Succeeded: report.Succeeded,
+			Failed:    report.Failed,
+			Results:   make([]bulkResult, len(report.Results)),
+		}
+		for i, r := range report.Results {
+			res.Results[i] = bulkResult{ID: r.ID}
+			if r.Err != nil {
+				res.Results[i].Error = r.Err.Error()
+			}
+		}
+
+		return res, nil
+	}
+}
+
+func reopenAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(alarmReq)
+		if err := req.validate(); err != nil {
+			return alarmRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return alarmRes{}, svcerr.ErrAuthorization
+		}
+
+		alarm, err := svc.ReopenAlarm(ctx, session, req.ID)
+		if err != nil {
+			return alarmRes{}, err
+		}
+
+		return alarmRes{
+			Alarm: alarm,
+		}, nil
+	}
+}
+
+func snoozeAlarmEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(snoozeAlarmReq)
+		if err := req.validate(); err != nil {
+			return alarmRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return alarmRes{}, svcerr.ErrAuthorization
+		}
+
+		alarm, err := svc.SnoozeAlarm(ctx, session, req.id, req.Until)
+		if err != nil {
+			return alarmRes{}, err
+		}
+
+		return alarmRes{
+			Alarm: alarm,
+		}, nil
+	}
+}
+
+func createEscalationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(escalationPolicyReq)
+		if err := req.validate(); err != nil {
+			return escalationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return escalationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		policy, err := svc.CreateEscalationPolicy(ctx, session, req.EscalationPolicy)
+		if err != nil {
+			return escalationPolicyRes{}, err
+		}
+
+		return escalationPolicyRes{
+			EscalationPolicy: policy,
+			created:          true,
+		}, nil
+	}
+}
+
+func viewEscalationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(escalationPolicyIDReq)
+		if err := req.validate(); err != nil {
+			return escalationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return escalationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		policy, err := svc.ViewEscalationPolicy(ctx, session, req.id)
+		if err != nil {
+			return escalationPolicyRes{}, err
+		}
+
+		return escalationPolicyRes{
+			EscalationPolicy: policy,
+		}, nil
+	}
+}
+
+func updateEscalationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updateEscalationPolicyReq)
+		if err := req.validate(); err != nil {
+			return escalationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return escalationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		policy, err := svc.UpdateEscalationPolicy(ctx, session, req.EscalationPolicy)
+		if err != nil {
+			return escalationPolicyRes{}, err
+		}
+
+		return escalationPolicyRes{
+			EscalationPolicy: policy,
+		}, nil
+	}
+}
+
+func listEscalationPoliciesEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listEscalationPoliciesReq)
+		if err := req.validate(); err != nil {
+			return escalationPoliciesPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return escalationPoliciesPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListEscalationPolicies(ctx, session, req.EscalationPolicyPageMeta)
+		if err != nil {
+			return escalationPoliciesPageRes{}, err
+		}
+
+		return escalationPoliciesPageRes{
+			EscalationPolicyPage: page,
+		}, nil
+	}
+}
+
+func deleteEscalationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(escalationPolicyIDReq)
+		if err := req.validate(); err != nil {
+			return escalationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return escalationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RemoveEscalationPolicy(ctx, session, req.id); err != nil {
+			return escalationPolicyRes{}, err
+		}
+
+		return escalationPolicyRes{deleted: true}, nil
+	}
+}
+
+func createNotificationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(notificationPolicyReq)
+		if err := req.validate(); err != nil {
+			return notificationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return notificationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		policy, err := svc.CreateNotificationPolicy(ctx, session, req.NotificationPolicy)
+		if err != nil {
+			return notificationPolicyRes{}, err
+		}
+
+		return notificationPolicyRes{
+			NotificationPolicy: policy,
+			created:            true,
+		}, nil
+	}
+}
+
+func viewNotificationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(notificationPolicyIDReq)
+		if err := req.validate(); err != nil {
+			return notificationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return notificationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		policy, err := svc.ViewNotificationPolicy(ctx, session, req.id)
+		if err != nil {
+			return notificationPolicyRes{}, err
+		}
+
+		return notificationPolicyRes{
+			NotificationPolicy: policy,
+		}, nil
+	}
+}
+
+func updateNotificationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updateNotificationPolicyReq)
+		if err := req.validate(); err != nil {
+			return notificationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return notificationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		policy, err := svc.UpdateNotificationPolicy(ctx, session, req.NotificationPolicy)
+		if err != nil {
+			return notificationPolicyRes{}, err
+		}
+
+		return notificationPolicyRes{
+			NotificationPolicy: policy,
+		}, nil
+	}
+}
+
+func listNotificationPoliciesEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listNotificationPoliciesReq)
+		if err := req.validate(); err != nil {
+			return notificationPoliciesPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return notificationPoliciesPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListNotificationPolicies(ctx, session, req.NotificationPolicyPageMeta)
+		if err != nil {
+			return notificationPoliciesPageRes{}, err
+		}
+
+		return notificationPoliciesPageRes{
+			NotificationPolicyPage: page,
+		}, nil
+	}
+}
+
+func deleteNotificationPolicyEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(notificationPolicyIDReq)
+		if err := req.validate(); err != nil {
+			return notificationPolicyRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return notificationPolicyRes{}, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RemoveNotificationPolicy(ctx, session, req.id); err != nil {
+			return notificationPolicyRes{}, err
+		}
+
+		return notificationPolicyRes{deleted: true}, nil
+	}
+}
+
+func createSuppressionWindowEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(suppressionWindowReq)
+		if err := req.validate(); err != nil {
+			return suppressionWindowRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return suppressionWindowRes{}, svcerr.ErrAuthorization
+		}
+
+		window, err := svc.CreateSuppressionWindow(ctx, session, req.SuppressionWindow)
+		if err != nil {
+			return suppressionWindowRes{}, err
+		}
+
+		return suppressionWindowRes{
+			SuppressionWindow: window,
+			created:           true,
+		}, nil
+	}
+}
+
+func viewSuppressionWindowEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(suppressionWindowIDReq)
+		if err := req.validate(); err != nil {
+			return suppressionWindowRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return suppressionWindowRes{}, svcerr.ErrAuthorization
+		}
+
+		window, err := svc.ViewSuppressionWindow(ctx, session, req.id)
+		if err != nil {
+			return suppressionWindowRes{}, err
+		}
+
+		return suppressionWindowRes{
+			SuppressionWindow: window,
+		}, nil
+	}
+}
+
+func updateSuppressionWindowEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(updateSuppressionWindowReq)
+		if err := req.validate(); err != nil {
+			return suppressionWindowRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return suppressionWindowRes{}, svcerr.ErrAuthorization
+		}
+
+		window, err := svc.UpdateSuppressionWindow(ctx, session, req.SuppressionWindow)
+		if err != nil {
+			return suppressionWindowRes{}, err
+		}
+
+		return suppressionWindowRes{
+			SuppressionWindow: window,
+		}, nil
+	}
+}
+
+func listSuppressionWindowsEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listSuppressionWindowsReq)
+		if err := req.validate(); err != nil {
+			return suppressionWindowsPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return suppressionWindowsPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListSuppressionWindows(ctx, session, req.SuppressionWindowPageMeta)
+		if err != nil {
+			return suppressionWindowsPageRes{}, err
+		}
+
+		return suppressionWindowsPageRes{
+			SuppressionWindowPage: page,
+		}, nil
+	}
+}
+
+func deleteSuppressionWindowEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(suppressionWindowIDReq)
+		if err := req.validate(); err != nil {
+			return suppressionWindowRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return suppressionWindowRes{}, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RemoveSuppressionWindow(ctx, session, req.id); err != nil {
+			return suppressionWindowRes{}, err
+		}
+
+		return suppressionWindowRes{deleted: true}, nil
+	}
+}
+
+func addCommentEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(commentReq)
+		if err := req.validate(); err != nil {
+			return commentRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return commentRes{}, svcerr.ErrAuthorization
+		}
+
+		comment, err := svc.AddComment(ctx, session, req.Comment)
+		if err != nil {
+			return commentRes{}, err
+		}
+
+		return commentRes{
+			Comment: comment,
+			created: true,
+		}, nil
+	}
+}
+
+func listCommentsEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listCommentsReq)
+		if err := req.validate(); err != nil {
+			return commentsPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return commentsPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListComments(ctx, session, req.CommentPageMeta)
+		if err != nil {
+			return commentsPageRes{}, err
+		}
+
+		return commentsPageRes{
+			CommentsPage: page,
+		}, nil
+	}
+}
+
+func removeCommentEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(commentIDReq)
+		if err := req.validate(); err != nil {
+			return commentRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return commentRes{}, svcerr.ErrAuthorization
+		}
+
+		if err := svc.RemoveComment(ctx, session, req.alarmID, req.id); err != nil {
+			return commentRes{}, err
+		}
+
+		return commentRes{deleted: true}, nil
+	}
+}
+
+func listActivitiesEndpoint(svc alarms.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(listActivitiesReq)
+		if err := req.validate(); err != nil {
+			return activitiesPageRes{}, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return activitiesPageRes{}, svcerr.ErrAuthorization
+		}
+
+		page, err := svc.ListActivities(ctx, session, req.ActivityPageMeta)
+		if err != nil {
+			return activitiesPageRes{}, err
+		}
+
+		return activitiesPageRes{
+			ActivityPage: page,
+		}, nil
+	}
+}
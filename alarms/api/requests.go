@@ -5,6 +5,7 @@ package api
 
 import (
 	"errors"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	api "github.com/absmach/magistrala/api/http"
@@ -31,13 +32,24 @@ func (req updateAlarmReq) validate() error {
 	if req.Alarm.ID == "" {
 		return errors.New("missing alarm id")
 	}
-	if req.Alarm.AssigneeID == "" && req.Alarm.AcknowledgedBy == "" && req.Alarm.ResolvedBy == "" && len(req.Alarm.Metadata) == 0 {
-		return errors.New("at least one of assignee_id, acknowledged_by, resolved_by, or metadata must be set")
+	if req.Alarm.HasLifecycleFields() {
+		return alarms.ErrLifecycleUpdate
+	}
+	if req.Alarm.AssigneeID == "" && len(req.Alarm.Metadata) == 0 {
+		return errors.New("at least one of assignee_id or metadata must be set")
 	}
 
 	return nil
 }
 
+type alarmStatsReq struct {
+	alarms.StatsQuery
+}
+
+func (req alarmStatsReq) validate() error {
+	return req.StatsQuery.Validate()
+}
+
 type listAlarmsReq struct {
 	alarms.PageMetadata
 }
@@ -57,3 +69,272 @@ func (req listAlarmsReq) validate() error {
 
 	return nil
 }
+
+type assignAlarmReq struct {
+	id         string
+	AssigneeID string `json:"assignee_id"`
+}
+
+func (req assignAlarmReq) validate() error {
+	if req.id == "" {
+		return errors.New("missing alarm id")
+	}
+	if req.AssigneeID == "" {
+		return errors.New("missing assignee id")
+	}
+
+	return nil
+}
+
+type bulkAlarmsReq struct {
+	alarms.BulkRequest
+}
+
+func (req bulkAlarmsReq) validate() error {
+	return req.BulkRequest.Validate()
+}
+
+type snoozeAlarmReq struct {
+	id    string
+	Until time.Time `json:"until"`
+}
+
+func (req snoozeAlarmReq) validate() error {
+	if req.id == "" {
+		return errors.New("missing alarm id")
+	}
+	if req.Until.IsZero() {
+		return errors.New("missing snooze time")
+	}
+
+	return nil
+}
+
+type escalationPolicyReq struct {
+	alarms.EscalationPolicy
+}
+
+func (req escalationPolicyReq) validate() error {
+	if req.Name == "" {
+		return apiutil.ErrMissingName
+	}
+	if len(req.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	return nil
+}
+
+type updateEscalationPolicyReq struct {
+	alarms.EscalationPolicy
+}
+
+func (req updateEscalationPolicyReq) validate() error {
+	if req.ID == "" {
+		return errors.New("missing escalation policy id")
+	}
+
+	return escalationPolicyReq(req).validate()
+}
+
+type escalationPolicyIDReq struct {
+	id string
+}
+
+func (req escalationPolicyIDReq) validate() error {
+	if req.id == "" {
+		return errors.New("missing escalation policy id")
+	}
+
+	return nil
+}
+
+type listEscalationPoliciesReq struct {
+	alarms.EscalationPolicyPageMeta
+}
+
+func (req listEscalationPoliciesReq) validate() error {
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type notificationPolicyReq struct {
+	alarms.NotificationPolicy
+}
+
+func (req notificationPolicyReq) validate() error {
+	if req.Name == "" {
+		return apiutil.ErrMissingName
+	}
+	if len(req.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	return nil
+}
+
+type updateNotificationPolicyReq struct {
+	alarms.NotificationPolicy
+}
+
+func (req updateNotificationPolicyReq) validate() error {
+	if req.ID == "" {
+		return errors.New("missing notification policy id")
+	}
+
+	return notificationPolicyReq(req).validate()
+}
+
+type notificationPolicyIDReq struct {
+	id string
+}
+
+func (req notificationPolicyIDReq) validate() error {
+	if req.id == "" {
+		return errors.New("missing notification policy id")
+	}
+
+	return nil
+}
+
+type listNotificationPoliciesReq struct {
+	alarms.NotificationPolicyPageMeta
+}
+
+func (req listNotificationPoliciesReq) validate() error {
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type suppressionWindowReq struct {
+	alarms.SuppressionWindow
+}
+
+func (req suppressionWindowReq) validate() error {
+	if req.Name == "" {
+		return apiutil.ErrMissingName
+	}
+	if len(req.Name) > api.MaxNameSize {
+		return apiutil.ErrNameSize
+	}
+
+	return nil
+}
+
+type updateSuppressionWindowReq struct {
+	alarms.SuppressionWindow
+}
+
+func (req updateSuppressionWindowReq) validate() error {
+	if req.ID == "" {
+		return errors.New("missing suppression window id")
+	}
+
+	return suppressionWindowReq(req).validate()
+}
+
+type suppressionWindowIDReq struct {
+	id string
+}
+
+func (req suppressionWindowIDReq) validate() error {
+	if req.id == "" {
+		return errors.New("missing suppression window id")
+	}
+
+	return nil
+}
+
+type listSuppressionWindowsReq struct {
+	alarms.SuppressionWindowPageMeta
+}
+
+func (req listSuppressionWindowsReq) validate() error {
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type commentReq struct {
+	alarms.Comment
+}
+
+func (req commentReq) validate() error {
+	if req.AlarmID == "" {
+		return errors.New("missing alarm id")
+	}
+
+	return req.Comment.Validate()
+}
+
+type commentIDReq struct {
+	alarmID string
+	id      string
+}
+
+func (req commentIDReq) validate() error {
+	if req.alarmID == "" {
+		return errors.New("missing alarm id")
+	}
+	if req.id == "" {
+		return errors.New("missing comment id")
+	}
+
+	return nil
+}
+
+type listCommentsReq struct {
+	alarms.CommentPageMeta
+}
+
+func (req listCommentsReq) validate() error {
+	if req.AlarmID == "" {
+		return errors.New("missing alarm id")
+	}
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type listActivitiesReq struct {
+	alarms.ActivityPageMeta
+}
+
+func (req listActivitiesReq) validate() error {
+	if req.AlarmID == "" {
+		return errors.New("missing alarm id")
+	}
+	if req.Limit > api.MaxLimitSize || req.Limit < 1 {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type streamAlarmsReq struct {
+	alarms.StreamFilter
+}
+
+type streamMessagesReq struct {
+	channelID string
+	clientID  string
+	subtopic  string
+}
+
+func (req streamMessagesReq) validate() error {
+	if req.channelID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
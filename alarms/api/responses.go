@@ -14,6 +14,17 @@ import (
 var (
 	_ magistrala.Response = (*alarmRes)(nil)
 	_ magistrala.Response = (*alarmsPageRes)(nil)
+	_ magistrala.Response = (*escalationPolicyRes)(nil)
+	_ magistrala.Response = (*escalationPoliciesPageRes)(nil)
+	_ magistrala.Response = (*notificationPolicyRes)(nil)
+	_ magistrala.Response = (*notificationPoliciesPageRes)(nil)
+	_ magistrala.Response = (*suppressionWindowRes)(nil)
+	_ magistrala.Response = (*suppressionWindowsPageRes)(nil)
+	_ magistrala.Response = (*commentRes)(nil)
+	_ magistrala.Response = (*commentsPageRes)(nil)
+	_ magistrala.Response = (*activitiesPageRes)(nil)
+	_ magistrala.Response = (*alarmStatsRes)(nil)
+	_ magistrala.Response = (*bulkAlarmsRes)(nil)
 )
 
 type alarmRes struct {
@@ -53,6 +64,22 @@ func (res alarmRes) Empty() bool {
 	}
 }
 
+type alarmStatsRes struct {
+	alarms.Stats `json:",inline"`
+}
+
+func (res alarmStatsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res alarmStatsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res alarmStatsRes) Empty() bool {
+	return false
+}
+
 type alarmsPageRes struct {
 	alarms.AlarmsPage `json:",inline"`
 }
@@ -68,3 +95,236 @@ func (res alarmsPageRes) Code() int {
 func (res alarmsPageRes) Empty() bool {
 	return false
 }
+
+type escalationPolicyRes struct {
+	alarms.EscalationPolicy `json:",inline"`
+	created                 bool
+	deleted                 bool
+}
+
+func (res escalationPolicyRes) Headers() map[string]string {
+	switch {
+	case res.created:
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/escalations/%s", res.DomainID, res.ID),
+		}
+	default:
+		return map[string]string{}
+	}
+}
+
+func (res escalationPolicyRes) Code() int {
+	switch {
+	case res.created:
+		return http.StatusCreated
+	case res.deleted:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+func (res escalationPolicyRes) Empty() bool {
+	return res.deleted
+}
+
+type escalationPoliciesPageRes struct {
+	alarms.EscalationPolicyPage `json:",inline"`
+}
+
+func (res escalationPoliciesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res escalationPoliciesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res escalationPoliciesPageRes) Empty() bool {
+	return false
+}
+
+type notificationPolicyRes struct {
+	alarms.NotificationPolicy `json:",inline"`
+	created                   bool
+	deleted                   bool
+}
+
+func (res notificationPolicyRes) Headers() map[string]string {
+	switch {
+	case res.created:
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/notifications/%s", res.DomainID, res.ID),
+		}
+	default:
+		return map[string]string{}
+	}
+}
+
+func (res notificationPolicyRes) Code() int {
+	switch {
+	case res.created:
+		return http.StatusCreated
+	case res.deleted:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+func (res notificationPolicyRes) Empty() bool {
+	return res.deleted
+}
+
+type notificationPoliciesPageRes struct {
+	alarms.NotificationPolicyPage `json:",inline"`
+}
+
+func (res notificationPoliciesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res notificationPoliciesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res notificationPoliciesPageRes) Empty() bool {
+	return false
+}
+
+type suppressionWindowRes struct {
+	alarms.SuppressionWindow `json:",inline"`
+	created                  bool
+	deleted                  bool
+}
+
+func (res suppressionWindowRes) Headers() map[string]string {
+	switch {
+	case res.created:
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/suppressions/%s", res.DomainID, res.ID),
+		}
+	default:
+		return map[string]string{}
+	}
+}
+
+func (res suppressionWindowRes) Code() int {
+	switch {
+	case res.created:
+		return http.StatusCreated
+	case res.deleted:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+func (res suppressionWindowRes) Empty() bool {
+	return res.deleted
+}
+
+type suppressionWindowsPageRes struct {
+	alarms.SuppressionWindowPage `json:",inline"`
+}
+
+func (res suppressionWindowsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res suppressionWindowsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res suppressionWindowsPageRes) Empty() bool {
+	return false
+}
+
+type commentRes struct {
+	alarms.Comment `json:",inline"`
+	created        bool
+	deleted        bool
+}
+
+func (res commentRes) Headers() map[string]string {
+	switch {
+	case res.created:
+		return map[string]string{
+			"Location": fmt.Sprintf("/%s/alarms/%s/comments/%s", res.DomainID, res.AlarmID, res.ID),
+		}
+	default:
+		return map[string]string{}
+	}
+}
+
+func (res commentRes) Code() int {
+	switch {
+	case res.created:
+		return http.StatusCreated
+	case res.deleted:
+		return http.StatusNoContent
+	default:
+		return http.StatusOK
+	}
+}
+
+func (res commentRes) Empty() bool {
+	return res.deleted
+}
+
+type commentsPageRes struct {
+	alarms.CommentsPage `json:",inline"`
+}
+
+func (res commentsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res commentsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res commentsPageRes) Empty() bool {
+	return false
+}
+
+type activitiesPageRes struct {
+	alarms.ActivityPage `json:",inline"`
+}
+
+func (res activitiesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res activitiesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res activitiesPageRes) Empty() bool {
+	return false
+}
+
+type bulkResult struct {
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+}
+
+// bulkAlarmsRes reports the outcome of the bulk operation for each alarm.
+type bulkAlarmsRes struct {
+	Action    alarms.BulkAction `json:"action"`
+	Succeeded uint64            `json:"succeeded"`
+	Failed    uint64            `json:"failed"`
+	Results   []bulkResult      `json:"results"`
+}
+
+func (res bulkAlarmsRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res bulkAlarmsRes) Code() int {
+	return http.StatusOK
+}
+
+func (res bulkAlarmsRes) Empty() bool {
+	return false
+}
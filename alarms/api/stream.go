@@ -0,0 +1,377 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/absmach/magistrala"
+	"github.com/absmach/magistrala/alarms"
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
+	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/connections"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/policies"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	alarmEvent      = "alarm"
+	messageEvent    = "message"
+	eventStreamType = "text/event-stream"
+	tokenKey        = "token"
+	messagesBuffer  = 64
+	heartbeatPeriod = 30 * time.Second
+	writeWait       = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// The streams are authenticated by the token, not by the cookies,
+	// so the cross origin dashboards can connect.
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// tokenQueryMiddleware authenticates the request with the token query
+// parameter if the Authorization header isn't set. The browsers can't set
+// the headers of the EventSource and the WebSocket requests.
+func tokenQueryMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			q := r.URL.Query()
+			if token := q.Get(tokenKey); token != "" {
+				r.Header.Set("Authorization", apiutil.BearerPrefix+token)
+				q.Del(tokenKey)
+				r.URL.RawQuery = q.Encode()
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// streamAlarmsHandler streams the domain alarms created from now on.
+func streamAlarmsHandler(svc alarms.Service, logger *slog.Logger) http.HandlerFunc {
+	encodeError := apiutil.LoggingErrorEncoder(logger, api.EncodeError)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		req, err := decodeStreamAlarmsReq(r)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			encodeError(ctx, svcerr.ErrAuthorization, w)
+			return
+		}
+
+		events, err := svc.StreamAlarms(ctx, session, req.StreamFilter)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		serve(ctx, cancel, w, r, alarmEvent, events, logger)
+	}
+}
+
+// streamMessagesHandler streams the live channel messages to the users
+// who can subscribe to the channel.
+func streamMessagesHandler(sub messaging.Subscriber, channels grpcChannelsV1.ChannelsServiceClient, idp magistrala.IDProvider, logger *slog.Logger) http.HandlerFunc {
+	encodeError := apiutil.LoggingErrorEncoder(logger, api.EncodeError)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		req, err := decodeStreamMessagesReq(r)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		if err := req.validate(); err != nil {
+			encodeError(ctx, errors.Wrap(apiutil.ErrValidation, err), w)
+			return
+		}
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			encodeError(ctx, svcerr.ErrAuthorization, w)
+			return
+		}
+		if err := authorizeChannel(ctx, channels, session, req.channelID); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		id, err := idp.ID()
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		h := &messageHandler{
+			clientID: req.clientID,
+			messages: make(chan messageRes, messagesBuffer),
+		}
+		topic := messaging.EncodeTopic(session.DomainID, req.channelID, req.subtopic)
+		subCfg := messaging.SubscriberConfig{
+			ID:             id,
+			ClientID:       session.UserID,
+			Topic:          topic,
+			DeliveryPolicy: messaging.DeliverNewPolicy,
+			Handler:        h,
+		}
+		if err := sub.Subscribe(ctx, subCfg); err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+		defer func() {
+			if err := sub.Unsubscribe(context.Background(), id, topic); err != nil {
+				logger.Warn(fmt.Sprintf("failed to unsubscribe the message stream %s: %s", id, err))
+			}
+		}()
+
+		serve(ctx, cancel, w, r, messageEvent, h.messages, logger)
+	}
+}
+
+func decodeStreamAlarmsReq(r *http.Request) (streamAlarmsReq, error) {
+	channelID, err := apiutil.ReadStringQuery(r, "channel_id", "")
+	if err != nil {
+		return streamAlarmsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	clientID, err := apiutil.ReadStringQuery(r, "client_id", "")
+	if err != nil {
+		return streamAlarmsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	subtopic, err := apiutil.ReadStringQuery(r, "subtopic", "")
+	if err != nil {
+		return streamAlarmsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	severity, err := apiutil.ReadNumQuery[uint16](r, "min_severity", 0)
+	if err != nil {
+		return streamAlarmsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	if severity > uint16(alarms.SeverityMax) {
+		return streamAlarmsReq{}, errors.Wrap(apiutil.ErrValidation, alarms.ErrInvalidSeverity)
+	}
+
+	return streamAlarmsReq{
+		StreamFilter: alarms.StreamFilter{
+			ChannelID:   channelID,
+			ClientID:    clientID,
+			Subtopic:    subtopic,
+			MinSeverity: uint8(severity),
+		},
+	}, nil
+}
+
+func decodeStreamMessagesReq(r *http.Request) (streamMessagesReq, error) {
+	clientID, err := apiutil.ReadStringQuery(r, "client_id", "")
+	if err != nil {
+		return streamMessagesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	subtopic, err := apiutil.ReadStringQuery(r, "subtopic", "")
+	if err != nil {
+		return streamMessagesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	subtopic, err = messaging.ParseSubscribeSubtopic(subtopic)
+	if err != nil {
+		return streamMessagesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return streamMessagesReq{
+		channelID: chi.URLParam(r, "channelID"),
+		clientID:  clientID,
+		subtopic:  subtopic,
+	}, nil
+}
+
+// authorizeChannel checks the session user can subscribe to the channel.
+func authorizeChannel(ctx context.Context, channels grpcChannelsV1.ChannelsServiceClient, session authn.Session, channelID string) error {
+	res, err := channels.Authorize(ctx, &grpcChannelsV1.AuthzReq{
+		ClientId:   session.DomainUserID,
+		ClientType: policies.UserType,
+		Type:       uint32(connections.Subscribe),
+		ChannelId:  channelID,
+		DomainId:   session.DomainID,
+	})
+	if err != nil {
+		return errors.Wrap(svcerr.ErrAuthorization, err)
+	}
+	if !res.GetAuthorized() {
+		return svcerr.ErrAuthorization
+	}
+
+	return nil
+}
+
+// messageHandler passes the channel messages of the client, or of all
+// the clients if the client isn't set, to the stream. The messages are
+// dropped if the stream reader falls behind.
+type messageHandler struct {
+	clientID string
+	messages chan messageRes
+}
+
+func (h *messageHandler) Handle(msg *messaging.Message) error {
+	if h.clientID != "" && h.clientID != msg.GetPublisher() {
+		return nil
+	}
+	select {
+	case h.messages <- toMessageRes(msg):
+	default:
+	}
+
+	return nil
+}
+
+func (h *messageHandler) Cancel() error {
+	return nil
+}
+
+type messageRes struct {
+	Channel   string          `json:"channel"`
+	Subtopic  string          `json:"subtopic,omitempty"`
+	Publisher string          `json:"publisher"`
+	Protocol  string          `json:"protocol"`
+	Payload   json.RawMessage `json:"payload"`
+	Created   int64           `json:"created"`
+}
+
+// toMessageRes keeps the JSON payloads as they are and sends the other
+// payloads as the JSON strings.
+func toMessageRes(msg *messaging.Message) messageRes {
+	payload := json.RawMessage(msg.GetPayload())
+	if !json.Valid(payload) {
+		payload, _ = json.Marshal(string(msg.GetPayload()))
+	}
+
+	return messageRes{
+		Channel:   msg.GetChannel(),
+		Subtopic:  msg.GetSubtopic(),
+		Publisher: msg.GetPublisher(),
+		Protocol:  msg.GetProtocol(),
+		Payload:   payload,
+		Created:   msg.GetCreated(),
+	}
+}
+
+// serve writes the events over the WebSocket if the request asks for the
+// upgrade, and as the server-sent events otherwise. It returns when the
+// client disconnects or the events channel is closed.
+func serve[T any](ctx context.Context, cancel context.CancelFunc, w http.ResponseWriter, r *http.Request, event string, events <-chan T, logger *slog.Logger) {
+	if websocket.IsWebSocketUpgrade(r) {
+		serveWebSocket(ctx, cancel, w, r, events, logger)
+		return
+	}
+	serveEvents(ctx, w, event, events, logger)
+}
+
+func serveEvents[T any](ctx context.Context, w http.ResponseWriter, event string, events <-chan T, logger *slog.Logger) {
+	rc := http.NewResponseController(w)
+	// The stream outlives the server write timeout.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Warn(fmt.Sprintf("failed to clear the event stream write deadline: %s", err))
+	}
+
+	w.Header().Set("Content-Type", eventStreamType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		logger.Warn(fmt.Sprintf("failed to flush the event stream: %s", err))
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatPeriod)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(e)
+			if err != nil {
+				logger.Warn(fmt.Sprintf("failed to encode the %s event: %s", event, err))
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func serveWebSocket[T any](ctx context.Context, cancel context.CancelFunc, w http.ResponseWriter, r *http.Request, events <-chan T, logger *slog.Logger) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader replies with the error.
+		logger.Warn(fmt.Sprintf("failed to upgrade the stream to websocket: %s", err))
+		return
+	}
+	defer conn.Close()
+
+	// The hijacked connection doesn't cancel the request context, so the
+	// reads detect the client closing the connection.
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(heartbeatPeriod)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+			_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeWait))
+			return
+		case <-heartbeat.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait)); err != nil {
+				return
+			}
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+				return
+			}
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -14,17 +14,19 @@ import (
 
 	"github.com/absmach/magistrala"
 	"github.com/absmach/magistrala/alarms"
+	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
 	"github.com/go-chi/chi/v5"
 	kithttp "github.com/go-kit/kit/transport/http"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
-func MakeHandler(svc alarms.Service, logger *slog.Logger, idp magistrala.IDProvider, instanceID string, authn smqauthn.AuthNMiddleware) http.Handler {
+func MakeHandler(svc alarms.Service, sub messaging.Subscriber, channels grpcChannelsV1.ChannelsServiceClient, logger *slog.Logger, idp magistrala.IDProvider, instanceID string, authn smqauthn.AuthNMiddleware) http.Handler {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(apiutil.LoggingErrorEncoder(logger, api.EncodeError)),
 	}
@@ -42,6 +44,18 @@ func MakeHandler(svc alarms.Service, logger *slog.Logger, idp magistrala.IDProvi
 				api.EncodeResponse,
 				opts...,
 			), "list_alarms").ServeHTTP)
+			r.Get("/stats", otelhttp.NewHandler(kithttp.NewServer(
+				alarmStatsEndpoint(svc),
+				decodeAlarmStatsReq,
+				api.EncodeResponse,
+				opts...,
+			), "alarm_stats").ServeHTTP)
+			r.Post("/bulk/{action}", otelhttp.NewHandler(kithttp.NewServer(
+				bulkAlarmsEndpoint(svc),
+				decodeBulkAlarmsReq,
+				api.EncodeResponse,
+				opts...,
+			), "bulk_alarms").ServeHTTP)
 			r.Route("/{alarmID}", func(r chi.Router) {
 				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
 					viewAlarmEndpoint(svc),
@@ -61,10 +75,197 @@ func MakeHandler(svc alarms.Service, logger *slog.Logger, idp magistrala.IDProvi
 					api.EncodeResponse,
 					opts...,
 				), "delete_alarm").ServeHTTP)
+				r.Post("/acknowledge", otelhttp.NewHandler(kithttp.NewServer(
+					acknowledgeAlarmEndpoint(svc),
+					decodeAlarmReq,
+					api.EncodeResponse,
+					opts...,
+				), "acknowledge_alarm").ServeHTTP)
+				r.Post("/assign", otelhttp.NewHandler(kithttp.NewServer(
+					assignAlarmEndpoint(svc),
+					decodeAssignAlarmReq,
+					api.EncodeResponse,
+					opts...,
+				), "assign_alarm").ServeHTTP)
+				r.Post("/resolve", otelhttp.NewHandler(kithttp.NewServer(
+					resolveAlarmEndpoint(svc),
+					decodeAlarmReq,
+					api.EncodeResponse,
+					opts...,
+				), "resolve_alarm").ServeHTTP)
+				r.Post("/reopen", otelhttp.NewHandler(kithttp.NewServer(
+					reopenAlarmEndpoint(svc),
+					decodeAlarmReq,
+					api.EncodeResponse,
+					opts...,
+				), "reopen_alarm").ServeHTTP)
+				r.Post("/snooze", otelhttp.NewHandler(kithttp.NewServer(
+					snoozeAlarmEndpoint(svc),
+					decodeSnoozeAlarmReq,
+					api.EncodeResponse,
+					opts...,
+				), "snooze_alarm").ServeHTTP)
+				r.Get("/timeline", otelhttp.NewHandler(kithttp.NewServer(
+					listActivitiesEndpoint(svc),
+					decodeListActivitiesReq,
+					api.EncodeResponse,
+					opts...,
+				), "list_alarm_activities").ServeHTTP)
+				r.Route("/comments", func(r chi.Router) {
+					r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+						addCommentEndpoint(svc),
+						decodeCommentReq,
+						api.EncodeResponse,
+						opts...,
+					), "add_alarm_comment").ServeHTTP)
+					r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+						listCommentsEndpoint(svc),
+						decodeListCommentsReq,
+						api.EncodeResponse,
+						opts...,
+					), "list_alarm_comments").ServeHTTP)
+					r.Delete("/{commentID}", otelhttp.NewHandler(kithttp.NewServer(
+						removeCommentEndpoint(svc),
+						decodeCommentIDReq,
+						api.EncodeResponse,
+						opts...,
+					), "remove_alarm_comment").ServeHTTP)
+				})
 			})
 		})
 	})
 
+	mux.Route("/{domainID}/escalations", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authn.WithOptions(smqauthn.WithDomainCheck(true)).Middleware())
+			r.Use(api.RequestIDMiddleware(idp))
+
+			r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+				createEscalationPolicyEndpoint(svc),
+				decodeEscalationPolicyReq,
+				api.EncodeResponse,
+				opts...,
+			), "create_escalation_policy").ServeHTTP)
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				listEscalationPoliciesEndpoint(svc),
+				decodeListEscalationPoliciesReq,
+				api.EncodeResponse,
+				opts...,
+			), "list_escalation_policies").ServeHTTP)
+			r.Route("/{policyID}", func(r chi.Router) {
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					viewEscalationPolicyEndpoint(svc),
+					decodeEscalationPolicyIDReq,
+					api.EncodeResponse,
+					opts...,
+				), "view_escalation_policy").ServeHTTP)
+				r.Put("/", otelhttp.NewHandler(kithttp.NewServer(
+					updateEscalationPolicyEndpoint(svc),
+					decodeUpdateEscalationPolicyReq,
+					api.EncodeResponse,
+					opts...,
+				), "update_escalation_policy").ServeHTTP)
+				r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+					deleteEscalationPolicyEndpoint(svc),
+					decodeEscalationPolicyIDReq,
+					api.EncodeResponse,
+					opts...,
+				), "delete_escalation_policy").ServeHTTP)
+			})
+		})
+	})
+
+	mux.Route("/{domainID}/notifications", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authn.WithOptions(smqauthn.WithDomainCheck(true)).Middleware())
+			r.Use(api.RequestIDMiddleware(idp))
+
+			r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+				createNotificationPolicyEndpoint(svc),
+				decodeNotificationPolicyReq,
+				api.EncodeResponse,
+				opts...,
+			), "create_notification_policy").ServeHTTP)
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				listNotificationPoliciesEndpoint(svc),
+				decodeListNotificationPoliciesReq,
+				api.EncodeResponse,
+				opts...,
+			), "list_notification_policies").ServeHTTP)
+			r.Route("/{policyID}", func(r chi.Router) {
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					viewNotificationPolicyEndpoint(svc),
+					decodeNotificationPolicyIDReq,
+					api.EncodeResponse,
+					opts...,
+				), "view_notification_policy").ServeHTTP)
+				r.Put("/", otelhttp.NewHandler(kithttp.NewServer(
+					updateNotificationPolicyEndpoint(svc),
+					decodeUpdateNotificationPolicyReq,
+					api.EncodeResponse,
+					opts...,
+				), "update_notification_policy").ServeHTTP)
+				r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+					deleteNotificationPolicyEndpoint(svc),
+					decodeNotificationPolicyIDReq,
+					api.EncodeResponse,
+					opts...,
+				), "delete_notification_policy").ServeHTTP)
+			})
+		})
+	})
+
+	mux.Route("/{domainID}/suppressions", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(authn.WithOptions(smqauthn.WithDomainCheck(true)).Middleware())
+			r.Use(api.RequestIDMiddleware(idp))
+
+			r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+				createSuppressionWindowEndpoint(svc),
+				decodeSuppressionWindowReq,
+				api.EncodeResponse,
+				opts...,
+			), "create_suppression_window").ServeHTTP)
+			r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+				listSuppressionWindowsEndpoint(svc),
+				decodeListSuppressionWindowsReq,
+				api.EncodeResponse,
+				opts...,
+			), "list_suppression_windows").ServeHTTP)
+			r.Route("/{windowID}", func(r chi.Router) {
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					viewSuppressionWindowEndpoint(svc),
+					decodeSuppressionWindowIDReq,
+					api.EncodeResponse,
+					opts...,
+				), "view_suppression_window").ServeHTTP)
+				r.Put("/", otelhttp.NewHandler(kithttp.NewServer(
+					updateSuppressionWindowEndpoint(svc),
+					decodeUpdateSuppressionWindowReq,
+					api.EncodeResponse,
+					opts...,
+				), "update_suppression_window").ServeHTTP)
+				r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+					deleteSuppressionWindowEndpoint(svc),
+					decodeSuppressionWindowIDReq,
+					api.EncodeResponse,
+					opts...,
+				), "delete_suppression_window").ServeHTTP)
+			})
+		})
+	})
+
+	mux.Route("/{domainID}/streams", func(r chi.Router) {
+		r.Group(func(r chi.Router) {
+			r.Use(tokenQueryMiddleware)
+			r.Use(authn.WithOptions(smqauthn.WithDomainCheck(true)).Middleware())
+			r.Use(api.RequestIDMiddleware(idp))
+
+			r.Get("/alarms", otelhttp.NewHandler(streamAlarmsHandler(svc, logger), "stream_alarms").ServeHTTP)
+			r.Get("/channels/{channelID}", otelhttp.NewHandler(streamMessagesHandler(sub, channels, idp, logger), "stream_messages").ServeHTTP)
+		})
+	})
+
 	mux.Get("/health", magistrala.Health("alarms", instanceID))
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -185,6 +386,33 @@ func decodeListAlarmsReq(_ context.Context, r *http.Request) (any, error) {
 	}, nil
 }
 
+// decodeAlarmStatsReq reads the list alarms filters and the comma separated
+// group by fields.
+func decodeAlarmStatsReq(ctx context.Context, r *http.Request) (any, error) {
+	req, err := decodeListAlarmsReq(ctx, r)
+	if err != nil {
+		return alarmStatsReq{}, err
+	}
+	groupBy, err := apiutil.ReadStringQuery(r, "group_by", "")
+	if err != nil {
+		return alarmStatsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	bucket, err := apiutil.ReadStringQuery(r, "bucket", "")
+	if err != nil {
+		return alarmStatsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	q := alarms.StatsQuery{
+		PageMetadata: req.(listAlarmsReq).PageMetadata,
+		Bucket:       bucket,
+	}
+	if groupBy != "" {
+		q.GroupBy = strings.Split(groupBy, ",")
+	}
+
+	return alarmStatsReq{StatsQuery: q}, nil
+}
+
 func decodeAlarmReq(_ context.Context, r *http.Request) (any, error) {
 	return alarmReq{
 		Alarm: alarms.Alarm{
@@ -207,3 +435,293 @@ func decodeUpdateAlarmReq(_ context.Context, r *http.Request) (any, error) {
 
 	return req, nil
 }
+
+func decodeAssignAlarmReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return assignAlarmReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := assignAlarmReq{id: chi.URLParam(r, "alarmID")}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return assignAlarmReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeSnoozeAlarmReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return snoozeAlarmReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := snoozeAlarmReq{id: chi.URLParam(r, "alarmID")}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return snoozeAlarmReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+// decodeBulkAlarmsReq reads the alarm IDs or the filter with the list
+// alarms fields. The filter matches all the statuses and severities unless
+// they're set.
+func decodeBulkAlarmsReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return bulkAlarmsReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	body := struct {
+		IDs        []string        `json:"ids"`
+		Filter     json.RawMessage `json:"filter"`
+		AssigneeID string          `json:"assignee_id"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return bulkAlarmsReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	req := bulkAlarmsReq{
+		BulkRequest: alarms.BulkRequest{
+			Action:     alarms.BulkAction(chi.URLParam(r, "action")),
+			IDs:        body.IDs,
+			AssigneeID: body.AssigneeID,
+		},
+	}
+	if len(body.Filter) > 0 && string(body.Filter) != "null" {
+		filter := alarms.PageMetadata{
+			Status:   alarms.AllStatus,
+			Severity: math.MaxUint8,
+		}
+		if err := json.Unmarshal(body.Filter, &filter); err != nil {
+			return bulkAlarmsReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+		}
+		req.Filter = &filter
+	}
+
+	return req, nil
+}
+
+func decodeCommentReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return commentReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := commentReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.Comment); err != nil {
+		return commentReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	req.AlarmID = chi.URLParam(r, "alarmID")
+
+	return req, nil
+}
+
+func decodeCommentIDReq(_ context.Context, r *http.Request) (any, error) {
+	return commentIDReq{
+		alarmID: chi.URLParam(r, "alarmID"),
+		id:      chi.URLParam(r, "commentID"),
+	}, nil
+}
+
+func decodeListCommentsReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listCommentsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listCommentsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listCommentsReq{
+		CommentPageMeta: alarms.CommentPageMeta{
+			Offset:  offset,
+			Limit:   limit,
+			AlarmID: chi.URLParam(r, "alarmID"),
+		},
+	}, nil
+}
+
+func decodeListActivitiesReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listActivitiesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listActivitiesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listActivitiesReq{
+		ActivityPageMeta: alarms.ActivityPageMeta{
+			Offset:  offset,
+			Limit:   limit,
+			AlarmID: chi.URLParam(r, "alarmID"),
+		},
+	}, nil
+}
+
+func decodeEscalationPolicyReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return escalationPolicyReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := escalationPolicyReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.EscalationPolicy); err != nil {
+		return escalationPolicyReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateEscalationPolicyReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return updateEscalationPolicyReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateEscalationPolicyReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.EscalationPolicy); err != nil {
+		return updateEscalationPolicyReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	req.ID = chi.URLParam(r, "policyID")
+
+	return req, nil
+}
+
+func decodeEscalationPolicyIDReq(_ context.Context, r *http.Request) (any, error) {
+	return escalationPolicyIDReq{id: chi.URLParam(r, "policyID")}, nil
+}
+
+func decodeListEscalationPoliciesReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listEscalationPoliciesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listEscalationPoliciesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	ruleID, err := apiutil.ReadStringQuery(r, "rule_id", "")
+	if err != nil {
+		return listEscalationPoliciesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listEscalationPoliciesReq{
+		EscalationPolicyPageMeta: alarms.EscalationPolicyPageMeta{
+			Offset: offset,
+			Limit:  limit,
+			RuleID: ruleID,
+		},
+	}, nil
+}
+
+func decodeNotificationPolicyReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return notificationPolicyReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := notificationPolicyReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.NotificationPolicy); err != nil {
+		return notificationPolicyReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateNotificationPolicyReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return updateNotificationPolicyReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateNotificationPolicyReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.NotificationPolicy); err != nil {
+		return updateNotificationPolicyReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	req.ID = chi.URLParam(r, "policyID")
+
+	return req, nil
+}
+
+func decodeNotificationPolicyIDReq(_ context.Context, r *http.Request) (any, error) {
+	return notificationPolicyIDReq{id: chi.URLParam(r, "policyID")}, nil
+}
+
+func decodeListNotificationPoliciesReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listNotificationPoliciesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listNotificationPoliciesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	ruleID, err := apiutil.ReadStringQuery(r, "rule_id", "")
+	if err != nil {
+		return listNotificationPoliciesReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listNotificationPoliciesReq{
+		NotificationPolicyPageMeta: alarms.NotificationPolicyPageMeta{
+			Offset: offset,
+			Limit:  limit,
+			RuleID: ruleID,
+		},
+	}, nil
+}
+
+func decodeSuppressionWindowReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return suppressionWindowReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := suppressionWindowReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.SuppressionWindow); err != nil {
+		return suppressionWindowReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return req, nil
+}
+
+func decodeUpdateSuppressionWindowReq(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return updateSuppressionWindowReq{}, apiutil.ErrUnsupportedContentType
+	}
+
+	req := updateSuppressionWindowReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.SuppressionWindow); err != nil {
+		return updateSuppressionWindowReq{}, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	req.ID = chi.URLParam(r, "windowID")
+
+	return req, nil
+}
+
+func decodeSuppressionWindowIDReq(_ context.Context, r *http.Request) (any, error) {
+	return suppressionWindowIDReq{id: chi.URLParam(r, "windowID")}, nil
+}
+
+func decodeListSuppressionWindowsReq(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return listSuppressionWindowsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return listSuppressionWindowsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	channelID, err := apiutil.ReadStringQuery(r, "channel_id", "")
+	if err != nil {
+		return listSuppressionWindowsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	clientID, err := apiutil.ReadStringQuery(r, "client_id", "")
+	if err != nil {
+		return listSuppressionWindowsReq{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listSuppressionWindowsReq{
+		SuppressionWindowPageMeta: alarms.SuppressionWindowPageMeta{
+			Offset:    offset,
+			Limit:     limit,
+			ChannelID: channelID,
+			ClientID:  clientID,
+		},
+	}, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"math"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// MaxBulkAlarms is the maximum number of the alarms one bulk operation
+// changes. The filter selects the first MaxBulkAlarms matching alarms.
+const MaxBulkAlarms = 1000
+
+var (
+	errBulkAction    = errors.NewRequestError("invalid bulk action")
+	errBulkSelection = errors.NewRequestError("bulk operation requires either the alarm ids or the filter")
+	errBulkSize      = errors.NewRequestError("too many alarms in the bulk operation")
+	errBulkAssignee  = errors.NewRequestError("bulk assign requires the assignee")
+)
+
+// BulkAction is the operation applied to all the selected alarms.
+type BulkAction string
+
+const (
+	AcknowledgeBulkAction BulkAction = "acknowledge"
+	AssignBulkAction      BulkAction = "assign"
+	ResolveBulkAction     BulkAction = "resolve"
+	DeleteBulkAction      BulkAction = "delete"
+)
+
+// BulkRequest selects the alarms of the bulk operation either by IDs or by
+// the filter.
+type BulkRequest struct {
+	Action     BulkAction    `json:"action"`
+	IDs        []string      `json:"ids,omitempty"`
+	Filter     *PageMetadata `json:"filter,omitempty"`
+	AssigneeID string        `json:"assignee_id,omitempty"`
+}
+
+func (req BulkRequest) Validate() error {
+	switch req.Action {
+	case AcknowledgeBulkAction, ResolveBulkAction, DeleteBulkAction:
+	case AssignBulkAction:
+		if req.AssigneeID == "" {
+			return errBulkAssignee
+		}
+	default:
+		return errBulkAction
+	}
+
+	if (len(req.IDs) == 0) == (req.Filter == nil) {
+		return errBulkSelection
+	}
+	if len(req.IDs) > MaxBulkAlarms {
+		return errBulkSize
+	}
+
+	return nil
+}
+
+// Selection returns the page metadata that lists the selected alarms of
+// the domain.
+func (req BulkRequest) Selection(domainID string) PageMetadata {
+	pm := PageMetadata{
+		Status:   AllStatus,
+		Severity: math.MaxUint8,
+		IDs:      req.IDs,
+	}
+	if req.Filter != nil {
+		pm = *req.Filter
+	}
+	pm.Offset = 0
+	pm.Limit = MaxBulkAlarms
+	pm.DomainID = domainID
+
+	return pm
+}
+
+// AlarmChange is the alarm change the bulk operation saves if the alarm
+// status is still the From status.
+type AlarmChange struct {
+	Alarm Alarm
+	From  Status
+}
+
+// BulkResult is the outcome of the bulk operation for one alarm.
+type BulkResult struct {
+	ID  string `json:"id"`
+	Err error  `json:"-"`
+}
+
+type BulkReport struct {
+	Action    BulkAction   `json:"action"`
+	Succeeded uint64       `json:"succeeded"`
+	Failed    uint64       `json:"failed"`
+	Results   []BulkResult `json:"results"`
+}
+
+// Add adds the outcome of the operation for the alarm to the report.
+func (r *BulkReport) Add(id string, err error) {
+	if err != nil {
+		r.Failed++
+	} else {
+		r.Succeeded++
+	}
+	r.Results = append(r.Results, BulkResult{ID: id, Err: err})
+}
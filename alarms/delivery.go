@@ -0,0 +1,129 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+)
+
+// enqueue queues the notification about the alarm change without blocking
+// the alarm processing.
+func (s *service) enqueue(alarm Alarm, trigger Trigger) {
+	// The suppressed and the flapping alarms are recorded without the notifications.
+	if alarm.Suppressed || alarm.Flapping {
+		return
+	}
+	select {
+	case s.notifications <- Notification{Trigger: trigger, Alarm: alarm}:
+	default:
+		s.runInfo <- pkglog.RunInfo{
+			Level:   slog.LevelWarn,
+			Message: "notification dropped, the delivery queue is full",
+			Details: []slog.Attr{
+				slog.String("domain_id", alarm.DomainID),
+				slog.String("alarm_id", alarm.ID),
+				slog.String("trigger", string(trigger)),
+			},
+		}
+	}
+}
+
+const (
+	// deliveryWorkers is the number of the notifications delivered at the
+	// same time, so a slow receiver doesn't hold back the other ones.
+	deliveryWorkers = 8
+	// sendTimeout limits sending the notification to a single receiver.
+	sendTimeout = 30 * time.Second
+)
+
+// deliver sends the queued notifications with the delivery workers until
+// the context is canceled.
+func (s *service) deliver(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range deliveryWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case n := <-s.notifications:
+					s.notify(ctx, n)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// notify sends the notification to the receivers of the matching policies
+// that aren't in the quiet hours or over the rate limit.
+func (s *service) notify(ctx context.Context, n Notification) {
+	policies, err := s.repo.MatchNotificationPolicies(ctx, n.Alarm)
+	if err != nil {
+		s.runInfo <- pkglog.RunInfo{
+			Level:   slog.LevelError,
+			Message: fmt.Sprintf("failed to list notification policies: %s", err),
+			Details: []slog.Attr{slog.String("domain_id", n.Alarm.DomainID), slog.String("alarm_id", n.Alarm.ID)},
+		}
+		return
+	}
+
+	now := time.Now()
+	for _, p := range policies {
+		if !p.Matches(n.Alarm, n.Trigger) {
+			continue
+		}
+		if p.QuietHours != nil && p.QuietHours.Contains(now) {
+			continue
+		}
+		details := []slog.Attr{
+			slog.String("domain_id", p.DomainID),
+			slog.String("policy_id", p.ID),
+			slog.String("alarm_id", n.Alarm.ID),
+			slog.String("trigger", string(n.Trigger)),
+		}
+		if !s.limiter.allow(p, now) {
+			s.runInfo <- pkglog.RunInfo{
+				Level:   slog.LevelWarn,
+				Message: "notification skipped, the policy rate limit is reached",
+				Details: details,
+			}
+			continue
+		}
+
+		n.PolicyID = p.ID
+		for _, r := range p.Receivers {
+			if err := s.secrets.open(&r); err != nil {
+				s.runInfo <- pkglog.RunInfo{
+					Level:   slog.LevelError,
+					Message: fmt.Sprintf("failed to open %s receiver secrets: %s", r.Type, err),
+					Details: details,
+				}
+				continue
+			}
+			if err := s.send(ctx, r, n); err != nil {
+				s.runInfo <- pkglog.RunInfo{
+					Level:   slog.LevelError,
+					Message: fmt.Sprintf("failed to send %s notification: %s", r.Type, err),
+					Details: details,
+				}
+			}
+		}
+	}
+}
+
+func (s *service) send(ctx context.Context, r Receiver, n Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	return s.sender.Send(ctx, r, n)
+}
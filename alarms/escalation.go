@@ -0,0 +1,101 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const maxEscalationLevels = 10
+
+var (
+	errNoLevels       = errors.NewRequestError("escalation policy must have at least one level")
+	errTooManyLevels  = errors.NewRequestError("escalation policy has too many levels")
+	errLevelAfter     = errors.NewRequestError("escalation level times must be positive and increasing")
+	errLevelAction    = errors.NewRequestError("escalation level must raise the severity or notify a group")
+	errLevelSeverity  = errors.NewRequestError("escalation level severity must be between 0 and 100")
+	errPolicySeverity = errors.NewRequestError("escalation policy minimum severity must be between 0 and 100")
+)
+
+// EscalationPolicy escalates the active alarms of the domain that aren't
+// acknowledged in time. The policy applies to the alarms of the rule, or
+// to all the domain alarms if the rule is empty.
+type EscalationPolicy struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	DomainID    string            `json:"domain_id"`
+	RuleID      string            `json:"rule_id,omitempty"`
+	MinSeverity uint8             `json:"min_severity"`
+	Levels      []EscalationLevel `json:"levels"`
+	CreatedAt   time.Time         `json:"created_at"`
+	CreatedBy   string            `json:"created_by"`
+	UpdatedAt   time.Time         `json:"updated_at,omitempty"`
+	UpdatedBy   string            `json:"updated_by,omitempty"`
+}
+
+// EscalationLevel applies to the alarm if it isn't acknowledged within
+// After minutes of being raised. The alarm severity is raised to Severity,
+// and the GroupID group is notified.
+type EscalationLevel struct {
+	After    uint32 `json:"after"`
+	Severity uint8  `json:"severity,omitempty"`
+	GroupID  string `json:"group_id,omitempty"`
+}
+
+// Validate checks the levels are ordered by time and each of them does something.
+func (p EscalationPolicy) Validate() error {
+	if p.MinSeverity > SeverityMax {
+		return errPolicySeverity
+	}
+	switch {
+	case len(p.Levels) == 0:
+		return errNoLevels
+	case len(p.Levels) > maxEscalationLevels:
+		return errTooManyLevels
+	}
+	var after uint32
+	for _, l := range p.Levels {
+		if l.After <= after {
+			return errLevelAfter
+		}
+		after = l.After
+		if l.Severity > SeverityMax {
+			return errLevelSeverity
+		}
+		if l.Severity == 0 && l.GroupID == "" {
+			return errLevelAction
+		}
+	}
+
+	return nil
+}
+
+type EscalationPolicyPageMeta struct {
+	Offset   uint64 `json:"offset"`
+	Limit    uint64 `json:"limit"`
+	Total    uint64 `json:"total"`
+	DomainID string `json:"domain_id,omitempty"`
+	RuleID   string `json:"rule_id,omitempty"`
+}
+
+type EscalationPolicyPage struct {
+	Offset   uint64             `json:"offset"`
+	Limit    uint64             `json:"limit"`
+	Total    uint64             `json:"total"`
+	Policies []EscalationPolicy `json:"policies"`
+}
+
+// Notifier publishes the alarm events.
+type Notifier interface {
+	// Notify notifies the group about the escalated alarm.
+	Notify(ctx context.Context, alarm Alarm, groupID string) error
+	// NotifyActivity publishes the alarm timeline activity.
+	NotifyActivity(ctx context.Context, activity Activity) error
+	// NotifyCreate publishes the created alarm to the streams of all the
+	// service instances.
+	NotifyCreate(ctx context.Context, alarm Alarm) error
+}
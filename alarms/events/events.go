@@ -0,0 +1,103 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/events"
+)
+
+const (
+	alarmPrefix   = "alarm."
+	alarmEscalate = alarmPrefix + "escalate"
+	alarmActivity = alarmPrefix + "activity"
+	alarmCreate   = alarmPrefix + "create"
+)
+
+var (
+	_ events.Event = (*escalateAlarmEvent)(nil)
+	_ events.Event = (*activityEvent)(nil)
+	_ events.Event = (*createAlarmEvent)(nil)
+)
+
+type escalateAlarmEvent struct {
+	alarms.Alarm
+	groupID string
+}
+
+func (eae escalateAlarmEvent) Encode() (map[string]any, error) {
+	val := map[string]any{
+		"operation":        alarmEscalate,
+		"id":               eae.ID,
+		"rule_id":          eae.RuleID,
+		"domain":           eae.DomainID,
+		"channel_id":       eae.ChannelID,
+		"client_id":        eae.ClientID,
+		"subtopic":         eae.Subtopic,
+		"status":           eae.Status.String(),
+		"measurement":      eae.Measurement,
+		"value":            eae.Value,
+		"unit":             eae.Unit,
+		"threshold":        eae.Threshold,
+		"cause":            eae.Cause,
+		"severity":         eae.Severity,
+		"escalation_level": eae.EscalationLevel,
+		"group_id":         eae.groupID,
+		"created_at":       eae.CreatedAt.Format(time.RFC3339Nano),
+		"escalated_at":     eae.EscalatedAt.Format(time.RFC3339Nano),
+	}
+	if eae.AssigneeID != "" {
+		val["assignee_id"] = eae.AssigneeID
+	}
+
+	return val, nil
+}
+
+// activityEvent is named after the activity type, such as
+// alarm.acknowledged, so the journal lists the alarm timeline.
+type activityEvent struct {
+	alarms.Activity
+}
+
+func (ae activityEvent) Encode() (map[string]any, error) {
+	val := map[string]any{
+		"operation":  alarmPrefix + string(ae.Type),
+		"id":         ae.AlarmID,
+		"activity":   ae.ID,
+		"domain":     ae.DomainID,
+		"created_at": ae.CreatedAt.Format(time.RFC3339Nano),
+	}
+	if ae.ActorID != "" {
+		val["user_id"] = ae.ActorID
+	}
+	if len(ae.Details) > 0 {
+		val["details"] = ae.Details
+	}
+
+	return val, nil
+}
+
+// createAlarmEvent carries the created alarm with the alarm JSON keys, so
+// the streams of the other service instances decode it as is.
+type createAlarmEvent struct {
+	alarms.Alarm
+}
+
+func (cae createAlarmEvent) Encode() (map[string]any, error) {
+	data, err := json.Marshal(cae.Alarm)
+	if err != nil {
+		return nil, err
+	}
+	val := map[string]any{}
+	if err := json.Unmarshal(data, &val); err != nil {
+		return nil, err
+	}
+	val["operation"] = alarmCreate
+	val["domain"] = cae.DomainID
+
+	return val, nil
+}
@@ -0,0 +1,50 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/store"
+)
+
+const (
+	magistralaPrefix = "magistrala."
+	EscalateStream   = magistralaPrefix + alarmEscalate
+	ActivityStream   = magistralaPrefix + alarmActivity
+	CreateStream     = magistralaPrefix + alarmCreate
+)
+
+var _ alarms.Notifier = (*notifier)(nil)
+
+type notifier struct {
+	publisher events.Publisher
+}
+
+// NewNotifier returns the notifier that publishes the escalated alarms and
+// the alarm activities to the event store, where the notification services
+// and the journal pick them up. The created alarms are published for the
+// alarm streams of the service instances.
+func NewNotifier(ctx context.Context, url string) (alarms.Notifier, error) {
+	publisher, err := store.NewPublisher(ctx, url, "alarms-es-pub")
+	if err != nil {
+		return nil, err
+	}
+
+	return &notifier{publisher: publisher}, nil
+}
+
+func (n *notifier) Notify(ctx context.Context, alarm alarms.Alarm, groupID string) error {
+	return n.publisher.Publish(ctx, EscalateStream, escalateAlarmEvent{Alarm: alarm, groupID: groupID})
+}
+
+func (n *notifier) NotifyActivity(ctx context.Context, activity alarms.Activity) error {
+	return n.publisher.Publish(ctx, ActivityStream, activityEvent{Activity: activity})
+}
+
+func (n *notifier) NotifyCreate(ctx context.Context, alarm alarms.Alarm) error {
+	return n.publisher.Publish(ctx, CreateStream, createAlarmEvent{Alarm: alarm})
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/store"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+const (
+	createStream = "events." + CreateStream
+
+	// streamInactiveThreshold removes the stream consumers of the stopped
+	// instances, since the instance IDs are generated on each start.
+	streamInactiveThreshold = 10 * time.Minute
+)
+
+var errDecodeAlarm = errors.New("failed to decode created alarm event")
+
+type streamHandler struct {
+	hub    *alarms.StreamHub
+	logger *slog.Logger
+}
+
+// StreamEventsSubscribe sends the alarms created by all the service
+// instances to the streams of the hub. Each instance has its own streams,
+// so the consumer name must be unique per instance.
+func StreamEventsSubscribe(ctx context.Context, hub *alarms.StreamHub, esURL, esConsumerName string, logger *slog.Logger) error {
+	subscriber, err := store.NewSubscriber(ctx, esURL, "alarms-stream-es-sub", logger)
+	if err != nil {
+		return err
+	}
+
+	subConfig := events.SubscriberConfig{
+		Stream:            createStream,
+		Consumer:          esConsumerName,
+		Handler:           NewStreamHandler(hub, logger),
+		DeliveryPolicy:    messaging.DeliverNewPolicy,
+		InactiveThreshold: streamInactiveThreshold,
+	}
+
+	return subscriber.Subscribe(ctx, subConfig)
+}
+
+// NewStreamHandler returns the event handler that sends the created alarms
+// to the streams of the hub.
+func NewStreamHandler(hub *alarms.StreamHub, logger *slog.Logger) events.EventHandler {
+	return &streamHandler{hub: hub, logger: logger}
+}
+
+func (h *streamHandler) Handle(ctx context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+	if op, _ := msg["operation"].(string); op != alarmCreate {
+		return nil
+	}
+
+	alarm, err := decodeAlarm(msg)
+	if err != nil {
+		return err
+	}
+	if dropped := h.hub.Publish(alarm); dropped > 0 {
+		h.logger.Warn("alarm dropped by the streams that fall behind",
+			slog.String("domain_id", alarm.DomainID),
+			slog.String("alarm_id", alarm.ID),
+			slog.Int("streams", dropped),
+		)
+	}
+
+	return nil
+}
+
+// decodeAlarm returns the alarm of the created alarm event.
+func decodeAlarm(msg map[string]any) (alarms.Alarm, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDecodeAlarm, err)
+	}
+	var alarm alarms.Alarm
+	if err := json.Unmarshal(data, &alarm); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDecodeAlarm, err)
+	}
+	alarm.DomainID = events.Read(msg, "domain", alarm.DomainID)
+
+	return alarm, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAlarm(t *testing.T) {
+	alarm := alarms.Alarm{
+		ID:          "alarm-id",
+		RuleID:      "rule-id",
+		DomainID:    "domain-id",
+		ChannelID:   "channel-id",
+		ClientID:    "client-id",
+		Subtopic:    "room/1",
+		Status:      alarms.ActiveStatus,
+		Measurement: "temperature",
+		Value:       "90",
+		Unit:        "C",
+		Cause:       "too hot",
+		Severity:    80,
+		CreatedAt:   time.Now().UTC().Truncate(time.Microsecond),
+		Metadata:    alarms.Metadata{"floor": "2"},
+	}
+
+	msg, err := createAlarmEvent{Alarm: alarm}.Encode()
+	require.Nil(t, err, fmt.Sprintf("encode created alarm: unexpected error %s", err))
+	assert.Equal(t, alarmCreate, msg["operation"])
+	assert.Equal(t, alarm.DomainID, msg["domain"])
+
+	// The event store sends the event as JSON.
+	data, err := json.Marshal(msg)
+	require.Nil(t, err, fmt.Sprintf("marshal created alarm event: unexpected error %s", err))
+	var received map[string]any
+	require.Nil(t, json.Unmarshal(data, &received))
+
+	decoded, err := decodeAlarm(received)
+	assert.Nil(t, err, fmt.Sprintf("decode created alarm: unexpected error %s", err))
+	assert.Equal(t, alarm, decoded)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"slices"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+var (
+	// ErrInvalidTransition indicates the alarm can't change from its current status.
+	ErrInvalidTransition = errors.NewRequestError("invalid alarm status transition")
+
+	// ErrSnoozeTime indicates the snooze time isn't in the future.
+	ErrSnoozeTime = errors.NewRequestError("snooze time must be in the future")
+
+	// ErrLifecycleUpdate indicates the alarm update sets the fields that only
+	// the lifecycle operations change.
+	ErrLifecycleUpdate = errors.NewRequestError("alarm status, acknowledgement, resolution, snooze and escalation can't be updated directly")
+)
+
+// transitions lists the statuses the users can change each status to. Rules
+// raise the alarms as active or cleared, and the snoozed alarms become
+// active again when the snooze expires.
+var transitions = map[Status][]Status{
+	ActiveStatus:       {AcknowledgedStatus, ResolvedStatus, SnoozedStatus},
+	AcknowledgedStatus: {ResolvedStatus, SnoozedStatus},
+	SnoozedStatus:      {AcknowledgedStatus, ResolvedStatus},
+	ClearedStatus:      {ResolvedStatus},
+	ResolvedStatus:     {ActiveStatus},
+}
+
+// CanTransition reports whether the status can change to the given status.
+func (s Status) CanTransition(to Status) bool {
+	return slices.Contains(transitions[s], to)
+}
+
+// HasLifecycleFields reports whether the alarm sets any of the fields that
+// only the lifecycle operations change.
+func (a Alarm) HasLifecycleFields() bool {
+	return a.Status != ActiveStatus ||
+		!a.AcknowledgedAt.IsZero() || a.AcknowledgedBy != "" ||
+		!a.ResolvedAt.IsZero() || a.ResolvedBy != "" ||
+		!a.SnoozedUntil.IsZero() || a.SnoozedBy != "" ||
+		a.EscalationLevel != 0 || !a.EscalatedAt.IsZero()
+}
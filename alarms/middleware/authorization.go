@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	"github.com/absmach/magistrala/alarms/operations"
@@ -15,14 +16,28 @@ import (
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
 	"github.com/absmach/magistrala/pkg/permissions"
 	"github.com/absmach/magistrala/pkg/policies"
+	reops "github.com/absmach/magistrala/re/operations"
 )
 
 var (
-	errDomainUpdateAlarms = errors.New("not authorized to update alarms in domain")
-	errDomainDeleteAlarms = errors.New("not authorized to delete alarms in domain")
-	errDomainViewAlarms   = errors.New("not authorized to view alarms in domain")
+	errDomainUpdateAlarms  = errors.New("not authorized to update alarms in domain")
+	errDomainDeleteAlarms  = errors.New("not authorized to delete alarms in domain")
+	errDomainViewAlarms    = errors.New("not authorized to view alarms in domain")
+	errDomainEscalations   = errors.New("not authorized to manage escalation policies in domain")
+	errDomainNotifications = errors.New("not authorized to manage notification policies in domain")
+	errDomainSuppressions  = errors.New("not authorized to manage suppression windows in domain")
+	errDomainComments      = errors.New("not authorized to comment alarms in domain")
 )
 
+// bulkOperations are the alarm operations of the bulk actions. The rules
+// have the same alarm permissions as the domain, except for the delete.
+var bulkOperations = map[alarms.BulkAction]permissions.Operation{
+	alarms.AcknowledgeBulkAction: operations.OpAcknowledgeAlarm,
+	alarms.AssignBulkAction:      operations.OpAssignAlarm,
+	alarms.ResolveBulkAction:     operations.OpResolveAlarm,
+	alarms.DeleteBulkAction:      operations.OpDeleteAlarm,
+}
+
 type authorizationMiddleware struct {
 	svc         alarms.Service
 	authz       smqauthz.Authorization
@@ -58,33 +73,217 @@ func (am *authorizationMiddleware) UpdateAlarm(ctx context.Context, session auth
 		if err := am.authorize(ctx, operations.OpAssignAlarm, session, policies.DomainType, session.DomainID); err != nil {
 			return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
 		}
-		domainUserID := auth.EncodeDomainUserID(session.DomainID, alarm.AssigneeID)
-		if err := am.authz.Authorize(ctx, smqauthz.PolicyReq{
-			Domain:      session.DomainID,
-			SubjectType: policies.UserType,
-			SubjectKind: policies.UsersKind,
-			Subject:     domainUserID,
-			Permission:  policies.MembershipPermission,
-			ObjectType:  policies.DomainType,
-			Object:      session.DomainID,
-		}, nil); err != nil {
+		if err := am.checkDomainMember(ctx, session, alarm.AssigneeID); err != nil {
 			return alarms.Alarm{}, err
 		}
 	}
 
-	if alarm.AcknowledgedBy != "" {
-		if err := am.authorize(ctx, operations.OpAcknowledgeAlarm, session, policies.DomainType, session.DomainID); err != nil {
-			return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	return am.svc.UpdateAlarm(ctx, session, alarm)
+}
+
+func (am *authorizationMiddleware) AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	if err := am.authorize(ctx, operations.OpAcknowledgeAlarm, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	}
+
+	return am.svc.AcknowledgeAlarm(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) AssignAlarm(ctx context.Context, session authn.Session, id, assigneeID string) (alarms.Alarm, error) {
+	if err := am.authorize(ctx, operations.OpAssignAlarm, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	}
+	if err := am.checkDomainMember(ctx, session, assigneeID); err != nil {
+		return alarms.Alarm{}, err
+	}
+
+	return am.svc.AssignAlarm(ctx, session, id, assigneeID)
+}
+
+func (am *authorizationMiddleware) ResolveAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	if err := am.authorize(ctx, operations.OpResolveAlarm, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	}
+
+	return am.svc.ResolveAlarm(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) ReopenAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	if err := am.authorize(ctx, operations.OpReopenAlarm, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	}
+
+	return am.svc.ReopenAlarm(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (alarms.Alarm, error) {
+	if err := am.authorize(ctx, operations.OpSnoozeAlarm, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	}
+
+	return am.svc.SnoozeAlarm(ctx, session, id, until)
+}
+
+func (am *authorizationMiddleware) BulkAlarms(ctx context.Context, session authn.Session, req alarms.BulkRequest) (alarms.BulkReport, error) {
+	op, ok := bulkOperations[req.Action]
+	if !ok {
+		return am.svc.BulkAlarms(ctx, session, req)
+	}
+	if req.Action == alarms.AssignBulkAction && req.AssigneeID != "" {
+		if err := am.checkDomainMember(ctx, session, req.AssigneeID); err != nil {
+			return alarms.BulkReport{}, err
 		}
 	}
+	switch err := am.checkSuperAdmin(ctx, session); {
+	case err == nil:
+		session.SuperAdmin = true
+	case errors.Contains(err, svcerr.ErrSuperAdminAction):
+	default:
+		return alarms.BulkReport{}, err
+	}
 
-	if alarm.ResolvedBy != "" {
-		if err := am.authorize(ctx, operations.OpResolveAlarm, session, policies.DomainType, session.DomainID); err != nil {
-			return alarms.Alarm{}, errors.Wrap(errDomainUpdateAlarms, err)
+	err := am.authorize(ctx, op, session, policies.DomainType, session.DomainID)
+	switch {
+	case err == nil:
+		return am.svc.BulkAlarms(ctx, session, req)
+	case req.Action == alarms.DeleteBulkAction:
+		return alarms.BulkReport{}, errors.Wrap(errDomainDeleteAlarms, err)
+	}
+	if err := req.Validate(); err != nil {
+		return alarms.BulkReport{}, err
+	}
+
+	// The user can't change all the domain alarms, so each selected alarm
+	// is authorized on its rule and only the allowed ones are changed.
+	page, err := am.svc.ListAlarms(ctx, session, req.Selection(session.DomainID))
+	if err != nil {
+		return alarms.BulkReport{}, err
+	}
+	rules := make(map[string]error)
+	denied := make(map[string]bool)
+	var allowed []string
+	for _, a := range page.Alarms {
+		err, ok := rules[a.RuleID]
+		if !ok {
+			err = am.authorize(ctx, op, session, reops.EntityType, a.RuleID)
+			rules[a.RuleID] = err
+		}
+		if err != nil {
+			denied[a.ID] = true
+			continue
 		}
+		allowed = append(allowed, a.ID)
 	}
 
-	return am.svc.UpdateAlarm(ctx, session, alarm)
+	// The requested IDs that aren't listed are reported as not found.
+	if len(req.IDs) > 0 {
+		allowed = nil
+		for _, id := range req.IDs {
+			if !denied[id] {
+				allowed = append(allowed, id)
+			}
+		}
+	}
+
+	report := alarms.BulkReport{Action: req.Action}
+	if len(allowed) > 0 {
+		req.IDs = allowed
+		req.Filter = nil
+		if report, err = am.svc.BulkAlarms(ctx, session, req); err != nil {
+			return report, err
+		}
+	}
+	for _, a := range page.Alarms {
+		if denied[a.ID] {
+			report.Add(a.ID, errors.Wrap(errDomainUpdateAlarms, svcerr.ErrAuthorization))
+		}
+	}
+
+	return report, nil
+}
+
+func (am *authorizationMiddleware) CreateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	if err := am.authorize(ctx, operations.OpCreateEscalationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(errDomainEscalations, err)
+	}
+
+	return am.svc.CreateEscalationPolicy(ctx, session, policy)
+}
+
+func (am *authorizationMiddleware) ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (alarms.EscalationPolicy, error) {
+	if err := am.authorize(ctx, operations.OpViewEscalationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(errDomainEscalations, err)
+	}
+
+	return am.svc.ViewEscalationPolicy(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	if err := am.authorize(ctx, operations.OpUpdateEscalationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(errDomainEscalations, err)
+	}
+
+	return am.svc.UpdateEscalationPolicy(ctx, session, policy)
+}
+
+func (am *authorizationMiddleware) ListEscalationPolicies(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error) {
+	if err := am.authorize(ctx, operations.OpViewEscalationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.EscalationPolicyPage{}, errors.Wrap(errDomainEscalations, err)
+	}
+
+	return am.svc.ListEscalationPolicies(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) error {
+	if err := am.authorize(ctx, operations.OpDeleteEscalationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return errors.Wrap(errDomainEscalations, err)
+	}
+
+	return am.svc.RemoveEscalationPolicy(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) CreateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	if err := am.authorize(ctx, operations.OpCreateNotificationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(errDomainNotifications, err)
+	}
+
+	return am.svc.CreateNotificationPolicy(ctx, session, policy)
+}
+
+func (am *authorizationMiddleware) ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (alarms.NotificationPolicy, error) {
+	if err := am.authorize(ctx, operations.OpViewNotificationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(errDomainNotifications, err)
+	}
+
+	return am.svc.ViewNotificationPolicy(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	if err := am.authorize(ctx, operations.OpUpdateNotificationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(errDomainNotifications, err)
+	}
+
+	return am.svc.UpdateNotificationPolicy(ctx, session, policy)
+}
+
+func (am *authorizationMiddleware) ListNotificationPolicies(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+	if err := am.authorize(ctx, operations.OpViewNotificationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.NotificationPolicyPage{}, errors.Wrap(errDomainNotifications, err)
+	}
+
+	return am.svc.ListNotificationPolicies(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) error {
+	if err := am.authorize(ctx, operations.OpDeleteNotificationPolicy, session, policies.DomainType, session.DomainID); err != nil {
+		return errors.Wrap(errDomainNotifications, err)
+	}
+
+	return am.svc.RemoveNotificationPolicy(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) StartScheduler(ctx context.Context) error {
+	return am.svc.StartScheduler(ctx)
 }
 
 func (am *authorizationMiddleware) DeleteAlarm(ctx context.Context, session authn.Session, id string) error {
@@ -111,6 +310,22 @@ func (am *authorizationMiddleware) ListAlarms(ctx context.Context, session authn
 	return am.svc.ListAlarms(ctx, session, pm)
 }
 
+func (am *authorizationMiddleware) AlarmStats(ctx context.Context, session authn.Session, q alarms.StatsQuery) (alarms.Stats, error) {
+	if q.DomainID == "" {
+		q.DomainID = session.DomainID
+	}
+
+	switch err := am.checkSuperAdmin(ctx, session); {
+	case err == nil:
+		session.SuperAdmin = true
+	case errors.Contains(err, svcerr.ErrSuperAdminAction):
+	default:
+		return alarms.Stats{}, err
+	}
+
+	return am.svc.AlarmStats(ctx, session, q)
+}
+
 func (am *authorizationMiddleware) ViewAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
 	if err := am.authorize(ctx, operations.OpViewAlarm, session, policies.DomainType, session.DomainID); err != nil {
 		return alarms.Alarm{}, errors.Wrap(errDomainViewAlarms, err)
@@ -119,6 +334,100 @@ func (am *authorizationMiddleware) ViewAlarm(ctx context.Context, session authn.
 	return am.svc.ViewAlarm(ctx, session, id)
 }
 
+func (am *authorizationMiddleware) CreateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	if err := am.authorize(ctx, operations.OpCreateSuppressionWindow, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.SuppressionWindow{}, errors.Wrap(errDomainSuppressions, err)
+	}
+
+	return am.svc.CreateSuppressionWindow(ctx, session, window)
+}
+
+func (am *authorizationMiddleware) ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (alarms.SuppressionWindow, error) {
+	if err := am.authorize(ctx, operations.OpViewSuppressionWindow, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.SuppressionWindow{}, errors.Wrap(errDomainSuppressions, err)
+	}
+
+	return am.svc.ViewSuppressionWindow(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) UpdateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	if err := am.authorize(ctx, operations.OpUpdateSuppressionWindow, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.SuppressionWindow{}, errors.Wrap(errDomainSuppressions, err)
+	}
+
+	return am.svc.UpdateSuppressionWindow(ctx, session, window)
+}
+
+func (am *authorizationMiddleware) ListSuppressionWindows(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error) {
+	if err := am.authorize(ctx, operations.OpViewSuppressionWindow, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.SuppressionWindowPage{}, errors.Wrap(errDomainSuppressions, err)
+	}
+
+	return am.svc.ListSuppressionWindows(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) error {
+	if err := am.authorize(ctx, operations.OpDeleteSuppressionWindow, session, policies.DomainType, session.DomainID); err != nil {
+		return errors.Wrap(errDomainSuppressions, err)
+	}
+
+	return am.svc.RemoveSuppressionWindow(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) StreamAlarms(ctx context.Context, session authn.Session, filter alarms.StreamFilter) (<-chan alarms.Alarm, error) {
+	if err := am.authorize(ctx, operations.OpViewAlarm, session, policies.DomainType, session.DomainID); err != nil {
+		return nil, errors.Wrap(errDomainViewAlarms, err)
+	}
+	switch err := am.checkSuperAdmin(ctx, session); {
+	case err == nil:
+		session.SuperAdmin = true
+	case errors.Contains(err, svcerr.ErrSuperAdminAction):
+	default:
+		return nil, err
+	}
+
+	return am.svc.StreamAlarms(ctx, session, filter)
+}
+
+func (am *authorizationMiddleware) AddComment(ctx context.Context, session authn.Session, comment alarms.Comment) (alarms.Comment, error) {
+	if err := am.authorize(ctx, operations.OpCreateComment, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.Comment{}, errors.Wrap(errDomainComments, err)
+	}
+
+	return am.svc.AddComment(ctx, session, comment)
+}
+
+func (am *authorizationMiddleware) ListComments(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta) (alarms.CommentsPage, error) {
+	if err := am.authorize(ctx, operations.OpListComments, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.CommentsPage{}, errors.Wrap(errDomainViewAlarms, err)
+	}
+
+	return am.svc.ListComments(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RemoveComment(ctx context.Context, session authn.Session, alarmID, id string) error {
+	if err := am.authorize(ctx, operations.OpDeleteComment, session, policies.DomainType, session.DomainID); err != nil {
+		return errors.Wrap(errDomainComments, err)
+	}
+	switch err := am.checkSuperAdmin(ctx, session); {
+	case err == nil:
+		session.SuperAdmin = true
+	case errors.Contains(err, svcerr.ErrSuperAdminAction):
+	default:
+		return err
+	}
+
+	return am.svc.RemoveComment(ctx, session, alarmID, id)
+}
+
+func (am *authorizationMiddleware) ListActivities(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error) {
+	if err := am.authorize(ctx, operations.OpViewTimeline, session, policies.DomainType, session.DomainID); err != nil {
+		return alarms.ActivityPage{}, errors.Wrap(errDomainViewAlarms, err)
+	}
+
+	return am.svc.ListActivities(ctx, session, pm)
+}
+
 func (am *authorizationMiddleware) authorize(ctx context.Context, op permissions.Operation, session authn.Session, objType, obj string) error {
 	perm, err := am.entitiesOps.GetPermission(operations.EntityType, op)
 	if err != nil {
@@ -155,6 +464,22 @@ func (am *authorizationMiddleware) authorize(ctx context.Context, op permissions
 	return nil
 }
 
+// checkDomainMember checks the user is a member of the session domain, so
+// the alarms are only assigned within the domain.
+func (am *authorizationMiddleware) checkDomainMember(ctx context.Context, session authn.Session, userID string) error {
+	domainUserID := auth.EncodeDomainUserID(session.DomainID, userID)
+
+	return am.authz.Authorize(ctx, smqauthz.PolicyReq{
+		Domain:      session.DomainID,
+		SubjectType: policies.UserType,
+		SubjectKind: policies.UsersKind,
+		Subject:     domainUserID,
+		Permission:  policies.MembershipPermission,
+		ObjectType:  policies.DomainType,
+		Object:      session.DomainID,
+	}, nil)
+}
+
 func (am *authorizationMiddleware) checkSuperAdmin(ctx context.Context, session authn.Session) error {
 	if session.Role != authn.SuperAdminRole {
 		return svcerr.ErrSuperAdminAction
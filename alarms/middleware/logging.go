@@ -92,6 +92,218 @@ func (lm *loggingMiddleware) UpdateAlarm(ctx context.Context, session authn.Sess
 	return lm.service.UpdateAlarm(ctx, session, alarm)
 }
 
+func (lm *loggingMiddleware) CreateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (p alarms.SuppressionWindow, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("suppression_window",
+				slog.String("id", p.ID),
+				slog.String("name", window.Name),
+				slog.String("channel_id", window.ChannelID),
+				slog.String("client_id", window.ClientID),
+				slog.Time("starts_at", window.StartsAt),
+				slog.Time("ends_at", window.EndsAt),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Create suppression window failed", args...)
+			return
+		}
+		lm.logger.Info("Create suppression window completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.CreateSuppressionWindow(ctx, session, window)
+}
+
+func (lm *loggingMiddleware) ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (p alarms.SuppressionWindow, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("View suppression window failed", args...)
+			return
+		}
+		lm.logger.Info("View suppression window completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewSuppressionWindow(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) UpdateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (p alarms.SuppressionWindow, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("suppression_window",
+				slog.String("id", window.ID),
+				slog.String("name", window.Name),
+				slog.String("channel_id", window.ChannelID),
+				slog.String("client_id", window.ClientID),
+				slog.Time("starts_at", window.StartsAt),
+				slog.Time("ends_at", window.EndsAt),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Update suppression window failed", args...)
+			return
+		}
+		lm.logger.Info("Update suppression window completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.UpdateSuppressionWindow(ctx, session, window)
+}
+
+func (lm *loggingMiddleware) ListSuppressionWindows(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta) (page alarms.SuppressionWindowPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Int("offset", int(pm.Offset)),
+			slog.Int("limit", int(pm.Limit)),
+			slog.String("channel_id", pm.ChannelID),
+			slog.String("client_id", pm.ClientID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List suppression windows failed", args...)
+			return
+		}
+		lm.logger.Info("List suppression windows completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListSuppressionWindows(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Remove suppression window failed", args...)
+			return
+		}
+		lm.logger.Info("Remove suppression window completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveSuppressionWindow(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) AddComment(ctx context.Context, session authn.Session, comment alarms.Comment) (c alarms.Comment, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("comment",
+				slog.String("id", c.ID),
+				slog.String("alarm_id", comment.AlarmID),
+				slog.String("parent_id", comment.ParentID),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Add alarm comment failed", args...)
+			return
+		}
+		lm.logger.Info("Add alarm comment completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.AddComment(ctx, session, comment)
+}
+
+func (lm *loggingMiddleware) ListComments(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta) (page alarms.CommentsPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("alarm_id", pm.AlarmID),
+			slog.Int("offset", int(pm.Offset)),
+			slog.Int("limit", int(pm.Limit)),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List alarm comments failed", args...)
+			return
+		}
+		lm.logger.Info("List alarm comments completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListComments(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) RemoveComment(ctx context.Context, session authn.Session, alarmID, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("alarm_id", alarmID),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Remove alarm comment failed", args...)
+			return
+		}
+		lm.logger.Info("Remove alarm comment completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveComment(ctx, session, alarmID, id)
+}
+
+func (lm *loggingMiddleware) ListActivities(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta) (page alarms.ActivityPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("alarm_id", pm.AlarmID),
+			slog.Int("offset", int(pm.Offset)),
+			slog.Int("limit", int(pm.Limit)),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List alarm activities failed", args...)
+			return
+		}
+		lm.logger.Info("List alarm activities completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListActivities(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) StreamAlarms(ctx context.Context, session authn.Session, filter alarms.StreamFilter) (ch <-chan alarms.Alarm, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("filter",
+				slog.String("channel_id", filter.ChannelID),
+				slog.String("client_id", filter.ClientID),
+				slog.String("subtopic", filter.Subtopic),
+				slog.Uint64("min_severity", uint64(filter.MinSeverity)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Stream alarms failed", args...)
+			return
+		}
+		lm.logger.Info("Stream alarms started successfully", args...)
+	}(time.Now())
+
+	return lm.service.StreamAlarms(ctx, session, filter)
+}
+
 func (lm *loggingMiddleware) ViewAlarm(ctx context.Context, session authn.Session, id string) (dba alarms.Alarm, err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -136,6 +348,27 @@ func (lm *loggingMiddleware) ListAlarms(ctx context.Context, session authn.Sessi
 	return lm.service.ListAlarms(ctx, session, pm)
 }
 
+func (lm *loggingMiddleware) AlarmStats(ctx context.Context, session authn.Session, q alarms.StatsQuery) (stats alarms.Stats, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("domain_id", q.DomainID),
+			slog.Any("group_by", q.GroupBy),
+			slog.String("bucket", q.Bucket),
+			slog.Int("groups", len(stats.Groups)),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Alarm stats failed", args...)
+			return
+		}
+		lm.logger.Info("Alarm stats completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.AlarmStats(ctx, session, q)
+}
+
 func (lm *loggingMiddleware) DeleteAlarm(ctx context.Context, session authn.Session, id string) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
@@ -153,3 +386,341 @@ func (lm *loggingMiddleware) DeleteAlarm(ctx context.Context, session authn.Sess
 
 	return lm.service.DeleteAlarm(ctx, session, id)
 }
+
+func (lm *loggingMiddleware) AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (a alarms.Alarm, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+			slog.String("status", a.Status.String()),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Acknowledge alarm failed", args...)
+			return
+		}
+		lm.logger.Info("Acknowledge alarm completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.AcknowledgeAlarm(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ResolveAlarm(ctx context.Context, session authn.Session, id string) (a alarms.Alarm, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+			slog.String("status", a.Status.String()),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Resolve alarm failed", args...)
+			return
+		}
+		lm.logger.Info("Resolve alarm completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ResolveAlarm(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ReopenAlarm(ctx context.Context, session authn.Session, id string) (a alarms.Alarm, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+			slog.String("status", a.Status.String()),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Reopen alarm failed", args...)
+			return
+		}
+		lm.logger.Info("Reopen alarm completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ReopenAlarm(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) AssignAlarm(ctx context.Context, session authn.Session, id, assigneeID string) (a alarms.Alarm, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+			slog.String("assignee_id", assigneeID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Assign alarm failed", args...)
+			return
+		}
+		lm.logger.Info("Assign alarm completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.AssignAlarm(ctx, session, id, assigneeID)
+}
+
+func (lm *loggingMiddleware) BulkAlarms(ctx context.Context, session authn.Session, req alarms.BulkRequest) (report alarms.BulkReport, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("action", string(req.Action)),
+			slog.Int("ids", len(req.IDs)),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Bulk alarms operation failed", args...)
+			return
+		}
+		args = append(args,
+			slog.Uint64("succeeded", report.Succeeded),
+			slog.Uint64("failed", report.Failed),
+		)
+		lm.logger.Info("Bulk alarms operation completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.BulkAlarms(ctx, session, req)
+}
+
+func (lm *loggingMiddleware) SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (a alarms.Alarm, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+			slog.Time("until", until),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Snooze alarm failed", args...)
+			return
+		}
+		lm.logger.Info("Snooze alarm completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.SnoozeAlarm(ctx, session, id, until)
+}
+
+func (lm *loggingMiddleware) CreateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (p alarms.EscalationPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("escalation_policy",
+				slog.String("id", p.ID),
+				slog.String("name", policy.Name),
+				slog.String("rule_id", policy.RuleID),
+				slog.Int("levels", len(policy.Levels)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Create escalation policy failed", args...)
+			return
+		}
+		lm.logger.Info("Create escalation policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.CreateEscalationPolicy(ctx, session, policy)
+}
+
+func (lm *loggingMiddleware) ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (p alarms.EscalationPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("View escalation policy failed", args...)
+			return
+		}
+		lm.logger.Info("View escalation policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewEscalationPolicy(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (p alarms.EscalationPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("escalation_policy",
+				slog.String("id", policy.ID),
+				slog.String("name", policy.Name),
+				slog.String("rule_id", policy.RuleID),
+				slog.Int("levels", len(policy.Levels)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Update escalation policy failed", args...)
+			return
+		}
+		lm.logger.Info("Update escalation policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.UpdateEscalationPolicy(ctx, session, policy)
+}
+
+func (lm *loggingMiddleware) ListEscalationPolicies(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta) (page alarms.EscalationPolicyPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Int("offset", int(pm.Offset)),
+			slog.Int("limit", int(pm.Limit)),
+			slog.String("rule_id", pm.RuleID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List escalation policies failed", args...)
+			return
+		}
+		lm.logger.Info("List escalation policies completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListEscalationPolicies(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Remove escalation policy failed", args...)
+			return
+		}
+		lm.logger.Info("Remove escalation policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveEscalationPolicy(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) CreateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (p alarms.NotificationPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("notification_policy",
+				slog.String("id", p.ID),
+				slog.String("name", policy.Name),
+				slog.String("rule_id", policy.RuleID),
+				slog.Int("receivers", len(policy.Receivers)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Create notification policy failed", args...)
+			return
+		}
+		lm.logger.Info("Create notification policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.CreateNotificationPolicy(ctx, session, policy)
+}
+
+func (lm *loggingMiddleware) ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (p alarms.NotificationPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("View notification policy failed", args...)
+			return
+		}
+		lm.logger.Info("View notification policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ViewNotificationPolicy(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (p alarms.NotificationPolicy, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Group("notification_policy",
+				slog.String("id", policy.ID),
+				slog.String("name", policy.Name),
+				slog.String("rule_id", policy.RuleID),
+				slog.Int("receivers", len(policy.Receivers)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Update notification policy failed", args...)
+			return
+		}
+		lm.logger.Info("Update notification policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.UpdateNotificationPolicy(ctx, session, policy)
+}
+
+func (lm *loggingMiddleware) ListNotificationPolicies(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta) (page alarms.NotificationPolicyPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.Int("offset", int(pm.Offset)),
+			slog.Int("limit", int(pm.Limit)),
+			slog.String("rule_id", pm.RuleID),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("List notification policies failed", args...)
+			return
+		}
+		lm.logger.Info("List notification policies completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.ListNotificationPolicies(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("request_id", middleware.GetReqID(ctx)),
+			slog.String("id", id),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Remove notification policy failed", args...)
+			return
+		}
+		lm.logger.Info("Remove notification policy completed successfully", args...)
+	}(time.Now())
+
+	return lm.service.RemoveNotificationPolicy(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) StartScheduler(ctx context.Context) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Alarms scheduler stopped", args...)
+			return
+		}
+		lm.logger.Info("Alarms scheduler stopped", args...)
+	}(time.Now())
+
+	return lm.service.StartScheduler(ctx)
+}
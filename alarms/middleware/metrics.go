@@ -46,6 +46,96 @@ func (mm *metricsMiddleware) UpdateAlarm(ctx context.Context, session authn.Sess
 	return mm.service.UpdateAlarm(ctx, session, alarm)
 }
 
+func (mm *metricsMiddleware) CreateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "create_suppression_window").Add(1)
+		mm.latency.With("method", "create_suppression_window").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.CreateSuppressionWindow(ctx, session, window)
+}
+
+func (mm *metricsMiddleware) ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (alarms.SuppressionWindow, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_suppression_window").Add(1)
+		mm.latency.With("method", "view_suppression_window").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewSuppressionWindow(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) UpdateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "update_suppression_window").Add(1)
+		mm.latency.With("method", "update_suppression_window").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.UpdateSuppressionWindow(ctx, session, window)
+}
+
+func (mm *metricsMiddleware) ListSuppressionWindows(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_suppression_windows").Add(1)
+		mm.latency.With("method", "list_suppression_windows").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListSuppressionWindows(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_suppression_window").Add(1)
+		mm.latency.With("method", "remove_suppression_window").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemoveSuppressionWindow(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) AddComment(ctx context.Context, session authn.Session, comment alarms.Comment) (alarms.Comment, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "add_comment").Add(1)
+		mm.latency.With("method", "add_comment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.AddComment(ctx, session, comment)
+}
+
+func (mm *metricsMiddleware) ListComments(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta) (alarms.CommentsPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_comments").Add(1)
+		mm.latency.With("method", "list_comments").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListComments(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) RemoveComment(ctx context.Context, session authn.Session, alarmID, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_comment").Add(1)
+		mm.latency.With("method", "remove_comment").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemoveComment(ctx, session, alarmID, id)
+}
+
+func (mm *metricsMiddleware) ListActivities(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_activities").Add(1)
+		mm.latency.With("method", "list_activities").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListActivities(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) StreamAlarms(ctx context.Context, session authn.Session, filter alarms.StreamFilter) (<-chan alarms.Alarm, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "stream_alarms").Add(1)
+		mm.latency.With("method", "stream_alarms").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.StreamAlarms(ctx, session, filter)
+}
+
 func (mm *metricsMiddleware) ViewAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "get_alarm").Add(1)
@@ -64,6 +154,15 @@ func (mm *metricsMiddleware) ListAlarms(ctx context.Context, session authn.Sessi
 	return mm.service.ListAlarms(ctx, session, pm)
 }
 
+func (mm *metricsMiddleware) AlarmStats(ctx context.Context, session authn.Session, q alarms.StatsQuery) (alarms.Stats, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "alarm_stats").Add(1)
+		mm.latency.With("method", "alarm_stats").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.AlarmStats(ctx, session, q)
+}
+
 func (mm *metricsMiddleware) DeleteAlarm(ctx context.Context, session authn.Session, id string) error {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "delete_alarm").Add(1)
@@ -72,3 +171,151 @@ func (mm *metricsMiddleware) DeleteAlarm(ctx context.Context, session authn.Sess
 
 	return mm.service.DeleteAlarm(ctx, session, id)
 }
+
+func (mm *metricsMiddleware) AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "acknowledge_alarm").Add(1)
+		mm.latency.With("method", "acknowledge_alarm").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.AcknowledgeAlarm(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) AssignAlarm(ctx context.Context, session authn.Session, id, assigneeID string) (alarms.Alarm, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "assign_alarm").Add(1)
+		mm.latency.With("method", "assign_alarm").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.AssignAlarm(ctx, session, id, assigneeID)
+}
+
+func (mm *metricsMiddleware) BulkAlarms(ctx context.Context, session authn.Session, req alarms.BulkRequest) (alarms.BulkReport, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "bulk_alarms").Add(1)
+		mm.latency.With("method", "bulk_alarms").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.BulkAlarms(ctx, session, req)
+}
+
+func (mm *metricsMiddleware) ResolveAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "resolve_alarm").Add(1)
+		mm.latency.With("method", "resolve_alarm").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ResolveAlarm(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) ReopenAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "reopen_alarm").Add(1)
+		mm.latency.With("method", "reopen_alarm").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ReopenAlarm(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (alarms.Alarm, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "snooze_alarm").Add(1)
+		mm.latency.With("method", "snooze_alarm").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.SnoozeAlarm(ctx, session, id, until)
+}
+
+func (mm *metricsMiddleware) CreateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "create_escalation_policy").Add(1)
+		mm.latency.With("method", "create_escalation_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.CreateEscalationPolicy(ctx, session, policy)
+}
+
+func (mm *metricsMiddleware) ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (alarms.EscalationPolicy, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_escalation_policy").Add(1)
+		mm.latency.With("method", "view_escalation_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewEscalationPolicy(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "update_escalation_policy").Add(1)
+		mm.latency.With("method", "update_escalation_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.UpdateEscalationPolicy(ctx, session, policy)
+}
+
+func (mm *metricsMiddleware) ListEscalationPolicies(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_escalation_policies").Add(1)
+		mm.latency.With("method", "list_escalation_policies").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListEscalationPolicies(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_escalation_policy").Add(1)
+		mm.latency.With("method", "remove_escalation_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemoveEscalationPolicy(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) CreateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "create_notification_policy").Add(1)
+		mm.latency.With("method", "create_notification_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.CreateNotificationPolicy(ctx, session, policy)
+}
+
+func (mm *metricsMiddleware) ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (alarms.NotificationPolicy, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_notification_policy").Add(1)
+		mm.latency.With("method", "view_notification_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewNotificationPolicy(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "update_notification_policy").Add(1)
+		mm.latency.With("method", "update_notification_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.UpdateNotificationPolicy(ctx, session, policy)
+}
+
+func (mm *metricsMiddleware) ListNotificationPolicies(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_notification_policies").Add(1)
+		mm.latency.With("method", "list_notification_policies").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListNotificationPolicies(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_notification_policy").Add(1)
+		mm.latency.With("method", "remove_notification_policy").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemoveNotificationPolicy(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) StartScheduler(ctx context.Context) error {
+	return mm.service.StartScheduler(ctx)
+}
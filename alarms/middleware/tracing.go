@@ -5,6 +5,7 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	"github.com/absmach/magistrala/pkg/authn"
@@ -55,6 +56,109 @@ func (tm *tracingMiddleware) UpdateAlarm(ctx context.Context, session authn.Sess
 	return tm.svc.UpdateAlarm(ctx, session, alarm)
 }
 
+func (tm *tracingMiddleware) CreateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "create_suppression_window", trace.WithAttributes(
+		attribute.String("name", window.Name),
+		attribute.String("channel_id", window.ChannelID),
+		attribute.String("client_id", window.ClientID),
+	))
+	defer span.End()
+
+	return tm.svc.CreateSuppressionWindow(ctx, session, window)
+}
+
+func (tm *tracingMiddleware) ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (alarms.SuppressionWindow, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_suppression_window", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewSuppressionWindow(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) UpdateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_suppression_window", trace.WithAttributes(
+		attribute.String("id", window.ID),
+		attribute.String("name", window.Name),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateSuppressionWindow(ctx, session, window)
+}
+
+func (tm *tracingMiddleware) ListSuppressionWindows(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_suppression_windows", trace.WithAttributes(
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListSuppressionWindows(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_suppression_window", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveSuppressionWindow(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) AddComment(ctx context.Context, session authn.Session, comment alarms.Comment) (alarms.Comment, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "add_comment", trace.WithAttributes(
+		attribute.String("alarm_id", comment.AlarmID),
+		attribute.String("parent_id", comment.ParentID),
+	))
+	defer span.End()
+
+	return tm.svc.AddComment(ctx, session, comment)
+}
+
+func (tm *tracingMiddleware) ListComments(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta) (alarms.CommentsPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_comments", trace.WithAttributes(
+		attribute.String("alarm_id", pm.AlarmID),
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListComments(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) RemoveComment(ctx context.Context, session authn.Session, alarmID, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_comment", trace.WithAttributes(
+		attribute.String("alarm_id", alarmID),
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveComment(ctx, session, alarmID, id)
+}
+
+func (tm *tracingMiddleware) ListActivities(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_activities", trace.WithAttributes(
+		attribute.String("alarm_id", pm.AlarmID),
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListActivities(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) StreamAlarms(ctx context.Context, session authn.Session, filter alarms.StreamFilter) (<-chan alarms.Alarm, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "stream_alarms", trace.WithAttributes(
+		attribute.String("channel_id", filter.ChannelID),
+		attribute.String("client_id", filter.ClientID),
+		attribute.String("subtopic", filter.Subtopic),
+		attribute.Int("min_severity", int(filter.MinSeverity)),
+	))
+	defer span.End()
+
+	return tm.svc.StreamAlarms(ctx, session, filter)
+}
+
 func (tm *tracingMiddleware) ViewAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
 	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "get_alarm", trace.WithAttributes(
 		attribute.String("id", id),
@@ -74,6 +178,16 @@ func (tm *tracingMiddleware) ListAlarms(ctx context.Context, session authn.Sessi
 	return tm.svc.ListAlarms(ctx, session, pm)
 }
 
+func (tm *tracingMiddleware) AlarmStats(ctx context.Context, session authn.Session, q alarms.StatsQuery) (alarms.Stats, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "alarm_stats", trace.WithAttributes(
+		attribute.StringSlice("group_by", q.GroupBy),
+		attribute.String("bucket", q.Bucket),
+	))
+	defer span.End()
+
+	return tm.svc.AlarmStats(ctx, session, q)
+}
+
 func (tm *tracingMiddleware) DeleteAlarm(ctx context.Context, session authn.Session, id string) error {
 	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "delete_alarm", trace.WithAttributes(
 		attribute.String("id", id),
@@ -82,3 +196,160 @@ func (tm *tracingMiddleware) DeleteAlarm(ctx context.Context, session authn.Sess
 
 	return tm.svc.DeleteAlarm(ctx, session, id)
 }
+
+func (tm *tracingMiddleware) AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "acknowledge_alarm", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.AcknowledgeAlarm(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) AssignAlarm(ctx context.Context, session authn.Session, id, assigneeID string) (alarms.Alarm, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "assign_alarm", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("assignee_id", assigneeID),
+	))
+	defer span.End()
+
+	return tm.svc.AssignAlarm(ctx, session, id, assigneeID)
+}
+
+func (tm *tracingMiddleware) BulkAlarms(ctx context.Context, session authn.Session, req alarms.BulkRequest) (alarms.BulkReport, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "bulk_alarms", trace.WithAttributes(
+		attribute.String("action", string(req.Action)),
+		attribute.Int("ids", len(req.IDs)),
+	))
+	defer span.End()
+
+	return tm.svc.BulkAlarms(ctx, session, req)
+}
+
+func (tm *tracingMiddleware) ResolveAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "resolve_alarm", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ResolveAlarm(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) ReopenAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "reopen_alarm", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ReopenAlarm(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (alarms.Alarm, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "snooze_alarm", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.String("until", until.String()),
+	))
+	defer span.End()
+
+	return tm.svc.SnoozeAlarm(ctx, session, id, until)
+}
+
+func (tm *tracingMiddleware) CreateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "create_escalation_policy", trace.WithAttributes(
+		attribute.String("name", policy.Name),
+		attribute.String("rule_id", policy.RuleID),
+	))
+	defer span.End()
+
+	return tm.svc.CreateEscalationPolicy(ctx, session, policy)
+}
+
+func (tm *tracingMiddleware) ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (alarms.EscalationPolicy, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_escalation_policy", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewEscalationPolicy(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_escalation_policy", trace.WithAttributes(
+		attribute.String("id", policy.ID),
+		attribute.String("name", policy.Name),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateEscalationPolicy(ctx, session, policy)
+}
+
+func (tm *tracingMiddleware) ListEscalationPolicies(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_escalation_policies", trace.WithAttributes(
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListEscalationPolicies(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_escalation_policy", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveEscalationPolicy(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) CreateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "create_notification_policy", trace.WithAttributes(
+		attribute.String("name", policy.Name),
+		attribute.String("rule_id", policy.RuleID),
+	))
+	defer span.End()
+
+	return tm.svc.CreateNotificationPolicy(ctx, session, policy)
+}
+
+func (tm *tracingMiddleware) ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (alarms.NotificationPolicy, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_notification_policy", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewNotificationPolicy(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_notification_policy", trace.WithAttributes(
+		attribute.String("id", policy.ID),
+		attribute.String("name", policy.Name),
+	))
+	defer span.End()
+
+	return tm.svc.UpdateNotificationPolicy(ctx, session, policy)
+}
+
+func (tm *tracingMiddleware) ListNotificationPolicies(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_notification_policies", trace.WithAttributes(
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListNotificationPolicies(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_notification_policy", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemoveNotificationPolicy(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) StartScheduler(ctx context.Context) error {
+	return tm.svc.StartScheduler(ctx)
+}
@@ -0,0 +1,220 @@
+// Copyright (c) Abstract Machines
+
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/absmach/magistrala/alarms"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewNotifier creates a new instance of Notifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Notifier {
+	mock := &Notifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// Notifier is an autogenerated mock type for the Notifier type
+type Notifier struct {
+	mock.Mock
+}
+
+type Notifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Notifier) EXPECT() *Notifier_Expecter {
+	return &Notifier_Expecter{mock: &_m.Mock}
+}
+
+// Notify provides a mock function for the type Notifier
+func (_mock *Notifier) Notify(ctx context.Context, alarm alarms.Alarm, groupID string) error {
+	ret := _mock.Called(ctx, alarm, groupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm, string) error); ok {
+		r0 = returnFunc(ctx, alarm, groupID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Notifier_Notify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Notify'
+type Notifier_Notify_Call struct {
+	*mock.Call
+}
+
+// Notify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+//   - groupID string
+func (_e *Notifier_Expecter) Notify(ctx interface{}, alarm interface{}, groupID interface{}) *Notifier_Notify_Call {
+	return &Notifier_Notify_Call{Call: _e.mock.On("Notify", ctx, alarm, groupID)}
+}
+
+func (_c *Notifier_Notify_Call) Run(run func(ctx context.Context, alarm alarms.Alarm, groupID string)) *Notifier_Notify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Notifier_Notify_Call) Return(err error) *Notifier_Notify_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Notifier_Notify_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm, groupID string) error) *Notifier_Notify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyActivity provides a mock function for the type Notifier
+func (_mock *Notifier) NotifyActivity(ctx context.Context, activity alarms.Activity) error {
+	ret := _mock.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyActivity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Activity) error); ok {
+		r0 = returnFunc(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Notifier_NotifyActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyActivity'
+type Notifier_NotifyActivity_Call struct {
+	*mock.Call
+}
+
+// NotifyActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activity alarms.Activity
+func (_e *Notifier_Expecter) NotifyActivity(ctx interface{}, activity interface{}) *Notifier_NotifyActivity_Call {
+	return &Notifier_NotifyActivity_Call{Call: _e.mock.On("NotifyActivity", ctx, activity)}
+}
+
+func (_c *Notifier_NotifyActivity_Call) Run(run func(ctx context.Context, activity alarms.Activity)) *Notifier_NotifyActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Activity
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Activity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Notifier_NotifyActivity_Call) Return(err error) *Notifier_NotifyActivity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Notifier_NotifyActivity_Call) RunAndReturn(run func(ctx context.Context, activity alarms.Activity) error) *Notifier_NotifyActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotifyCreate provides a mock function for the type Notifier
+func (_mock *Notifier) NotifyCreate(ctx context.Context, alarm alarms.Alarm) error {
+	ret := _mock.Called(ctx, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotifyCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) error); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Notifier_NotifyCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotifyCreate'
+type Notifier_NotifyCreate_Call struct {
+	*mock.Call
+}
+
+// NotifyCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Notifier_Expecter) NotifyCreate(ctx interface{}, alarm interface{}) *Notifier_NotifyCreate_Call {
+	return &Notifier_NotifyCreate_Call{Call: _e.mock.On("NotifyCreate", ctx, alarm)}
+}
+
+func (_c *Notifier_NotifyCreate_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Notifier_NotifyCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Notifier_NotifyCreate_Call) Return(err error) *Notifier_NotifyCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Notifier_NotifyCreate_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) error) *Notifier_NotifyCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	mock "github.com/stretchr/testify/mock"
@@ -42,102 +43,2274 @@ func (_m *Repository) EXPECT() *Repository_Expecter {
 	return &Repository_Expecter{mock: &_m.Mock}
 }
 
+// AddActivity provides a mock function for the type Repository
+func (_mock *Repository) AddActivity(ctx context.Context, activity alarms.Activity) error {
+	ret := _mock.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddActivity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Activity) error); ok {
+		r0 = returnFunc(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_AddActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddActivity'
+type Repository_AddActivity_Call struct {
+	*mock.Call
+}
+
+// AddActivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - activity alarms.Activity
+func (_e *Repository_Expecter) AddActivity(ctx interface{}, activity interface{}) *Repository_AddActivity_Call {
+	return &Repository_AddActivity_Call{Call: _e.mock.On("AddActivity", ctx, activity)}
+}
+
+func (_c *Repository_AddActivity_Call) Run(run func(ctx context.Context, activity alarms.Activity)) *Repository_AddActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Activity
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Activity)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddActivity_Call) Return(err error) *Repository_AddActivity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_AddActivity_Call) RunAndReturn(run func(ctx context.Context, activity alarms.Activity) error) *Repository_AddActivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddComment provides a mock function for the type Repository
+func (_mock *Repository) AddComment(ctx context.Context, comment alarms.Comment) (alarms.Comment, error) {
+	ret := _mock.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddComment")
+	}
+
+	var r0 alarms.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Comment) (alarms.Comment, error)); ok {
+		return returnFunc(ctx, comment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Comment) alarms.Comment); ok {
+		r0 = returnFunc(ctx, comment)
+	} else {
+		r0 = ret.Get(0).(alarms.Comment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Comment) error); ok {
+		r1 = returnFunc(ctx, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type Repository_AddComment_Call struct {
+	*mock.Call
+}
+
+// AddComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment alarms.Comment
+func (_e *Repository_Expecter) AddComment(ctx interface{}, comment interface{}) *Repository_AddComment_Call {
+	return &Repository_AddComment_Call{Call: _e.mock.On("AddComment", ctx, comment)}
+}
+
+func (_c *Repository_AddComment_Call) Run(run func(ctx context.Context, comment alarms.Comment)) *Repository_AddComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Comment
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Comment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddComment_Call) Return(comment1 alarms.Comment, err error) *Repository_AddComment_Call {
+	_c.Call.Return(comment1, err)
+	return _c
+}
+
+func (_c *Repository_AddComment_Call) RunAndReturn(run func(ctx context.Context, comment alarms.Comment) (alarms.Comment, error)) *Repository_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddEscalationPolicy provides a mock function for the type Repository
+func (_mock *Repository) AddEscalationPolicy(ctx context.Context, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEscalationPolicy")
+	}
+
+	var r0 alarms.EscalationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicy) (alarms.EscalationPolicy, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicy) alarms.EscalationPolicy); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.EscalationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.EscalationPolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AddEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddEscalationPolicy'
+type Repository_AddEscalationPolicy_Call struct {
+	*mock.Call
+}
+
+// AddEscalationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy alarms.EscalationPolicy
+func (_e *Repository_Expecter) AddEscalationPolicy(ctx interface{}, policy interface{}) *Repository_AddEscalationPolicy_Call {
+	return &Repository_AddEscalationPolicy_Call{Call: _e.mock.On("AddEscalationPolicy", ctx, policy)}
+}
+
+func (_c *Repository_AddEscalationPolicy_Call) Run(run func(ctx context.Context, policy alarms.EscalationPolicy)) *Repository_AddEscalationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.EscalationPolicy
+		if args[1] != nil {
+			arg1 = args[1].(alarms.EscalationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddEscalationPolicy_Call) Return(escalationPolicy alarms.EscalationPolicy, err error) *Repository_AddEscalationPolicy_Call {
+	_c.Call.Return(escalationPolicy, err)
+	return _c
+}
+
+func (_c *Repository_AddEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error)) *Repository_AddEscalationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddNotificationPolicy provides a mock function for the type Repository
+func (_mock *Repository) AddNotificationPolicy(ctx context.Context, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddNotificationPolicy")
+	}
+
+	var r0 alarms.NotificationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.NotificationPolicy) (alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.NotificationPolicy) alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.NotificationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.NotificationPolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AddNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddNotificationPolicy'
+type Repository_AddNotificationPolicy_Call struct {
+	*mock.Call
+}
+
+// AddNotificationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy alarms.NotificationPolicy
+func (_e *Repository_Expecter) AddNotificationPolicy(ctx interface{}, policy interface{}) *Repository_AddNotificationPolicy_Call {
+	return &Repository_AddNotificationPolicy_Call{Call: _e.mock.On("AddNotificationPolicy", ctx, policy)}
+}
+
+func (_c *Repository_AddNotificationPolicy_Call) Run(run func(ctx context.Context, policy alarms.NotificationPolicy)) *Repository_AddNotificationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.NotificationPolicy
+		if args[1] != nil {
+			arg1 = args[1].(alarms.NotificationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddNotificationPolicy_Call) Return(notificationPolicy alarms.NotificationPolicy, err error) *Repository_AddNotificationPolicy_Call {
+	_c.Call.Return(notificationPolicy, err)
+	return _c
+}
+
+func (_c *Repository_AddNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error)) *Repository_AddNotificationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddSuppressionWindow provides a mock function for the type Repository
+func (_mock *Repository) AddSuppressionWindow(ctx context.Context, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	ret := _mock.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSuppressionWindow")
+	}
+
+	var r0 alarms.SuppressionWindow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.SuppressionWindow) (alarms.SuppressionWindow, error)); ok {
+		return returnFunc(ctx, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.SuppressionWindow) alarms.SuppressionWindow); ok {
+		r0 = returnFunc(ctx, window)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.SuppressionWindow) error); ok {
+		r1 = returnFunc(ctx, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AddSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSuppressionWindow'
+type Repository_AddSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// AddSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window alarms.SuppressionWindow
+func (_e *Repository_Expecter) AddSuppressionWindow(ctx interface{}, window interface{}) *Repository_AddSuppressionWindow_Call {
+	return &Repository_AddSuppressionWindow_Call{Call: _e.mock.On("AddSuppressionWindow", ctx, window)}
+}
+
+func (_c *Repository_AddSuppressionWindow_Call) Run(run func(ctx context.Context, window alarms.SuppressionWindow)) *Repository_AddSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.SuppressionWindow
+		if args[1] != nil {
+			arg1 = args[1].(alarms.SuppressionWindow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Repository_AddSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
+	return _c
+}
+
+func (_c *Repository_AddSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error)) *Repository_AddSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlarmStats provides a mock function for the type Repository
+func (_mock *Repository) AlarmStats(ctx context.Context, q alarms.StatsQuery) (alarms.Stats, error) {
+	ret := _mock.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlarmStats")
+	}
+
+	var r0 alarms.Stats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.StatsQuery) (alarms.Stats, error)); ok {
+		return returnFunc(ctx, q)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.StatsQuery) alarms.Stats); ok {
+		r0 = returnFunc(ctx, q)
+	} else {
+		r0 = ret.Get(0).(alarms.Stats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.StatsQuery) error); ok {
+		r1 = returnFunc(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AlarmStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlarmStats'
+type Repository_AlarmStats_Call struct {
+	*mock.Call
+}
+
+// AlarmStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q alarms.StatsQuery
+func (_e *Repository_Expecter) AlarmStats(ctx interface{}, q interface{}) *Repository_AlarmStats_Call {
+	return &Repository_AlarmStats_Call{Call: _e.mock.On("AlarmStats", ctx, q)}
+}
+
+func (_c *Repository_AlarmStats_Call) Run(run func(ctx context.Context, q alarms.StatsQuery)) *Repository_AlarmStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.StatsQuery
+		if args[1] != nil {
+			arg1 = args[1].(alarms.StatsQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AlarmStats_Call) Return(stats alarms.Stats, err error) *Repository_AlarmStats_Call {
+	_c.Call.Return(stats, err)
+	return _c
+}
+
+func (_c *Repository_AlarmStats_Call) RunAndReturn(run func(ctx context.Context, q alarms.StatsQuery) (alarms.Stats, error)) *Repository_AlarmStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountAlarmChanges provides a mock function for the type Repository
+func (_mock *Repository) CountAlarmChanges(ctx context.Context, domainID string, dedupKey string, since time.Time) (uint64, error) {
+	ret := _mock.Called(ctx, domainID, dedupKey, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountAlarmChanges")
+	}
+
+	var r0 uint64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (uint64, error)); ok {
+		return returnFunc(ctx, domainID, dedupKey, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) uint64); ok {
+		r0 = returnFunc(ctx, domainID, dedupKey, since)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, domainID, dedupKey, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CountAlarmChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountAlarmChanges'
+type Repository_CountAlarmChanges_Call struct {
+	*mock.Call
+}
+
+// CountAlarmChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - dedupKey string
+//   - since time.Time
+func (_e *Repository_Expecter) CountAlarmChanges(ctx interface{}, domainID interface{}, dedupKey interface{}, since interface{}) *Repository_CountAlarmChanges_Call {
+	return &Repository_CountAlarmChanges_Call{Call: _e.mock.On("CountAlarmChanges", ctx, domainID, dedupKey, since)}
+}
+
+func (_c *Repository_CountAlarmChanges_Call) Run(run func(ctx context.Context, domainID string, dedupKey string, since time.Time)) *Repository_CountAlarmChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CountAlarmChanges_Call) Return(v uint64, err error) *Repository_CountAlarmChanges_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *Repository_CountAlarmChanges_Call) RunAndReturn(run func(ctx context.Context, domainID string, dedupKey string, since time.Time) (uint64, error)) *Repository_CountAlarmChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlarm provides a mock function for the type Repository
 func (_mock *Repository) CreateAlarm(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error) {
 	ret := _mock.Called(ctx, alarm)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAlarm")
+		panic("no return value specified for CreateAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_CreateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlarm'
+type Repository_CreateAlarm_Call struct {
+	*mock.Call
+}
+
+// CreateAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Repository_Expecter) CreateAlarm(ctx interface{}, alarm interface{}) *Repository_CreateAlarm_Call {
+	return &Repository_CreateAlarm_Call{Call: _e.mock.On("CreateAlarm", ctx, alarm)}
+}
+
+func (_c *Repository_CreateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_CreateAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_CreateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Repository_CreateAlarm_Call {
+	_c.Call.Return(alarm1, err)
+	return _c
+}
+
+func (_c *Repository_CreateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error)) *Repository_CreateAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlarm provides a mock function for the type Repository
+func (_mock *Repository) DeleteAlarm(ctx context.Context, id string, domainID string) error {
+	ret := _mock.Called(ctx, id, domainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlarm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, domainID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_DeleteAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlarm'
+type Repository_DeleteAlarm_Call struct {
+	*mock.Call
+}
+
+// DeleteAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - domainID string
+func (_e *Repository_Expecter) DeleteAlarm(ctx interface{}, id interface{}, domainID interface{}) *Repository_DeleteAlarm_Call {
+	return &Repository_DeleteAlarm_Call{Call: _e.mock.On("DeleteAlarm", ctx, id, domainID)}
+}
+
+func (_c *Repository_DeleteAlarm_Call) Run(run func(ctx context.Context, id string, domainID string)) *Repository_DeleteAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteAlarm_Call) Return(err error) *Repository_DeleteAlarm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_DeleteAlarm_Call) RunAndReturn(run func(ctx context.Context, id string, domainID string) error) *Repository_DeleteAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlarms provides a mock function for the type Repository
+func (_mock *Repository) DeleteAlarms(ctx context.Context, domainID string, ids []string) ([]string, error) {
+	ret := _mock.Called(ctx, domainID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlarms")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) ([]string, error)); ok {
+		return returnFunc(ctx, domainID, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) []string); ok {
+		r0 = returnFunc(ctx, domainID, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = returnFunc(ctx, domainID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_DeleteAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlarms'
+type Repository_DeleteAlarms_Call struct {
+	*mock.Call
+}
+
+// DeleteAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - ids []string
+func (_e *Repository_Expecter) DeleteAlarms(ctx interface{}, domainID interface{}, ids interface{}) *Repository_DeleteAlarms_Call {
+	return &Repository_DeleteAlarms_Call{Call: _e.mock.On("DeleteAlarms", ctx, domainID, ids)}
+}
+
+func (_c *Repository_DeleteAlarms_Call) Run(run func(ctx context.Context, domainID string, ids []string)) *Repository_DeleteAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_DeleteAlarms_Call) Return(strings []string, err error) *Repository_DeleteAlarms_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Repository_DeleteAlarms_Call) RunAndReturn(run func(ctx context.Context, domainID string, ids []string) ([]string, error)) *Repository_DeleteAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EscalateAlarm provides a mock function for the type Repository
+func (_mock *Repository) EscalateAlarm(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EscalateAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_EscalateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EscalateAlarm'
+type Repository_EscalateAlarm_Call struct {
+	*mock.Call
+}
+
+// EscalateAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Repository_Expecter) EscalateAlarm(ctx interface{}, alarm interface{}) *Repository_EscalateAlarm_Call {
+	return &Repository_EscalateAlarm_Call{Call: _e.mock.On("EscalateAlarm", ctx, alarm)}
+}
+
+func (_c *Repository_EscalateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_EscalateAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_EscalateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Repository_EscalateAlarm_Call {
+	_c.Call.Return(alarm1, err)
+	return _c
+}
+
+func (_c *Repository_EscalateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error)) *Repository_EscalateAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsUserAlarm provides a mock function for the type Repository
+func (_mock *Repository) IsUserAlarm(ctx context.Context, userID string, alarm alarms.Alarm) (bool, error) {
+	ret := _mock.Called(ctx, userID, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsUserAlarm")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.Alarm) (bool, error)); ok {
+		return returnFunc(ctx, userID, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.Alarm) bool); ok {
+		r0 = returnFunc(ctx, userID, alarm)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, userID, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_IsUserAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsUserAlarm'
+type Repository_IsUserAlarm_Call struct {
+	*mock.Call
+}
+
+// IsUserAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - alarm alarms.Alarm
+func (_e *Repository_Expecter) IsUserAlarm(ctx interface{}, userID interface{}, alarm interface{}) *Repository_IsUserAlarm_Call {
+	return &Repository_IsUserAlarm_Call{Call: _e.mock.On("IsUserAlarm", ctx, userID, alarm)}
+}
+
+func (_c *Repository_IsUserAlarm_Call) Run(run func(ctx context.Context, userID string, alarm alarms.Alarm)) *Repository_IsUserAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 alarms.Alarm
+		if args[2] != nil {
+			arg2 = args[2].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_IsUserAlarm_Call) Return(b bool, err error) *Repository_IsUserAlarm_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *Repository_IsUserAlarm_Call) RunAndReturn(run func(ctx context.Context, userID string, alarm alarms.Alarm) (bool, error)) *Repository_IsUserAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActivities provides a mock function for the type Repository
+func (_mock *Repository) ListActivities(ctx context.Context, domainID string, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error) {
+	ret := _mock.Called(ctx, domainID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActivities")
+	}
+
+	var r0 alarms.ActivityPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.ActivityPageMeta) (alarms.ActivityPage, error)); ok {
+		return returnFunc(ctx, domainID, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.ActivityPageMeta) alarms.ActivityPage); ok {
+		r0 = returnFunc(ctx, domainID, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.ActivityPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, alarms.ActivityPageMeta) error); ok {
+		r1 = returnFunc(ctx, domainID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActivities'
+type Repository_ListActivities_Call struct {
+	*mock.Call
+}
+
+// ListActivities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - pm alarms.ActivityPageMeta
+func (_e *Repository_Expecter) ListActivities(ctx interface{}, domainID interface{}, pm interface{}) *Repository_ListActivities_Call {
+	return &Repository_ListActivities_Call{Call: _e.mock.On("ListActivities", ctx, domainID, pm)}
+}
+
+func (_c *Repository_ListActivities_Call) Run(run func(ctx context.Context, domainID string, pm alarms.ActivityPageMeta)) *Repository_ListActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 alarms.ActivityPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.ActivityPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListActivities_Call) Return(activityPage alarms.ActivityPage, err error) *Repository_ListActivities_Call {
+	_c.Call.Return(activityPage, err)
+	return _c
+}
+
+func (_c *Repository_ListActivities_Call) RunAndReturn(run func(ctx context.Context, domainID string, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error)) *Repository_ListActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAlarmsToEscalate provides a mock function for the type Repository
+func (_mock *Repository) ListAlarmsToEscalate(ctx context.Context, policy alarms.EscalationPolicy, level uint8, raisedBefore time.Time) ([]alarms.Alarm, error) {
+	ret := _mock.Called(ctx, policy, level, raisedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlarmsToEscalate")
+	}
+
+	var r0 []alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicy, uint8, time.Time) ([]alarms.Alarm, error)); ok {
+		return returnFunc(ctx, policy, level, raisedBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicy, uint8, time.Time) []alarms.Alarm); ok {
+		r0 = returnFunc(ctx, policy, level, raisedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alarms.Alarm)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.EscalationPolicy, uint8, time.Time) error); ok {
+		r1 = returnFunc(ctx, policy, level, raisedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListAlarmsToEscalate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAlarmsToEscalate'
+type Repository_ListAlarmsToEscalate_Call struct {
+	*mock.Call
+}
+
+// ListAlarmsToEscalate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy alarms.EscalationPolicy
+//   - level uint8
+//   - raisedBefore time.Time
+func (_e *Repository_Expecter) ListAlarmsToEscalate(ctx interface{}, policy interface{}, level interface{}, raisedBefore interface{}) *Repository_ListAlarmsToEscalate_Call {
+	return &Repository_ListAlarmsToEscalate_Call{Call: _e.mock.On("ListAlarmsToEscalate", ctx, policy, level, raisedBefore)}
+}
+
+func (_c *Repository_ListAlarmsToEscalate_Call) Run(run func(ctx context.Context, policy alarms.EscalationPolicy, level uint8, raisedBefore time.Time)) *Repository_ListAlarmsToEscalate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.EscalationPolicy
+		if args[1] != nil {
+			arg1 = args[1].(alarms.EscalationPolicy)
+		}
+		var arg2 uint8
+		if args[2] != nil {
+			arg2 = args[2].(uint8)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListAlarmsToEscalate_Call) Return(alarms1 []alarms.Alarm, err error) *Repository_ListAlarmsToEscalate_Call {
+	_c.Call.Return(alarms1, err)
+	return _c
+}
+
+func (_c *Repository_ListAlarmsToEscalate_Call) RunAndReturn(run func(ctx context.Context, policy alarms.EscalationPolicy, level uint8, raisedBefore time.Time) ([]alarms.Alarm, error)) *Repository_ListAlarmsToEscalate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAllAlarms provides a mock function for the type Repository
+func (_mock *Repository) ListAllAlarms(ctx context.Context, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAllAlarms")
+	}
+
+	var r0 alarms.AlarmsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.PageMetadata) (alarms.AlarmsPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.PageMetadata) alarms.AlarmsPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.AlarmsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.PageMetadata) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListAllAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllAlarms'
+type Repository_ListAllAlarms_Call struct {
+	*mock.Call
+}
+
+// ListAllAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm alarms.PageMetadata
+func (_e *Repository_Expecter) ListAllAlarms(ctx interface{}, pm interface{}) *Repository_ListAllAlarms_Call {
+	return &Repository_ListAllAlarms_Call{Call: _e.mock.On("ListAllAlarms", ctx, pm)}
+}
+
+func (_c *Repository_ListAllAlarms_Call) Run(run func(ctx context.Context, pm alarms.PageMetadata)) *Repository_ListAllAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.PageMetadata
+		if args[1] != nil {
+			arg1 = args[1].(alarms.PageMetadata)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListAllAlarms_Call) Return(alarmsPage alarms.AlarmsPage, err error) *Repository_ListAllAlarms_Call {
+	_c.Call.Return(alarmsPage, err)
+	return _c
+}
+
+func (_c *Repository_ListAllAlarms_Call) RunAndReturn(run func(ctx context.Context, pm alarms.PageMetadata) (alarms.AlarmsPage, error)) *Repository_ListAllAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function for the type Repository
+func (_mock *Repository) ListComments(ctx context.Context, domainID string, pm alarms.CommentPageMeta) (alarms.CommentsPage, error) {
+	ret := _mock.Called(ctx, domainID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 alarms.CommentsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.CommentPageMeta) (alarms.CommentsPage, error)); ok {
+		return returnFunc(ctx, domainID, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.CommentPageMeta) alarms.CommentsPage); ok {
+		r0 = returnFunc(ctx, domainID, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.CommentsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, alarms.CommentPageMeta) error); ok {
+		r1 = returnFunc(ctx, domainID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type Repository_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - pm alarms.CommentPageMeta
+func (_e *Repository_Expecter) ListComments(ctx interface{}, domainID interface{}, pm interface{}) *Repository_ListComments_Call {
+	return &Repository_ListComments_Call{Call: _e.mock.On("ListComments", ctx, domainID, pm)}
+}
+
+func (_c *Repository_ListComments_Call) Run(run func(ctx context.Context, domainID string, pm alarms.CommentPageMeta)) *Repository_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 alarms.CommentPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.CommentPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListComments_Call) Return(commentsPage alarms.CommentsPage, err error) *Repository_ListComments_Call {
+	_c.Call.Return(commentsPage, err)
+	return _c
+}
+
+func (_c *Repository_ListComments_Call) RunAndReturn(run func(ctx context.Context, domainID string, pm alarms.CommentPageMeta) (alarms.CommentsPage, error)) *Repository_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEscalationPolicies provides a mock function for the type Repository
+func (_mock *Repository) ListEscalationPolicies(ctx context.Context, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEscalationPolicies")
+	}
+
+	var r0 alarms.EscalationPolicyPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicyPageMeta) alarms.EscalationPolicyPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.EscalationPolicyPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.EscalationPolicyPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListEscalationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEscalationPolicies'
+type Repository_ListEscalationPolicies_Call struct {
+	*mock.Call
+}
+
+// ListEscalationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm alarms.EscalationPolicyPageMeta
+func (_e *Repository_Expecter) ListEscalationPolicies(ctx interface{}, pm interface{}) *Repository_ListEscalationPolicies_Call {
+	return &Repository_ListEscalationPolicies_Call{Call: _e.mock.On("ListEscalationPolicies", ctx, pm)}
+}
+
+func (_c *Repository_ListEscalationPolicies_Call) Run(run func(ctx context.Context, pm alarms.EscalationPolicyPageMeta)) *Repository_ListEscalationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.EscalationPolicyPageMeta
+		if args[1] != nil {
+			arg1 = args[1].(alarms.EscalationPolicyPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListEscalationPolicies_Call) Return(escalationPolicyPage alarms.EscalationPolicyPage, err error) *Repository_ListEscalationPolicies_Call {
+	_c.Call.Return(escalationPolicyPage, err)
+	return _c
+}
+
+func (_c *Repository_ListEscalationPolicies_Call) RunAndReturn(run func(ctx context.Context, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error)) *Repository_ListEscalationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotificationPolicies provides a mock function for the type Repository
+func (_mock *Repository) ListNotificationPolicies(ctx context.Context, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotificationPolicies")
+	}
+
+	var r0 alarms.NotificationPolicyPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.NotificationPolicyPageMeta) alarms.NotificationPolicyPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.NotificationPolicyPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.NotificationPolicyPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListNotificationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotificationPolicies'
+type Repository_ListNotificationPolicies_Call struct {
+	*mock.Call
+}
+
+// ListNotificationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm alarms.NotificationPolicyPageMeta
+func (_e *Repository_Expecter) ListNotificationPolicies(ctx interface{}, pm interface{}) *Repository_ListNotificationPolicies_Call {
+	return &Repository_ListNotificationPolicies_Call{Call: _e.mock.On("ListNotificationPolicies", ctx, pm)}
+}
+
+func (_c *Repository_ListNotificationPolicies_Call) Run(run func(ctx context.Context, pm alarms.NotificationPolicyPageMeta)) *Repository_ListNotificationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.NotificationPolicyPageMeta
+		if args[1] != nil {
+			arg1 = args[1].(alarms.NotificationPolicyPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListNotificationPolicies_Call) Return(notificationPolicyPage alarms.NotificationPolicyPage, err error) *Repository_ListNotificationPolicies_Call {
+	_c.Call.Return(notificationPolicyPage, err)
+	return _c
+}
+
+func (_c *Repository_ListNotificationPolicies_Call) RunAndReturn(run func(ctx context.Context, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error)) *Repository_ListNotificationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSuppressionWindows provides a mock function for the type Repository
+func (_mock *Repository) ListSuppressionWindows(ctx context.Context, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSuppressionWindows")
+	}
+
+	var r0 alarms.SuppressionWindowPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.SuppressionWindowPageMeta) alarms.SuppressionWindowPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindowPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.SuppressionWindowPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListSuppressionWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSuppressionWindows'
+type Repository_ListSuppressionWindows_Call struct {
+	*mock.Call
+}
+
+// ListSuppressionWindows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm alarms.SuppressionWindowPageMeta
+func (_e *Repository_Expecter) ListSuppressionWindows(ctx interface{}, pm interface{}) *Repository_ListSuppressionWindows_Call {
+	return &Repository_ListSuppressionWindows_Call{Call: _e.mock.On("ListSuppressionWindows", ctx, pm)}
+}
+
+func (_c *Repository_ListSuppressionWindows_Call) Run(run func(ctx context.Context, pm alarms.SuppressionWindowPageMeta)) *Repository_ListSuppressionWindows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.SuppressionWindowPageMeta
+		if args[1] != nil {
+			arg1 = args[1].(alarms.SuppressionWindowPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListSuppressionWindows_Call) Return(suppressionWindowPage alarms.SuppressionWindowPage, err error) *Repository_ListSuppressionWindows_Call {
+	_c.Call.Return(suppressionWindowPage, err)
+	return _c
+}
+
+func (_c *Repository_ListSuppressionWindows_Call) RunAndReturn(run func(ctx context.Context, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error)) *Repository_ListSuppressionWindows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserAlarms provides a mock function for the type Repository
+func (_mock *Repository) ListUserAlarms(ctx context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
+	ret := _mock.Called(ctx, userID, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUserAlarms")
+	}
+
+	var r0 alarms.AlarmsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.PageMetadata) (alarms.AlarmsPage, error)); ok {
+		return returnFunc(ctx, userID, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.PageMetadata) alarms.AlarmsPage); ok {
+		r0 = returnFunc(ctx, userID, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.AlarmsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, alarms.PageMetadata) error); ok {
+		r1 = returnFunc(ctx, userID, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListUserAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAlarms'
+type Repository_ListUserAlarms_Call struct {
+	*mock.Call
+}
+
+// ListUserAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - pm alarms.PageMetadata
+func (_e *Repository_Expecter) ListUserAlarms(ctx interface{}, userID interface{}, pm interface{}) *Repository_ListUserAlarms_Call {
+	return &Repository_ListUserAlarms_Call{Call: _e.mock.On("ListUserAlarms", ctx, userID, pm)}
+}
+
+func (_c *Repository_ListUserAlarms_Call) Run(run func(ctx context.Context, userID string, pm alarms.PageMetadata)) *Repository_ListUserAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 alarms.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(alarms.PageMetadata)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListUserAlarms_Call) Return(alarmsPage alarms.AlarmsPage, err error) *Repository_ListUserAlarms_Call {
+	_c.Call.Return(alarmsPage, err)
+	return _c
+}
+
+func (_c *Repository_ListUserAlarms_Call) RunAndReturn(run func(ctx context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error)) *Repository_ListUserAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MatchNotificationPolicies provides a mock function for the type Repository
+func (_mock *Repository) MatchNotificationPolicies(ctx context.Context, alarm alarms.Alarm) ([]alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchNotificationPolicies")
+	}
+
+	var r0 []alarms.NotificationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) ([]alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) []alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alarms.NotificationPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_MatchNotificationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchNotificationPolicies'
+type Repository_MatchNotificationPolicies_Call struct {
+	*mock.Call
+}
+
+// MatchNotificationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Repository_Expecter) MatchNotificationPolicies(ctx interface{}, alarm interface{}) *Repository_MatchNotificationPolicies_Call {
+	return &Repository_MatchNotificationPolicies_Call{Call: _e.mock.On("MatchNotificationPolicies", ctx, alarm)}
+}
+
+func (_c *Repository_MatchNotificationPolicies_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_MatchNotificationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_MatchNotificationPolicies_Call) Return(notificationPolicys []alarms.NotificationPolicy, err error) *Repository_MatchNotificationPolicies_Call {
+	_c.Call.Return(notificationPolicys, err)
+	return _c
+}
+
+func (_c *Repository_MatchNotificationPolicies_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) ([]alarms.NotificationPolicy, error)) *Repository_MatchNotificationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MatchSuppressionWindow provides a mock function for the type Repository
+func (_mock *Repository) MatchSuppressionWindow(ctx context.Context, alarm alarms.Alarm) (alarms.SuppressionWindow, error) {
+	ret := _mock.Called(ctx, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchSuppressionWindow")
+	}
+
+	var r0 alarms.SuppressionWindow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) (alarms.SuppressionWindow, error)); ok {
+		return returnFunc(ctx, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) alarms.SuppressionWindow); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_MatchSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchSuppressionWindow'
+type Repository_MatchSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// MatchSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Repository_Expecter) MatchSuppressionWindow(ctx interface{}, alarm interface{}) *Repository_MatchSuppressionWindow_Call {
+	return &Repository_MatchSuppressionWindow_Call{Call: _e.mock.On("MatchSuppressionWindow", ctx, alarm)}
+}
+
+func (_c *Repository_MatchSuppressionWindow_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_MatchSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_MatchSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Repository_MatchSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
+	return _c
+}
+
+func (_c *Repository_MatchSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) (alarms.SuppressionWindow, error)) *Repository_MatchSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveComment provides a mock function for the type Repository
+func (_mock *Repository) RemoveComment(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveComment'
+type Repository_RemoveComment_Call struct {
+	*mock.Call
+}
+
+// RemoveComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) RemoveComment(ctx interface{}, domainID interface{}, id interface{}) *Repository_RemoveComment_Call {
+	return &Repository_RemoveComment_Call{Call: _e.mock.On("RemoveComment", ctx, domainID, id)}
+}
+
+func (_c *Repository_RemoveComment_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_RemoveComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveComment_Call) Return(err error) *Repository_RemoveComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveComment_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *Repository_RemoveComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveEscalationPolicy provides a mock function for the type Repository
+func (_mock *Repository) RemoveEscalationPolicy(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveEscalationPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveEscalationPolicy'
+type Repository_RemoveEscalationPolicy_Call struct {
+	*mock.Call
+}
+
+// RemoveEscalationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) RemoveEscalationPolicy(ctx interface{}, domainID interface{}, id interface{}) *Repository_RemoveEscalationPolicy_Call {
+	return &Repository_RemoveEscalationPolicy_Call{Call: _e.mock.On("RemoveEscalationPolicy", ctx, domainID, id)}
+}
+
+func (_c *Repository_RemoveEscalationPolicy_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_RemoveEscalationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveEscalationPolicy_Call) Return(err error) *Repository_RemoveEscalationPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *Repository_RemoveEscalationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNotificationPolicy provides a mock function for the type Repository
+func (_mock *Repository) RemoveNotificationPolicy(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNotificationPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNotificationPolicy'
+type Repository_RemoveNotificationPolicy_Call struct {
+	*mock.Call
+}
+
+// RemoveNotificationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) RemoveNotificationPolicy(ctx interface{}, domainID interface{}, id interface{}) *Repository_RemoveNotificationPolicy_Call {
+	return &Repository_RemoveNotificationPolicy_Call{Call: _e.mock.On("RemoveNotificationPolicy", ctx, domainID, id)}
+}
+
+func (_c *Repository_RemoveNotificationPolicy_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_RemoveNotificationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveNotificationPolicy_Call) Return(err error) *Repository_RemoveNotificationPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *Repository_RemoveNotificationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSuppressionWindow provides a mock function for the type Repository
+func (_mock *Repository) RemoveSuppressionWindow(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSuppressionWindow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSuppressionWindow'
+type Repository_RemoveSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// RemoveSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) RemoveSuppressionWindow(ctx interface{}, domainID interface{}, id interface{}) *Repository_RemoveSuppressionWindow_Call {
+	return &Repository_RemoveSuppressionWindow_Call{Call: _e.mock.On("RemoveSuppressionWindow", ctx, domainID, id)}
+}
+
+func (_c *Repository_RemoveSuppressionWindow_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_RemoveSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveSuppressionWindow_Call) Return(err error) *Repository_RemoveSuppressionWindow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *Repository_RemoveSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlarm provides a mock function for the type Repository
+func (_mock *Repository) UpdateAlarm(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlarm'
+type Repository_UpdateAlarm_Call struct {
+	*mock.Call
+}
+
+// UpdateAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Repository_Expecter) UpdateAlarm(ctx interface{}, alarm interface{}) *Repository_UpdateAlarm_Call {
+	return &Repository_UpdateAlarm_Call{Call: _e.mock.On("UpdateAlarm", ctx, alarm)}
+}
+
+func (_c *Repository_UpdateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_UpdateAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Repository_UpdateAlarm_Call {
+	_c.Call.Return(alarm1, err)
+	return _c
+}
+
+func (_c *Repository_UpdateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error)) *Repository_UpdateAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlarmStatus provides a mock function for the type Repository
+func (_mock *Repository) UpdateAlarmStatus(ctx context.Context, alarm alarms.Alarm, from alarms.Status) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, alarm, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlarmStatus")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm, alarms.Status) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, alarm, from)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm, alarms.Status) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, alarm, from)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm, alarms.Status) error); ok {
+		r1 = returnFunc(ctx, alarm, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateAlarmStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlarmStatus'
+type Repository_UpdateAlarmStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateAlarmStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+//   - from alarms.Status
+func (_e *Repository_Expecter) UpdateAlarmStatus(ctx interface{}, alarm interface{}, from interface{}) *Repository_UpdateAlarmStatus_Call {
+	return &Repository_UpdateAlarmStatus_Call{Call: _e.mock.On("UpdateAlarmStatus", ctx, alarm, from)}
+}
+
+func (_c *Repository_UpdateAlarmStatus_Call) Run(run func(ctx context.Context, alarm alarms.Alarm, from alarms.Status)) *Repository_UpdateAlarmStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		var arg2 alarms.Status
+		if args[2] != nil {
+			arg2 = args[2].(alarms.Status)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateAlarmStatus_Call) Return(alarm1 alarms.Alarm, err error) *Repository_UpdateAlarmStatus_Call {
+	_c.Call.Return(alarm1, err)
+	return _c
+}
+
+func (_c *Repository_UpdateAlarmStatus_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm, from alarms.Status) (alarms.Alarm, error)) *Repository_UpdateAlarmStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlarms provides a mock function for the type Repository
+func (_mock *Repository) UpdateAlarms(ctx context.Context, changes []alarms.AlarmChange) ([]alarms.Alarm, error) {
+	ret := _mock.Called(ctx, changes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlarms")
+	}
+
+	var r0 []alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []alarms.AlarmChange) ([]alarms.Alarm, error)); ok {
+		return returnFunc(ctx, changes)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []alarms.AlarmChange) []alarms.Alarm); ok {
+		r0 = returnFunc(ctx, changes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]alarms.Alarm)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []alarms.AlarmChange) error); ok {
+		r1 = returnFunc(ctx, changes)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlarms'
+type Repository_UpdateAlarms_Call struct {
+	*mock.Call
+}
+
+// UpdateAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - changes []alarms.AlarmChange
+func (_e *Repository_Expecter) UpdateAlarms(ctx interface{}, changes interface{}) *Repository_UpdateAlarms_Call {
+	return &Repository_UpdateAlarms_Call{Call: _e.mock.On("UpdateAlarms", ctx, changes)}
+}
+
+func (_c *Repository_UpdateAlarms_Call) Run(run func(ctx context.Context, changes []alarms.AlarmChange)) *Repository_UpdateAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []alarms.AlarmChange
+		if args[1] != nil {
+			arg1 = args[1].([]alarms.AlarmChange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateAlarms_Call) Return(alarms1 []alarms.Alarm, err error) *Repository_UpdateAlarms_Call {
+	_c.Call.Return(alarms1, err)
+	return _c
+}
+
+func (_c *Repository_UpdateAlarms_Call) RunAndReturn(run func(ctx context.Context, changes []alarms.AlarmChange) ([]alarms.Alarm, error)) *Repository_UpdateAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEscalationPolicy provides a mock function for the type Repository
+func (_mock *Repository) UpdateEscalationPolicy(ctx context.Context, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEscalationPolicy")
+	}
+
+	var r0 alarms.EscalationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicy) (alarms.EscalationPolicy, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.EscalationPolicy) alarms.EscalationPolicy); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.EscalationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.EscalationPolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEscalationPolicy'
+type Repository_UpdateEscalationPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateEscalationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy alarms.EscalationPolicy
+func (_e *Repository_Expecter) UpdateEscalationPolicy(ctx interface{}, policy interface{}) *Repository_UpdateEscalationPolicy_Call {
+	return &Repository_UpdateEscalationPolicy_Call{Call: _e.mock.On("UpdateEscalationPolicy", ctx, policy)}
+}
+
+func (_c *Repository_UpdateEscalationPolicy_Call) Run(run func(ctx context.Context, policy alarms.EscalationPolicy)) *Repository_UpdateEscalationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.EscalationPolicy
+		if args[1] != nil {
+			arg1 = args[1].(alarms.EscalationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateEscalationPolicy_Call) Return(escalationPolicy alarms.EscalationPolicy, err error) *Repository_UpdateEscalationPolicy_Call {
+	_c.Call.Return(escalationPolicy, err)
+	return _c
+}
+
+func (_c *Repository_UpdateEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error)) *Repository_UpdateEscalationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationPolicy provides a mock function for the type Repository
+func (_mock *Repository) UpdateNotificationPolicy(ctx context.Context, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationPolicy")
+	}
+
+	var r0 alarms.NotificationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.NotificationPolicy) (alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.NotificationPolicy) alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.NotificationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.NotificationPolicy) error); ok {
+		r1 = returnFunc(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationPolicy'
+type Repository_UpdateNotificationPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy alarms.NotificationPolicy
+func (_e *Repository_Expecter) UpdateNotificationPolicy(ctx interface{}, policy interface{}) *Repository_UpdateNotificationPolicy_Call {
+	return &Repository_UpdateNotificationPolicy_Call{Call: _e.mock.On("UpdateNotificationPolicy", ctx, policy)}
+}
+
+func (_c *Repository_UpdateNotificationPolicy_Call) Run(run func(ctx context.Context, policy alarms.NotificationPolicy)) *Repository_UpdateNotificationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.NotificationPolicy
+		if args[1] != nil {
+			arg1 = args[1].(alarms.NotificationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateNotificationPolicy_Call) Return(notificationPolicy alarms.NotificationPolicy, err error) *Repository_UpdateNotificationPolicy_Call {
+	_c.Call.Return(notificationPolicy, err)
+	return _c
+}
+
+func (_c *Repository_UpdateNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error)) *Repository_UpdateNotificationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSuppressionWindow provides a mock function for the type Repository
+func (_mock *Repository) UpdateSuppressionWindow(ctx context.Context, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	ret := _mock.Called(ctx, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSuppressionWindow")
+	}
+
+	var r0 alarms.SuppressionWindow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.SuppressionWindow) (alarms.SuppressionWindow, error)); ok {
+		return returnFunc(ctx, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.SuppressionWindow) alarms.SuppressionWindow); ok {
+		r0 = returnFunc(ctx, window)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.SuppressionWindow) error); ok {
+		r1 = returnFunc(ctx, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_UpdateSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSuppressionWindow'
+type Repository_UpdateSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// UpdateSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - window alarms.SuppressionWindow
+func (_e *Repository_Expecter) UpdateSuppressionWindow(ctx interface{}, window interface{}) *Repository_UpdateSuppressionWindow_Call {
+	return &Repository_UpdateSuppressionWindow_Call{Call: _e.mock.On("UpdateSuppressionWindow", ctx, window)}
+}
+
+func (_c *Repository_UpdateSuppressionWindow_Call) Run(run func(ctx context.Context, window alarms.SuppressionWindow)) *Repository_UpdateSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.SuppressionWindow
+		if args[1] != nil {
+			arg1 = args[1].(alarms.SuppressionWindow)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_UpdateSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Repository_UpdateSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
+	return _c
+}
+
+func (_c *Repository_UpdateSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error)) *Repository_UpdateSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserAlarmStats provides a mock function for the type Repository
+func (_mock *Repository) UserAlarmStats(ctx context.Context, userID string, q alarms.StatsQuery) (alarms.Stats, error) {
+	ret := _mock.Called(ctx, userID, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserAlarmStats")
 	}
 
-	var r0 alarms.Alarm
+	var r0 alarms.Stats
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) (alarms.Alarm, error)); ok {
-		return returnFunc(ctx, alarm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.StatsQuery) (alarms.Stats, error)); ok {
+		return returnFunc(ctx, userID, q)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) alarms.Alarm); ok {
-		r0 = returnFunc(ctx, alarm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.StatsQuery) alarms.Stats); ok {
+		r0 = returnFunc(ctx, userID, q)
 	} else {
-		r0 = ret.Get(0).(alarms.Alarm)
+		r0 = ret.Get(0).(alarms.Stats)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
-		r1 = returnFunc(ctx, alarm)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, alarms.StatsQuery) error); ok {
+		r1 = returnFunc(ctx, userID, q)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_CreateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlarm'
-type Repository_CreateAlarm_Call struct {
+// Repository_UserAlarmStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserAlarmStats'
+type Repository_UserAlarmStats_Call struct {
 	*mock.Call
 }
 
-// CreateAlarm is a helper method to define mock.On call
+// UserAlarmStats is a helper method to define mock.On call
 //   - ctx context.Context
-//   - alarm alarms.Alarm
-func (_e *Repository_Expecter) CreateAlarm(ctx interface{}, alarm interface{}) *Repository_CreateAlarm_Call {
-	return &Repository_CreateAlarm_Call{Call: _e.mock.On("CreateAlarm", ctx, alarm)}
+//   - userID string
+//   - q alarms.StatsQuery
+func (_e *Repository_Expecter) UserAlarmStats(ctx interface{}, userID interface{}, q interface{}) *Repository_UserAlarmStats_Call {
+	return &Repository_UserAlarmStats_Call{Call: _e.mock.On("UserAlarmStats", ctx, userID, q)}
 }
 
-func (_c *Repository_CreateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_CreateAlarm_Call {
+func (_c *Repository_UserAlarmStats_Call) Run(run func(ctx context.Context, userID string, q alarms.StatsQuery)) *Repository_UserAlarmStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 alarms.Alarm
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(alarms.Alarm)
+			arg1 = args[1].(string)
+		}
+		var arg2 alarms.StatsQuery
+		if args[2] != nil {
+			arg2 = args[2].(alarms.StatsQuery)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_CreateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Repository_CreateAlarm_Call {
-	_c.Call.Return(alarm1, err)
+func (_c *Repository_UserAlarmStats_Call) Return(stats alarms.Stats, err error) *Repository_UserAlarmStats_Call {
+	_c.Call.Return(stats, err)
 	return _c
 }
 
-func (_c *Repository_CreateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error)) *Repository_CreateAlarm_Call {
+func (_c *Repository_UserAlarmStats_Call) RunAndReturn(run func(ctx context.Context, userID string, q alarms.StatsQuery) (alarms.Stats, error)) *Repository_UserAlarmStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAlarm provides a mock function for the type Repository
-func (_mock *Repository) DeleteAlarm(ctx context.Context, id string) error {
-	ret := _mock.Called(ctx, id)
+// ViewAlarm provides a mock function for the type Repository
+func (_mock *Repository) ViewAlarm(ctx context.Context, alarmID string, domainID string) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, alarmID, domainID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAlarm")
+		panic("no return value specified for ViewAlarm")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, alarmID, domainID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, alarmID, domainID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(alarms.Alarm)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, alarmID, domainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Repository_DeleteAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlarm'
-type Repository_DeleteAlarm_Call struct {
+// Repository_ViewAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewAlarm'
+type Repository_ViewAlarm_Call struct {
 	*mock.Call
 }
 
-// DeleteAlarm is a helper method to define mock.On call
+// ViewAlarm is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *Repository_Expecter) DeleteAlarm(ctx interface{}, id interface{}) *Repository_DeleteAlarm_Call {
-	return &Repository_DeleteAlarm_Call{Call: _e.mock.On("DeleteAlarm", ctx, id)}
+//   - alarmID string
+//   - domainID string
+func (_e *Repository_Expecter) ViewAlarm(ctx interface{}, alarmID interface{}, domainID interface{}) *Repository_ViewAlarm_Call {
+	return &Repository_ViewAlarm_Call{Call: _e.mock.On("ViewAlarm", ctx, alarmID, domainID)}
 }
 
-func (_c *Repository_DeleteAlarm_Call) Run(run func(ctx context.Context, id string)) *Repository_DeleteAlarm_Call {
+func (_c *Repository_ViewAlarm_Call) Run(run func(ctx context.Context, alarmID string, domainID string)) *Repository_ViewAlarm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -147,130 +2320,141 @@ func (_c *Repository_DeleteAlarm_Call) Run(run func(ctx context.Context, id stri
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_DeleteAlarm_Call) Return(err error) *Repository_DeleteAlarm_Call {
-	_c.Call.Return(err)
+func (_c *Repository_ViewAlarm_Call) Return(alarm alarms.Alarm, err error) *Repository_ViewAlarm_Call {
+	_c.Call.Return(alarm, err)
 	return _c
 }
 
-func (_c *Repository_DeleteAlarm_Call) RunAndReturn(run func(ctx context.Context, id string) error) *Repository_DeleteAlarm_Call {
+func (_c *Repository_ViewAlarm_Call) RunAndReturn(run func(ctx context.Context, alarmID string, domainID string) (alarms.Alarm, error)) *Repository_ViewAlarm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListAllAlarms provides a mock function for the type Repository
-func (_mock *Repository) ListAllAlarms(ctx context.Context, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
-	ret := _mock.Called(ctx, pm)
+// ViewComment provides a mock function for the type Repository
+func (_mock *Repository) ViewComment(ctx context.Context, domainID string, id string) (alarms.Comment, error) {
+	ret := _mock.Called(ctx, domainID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListAllAlarms")
+		panic("no return value specified for ViewComment")
 	}
 
-	var r0 alarms.AlarmsPage
+	var r0 alarms.Comment
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.PageMetadata) (alarms.AlarmsPage, error)); ok {
-		return returnFunc(ctx, pm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (alarms.Comment, error)); ok {
+		return returnFunc(ctx, domainID, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.PageMetadata) alarms.AlarmsPage); ok {
-		r0 = returnFunc(ctx, pm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) alarms.Comment); ok {
+		r0 = returnFunc(ctx, domainID, id)
 	} else {
-		r0 = ret.Get(0).(alarms.AlarmsPage)
+		r0 = ret.Get(0).(alarms.Comment)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.PageMetadata) error); ok {
-		r1 = returnFunc(ctx, pm)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_ListAllAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAllAlarms'
-type Repository_ListAllAlarms_Call struct {
+// Repository_ViewComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewComment'
+type Repository_ViewComment_Call struct {
 	*mock.Call
 }
 
-// ListAllAlarms is a helper method to define mock.On call
+// ViewComment is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pm alarms.PageMetadata
-func (_e *Repository_Expecter) ListAllAlarms(ctx interface{}, pm interface{}) *Repository_ListAllAlarms_Call {
-	return &Repository_ListAllAlarms_Call{Call: _e.mock.On("ListAllAlarms", ctx, pm)}
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) ViewComment(ctx interface{}, domainID interface{}, id interface{}) *Repository_ViewComment_Call {
+	return &Repository_ViewComment_Call{Call: _e.mock.On("ViewComment", ctx, domainID, id)}
 }
 
-func (_c *Repository_ListAllAlarms_Call) Run(run func(ctx context.Context, pm alarms.PageMetadata)) *Repository_ListAllAlarms_Call {
+func (_c *Repository_ViewComment_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_ViewComment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 alarms.PageMetadata
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(alarms.PageMetadata)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_ListAllAlarms_Call) Return(alarmsPage alarms.AlarmsPage, err error) *Repository_ListAllAlarms_Call {
-	_c.Call.Return(alarmsPage, err)
+func (_c *Repository_ViewComment_Call) Return(comment alarms.Comment, err error) *Repository_ViewComment_Call {
+	_c.Call.Return(comment, err)
 	return _c
 }
 
-func (_c *Repository_ListAllAlarms_Call) RunAndReturn(run func(ctx context.Context, pm alarms.PageMetadata) (alarms.AlarmsPage, error)) *Repository_ListAllAlarms_Call {
+func (_c *Repository_ViewComment_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (alarms.Comment, error)) *Repository_ViewComment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListUserAlarms provides a mock function for the type Repository
-func (_mock *Repository) ListUserAlarms(ctx context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
-	ret := _mock.Called(ctx, userID, pm)
+// ViewEscalationPolicy provides a mock function for the type Repository
+func (_mock *Repository) ViewEscalationPolicy(ctx context.Context, domainID string, id string) (alarms.EscalationPolicy, error) {
+	ret := _mock.Called(ctx, domainID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListUserAlarms")
+		panic("no return value specified for ViewEscalationPolicy")
 	}
 
-	var r0 alarms.AlarmsPage
+	var r0 alarms.EscalationPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.PageMetadata) (alarms.AlarmsPage, error)); ok {
-		return returnFunc(ctx, userID, pm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (alarms.EscalationPolicy, error)); ok {
+		return returnFunc(ctx, domainID, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, alarms.PageMetadata) alarms.AlarmsPage); ok {
-		r0 = returnFunc(ctx, userID, pm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) alarms.EscalationPolicy); ok {
+		r0 = returnFunc(ctx, domainID, id)
 	} else {
-		r0 = ret.Get(0).(alarms.AlarmsPage)
+		r0 = ret.Get(0).(alarms.EscalationPolicy)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, alarms.PageMetadata) error); ok {
-		r1 = returnFunc(ctx, userID, pm)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_ListUserAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserAlarms'
-type Repository_ListUserAlarms_Call struct {
+// Repository_ViewEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewEscalationPolicy'
+type Repository_ViewEscalationPolicy_Call struct {
 	*mock.Call
 }
 
-// ListUserAlarms is a helper method to define mock.On call
+// ViewEscalationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID string
-//   - pm alarms.PageMetadata
-func (_e *Repository_Expecter) ListUserAlarms(ctx interface{}, userID interface{}, pm interface{}) *Repository_ListUserAlarms_Call {
-	return &Repository_ListUserAlarms_Call{Call: _e.mock.On("ListUserAlarms", ctx, userID, pm)}
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) ViewEscalationPolicy(ctx interface{}, domainID interface{}, id interface{}) *Repository_ViewEscalationPolicy_Call {
+	return &Repository_ViewEscalationPolicy_Call{Call: _e.mock.On("ViewEscalationPolicy", ctx, domainID, id)}
 }
 
-func (_c *Repository_ListUserAlarms_Call) Run(run func(ctx context.Context, userID string, pm alarms.PageMetadata)) *Repository_ListUserAlarms_Call {
+func (_c *Repository_ViewEscalationPolicy_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_ViewEscalationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -280,9 +2464,9 @@ func (_c *Repository_ListUserAlarms_Call) Run(run func(ctx context.Context, user
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 alarms.PageMetadata
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(alarms.PageMetadata)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -293,122 +2477,128 @@ func (_c *Repository_ListUserAlarms_Call) Run(run func(ctx context.Context, user
 	return _c
 }
 
-func (_c *Repository_ListUserAlarms_Call) Return(alarmsPage alarms.AlarmsPage, err error) *Repository_ListUserAlarms_Call {
-	_c.Call.Return(alarmsPage, err)
+func (_c *Repository_ViewEscalationPolicy_Call) Return(escalationPolicy alarms.EscalationPolicy, err error) *Repository_ViewEscalationPolicy_Call {
+	_c.Call.Return(escalationPolicy, err)
 	return _c
 }
 
-func (_c *Repository_ListUserAlarms_Call) RunAndReturn(run func(ctx context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error)) *Repository_ListUserAlarms_Call {
+func (_c *Repository_ViewEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (alarms.EscalationPolicy, error)) *Repository_ViewEscalationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAlarm provides a mock function for the type Repository
-func (_mock *Repository) UpdateAlarm(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error) {
-	ret := _mock.Called(ctx, alarm)
+// ViewNotificationPolicy provides a mock function for the type Repository
+func (_mock *Repository) ViewNotificationPolicy(ctx context.Context, domainID string, id string) (alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, domainID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAlarm")
+		panic("no return value specified for ViewNotificationPolicy")
 	}
 
-	var r0 alarms.Alarm
+	var r0 alarms.NotificationPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) (alarms.Alarm, error)); ok {
-		return returnFunc(ctx, alarm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, domainID, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) alarms.Alarm); ok {
-		r0 = returnFunc(ctx, alarm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, domainID, id)
 	} else {
-		r0 = ret.Get(0).(alarms.Alarm)
+		r0 = ret.Get(0).(alarms.NotificationPolicy)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, alarms.Alarm) error); ok {
-		r1 = returnFunc(ctx, alarm)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_UpdateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlarm'
-type Repository_UpdateAlarm_Call struct {
+// Repository_ViewNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewNotificationPolicy'
+type Repository_ViewNotificationPolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateAlarm is a helper method to define mock.On call
+// ViewNotificationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - alarm alarms.Alarm
-func (_e *Repository_Expecter) UpdateAlarm(ctx interface{}, alarm interface{}) *Repository_UpdateAlarm_Call {
-	return &Repository_UpdateAlarm_Call{Call: _e.mock.On("UpdateAlarm", ctx, alarm)}
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) ViewNotificationPolicy(ctx interface{}, domainID interface{}, id interface{}) *Repository_ViewNotificationPolicy_Call {
+	return &Repository_ViewNotificationPolicy_Call{Call: _e.mock.On("ViewNotificationPolicy", ctx, domainID, id)}
 }
 
-func (_c *Repository_UpdateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Repository_UpdateAlarm_Call {
+func (_c *Repository_ViewNotificationPolicy_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_ViewNotificationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 alarms.Alarm
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(alarms.Alarm)
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_UpdateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Repository_UpdateAlarm_Call {
-	_c.Call.Return(alarm1, err)
+func (_c *Repository_ViewNotificationPolicy_Call) Return(notificationPolicy alarms.NotificationPolicy, err error) *Repository_ViewNotificationPolicy_Call {
+	_c.Call.Return(notificationPolicy, err)
 	return _c
 }
 
-func (_c *Repository_UpdateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error)) *Repository_UpdateAlarm_Call {
+func (_c *Repository_ViewNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (alarms.NotificationPolicy, error)) *Repository_ViewNotificationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ViewAlarm provides a mock function for the type Repository
-func (_mock *Repository) ViewAlarm(ctx context.Context, alarmID string, domainID string) (alarms.Alarm, error) {
-	ret := _mock.Called(ctx, alarmID, domainID)
+// ViewSuppressionWindow provides a mock function for the type Repository
+func (_mock *Repository) ViewSuppressionWindow(ctx context.Context, domainID string, id string) (alarms.SuppressionWindow, error) {
+	ret := _mock.Called(ctx, domainID, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ViewAlarm")
+		panic("no return value specified for ViewSuppressionWindow")
 	}
 
-	var r0 alarms.Alarm
+	var r0 alarms.SuppressionWindow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (alarms.Alarm, error)); ok {
-		return returnFunc(ctx, alarmID, domainID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (alarms.SuppressionWindow, error)); ok {
+		return returnFunc(ctx, domainID, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) alarms.Alarm); ok {
-		r0 = returnFunc(ctx, alarmID, domainID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) alarms.SuppressionWindow); ok {
+		r0 = returnFunc(ctx, domainID, id)
 	} else {
-		r0 = ret.Get(0).(alarms.Alarm)
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, alarmID, domainID)
+		r1 = returnFunc(ctx, domainID, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_ViewAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewAlarm'
-type Repository_ViewAlarm_Call struct {
+// Repository_ViewSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewSuppressionWindow'
+type Repository_ViewSuppressionWindow_Call struct {
 	*mock.Call
 }
 
-// ViewAlarm is a helper method to define mock.On call
+// ViewSuppressionWindow is a helper method to define mock.On call
 //   - ctx context.Context
-//   - alarmID string
 //   - domainID string
-func (_e *Repository_Expecter) ViewAlarm(ctx interface{}, alarmID interface{}, domainID interface{}) *Repository_ViewAlarm_Call {
-	return &Repository_ViewAlarm_Call{Call: _e.mock.On("ViewAlarm", ctx, alarmID, domainID)}
+//   - id string
+func (_e *Repository_Expecter) ViewSuppressionWindow(ctx interface{}, domainID interface{}, id interface{}) *Repository_ViewSuppressionWindow_Call {
+	return &Repository_ViewSuppressionWindow_Call{Call: _e.mock.On("ViewSuppressionWindow", ctx, domainID, id)}
 }
 
-func (_c *Repository_ViewAlarm_Call) Run(run func(ctx context.Context, alarmID string, domainID string)) *Repository_ViewAlarm_Call {
+func (_c *Repository_ViewSuppressionWindow_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_ViewSuppressionWindow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -431,12 +2621,69 @@ func (_c *Repository_ViewAlarm_Call) Run(run func(ctx context.Context, alarmID s
 	return _c
 }
 
-func (_c *Repository_ViewAlarm_Call) Return(alarm alarms.Alarm, err error) *Repository_ViewAlarm_Call {
-	_c.Call.Return(alarm, err)
+func (_c *Repository_ViewSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Repository_ViewSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
 	return _c
 }
 
-func (_c *Repository_ViewAlarm_Call) RunAndReturn(run func(ctx context.Context, alarmID string, domainID string) (alarms.Alarm, error)) *Repository_ViewAlarm_Call {
+func (_c *Repository_ViewSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (alarms.SuppressionWindow, error)) *Repository_ViewSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WakeSnoozedAlarms provides a mock function for the type Repository
+func (_mock *Repository) WakeSnoozedAlarms(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WakeSnoozedAlarms")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_WakeSnoozedAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WakeSnoozedAlarms'
+type Repository_WakeSnoozedAlarms_Call struct {
+	*mock.Call
+}
+
+// WakeSnoozedAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *Repository_Expecter) WakeSnoozedAlarms(ctx interface{}, before interface{}) *Repository_WakeSnoozedAlarms_Call {
+	return &Repository_WakeSnoozedAlarms_Call{Call: _e.mock.On("WakeSnoozedAlarms", ctx, before)}
+}
+
+func (_c *Repository_WakeSnoozedAlarms_Call) Run(run func(ctx context.Context, before time.Time)) *Repository_WakeSnoozedAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_WakeSnoozedAlarms_Call) Return(err error) *Repository_WakeSnoozedAlarms_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_WakeSnoozedAlarms_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *Repository_WakeSnoozedAlarms_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -10,6 +10,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	"github.com/absmach/magistrala/pkg/authn"
@@ -43,94 +44,2073 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// AcknowledgeAlarm provides a mock function for the type Service
+func (_mock *Service) AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_AcknowledgeAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeAlarm'
+type Service_AcknowledgeAlarm_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) AcknowledgeAlarm(ctx interface{}, session interface{}, id interface{}) *Service_AcknowledgeAlarm_Call {
+	return &Service_AcknowledgeAlarm_Call{Call: _e.mock.On("AcknowledgeAlarm", ctx, session, id)}
+}
+
+func (_c *Service_AcknowledgeAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_AcknowledgeAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_AcknowledgeAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_AcknowledgeAlarm_Call {
+	_c.Call.Return(alarm, err)
+	return _c
+}
+
+func (_c *Service_AcknowledgeAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error)) *Service_AcknowledgeAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddComment provides a mock function for the type Service
+func (_mock *Service) AddComment(ctx context.Context, session authn.Session, comment alarms.Comment) (alarms.Comment, error) {
+	ret := _mock.Called(ctx, session, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddComment")
+	}
+
+	var r0 alarms.Comment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.Comment) (alarms.Comment, error)); ok {
+		return returnFunc(ctx, session, comment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.Comment) alarms.Comment); ok {
+		r0 = returnFunc(ctx, session, comment)
+	} else {
+		r0 = ret.Get(0).(alarms.Comment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.Comment) error); ok {
+		r1 = returnFunc(ctx, session, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type Service_AddComment_Call struct {
+	*mock.Call
+}
+
+// AddComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - comment alarms.Comment
+func (_e *Service_Expecter) AddComment(ctx interface{}, session interface{}, comment interface{}) *Service_AddComment_Call {
+	return &Service_AddComment_Call{Call: _e.mock.On("AddComment", ctx, session, comment)}
+}
+
+func (_c *Service_AddComment_Call) Run(run func(ctx context.Context, session authn.Session, comment alarms.Comment)) *Service_AddComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.Comment
+		if args[2] != nil {
+			arg2 = args[2].(alarms.Comment)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_AddComment_Call) Return(comment1 alarms.Comment, err error) *Service_AddComment_Call {
+	_c.Call.Return(comment1, err)
+	return _c
+}
+
+func (_c *Service_AddComment_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, comment alarms.Comment) (alarms.Comment, error)) *Service_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlarmStats provides a mock function for the type Service
+func (_mock *Service) AlarmStats(ctx context.Context, session authn.Session, q alarms.StatsQuery) (alarms.Stats, error) {
+	ret := _mock.Called(ctx, session, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlarmStats")
+	}
+
+	var r0 alarms.Stats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.StatsQuery) (alarms.Stats, error)); ok {
+		return returnFunc(ctx, session, q)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.StatsQuery) alarms.Stats); ok {
+		r0 = returnFunc(ctx, session, q)
+	} else {
+		r0 = ret.Get(0).(alarms.Stats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.StatsQuery) error); ok {
+		r1 = returnFunc(ctx, session, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_AlarmStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlarmStats'
+type Service_AlarmStats_Call struct {
+	*mock.Call
+}
+
+// AlarmStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - q alarms.StatsQuery
+func (_e *Service_Expecter) AlarmStats(ctx interface{}, session interface{}, q interface{}) *Service_AlarmStats_Call {
+	return &Service_AlarmStats_Call{Call: _e.mock.On("AlarmStats", ctx, session, q)}
+}
+
+func (_c *Service_AlarmStats_Call) Run(run func(ctx context.Context, session authn.Session, q alarms.StatsQuery)) *Service_AlarmStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.StatsQuery
+		if args[2] != nil {
+			arg2 = args[2].(alarms.StatsQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_AlarmStats_Call) Return(stats alarms.Stats, err error) *Service_AlarmStats_Call {
+	_c.Call.Return(stats, err)
+	return _c
+}
+
+func (_c *Service_AlarmStats_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, q alarms.StatsQuery) (alarms.Stats, error)) *Service_AlarmStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignAlarm provides a mock function for the type Service
+func (_mock *Service) AssignAlarm(ctx context.Context, session authn.Session, id string, assigneeID string) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, id, assigneeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, id, assigneeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, id, assigneeID)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, string) error); ok {
+		r1 = returnFunc(ctx, session, id, assigneeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_AssignAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignAlarm'
+type Service_AssignAlarm_Call struct {
+	*mock.Call
+}
+
+// AssignAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - assigneeID string
+func (_e *Service_Expecter) AssignAlarm(ctx interface{}, session interface{}, id interface{}, assigneeID interface{}) *Service_AssignAlarm_Call {
+	return &Service_AssignAlarm_Call{Call: _e.mock.On("AssignAlarm", ctx, session, id, assigneeID)}
+}
+
+func (_c *Service_AssignAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string, assigneeID string)) *Service_AssignAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_AssignAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_AssignAlarm_Call {
+	_c.Call.Return(alarm, err)
+	return _c
+}
+
+func (_c *Service_AssignAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, assigneeID string) (alarms.Alarm, error)) *Service_AssignAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkAlarms provides a mock function for the type Service
+func (_mock *Service) BulkAlarms(ctx context.Context, session authn.Session, req alarms.BulkRequest) (alarms.BulkReport, error) {
+	ret := _mock.Called(ctx, session, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkAlarms")
+	}
+
+	var r0 alarms.BulkReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.BulkRequest) (alarms.BulkReport, error)); ok {
+		return returnFunc(ctx, session, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.BulkRequest) alarms.BulkReport); ok {
+		r0 = returnFunc(ctx, session, req)
+	} else {
+		r0 = ret.Get(0).(alarms.BulkReport)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.BulkRequest) error); ok {
+		r1 = returnFunc(ctx, session, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_BulkAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkAlarms'
+type Service_BulkAlarms_Call struct {
+	*mock.Call
+}
+
+// BulkAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - req alarms.BulkRequest
+func (_e *Service_Expecter) BulkAlarms(ctx interface{}, session interface{}, req interface{}) *Service_BulkAlarms_Call {
+	return &Service_BulkAlarms_Call{Call: _e.mock.On("BulkAlarms", ctx, session, req)}
+}
+
+func (_c *Service_BulkAlarms_Call) Run(run func(ctx context.Context, session authn.Session, req alarms.BulkRequest)) *Service_BulkAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.BulkRequest
+		if args[2] != nil {
+			arg2 = args[2].(alarms.BulkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_BulkAlarms_Call) Return(bulkReport alarms.BulkReport, err error) *Service_BulkAlarms_Call {
+	_c.Call.Return(bulkReport, err)
+	return _c
+}
+
+func (_c *Service_BulkAlarms_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, req alarms.BulkRequest) (alarms.BulkReport, error)) *Service_BulkAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlarm provides a mock function for the type Service
 func (_mock *Service) CreateAlarm(ctx context.Context, alarm alarms.Alarm) error {
 	ret := _mock.Called(ctx, alarm)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateAlarm")
+		panic("no return value specified for CreateAlarm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) error); ok {
+		r0 = returnFunc(ctx, alarm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_CreateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlarm'
+type Service_CreateAlarm_Call struct {
+	*mock.Call
+}
+
+// CreateAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarm alarms.Alarm
+func (_e *Service_Expecter) CreateAlarm(ctx interface{}, alarm interface{}) *Service_CreateAlarm_Call {
+	return &Service_CreateAlarm_Call{Call: _e.mock.On("CreateAlarm", ctx, alarm)}
+}
+
+func (_c *Service_CreateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Service_CreateAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 alarms.Alarm
+		if args[1] != nil {
+			arg1 = args[1].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_CreateAlarm_Call) Return(err error) *Service_CreateAlarm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_CreateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) error) *Service_CreateAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateEscalationPolicy provides a mock function for the type Service
+func (_mock *Service) CreateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	ret := _mock.Called(ctx, session, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEscalationPolicy")
+	}
+
+	var r0 alarms.EscalationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.EscalationPolicy) (alarms.EscalationPolicy, error)); ok {
+		return returnFunc(ctx, session, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.EscalationPolicy) alarms.EscalationPolicy); ok {
+		r0 = returnFunc(ctx, session, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.EscalationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.EscalationPolicy) error); ok {
+		r1 = returnFunc(ctx, session, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_CreateEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateEscalationPolicy'
+type Service_CreateEscalationPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateEscalationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - policy alarms.EscalationPolicy
+func (_e *Service_Expecter) CreateEscalationPolicy(ctx interface{}, session interface{}, policy interface{}) *Service_CreateEscalationPolicy_Call {
+	return &Service_CreateEscalationPolicy_Call{Call: _e.mock.On("CreateEscalationPolicy", ctx, session, policy)}
+}
+
+func (_c *Service_CreateEscalationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy)) *Service_CreateEscalationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.EscalationPolicy
+		if args[2] != nil {
+			arg2 = args[2].(alarms.EscalationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_CreateEscalationPolicy_Call) Return(escalationPolicy alarms.EscalationPolicy, err error) *Service_CreateEscalationPolicy_Call {
+	_c.Call.Return(escalationPolicy, err)
+	return _c
+}
+
+func (_c *Service_CreateEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error)) *Service_CreateEscalationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotificationPolicy provides a mock function for the type Service
+func (_mock *Service) CreateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, session, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationPolicy")
+	}
+
+	var r0 alarms.NotificationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.NotificationPolicy) (alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, session, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.NotificationPolicy) alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, session, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.NotificationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.NotificationPolicy) error); ok {
+		r1 = returnFunc(ctx, session, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_CreateNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationPolicy'
+type Service_CreateNotificationPolicy_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - policy alarms.NotificationPolicy
+func (_e *Service_Expecter) CreateNotificationPolicy(ctx interface{}, session interface{}, policy interface{}) *Service_CreateNotificationPolicy_Call {
+	return &Service_CreateNotificationPolicy_Call{Call: _e.mock.On("CreateNotificationPolicy", ctx, session, policy)}
+}
+
+func (_c *Service_CreateNotificationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy)) *Service_CreateNotificationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.NotificationPolicy
+		if args[2] != nil {
+			arg2 = args[2].(alarms.NotificationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_CreateNotificationPolicy_Call) Return(notificationPolicy alarms.NotificationPolicy, err error) *Service_CreateNotificationPolicy_Call {
+	_c.Call.Return(notificationPolicy, err)
+	return _c
+}
+
+func (_c *Service_CreateNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error)) *Service_CreateNotificationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSuppressionWindow provides a mock function for the type Service
+func (_mock *Service) CreateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	ret := _mock.Called(ctx, session, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSuppressionWindow")
+	}
+
+	var r0 alarms.SuppressionWindow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.SuppressionWindow) (alarms.SuppressionWindow, error)); ok {
+		return returnFunc(ctx, session, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.SuppressionWindow) alarms.SuppressionWindow); ok {
+		r0 = returnFunc(ctx, session, window)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.SuppressionWindow) error); ok {
+		r1 = returnFunc(ctx, session, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_CreateSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSuppressionWindow'
+type Service_CreateSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// CreateSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - window alarms.SuppressionWindow
+func (_e *Service_Expecter) CreateSuppressionWindow(ctx interface{}, session interface{}, window interface{}) *Service_CreateSuppressionWindow_Call {
+	return &Service_CreateSuppressionWindow_Call{Call: _e.mock.On("CreateSuppressionWindow", ctx, session, window)}
+}
+
+func (_c *Service_CreateSuppressionWindow_Call) Run(run func(ctx context.Context, session authn.Session, window alarms.SuppressionWindow)) *Service_CreateSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.SuppressionWindow
+		if args[2] != nil {
+			arg2 = args[2].(alarms.SuppressionWindow)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_CreateSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Service_CreateSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
+	return _c
+}
+
+func (_c *Service_CreateSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error)) *Service_CreateSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAlarm provides a mock function for the type Service
+func (_mock *Service) DeleteAlarm(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlarm")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_DeleteAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlarm'
+type Service_DeleteAlarm_Call struct {
+	*mock.Call
+}
+
+// DeleteAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) DeleteAlarm(ctx interface{}, session interface{}, id interface{}) *Service_DeleteAlarm_Call {
+	return &Service_DeleteAlarm_Call{Call: _e.mock.On("DeleteAlarm", ctx, session, id)}
+}
+
+func (_c *Service_DeleteAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_DeleteAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DeleteAlarm_Call) Return(err error) *Service_DeleteAlarm_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_DeleteAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_DeleteAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListActivities provides a mock function for the type Service
+func (_mock *Service) ListActivities(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActivities")
+	}
+
+	var r0 alarms.ActivityPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.ActivityPageMeta) (alarms.ActivityPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.ActivityPageMeta) alarms.ActivityPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.ActivityPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.ActivityPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListActivities'
+type Service_ListActivities_Call struct {
+	*mock.Call
+}
+
+// ListActivities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm alarms.ActivityPageMeta
+func (_e *Service_Expecter) ListActivities(ctx interface{}, session interface{}, pm interface{}) *Service_ListActivities_Call {
+	return &Service_ListActivities_Call{Call: _e.mock.On("ListActivities", ctx, session, pm)}
+}
+
+func (_c *Service_ListActivities_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta)) *Service_ListActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.ActivityPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.ActivityPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListActivities_Call) Return(activityPage alarms.ActivityPage, err error) *Service_ListActivities_Call {
+	_c.Call.Return(activityPage, err)
+	return _c
+}
+
+func (_c *Service_ListActivities_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error)) *Service_ListActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAlarms provides a mock function for the type Service
+func (_mock *Service) ListAlarms(ctx context.Context, session authn.Session, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAlarms")
+	}
+
+	var r0 alarms.AlarmsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.PageMetadata) (alarms.AlarmsPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.PageMetadata) alarms.AlarmsPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.AlarmsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.PageMetadata) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAlarms'
+type Service_ListAlarms_Call struct {
+	*mock.Call
+}
+
+// ListAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm alarms.PageMetadata
+func (_e *Service_Expecter) ListAlarms(ctx interface{}, session interface{}, pm interface{}) *Service_ListAlarms_Call {
+	return &Service_ListAlarms_Call{Call: _e.mock.On("ListAlarms", ctx, session, pm)}
+}
+
+func (_c *Service_ListAlarms_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.PageMetadata)) *Service_ListAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(alarms.PageMetadata)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListAlarms_Call) Return(alarmsPage alarms.AlarmsPage, err error) *Service_ListAlarms_Call {
+	_c.Call.Return(alarmsPage, err)
+	return _c
+}
+
+func (_c *Service_ListAlarms_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.PageMetadata) (alarms.AlarmsPage, error)) *Service_ListAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListComments provides a mock function for the type Service
+func (_mock *Service) ListComments(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta) (alarms.CommentsPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComments")
+	}
+
+	var r0 alarms.CommentsPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.CommentPageMeta) (alarms.CommentsPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.CommentPageMeta) alarms.CommentsPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.CommentsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.CommentPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListComments'
+type Service_ListComments_Call struct {
+	*mock.Call
+}
+
+// ListComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm alarms.CommentPageMeta
+func (_e *Service_Expecter) ListComments(ctx interface{}, session interface{}, pm interface{}) *Service_ListComments_Call {
+	return &Service_ListComments_Call{Call: _e.mock.On("ListComments", ctx, session, pm)}
+}
+
+func (_c *Service_ListComments_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta)) *Service_ListComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.CommentPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.CommentPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListComments_Call) Return(commentsPage alarms.CommentsPage, err error) *Service_ListComments_Call {
+	_c.Call.Return(commentsPage, err)
+	return _c
+}
+
+func (_c *Service_ListComments_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.CommentPageMeta) (alarms.CommentsPage, error)) *Service_ListComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEscalationPolicies provides a mock function for the type Service
+func (_mock *Service) ListEscalationPolicies(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEscalationPolicies")
+	}
+
+	var r0 alarms.EscalationPolicyPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.EscalationPolicyPageMeta) alarms.EscalationPolicyPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.EscalationPolicyPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.EscalationPolicyPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListEscalationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEscalationPolicies'
+type Service_ListEscalationPolicies_Call struct {
+	*mock.Call
+}
+
+// ListEscalationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm alarms.EscalationPolicyPageMeta
+func (_e *Service_Expecter) ListEscalationPolicies(ctx interface{}, session interface{}, pm interface{}) *Service_ListEscalationPolicies_Call {
+	return &Service_ListEscalationPolicies_Call{Call: _e.mock.On("ListEscalationPolicies", ctx, session, pm)}
+}
+
+func (_c *Service_ListEscalationPolicies_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta)) *Service_ListEscalationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.EscalationPolicyPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.EscalationPolicyPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListEscalationPolicies_Call) Return(escalationPolicyPage alarms.EscalationPolicyPage, err error) *Service_ListEscalationPolicies_Call {
+	_c.Call.Return(escalationPolicyPage, err)
+	return _c
+}
+
+func (_c *Service_ListEscalationPolicies_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error)) *Service_ListEscalationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotificationPolicies provides a mock function for the type Service
+func (_mock *Service) ListNotificationPolicies(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotificationPolicies")
+	}
+
+	var r0 alarms.NotificationPolicyPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.NotificationPolicyPageMeta) alarms.NotificationPolicyPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.NotificationPolicyPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.NotificationPolicyPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListNotificationPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotificationPolicies'
+type Service_ListNotificationPolicies_Call struct {
+	*mock.Call
+}
+
+// ListNotificationPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm alarms.NotificationPolicyPageMeta
+func (_e *Service_Expecter) ListNotificationPolicies(ctx interface{}, session interface{}, pm interface{}) *Service_ListNotificationPolicies_Call {
+	return &Service_ListNotificationPolicies_Call{Call: _e.mock.On("ListNotificationPolicies", ctx, session, pm)}
+}
+
+func (_c *Service_ListNotificationPolicies_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta)) *Service_ListNotificationPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.NotificationPolicyPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.NotificationPolicyPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListNotificationPolicies_Call) Return(notificationPolicyPage alarms.NotificationPolicyPage, err error) *Service_ListNotificationPolicies_Call {
+	_c.Call.Return(notificationPolicyPage, err)
+	return _c
+}
+
+func (_c *Service_ListNotificationPolicies_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error)) *Service_ListNotificationPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSuppressionWindows provides a mock function for the type Service
+func (_mock *Service) ListSuppressionWindows(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSuppressionWindows")
+	}
+
+	var r0 alarms.SuppressionWindowPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.SuppressionWindowPageMeta) alarms.SuppressionWindowPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindowPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.SuppressionWindowPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListSuppressionWindows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSuppressionWindows'
+type Service_ListSuppressionWindows_Call struct {
+	*mock.Call
+}
+
+// ListSuppressionWindows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm alarms.SuppressionWindowPageMeta
+func (_e *Service_Expecter) ListSuppressionWindows(ctx interface{}, session interface{}, pm interface{}) *Service_ListSuppressionWindows_Call {
+	return &Service_ListSuppressionWindows_Call{Call: _e.mock.On("ListSuppressionWindows", ctx, session, pm)}
+}
+
+func (_c *Service_ListSuppressionWindows_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta)) *Service_ListSuppressionWindows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.SuppressionWindowPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(alarms.SuppressionWindowPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListSuppressionWindows_Call) Return(suppressionWindowPage alarms.SuppressionWindowPage, err error) *Service_ListSuppressionWindows_Call {
+	_c.Call.Return(suppressionWindowPage, err)
+	return _c
+}
+
+func (_c *Service_ListSuppressionWindows_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error)) *Service_ListSuppressionWindows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveComment provides a mock function for the type Service
+func (_mock *Service) RemoveComment(ctx context.Context, session authn.Session, alarmID string, id string) error {
+	ret := _mock.Called(ctx, session, alarmID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, string) error); ok {
+		r0 = returnFunc(ctx, session, alarmID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveComment'
+type Service_RemoveComment_Call struct {
+	*mock.Call
+}
+
+// RemoveComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - alarmID string
+//   - id string
+func (_e *Service_Expecter) RemoveComment(ctx interface{}, session interface{}, alarmID interface{}, id interface{}) *Service_RemoveComment_Call {
+	return &Service_RemoveComment_Call{Call: _e.mock.On("RemoveComment", ctx, session, alarmID, id)}
+}
+
+func (_c *Service_RemoveComment_Call) Run(run func(ctx context.Context, session authn.Session, alarmID string, id string)) *Service_RemoveComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveComment_Call) Return(err error) *Service_RemoveComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveComment_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, alarmID string, id string) error) *Service_RemoveComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveEscalationPolicy provides a mock function for the type Service
+func (_mock *Service) RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveEscalationPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveEscalationPolicy'
+type Service_RemoveEscalationPolicy_Call struct {
+	*mock.Call
+}
+
+// RemoveEscalationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RemoveEscalationPolicy(ctx interface{}, session interface{}, id interface{}) *Service_RemoveEscalationPolicy_Call {
+	return &Service_RemoveEscalationPolicy_Call{Call: _e.mock.On("RemoveEscalationPolicy", ctx, session, id)}
+}
+
+func (_c *Service_RemoveEscalationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RemoveEscalationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveEscalationPolicy_Call) Return(err error) *Service_RemoveEscalationPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RemoveEscalationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveNotificationPolicy provides a mock function for the type Service
+func (_mock *Service) RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNotificationPolicy")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNotificationPolicy'
+type Service_RemoveNotificationPolicy_Call struct {
+	*mock.Call
+}
+
+// RemoveNotificationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RemoveNotificationPolicy(ctx interface{}, session interface{}, id interface{}) *Service_RemoveNotificationPolicy_Call {
+	return &Service_RemoveNotificationPolicy_Call{Call: _e.mock.On("RemoveNotificationPolicy", ctx, session, id)}
+}
+
+func (_c *Service_RemoveNotificationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RemoveNotificationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveNotificationPolicy_Call) Return(err error) *Service_RemoveNotificationPolicy_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RemoveNotificationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveSuppressionWindow provides a mock function for the type Service
+func (_mock *Service) RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSuppressionWindow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemoveSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveSuppressionWindow'
+type Service_RemoveSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// RemoveSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RemoveSuppressionWindow(ctx interface{}, session interface{}, id interface{}) *Service_RemoveSuppressionWindow_Call {
+	return &Service_RemoveSuppressionWindow_Call{Call: _e.mock.On("RemoveSuppressionWindow", ctx, session, id)}
+}
+
+func (_c *Service_RemoveSuppressionWindow_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RemoveSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemoveSuppressionWindow_Call) Return(err error) *Service_RemoveSuppressionWindow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemoveSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RemoveSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReopenAlarm provides a mock function for the type Service
+func (_mock *Service) ReopenAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReopenAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ReopenAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReopenAlarm'
+type Service_ReopenAlarm_Call struct {
+	*mock.Call
+}
+
+// ReopenAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) ReopenAlarm(ctx interface{}, session interface{}, id interface{}) *Service_ReopenAlarm_Call {
+	return &Service_ReopenAlarm_Call{Call: _e.mock.On("ReopenAlarm", ctx, session, id)}
+}
+
+func (_c *Service_ReopenAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ReopenAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ReopenAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_ReopenAlarm_Call {
+	_c.Call.Return(alarm, err)
+	return _c
+}
+
+func (_c *Service_ReopenAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error)) *Service_ReopenAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveAlarm provides a mock function for the type Service
+func (_mock *Service) ResolveAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ResolveAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveAlarm'
+type Service_ResolveAlarm_Call struct {
+	*mock.Call
+}
+
+// ResolveAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) ResolveAlarm(ctx interface{}, session interface{}, id interface{}) *Service_ResolveAlarm_Call {
+	return &Service_ResolveAlarm_Call{Call: _e.mock.On("ResolveAlarm", ctx, session, id)}
+}
+
+func (_c *Service_ResolveAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ResolveAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ResolveAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_ResolveAlarm_Call {
+	_c.Call.Return(alarm, err)
+	return _c
+}
+
+func (_c *Service_ResolveAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error)) *Service_ResolveAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnoozeAlarm provides a mock function for the type Service
+func (_mock *Service) SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, id, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnoozeAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, time.Time) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, id, until)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, time.Time) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, id, until)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, session, id, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_SnoozeAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnoozeAlarm'
+type Service_SnoozeAlarm_Call struct {
+	*mock.Call
+}
+
+// SnoozeAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - until time.Time
+func (_e *Service_Expecter) SnoozeAlarm(ctx interface{}, session interface{}, id interface{}, until interface{}) *Service_SnoozeAlarm_Call {
+	return &Service_SnoozeAlarm_Call{Call: _e.mock.On("SnoozeAlarm", ctx, session, id, until)}
+}
+
+func (_c *Service_SnoozeAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string, until time.Time)) *Service_SnoozeAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_SnoozeAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_SnoozeAlarm_Call {
+	_c.Call.Return(alarm, err)
+	return _c
+}
+
+func (_c *Service_SnoozeAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, until time.Time) (alarms.Alarm, error)) *Service_SnoozeAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartScheduler provides a mock function for the type Service
+func (_mock *Service) StartScheduler(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartScheduler")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_StartScheduler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartScheduler'
+type Service_StartScheduler_Call struct {
+	*mock.Call
+}
+
+// StartScheduler is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Service_Expecter) StartScheduler(ctx interface{}) *Service_StartScheduler_Call {
+	return &Service_StartScheduler_Call{Call: _e.mock.On("StartScheduler", ctx)}
+}
+
+func (_c *Service_StartScheduler_Call) Run(run func(ctx context.Context)) *Service_StartScheduler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_StartScheduler_Call) Return(err error) *Service_StartScheduler_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_StartScheduler_Call) RunAndReturn(run func(ctx context.Context) error) *Service_StartScheduler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamAlarms provides a mock function for the type Service
+func (_mock *Service) StreamAlarms(ctx context.Context, session authn.Session, filter alarms.StreamFilter) (<-chan alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAlarms")
+	}
+
+	var r0 <-chan alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.StreamFilter) (<-chan alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.StreamFilter) <-chan alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan alarms.Alarm)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.StreamFilter) error); ok {
+		r1 = returnFunc(ctx, session, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_StreamAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamAlarms'
+type Service_StreamAlarms_Call struct {
+	*mock.Call
+}
+
+// StreamAlarms is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - filter alarms.StreamFilter
+func (_e *Service_Expecter) StreamAlarms(ctx interface{}, session interface{}, filter interface{}) *Service_StreamAlarms_Call {
+	return &Service_StreamAlarms_Call{Call: _e.mock.On("StreamAlarms", ctx, session, filter)}
+}
+
+func (_c *Service_StreamAlarms_Call) Run(run func(ctx context.Context, session authn.Session, filter alarms.StreamFilter)) *Service_StreamAlarms_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.StreamFilter
+		if args[2] != nil {
+			arg2 = args[2].(alarms.StreamFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_StreamAlarms_Call) Return(alarmCh <-chan alarms.Alarm, err error) *Service_StreamAlarms_Call {
+	_c.Call.Return(alarmCh, err)
+	return _c
+}
+
+func (_c *Service_StreamAlarms_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, filter alarms.StreamFilter) (<-chan alarms.Alarm, error)) *Service_StreamAlarms_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlarm provides a mock function for the type Service
+func (_mock *Service) UpdateAlarm(ctx context.Context, session authn.Session, alarm alarms.Alarm) (alarms.Alarm, error) {
+	ret := _mock.Called(ctx, session, alarm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlarm")
+	}
+
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.Alarm) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, alarm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.Alarm) alarms.Alarm); ok {
+		r0 = returnFunc(ctx, session, alarm)
+	} else {
+		r0 = ret.Get(0).(alarms.Alarm)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.Alarm) error); ok {
+		r1 = returnFunc(ctx, session, alarm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlarm'
+type Service_UpdateAlarm_Call struct {
+	*mock.Call
+}
+
+// UpdateAlarm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - alarm alarms.Alarm
+func (_e *Service_Expecter) UpdateAlarm(ctx interface{}, session interface{}, alarm interface{}) *Service_UpdateAlarm_Call {
+	return &Service_UpdateAlarm_Call{Call: _e.mock.On("UpdateAlarm", ctx, session, alarm)}
+}
+
+func (_c *Service_UpdateAlarm_Call) Run(run func(ctx context.Context, session authn.Session, alarm alarms.Alarm)) *Service_UpdateAlarm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.Alarm
+		if args[2] != nil {
+			arg2 = args[2].(alarms.Alarm)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Service_UpdateAlarm_Call {
+	_c.Call.Return(alarm1, err)
+	return _c
+}
+
+func (_c *Service_UpdateAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, alarm alarms.Alarm) (alarms.Alarm, error)) *Service_UpdateAlarm_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEscalationPolicy provides a mock function for the type Service
+func (_mock *Service) UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	ret := _mock.Called(ctx, session, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEscalationPolicy")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, alarms.Alarm) error); ok {
-		r0 = returnFunc(ctx, alarm)
+	var r0 alarms.EscalationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.EscalationPolicy) (alarms.EscalationPolicy, error)); ok {
+		return returnFunc(ctx, session, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.EscalationPolicy) alarms.EscalationPolicy); ok {
+		r0 = returnFunc(ctx, session, policy)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(alarms.EscalationPolicy)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.EscalationPolicy) error); ok {
+		r1 = returnFunc(ctx, session, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Service_CreateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlarm'
-type Service_CreateAlarm_Call struct {
+// Service_UpdateEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEscalationPolicy'
+type Service_UpdateEscalationPolicy_Call struct {
 	*mock.Call
 }
 
-// CreateAlarm is a helper method to define mock.On call
+// UpdateEscalationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - alarm alarms.Alarm
-func (_e *Service_Expecter) CreateAlarm(ctx interface{}, alarm interface{}) *Service_CreateAlarm_Call {
-	return &Service_CreateAlarm_Call{Call: _e.mock.On("CreateAlarm", ctx, alarm)}
+//   - session authn.Session
+//   - policy alarms.EscalationPolicy
+func (_e *Service_Expecter) UpdateEscalationPolicy(ctx interface{}, session interface{}, policy interface{}) *Service_UpdateEscalationPolicy_Call {
+	return &Service_UpdateEscalationPolicy_Call{Call: _e.mock.On("UpdateEscalationPolicy", ctx, session, policy)}
 }
 
-func (_c *Service_CreateAlarm_Call) Run(run func(ctx context.Context, alarm alarms.Alarm)) *Service_CreateAlarm_Call {
+func (_c *Service_UpdateEscalationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy)) *Service_UpdateEscalationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 alarms.Alarm
+		var arg1 authn.Session
 		if args[1] != nil {
-			arg1 = args[1].(alarms.Alarm)
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.EscalationPolicy
+		if args[2] != nil {
+			arg2 = args[2].(alarms.EscalationPolicy)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Service_CreateAlarm_Call) Return(err error) *Service_CreateAlarm_Call {
-	_c.Call.Return(err)
+func (_c *Service_UpdateEscalationPolicy_Call) Return(escalationPolicy alarms.EscalationPolicy, err error) *Service_UpdateEscalationPolicy_Call {
+	_c.Call.Return(escalationPolicy, err)
 	return _c
 }
 
-func (_c *Service_CreateAlarm_Call) RunAndReturn(run func(ctx context.Context, alarm alarms.Alarm) error) *Service_CreateAlarm_Call {
+func (_c *Service_UpdateEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error)) *Service_UpdateEscalationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteAlarm provides a mock function for the type Service
-func (_mock *Service) DeleteAlarm(ctx context.Context, session authn.Session, id string) error {
+// UpdateNotificationPolicy provides a mock function for the type Service
+func (_mock *Service) UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, session, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationPolicy")
+	}
+
+	var r0 alarms.NotificationPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.NotificationPolicy) (alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, session, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.NotificationPolicy) alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, session, policy)
+	} else {
+		r0 = ret.Get(0).(alarms.NotificationPolicy)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.NotificationPolicy) error); ok {
+		r1 = returnFunc(ctx, session, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdateNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationPolicy'
+type Service_UpdateNotificationPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - policy alarms.NotificationPolicy
+func (_e *Service_Expecter) UpdateNotificationPolicy(ctx interface{}, session interface{}, policy interface{}) *Service_UpdateNotificationPolicy_Call {
+	return &Service_UpdateNotificationPolicy_Call{Call: _e.mock.On("UpdateNotificationPolicy", ctx, session, policy)}
+}
+
+func (_c *Service_UpdateNotificationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy)) *Service_UpdateNotificationPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.NotificationPolicy
+		if args[2] != nil {
+			arg2 = args[2].(alarms.NotificationPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdateNotificationPolicy_Call) Return(notificationPolicy alarms.NotificationPolicy, err error) *Service_UpdateNotificationPolicy_Call {
+	_c.Call.Return(notificationPolicy, err)
+	return _c
+}
+
+func (_c *Service_UpdateNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error)) *Service_UpdateNotificationPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSuppressionWindow provides a mock function for the type Service
+func (_mock *Service) UpdateSuppressionWindow(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	ret := _mock.Called(ctx, session, window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSuppressionWindow")
+	}
+
+	var r0 alarms.SuppressionWindow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.SuppressionWindow) (alarms.SuppressionWindow, error)); ok {
+		return returnFunc(ctx, session, window)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.SuppressionWindow) alarms.SuppressionWindow); ok {
+		r0 = returnFunc(ctx, session, window)
+	} else {
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.SuppressionWindow) error); ok {
+		r1 = returnFunc(ctx, session, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdateSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSuppressionWindow'
+type Service_UpdateSuppressionWindow_Call struct {
+	*mock.Call
+}
+
+// UpdateSuppressionWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - window alarms.SuppressionWindow
+func (_e *Service_Expecter) UpdateSuppressionWindow(ctx interface{}, session interface{}, window interface{}) *Service_UpdateSuppressionWindow_Call {
+	return &Service_UpdateSuppressionWindow_Call{Call: _e.mock.On("UpdateSuppressionWindow", ctx, session, window)}
+}
+
+func (_c *Service_UpdateSuppressionWindow_Call) Run(run func(ctx context.Context, session authn.Session, window alarms.SuppressionWindow)) *Service_UpdateSuppressionWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 alarms.SuppressionWindow
+		if args[2] != nil {
+			arg2 = args[2].(alarms.SuppressionWindow)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdateSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Service_UpdateSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
+	return _c
+}
+
+func (_c *Service_UpdateSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error)) *Service_UpdateSuppressionWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ViewAlarm provides a mock function for the type Service
+func (_mock *Service) ViewAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
 	ret := _mock.Called(ctx, session, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAlarm")
+		panic("no return value specified for ViewAlarm")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+	var r0 alarms.Alarm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.Alarm, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.Alarm); ok {
 		r0 = returnFunc(ctx, session, id)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(alarms.Alarm)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Service_DeleteAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAlarm'
-type Service_DeleteAlarm_Call struct {
+// Service_ViewAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewAlarm'
+type Service_ViewAlarm_Call struct {
 	*mock.Call
 }
 
-// DeleteAlarm is a helper method to define mock.On call
+// ViewAlarm is a helper method to define mock.On call
 //   - ctx context.Context
 //   - session authn.Session
 //   - id string
-func (_e *Service_Expecter) DeleteAlarm(ctx interface{}, session interface{}, id interface{}) *Service_DeleteAlarm_Call {
-	return &Service_DeleteAlarm_Call{Call: _e.mock.On("DeleteAlarm", ctx, session, id)}
+func (_e *Service_Expecter) ViewAlarm(ctx interface{}, session interface{}, id interface{}) *Service_ViewAlarm_Call {
+	return &Service_ViewAlarm_Call{Call: _e.mock.On("ViewAlarm", ctx, session, id)}
 }
 
-func (_c *Service_DeleteAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_DeleteAlarm_Call {
+func (_c *Service_ViewAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewAlarm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -153,56 +2133,56 @@ func (_c *Service_DeleteAlarm_Call) Run(run func(ctx context.Context, session au
 	return _c
 }
 
-func (_c *Service_DeleteAlarm_Call) Return(err error) *Service_DeleteAlarm_Call {
-	_c.Call.Return(err)
+func (_c *Service_ViewAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_ViewAlarm_Call {
+	_c.Call.Return(alarm, err)
 	return _c
 }
 
-func (_c *Service_DeleteAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_DeleteAlarm_Call {
+func (_c *Service_ViewAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error)) *Service_ViewAlarm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListAlarms provides a mock function for the type Service
-func (_mock *Service) ListAlarms(ctx context.Context, session authn.Session, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
-	ret := _mock.Called(ctx, session, pm)
+// ViewEscalationPolicy provides a mock function for the type Service
+func (_mock *Service) ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (alarms.EscalationPolicy, error) {
+	ret := _mock.Called(ctx, session, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListAlarms")
+		panic("no return value specified for ViewEscalationPolicy")
 	}
 
-	var r0 alarms.AlarmsPage
+	var r0 alarms.EscalationPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.PageMetadata) (alarms.AlarmsPage, error)); ok {
-		return returnFunc(ctx, session, pm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.EscalationPolicy, error)); ok {
+		return returnFunc(ctx, session, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.PageMetadata) alarms.AlarmsPage); ok {
-		r0 = returnFunc(ctx, session, pm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.EscalationPolicy); ok {
+		r0 = returnFunc(ctx, session, id)
 	} else {
-		r0 = ret.Get(0).(alarms.AlarmsPage)
+		r0 = ret.Get(0).(alarms.EscalationPolicy)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.PageMetadata) error); ok {
-		r1 = returnFunc(ctx, session, pm)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Service_ListAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAlarms'
-type Service_ListAlarms_Call struct {
+// Service_ViewEscalationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewEscalationPolicy'
+type Service_ViewEscalationPolicy_Call struct {
 	*mock.Call
 }
 
-// ListAlarms is a helper method to define mock.On call
+// ViewEscalationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - session authn.Session
-//   - pm alarms.PageMetadata
-func (_e *Service_Expecter) ListAlarms(ctx interface{}, session interface{}, pm interface{}) *Service_ListAlarms_Call {
-	return &Service_ListAlarms_Call{Call: _e.mock.On("ListAlarms", ctx, session, pm)}
+//   - id string
+func (_e *Service_Expecter) ViewEscalationPolicy(ctx interface{}, session interface{}, id interface{}) *Service_ViewEscalationPolicy_Call {
+	return &Service_ViewEscalationPolicy_Call{Call: _e.mock.On("ViewEscalationPolicy", ctx, session, id)}
 }
 
-func (_c *Service_ListAlarms_Call) Run(run func(ctx context.Context, session authn.Session, pm alarms.PageMetadata)) *Service_ListAlarms_Call {
+func (_c *Service_ViewEscalationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewEscalationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -212,9 +2192,9 @@ func (_c *Service_ListAlarms_Call) Run(run func(ctx context.Context, session aut
 		if args[1] != nil {
 			arg1 = args[1].(authn.Session)
 		}
-		var arg2 alarms.PageMetadata
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(alarms.PageMetadata)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -225,56 +2205,56 @@ func (_c *Service_ListAlarms_Call) Run(run func(ctx context.Context, session aut
 	return _c
 }
 
-func (_c *Service_ListAlarms_Call) Return(alarmsPage alarms.AlarmsPage, err error) *Service_ListAlarms_Call {
-	_c.Call.Return(alarmsPage, err)
+func (_c *Service_ViewEscalationPolicy_Call) Return(escalationPolicy alarms.EscalationPolicy, err error) *Service_ViewEscalationPolicy_Call {
+	_c.Call.Return(escalationPolicy, err)
 	return _c
 }
 
-func (_c *Service_ListAlarms_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm alarms.PageMetadata) (alarms.AlarmsPage, error)) *Service_ListAlarms_Call {
+func (_c *Service_ViewEscalationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.EscalationPolicy, error)) *Service_ViewEscalationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateAlarm provides a mock function for the type Service
-func (_mock *Service) UpdateAlarm(ctx context.Context, session authn.Session, alarm alarms.Alarm) (alarms.Alarm, error) {
-	ret := _mock.Called(ctx, session, alarm)
+// ViewNotificationPolicy provides a mock function for the type Service
+func (_mock *Service) ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (alarms.NotificationPolicy, error) {
+	ret := _mock.Called(ctx, session, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateAlarm")
+		panic("no return value specified for ViewNotificationPolicy")
 	}
 
-	var r0 alarms.Alarm
+	var r0 alarms.NotificationPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.Alarm) (alarms.Alarm, error)); ok {
-		return returnFunc(ctx, session, alarm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.NotificationPolicy, error)); ok {
+		return returnFunc(ctx, session, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, alarms.Alarm) alarms.Alarm); ok {
-		r0 = returnFunc(ctx, session, alarm)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.NotificationPolicy); ok {
+		r0 = returnFunc(ctx, session, id)
 	} else {
-		r0 = ret.Get(0).(alarms.Alarm)
+		r0 = ret.Get(0).(alarms.NotificationPolicy)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, alarms.Alarm) error); ok {
-		r1 = returnFunc(ctx, session, alarm)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Service_UpdateAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlarm'
-type Service_UpdateAlarm_Call struct {
+// Service_ViewNotificationPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewNotificationPolicy'
+type Service_ViewNotificationPolicy_Call struct {
 	*mock.Call
 }
 
-// UpdateAlarm is a helper method to define mock.On call
+// ViewNotificationPolicy is a helper method to define mock.On call
 //   - ctx context.Context
 //   - session authn.Session
-//   - alarm alarms.Alarm
-func (_e *Service_Expecter) UpdateAlarm(ctx interface{}, session interface{}, alarm interface{}) *Service_UpdateAlarm_Call {
-	return &Service_UpdateAlarm_Call{Call: _e.mock.On("UpdateAlarm", ctx, session, alarm)}
+//   - id string
+func (_e *Service_Expecter) ViewNotificationPolicy(ctx interface{}, session interface{}, id interface{}) *Service_ViewNotificationPolicy_Call {
+	return &Service_ViewNotificationPolicy_Call{Call: _e.mock.On("ViewNotificationPolicy", ctx, session, id)}
 }
 
-func (_c *Service_UpdateAlarm_Call) Run(run func(ctx context.Context, session authn.Session, alarm alarms.Alarm)) *Service_UpdateAlarm_Call {
+func (_c *Service_ViewNotificationPolicy_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewNotificationPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -284,9 +2264,9 @@ func (_c *Service_UpdateAlarm_Call) Run(run func(ctx context.Context, session au
 		if args[1] != nil {
 			arg1 = args[1].(authn.Session)
 		}
-		var arg2 alarms.Alarm
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(alarms.Alarm)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -297,33 +2277,33 @@ func (_c *Service_UpdateAlarm_Call) Run(run func(ctx context.Context, session au
 	return _c
 }
 
-func (_c *Service_UpdateAlarm_Call) Return(alarm1 alarms.Alarm, err error) *Service_UpdateAlarm_Call {
-	_c.Call.Return(alarm1, err)
+func (_c *Service_ViewNotificationPolicy_Call) Return(notificationPolicy alarms.NotificationPolicy, err error) *Service_ViewNotificationPolicy_Call {
+	_c.Call.Return(notificationPolicy, err)
 	return _c
 }
 
-func (_c *Service_UpdateAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, alarm alarms.Alarm) (alarms.Alarm, error)) *Service_UpdateAlarm_Call {
+func (_c *Service_ViewNotificationPolicy_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.NotificationPolicy, error)) *Service_ViewNotificationPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ViewAlarm provides a mock function for the type Service
-func (_mock *Service) ViewAlarm(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error) {
+// ViewSuppressionWindow provides a mock function for the type Service
+func (_mock *Service) ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (alarms.SuppressionWindow, error) {
 	ret := _mock.Called(ctx, session, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ViewAlarm")
+		panic("no return value specified for ViewSuppressionWindow")
 	}
 
-	var r0 alarms.Alarm
+	var r0 alarms.SuppressionWindow
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.Alarm, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (alarms.SuppressionWindow, error)); ok {
 		return returnFunc(ctx, session, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.Alarm); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) alarms.SuppressionWindow); ok {
 		r0 = returnFunc(ctx, session, id)
 	} else {
-		r0 = ret.Get(0).(alarms.Alarm)
+		r0 = ret.Get(0).(alarms.SuppressionWindow)
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
 		r1 = returnFunc(ctx, session, id)
@@ -333,20 +2313,20 @@ func (_mock *Service) ViewAlarm(ctx context.Context, session authn.Session, id s
 	return r0, r1
 }
 
-// Service_ViewAlarm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewAlarm'
-type Service_ViewAlarm_Call struct {
+// Service_ViewSuppressionWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewSuppressionWindow'
+type Service_ViewSuppressionWindow_Call struct {
 	*mock.Call
 }
 
-// ViewAlarm is a helper method to define mock.On call
+// ViewSuppressionWindow is a helper method to define mock.On call
 //   - ctx context.Context
 //   - session authn.Session
 //   - id string
-func (_e *Service_Expecter) ViewAlarm(ctx interface{}, session interface{}, id interface{}) *Service_ViewAlarm_Call {
-	return &Service_ViewAlarm_Call{Call: _e.mock.On("ViewAlarm", ctx, session, id)}
+func (_e *Service_Expecter) ViewSuppressionWindow(ctx interface{}, session interface{}, id interface{}) *Service_ViewSuppressionWindow_Call {
+	return &Service_ViewSuppressionWindow_Call{Call: _e.mock.On("ViewSuppressionWindow", ctx, session, id)}
 }
 
-func (_c *Service_ViewAlarm_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewAlarm_Call {
+func (_c *Service_ViewSuppressionWindow_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewSuppressionWindow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -369,12 +2349,12 @@ func (_c *Service_ViewAlarm_Call) Run(run func(ctx context.Context, session auth
 	return _c
 }
 
-func (_c *Service_ViewAlarm_Call) Return(alarm alarms.Alarm, err error) *Service_ViewAlarm_Call {
-	_c.Call.Return(alarm, err)
+func (_c *Service_ViewSuppressionWindow_Call) Return(suppressionWindow alarms.SuppressionWindow, err error) *Service_ViewSuppressionWindow_Call {
+	_c.Call.Return(suppressionWindow, err)
 	return _c
 }
 
-func (_c *Service_ViewAlarm_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.Alarm, error)) *Service_ViewAlarm_Call {
+func (_c *Service_ViewSuppressionWindow_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (alarms.SuppressionWindow, error)) *Service_ViewSuppressionWindow_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,275 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	maxReceivers    = 10
+	quietHourLayout = "15:04"
+)
+
+var (
+	errNoTriggers       = errors.NewRequestError("notification policy must have at least one trigger")
+	errInvalidTrigger   = errors.NewRequestError("invalid notification trigger, must be create, escalate or clear")
+	errNoReceivers      = errors.NewRequestError("notification policy must have at least one receiver")
+	errTooManyReceivers = errors.NewRequestError("notification policy has too many receivers")
+	errInvalidReceiver  = errors.NewRequestError("invalid notification receiver type, must be email, slack, webhook or sms")
+	errReceiverTo       = errors.NewRequestError("email and sms receivers must have recipients")
+	errReceiverSlack    = errors.NewRequestError("slack receiver must have token and channel id")
+	errReceiverURL      = errors.NewRequestError("webhook receiver must have http or https url")
+	errQuietHours       = errors.NewRequestError("quiet hours must be in 15:04 format with a valid time zone")
+	errRateLimit        = errors.NewRequestError("rate limit count and period must be positive")
+)
+
+// Trigger is the alarm change the notification is sent for.
+type Trigger string
+
+const (
+	// CreateTrigger is the rule raising an active alarm.
+	CreateTrigger Trigger = "create"
+	// EscalateTrigger is the scheduler escalating the alarm.
+	EscalateTrigger Trigger = "escalate"
+	// ClearTrigger is the rule raising a cleared alarm.
+	ClearTrigger Trigger = "clear"
+)
+
+// ReceiverType is the way the notification is delivered.
+type ReceiverType string
+
+const (
+	EmailReceiver   ReceiverType = "email"
+	SlackReceiver   ReceiverType = "slack"
+	WebhookReceiver ReceiverType = "webhook"
+	SMSReceiver     ReceiverType = "sms"
+)
+
+// Receiver is the notification destination. The email and SMS receivers
+// use To for the addresses and the phone numbers, the Slack receiver
+// uses Token and ChannelID, and the webhook receiver uses URL and signs
+// the requests with Secret if it's set.
+type Receiver struct {
+	Type      ReceiverType `json:"type"`
+	To        []string     `json:"to,omitempty"`
+	Token     string       `json:"token,omitempty"`
+	ChannelID string       `json:"channel_id,omitempty"`
+	URL       string       `json:"url,omitempty"`
+	Secret    string       `json:"secret,omitempty"`
+}
+
+// QuietHours mutes the notifications from Start to End, in the 15:04
+// format and the TimeZone, which is UTC if empty. The quiet hours wrap
+// midnight if End is before Start.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	TimeZone string `json:"time_zone,omitempty"`
+}
+
+// RateLimit allows at most Count notifications of the policy in Period
+// minutes. The limit is kept by each service instance.
+type RateLimit struct {
+	Count  uint32 `json:"count"`
+	Period uint32 `json:"period"`
+}
+
+// NotificationPolicy sends the notifications about the domain alarms with
+// at least the minimum severity. Empty rule, channel and measurement
+// match all the alarms.
+type NotificationPolicy struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	DomainID    string      `json:"domain_id"`
+	Triggers    []Trigger   `json:"triggers"`
+	MinSeverity uint8       `json:"min_severity"`
+	RuleID      string      `json:"rule_id,omitempty"`
+	ChannelID   string      `json:"channel_id,omitempty"`
+	Measurement string      `json:"measurement,omitempty"`
+	Receivers   []Receiver  `json:"receivers"`
+	QuietHours  *QuietHours `json:"quiet_hours,omitempty"`
+	RateLimit   *RateLimit  `json:"rate_limit,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	CreatedBy   string      `json:"created_by"`
+	UpdatedAt   time.Time   `json:"updated_at,omitempty"`
+	UpdatedBy   string      `json:"updated_by,omitempty"`
+}
+
+func (p NotificationPolicy) Validate() error {
+	if p.MinSeverity > SeverityMax {
+		return ErrInvalidSeverity
+	}
+	if len(p.Triggers) == 0 {
+		return errNoTriggers
+	}
+	for _, t := range p.Triggers {
+		switch t {
+		case CreateTrigger, EscalateTrigger, ClearTrigger:
+		default:
+			return errInvalidTrigger
+		}
+	}
+	switch {
+	case len(p.Receivers) == 0:
+		return errNoReceivers
+	case len(p.Receivers) > maxReceivers:
+		return errTooManyReceivers
+	}
+	for _, r := range p.Receivers {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.QuietHours != nil {
+		if _, _, _, err := p.QuietHours.parse(); err != nil {
+			return errQuietHours
+		}
+	}
+	if p.RateLimit != nil && (p.RateLimit.Count == 0 || p.RateLimit.Period == 0) {
+		return errRateLimit
+	}
+
+	return nil
+}
+
+// Matches reports whether the policy sends the notification about the alarm change.
+func (p NotificationPolicy) Matches(alarm Alarm, trigger Trigger) bool {
+	switch {
+	case !slices.Contains(p.Triggers, trigger),
+		alarm.DomainID != p.DomainID,
+		alarm.Severity < p.MinSeverity,
+		p.RuleID != "" && p.RuleID != alarm.RuleID,
+		p.ChannelID != "" && p.ChannelID != alarm.ChannelID,
+		p.Measurement != "" && p.Measurement != alarm.Measurement:
+		return false
+	default:
+		return true
+	}
+}
+
+func (r Receiver) Validate() error {
+	switch r.Type {
+	case EmailReceiver, SMSReceiver:
+		if len(r.To) == 0 || slices.Contains(r.To, "") {
+			return errReceiverTo
+		}
+	case SlackReceiver:
+		if r.Token == "" || r.ChannelID == "" {
+			return errReceiverSlack
+		}
+	case WebhookReceiver:
+		u, err := url.Parse(r.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errReceiverURL
+		}
+	default:
+		return errInvalidReceiver
+	}
+
+	return nil
+}
+
+// Contains reports whether the time is in the quiet hours.
+func (q QuietHours) Contains(t time.Time) bool {
+	start, end, loc, err := q.parse()
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	now := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if start <= end {
+		return now >= start && now < end
+	}
+
+	return now >= start || now < end
+}
+
+// parse returns the start and the end as the offsets from midnight.
+func (q QuietHours) parse() (time.Duration, time.Duration, *time.Location, error) {
+	start, err := time.Parse(quietHourLayout, q.Start)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	end, err := time.Parse(quietHourLayout, q.End)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	loc, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	midnight := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return start.Sub(midnight), end.Sub(midnight), loc, nil
+}
+
+type NotificationPolicyPageMeta struct {
+	Offset   uint64 `json:"offset"`
+	Limit    uint64 `json:"limit"`
+	Total    uint64 `json:"total"`
+	DomainID string `json:"domain_id,omitempty"`
+	RuleID   string `json:"rule_id,omitempty"`
+}
+
+type NotificationPolicyPage struct {
+	Offset   uint64               `json:"offset"`
+	Limit    uint64               `json:"limit"`
+	Total    uint64               `json:"total"`
+	Policies []NotificationPolicy `json:"policies"`
+}
+
+// Notification is the alarm change sent to the policy receivers.
+type Notification struct {
+	Trigger  Trigger `json:"trigger"`
+	PolicyID string  `json:"policy_id"`
+	Alarm    Alarm   `json:"alarm"`
+}
+
+// Sender delivers the notification to the receiver.
+type Sender interface {
+	Send(ctx context.Context, receiver Receiver, notification Notification) error
+}
+
+// limiter counts the notifications of each policy in fixed windows.
+type limiter struct {
+	mu      sync.Mutex
+	windows map[string]window
+}
+
+type window struct {
+	start time.Time
+	count uint32
+}
+
+func newLimiter() *limiter {
+	return &limiter{windows: make(map[string]window)}
+}
+
+// allow reports whether the policy can send one more notification.
+func (l *limiter) allow(p NotificationPolicy, now time.Time) bool {
+	if p.RateLimit == nil {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w := l.windows[p.ID]
+	if now.Sub(w.start) >= time.Duration(p.RateLimit.Period)*time.Minute {
+		w = window{start: now}
+	}
+	if w.count >= p.RateLimit.Count {
+		return false
+	}
+	w.count++
+	l.windows[p.ID] = w
+
+	return true
+}
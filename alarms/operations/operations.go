@@ -16,6 +16,24 @@ const (
 	OpAcknowledgeAlarm
 	OpResolveAlarm
 	OpUpdateAlarm
+	OpSnoozeAlarm
+	OpReopenAlarm
+	OpCreateEscalationPolicy
+	OpViewEscalationPolicy
+	OpUpdateEscalationPolicy
+	OpDeleteEscalationPolicy
+	OpCreateNotificationPolicy
+	OpViewNotificationPolicy
+	OpUpdateNotificationPolicy
+	OpDeleteNotificationPolicy
+	OpCreateSuppressionWindow
+	OpViewSuppressionWindow
+	OpUpdateSuppressionWindow
+	OpDeleteSuppressionWindow
+	OpCreateComment
+	OpListComments
+	OpDeleteComment
+	OpViewTimeline
 )
 
 func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
@@ -48,5 +66,77 @@ func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
 			Name:               "update",
 			PermissionRequired: true,
 		},
+		OpSnoozeAlarm: {
+			Name:               "alarm_snooze",
+			PermissionRequired: true,
+		},
+		OpReopenAlarm: {
+			Name:               "alarm_reopen",
+			PermissionRequired: true,
+		},
+		OpCreateEscalationPolicy: {
+			Name:               "escalation_create",
+			PermissionRequired: true,
+		},
+		OpViewEscalationPolicy: {
+			Name:               "escalation_view",
+			PermissionRequired: true,
+		},
+		OpUpdateEscalationPolicy: {
+			Name:               "escalation_update",
+			PermissionRequired: true,
+		},
+		OpDeleteEscalationPolicy: {
+			Name:               "escalation_delete",
+			PermissionRequired: true,
+		},
+		OpCreateNotificationPolicy: {
+			Name:               "notification_create",
+			PermissionRequired: true,
+		},
+		OpViewNotificationPolicy: {
+			Name:               "notification_view",
+			PermissionRequired: true,
+		},
+		OpUpdateNotificationPolicy: {
+			Name:               "notification_update",
+			PermissionRequired: true,
+		},
+		OpDeleteNotificationPolicy: {
+			Name:               "notification_delete",
+			PermissionRequired: true,
+		},
+		OpCreateSuppressionWindow: {
+			Name:               "suppression_create",
+			PermissionRequired: true,
+		},
+		OpViewSuppressionWindow: {
+			Name:               "suppression_view",
+			PermissionRequired: true,
+		},
+		OpUpdateSuppressionWindow: {
+			Name:               "suppression_update",
+			PermissionRequired: true,
+		},
+		OpDeleteSuppressionWindow: {
+			Name:               "suppression_delete",
+			PermissionRequired: true,
+		},
+		OpCreateComment: {
+			Name:               "comment_create",
+			PermissionRequired: true,
+		},
+		OpListComments: {
+			Name:               "comment_view",
+			PermissionRequired: true,
+		},
+		OpDeleteComment: {
+			Name:               "comment_delete",
+			PermissionRequired: true,
+		},
+		OpViewTimeline: {
+			Name:               "timeline_view",
+			PermissionRequired: true,
+		},
 	}
 }
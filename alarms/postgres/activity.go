@@ -0,0 +1,271 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+)
+
+const (
+	commentColumns  = `id, alarm_id, domain_id, parent_id, body, created_at, created_by`
+	activityColumns = `id, alarm_id, domain_id, type, actor_id, details, created_at`
+)
+
+func (r *repository) AddComment(ctx context.Context, comment alarms.Comment) (alarms.Comment, error) {
+	q := fmt.Sprintf(`INSERT INTO alarm_comments (%s)
+		VALUES (:id, :alarm_id, :domain_id, :parent_id, :body, :created_at, :created_by)
+		RETURNING %s;`, commentColumns, commentColumns)
+
+	row, err := r.db.NamedQueryContext(ctx, q, toDBComment(comment))
+	if err != nil {
+		return alarms.Comment{}, postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.Comment{}, repoerr.ErrNotFound
+	}
+
+	dbc := dbComment{}
+	if err := row.StructScan(&dbc); err != nil {
+		return alarms.Comment{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return toComment(dbc), nil
+}
+
+func (r *repository) ViewComment(ctx context.Context, domainID, id string) (alarms.Comment, error) {
+	q := fmt.Sprintf(`SELECT %s FROM alarm_comments WHERE id = :id AND domain_id = :domain_id;`, commentColumns)
+
+	row, err := r.db.NamedQueryContext(ctx, q, dbComment{ID: id, DomainID: domainID})
+	if err != nil {
+		return alarms.Comment{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.Comment{}, repoerr.ErrNotFound
+	}
+
+	dbc := dbComment{}
+	if err := row.StructScan(&dbc); err != nil {
+		return alarms.Comment{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return toComment(dbc), nil
+}
+
+func (r *repository) ListComments(ctx context.Context, domainID string, pm alarms.CommentPageMeta) (alarms.CommentsPage, error) {
+	where := "WHERE alarm_id = :alarm_id AND domain_id = :domain_id"
+	q := fmt.Sprintf(`SELECT %s FROM alarm_comments %s ORDER BY created_at, id LIMIT :limit OFFSET :offset;`, commentColumns, where)
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM alarm_comments %s;`, where)
+
+	params := map[string]any{
+		"alarm_id":  pm.AlarmID,
+		"domain_id": domainID,
+		"limit":     pm.Limit,
+		"offset":    pm.Offset,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return alarms.CommentsPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	comments := []alarms.Comment{}
+	for rows.Next() {
+		dbc := dbComment{}
+		if err := rows.StructScan(&dbc); err != nil {
+			return alarms.CommentsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		comments = append(comments, toComment(dbc))
+	}
+
+	total, err := postgres.Total(ctx, r.db, cq, params)
+	if err != nil {
+		return alarms.CommentsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return alarms.CommentsPage{
+		Offset:   pm.Offset,
+		Limit:    pm.Limit,
+		Total:    total,
+		Comments: comments,
+	}, nil
+}
+
+func (r *repository) RemoveComment(ctx context.Context, domainID, id string) error {
+	q := `DELETE FROM alarm_comments WHERE id = :id AND domain_id = :domain_id;`
+	result, err := r.db.NamedExecContext(ctx, q, map[string]any{"id": id, "domain_id": domainID})
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repository) AddActivity(ctx context.Context, activity alarms.Activity) error {
+	q := fmt.Sprintf(`INSERT INTO alarm_activities (%s)
+		VALUES (:id, :alarm_id, :domain_id, :type, :actor_id, :details, :created_at);`, activityColumns)
+
+	dba, err := toDBActivity(activity)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if _, err := r.db.NamedExecContext(ctx, q, dba); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (r *repository) ListActivities(ctx context.Context, domainID string, pm alarms.ActivityPageMeta) (alarms.ActivityPage, error) {
+	where := "WHERE alarm_id = :alarm_id AND domain_id = :domain_id"
+	q := fmt.Sprintf(`SELECT %s FROM alarm_activities %s ORDER BY created_at, id LIMIT :limit OFFSET :offset;`, activityColumns, where)
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM alarm_activities %s;`, where)
+
+	params := map[string]any{
+		"alarm_id":  pm.AlarmID,
+		"domain_id": domainID,
+		"limit":     pm.Limit,
+		"offset":    pm.Offset,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return alarms.ActivityPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	activities := []alarms.Activity{}
+	for rows.Next() {
+		dba := dbActivity{}
+		if err := rows.StructScan(&dba); err != nil {
+			return alarms.ActivityPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		activity, err := toActivity(dba)
+		if err != nil {
+			return alarms.ActivityPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		activities = append(activities, activity)
+	}
+
+	total, err := postgres.Total(ctx, r.db, cq, params)
+	if err != nil {
+		return alarms.ActivityPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return alarms.ActivityPage{
+		Offset:     pm.Offset,
+		Limit:      pm.Limit,
+		Total:      total,
+		Activities: activities,
+	}, nil
+}
+
+type dbComment struct {
+	ID        string    `db:"id"`
+	AlarmID   string    `db:"alarm_id"`
+	DomainID  string    `db:"domain_id"`
+	ParentID  *string   `db:"parent_id"`
+	Body      string    `db:"body"`
+	CreatedAt time.Time `db:"created_at"`
+	CreatedBy string    `db:"created_by"`
+}
+
+func toDBComment(c alarms.Comment) dbComment {
+	var parentID *string
+	if c.ParentID != "" {
+		parentID = &c.ParentID
+	}
+
+	return dbComment{
+		ID:        c.ID,
+		AlarmID:   c.AlarmID,
+		DomainID:  c.DomainID,
+		ParentID:  parentID,
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+		CreatedBy: c.CreatedBy,
+	}
+}
+
+func toComment(dbc dbComment) alarms.Comment {
+	var parentID string
+	if dbc.ParentID != nil {
+		parentID = *dbc.ParentID
+	}
+
+	return alarms.Comment{
+		ID:        dbc.ID,
+		AlarmID:   dbc.AlarmID,
+		DomainID:  dbc.DomainID,
+		ParentID:  parentID,
+		Body:      dbc.Body,
+		CreatedAt: dbc.CreatedAt,
+		CreatedBy: dbc.CreatedBy,
+	}
+}
+
+type dbActivity struct {
+	ID        string    `db:"id"`
+	AlarmID   string    `db:"alarm_id"`
+	DomainID  string    `db:"domain_id"`
+	Type      string    `db:"type"`
+	ActorID   string    `db:"actor_id"`
+	Details   []byte    `db:"details"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func toDBActivity(a alarms.Activity) (dbActivity, error) {
+	var details []byte
+	if len(a.Details) > 0 {
+		b, err := json.Marshal(a.Details)
+		if err != nil {
+			return dbActivity{}, err
+		}
+		details = b
+	}
+
+	return dbActivity{
+		ID:        a.ID,
+		AlarmID:   a.AlarmID,
+		DomainID:  a.DomainID,
+		Type:      string(a.Type),
+		ActorID:   a.ActorID,
+		Details:   details,
+		CreatedAt: a.CreatedAt,
+	}, nil
+}
+
+func toActivity(dba dbActivity) (alarms.Activity, error) {
+	var details map[string]any
+	if len(dba.Details) > 0 {
+		if err := json.Unmarshal(dba.Details, &details); err != nil {
+			return alarms.Activity{}, err
+		}
+	}
+
+	return alarms.Activity{
+		ID:        dba.ID,
+		AlarmID:   dba.AlarmID,
+		DomainID:  dba.DomainID,
+		Type:      alarms.ActivityType(dba.Type),
+		ActorID:   dba.ActorID,
+		Details:   details,
+		CreatedAt: dba.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/alarms/postgres"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestAlarm(t *testing.T, repo alarms.Repository) alarms.Alarm {
+	alarm := alarms.Alarm{
+		ID:          generateUUID(t),
+		RuleID:      generateUUID(t),
+		DomainID:    generateUUID(t),
+		ChannelID:   generateUUID(t),
+		ClientID:    generateUUID(t),
+		Measurement: namegen.Generate(),
+		Value:       namegen.Generate(),
+		Cause:       namegen.Generate(),
+		CreatedAt:   time.Now().UTC(),
+	}
+	alarm.DedupKey = alarms.DedupKey(alarm, nil)
+	alarm, err := repo.CreateAlarm(context.Background(), alarm)
+	require.Nil(t, err, fmt.Sprintf("create alarm unexpected error: %s", err))
+
+	return alarm
+}
+
+func TestComments(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM alarms")
+		require.Nil(t, err, fmt.Sprintf("clean alarms unexpected error: %s", err))
+	})
+	repo := postgres.NewAlarmsRepo(db)
+	alarm := createTestAlarm(t, repo)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	comment := alarms.Comment{
+		ID:        generateUUID(t),
+		AlarmID:   alarm.ID,
+		DomainID:  alarm.DomainID,
+		Body:      "pump restarted",
+		CreatedAt: now,
+		CreatedBy: generateUUID(t),
+	}
+	reply := alarms.Comment{
+		ID:        generateUUID(t),
+		AlarmID:   alarm.ID,
+		DomainID:  alarm.DomainID,
+		ParentID:  comment.ID,
+		Body:      "still overheating",
+		CreatedAt: now.Add(time.Minute),
+		CreatedBy: generateUUID(t),
+	}
+
+	cases := []struct {
+		desc    string
+		comment alarms.Comment
+		err     error
+	}{
+		{
+			desc:    "add comment",
+			comment: comment,
+		},
+		{
+			desc:    "add reply",
+			comment: reply,
+		},
+		{
+			desc:    "add duplicate comment",
+			comment: comment,
+			err:     repoerr.ErrConflict,
+		},
+		{
+			desc: "add comment of unknown alarm",
+			comment: alarms.Comment{
+				ID:        generateUUID(t),
+				AlarmID:   generateUUID(t),
+				DomainID:  alarm.DomainID,
+				Body:      "pump restarted",
+				CreatedAt: now,
+				CreatedBy: generateUUID(t),
+			},
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c, err := repo.AddComment(context.Background(), tc.comment)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				c.CreatedAt = c.CreatedAt.UTC()
+				assert.Equal(t, tc.comment, c)
+			}
+		})
+	}
+
+	page, err := repo.ListComments(context.Background(), alarm.DomainID, alarms.CommentPageMeta{AlarmID: alarm.ID, Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("list comments unexpected error: %s", err))
+	assert.Equal(t, uint64(2), page.Total)
+	for i := range page.Comments {
+		page.Comments[i].CreatedAt = page.Comments[i].CreatedAt.UTC()
+	}
+	assert.Equal(t, []alarms.Comment{comment, reply}, page.Comments)
+
+	err = repo.RemoveComment(context.Background(), alarm.DomainID, comment.ID)
+	require.Nil(t, err, fmt.Sprintf("remove comment unexpected error: %s", err))
+	_, err = repo.ViewComment(context.Background(), alarm.DomainID, reply.ID)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("view removed reply: expected %s got %s\n", repoerr.ErrNotFound, err))
+}
+
+func TestActivities(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM alarms")
+		require.Nil(t, err, fmt.Sprintf("clean alarms unexpected error: %s", err))
+	})
+	repo := postgres.NewAlarmsRepo(db)
+	alarm := createTestAlarm(t, repo)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	activities := []alarms.Activity{
+		{Type: alarms.AssignedActivity, ActorID: generateUUID(t), Details: map[string]any{"assignee_id": generateUUID(t)}},
+		{Type: alarms.EscalatedActivity, Details: map[string]any{"level": float64(1)}},
+		{Type: alarms.AcknowledgedActivity, ActorID: generateUUID(t)},
+	}
+	for i := range activities {
+		activities[i].ID = generateUUID(t)
+		activities[i].AlarmID = alarm.ID
+		activities[i].DomainID = alarm.DomainID
+		activities[i].CreatedAt = now.Add(time.Duration(i) * time.Minute)
+		err := repo.AddActivity(context.Background(), activities[i])
+		require.Nil(t, err, fmt.Sprintf("add activity unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc       string
+		domainID   string
+		pm         alarms.ActivityPageMeta
+		total      uint64
+		activities []alarms.Activity
+	}{
+		{
+			desc:       "list activities",
+			domainID:   alarm.DomainID,
+			pm:         alarms.ActivityPageMeta{AlarmID: alarm.ID, Limit: 10},
+			total:      3,
+			activities: activities,
+		},
+		{
+			desc:       "list activities with offset",
+			domainID:   alarm.DomainID,
+			pm:         alarms.ActivityPageMeta{AlarmID: alarm.ID, Offset: 1, Limit: 1},
+			total:      3,
+			activities: activities[1:2],
+		},
+		{
+			desc:       "list activities of other domain",
+			domainID:   generateUUID(t),
+			pm:         alarms.ActivityPageMeta{AlarmID: alarm.ID, Limit: 10},
+			activities: []alarms.Activity{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.ListActivities(context.Background(), tc.domainID, tc.pm)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total)
+			for i := range page.Activities {
+				page.Activities[i].CreatedAt = page.Activities[i].CreatedAt.UTC()
+			}
+			assert.Equal(t, tc.activities, page.Activities)
+		})
+	}
+}
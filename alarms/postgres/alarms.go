@@ -14,15 +14,38 @@ import (
 
 	"github.com/absmach/magistrala/alarms"
 	api "github.com/absmach/magistrala/api/http"
+	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/pkg/errors"
 	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
 	"github.com/absmach/magistrala/pkg/postgres"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 const alarmColumns = `alarms.id, alarms.rule_id, alarms.domain_id, alarms.channel_id, alarms.client_id, alarms.subtopic, alarms.measurement, alarms.value, alarms.unit,
 alarms.threshold, alarms.cause, alarms.status, alarms.severity, alarms.assignee_id, alarms.created_at, alarms.updated_at, alarms.updated_by, alarms.assigned_at,
-alarms.assigned_by, alarms.acknowledged_at, alarms.acknowledged_by, alarms.resolved_at, alarms.resolved_by, alarms.metadata`
+alarms.assigned_by, alarms.acknowledged_at, alarms.acknowledged_by, alarms.resolved_at, alarms.resolved_by, alarms.snoozed_until, alarms.snoozed_by,
+alarms.escalation_level, alarms.escalated_at, alarms.dedup_key, alarms.flapping, alarms.suppressed, alarms.suppression_id, alarms.metadata`
+
+// userAlarmsClause selects the alarms of the rules the user is a member of
+// and of the domains where the user has an alarm action.
+const userAlarmsClause = `(
+	EXISTS (
+		SELECT 1
+		FROM rules_roles rr
+		JOIN rules_role_members rrm ON rrm.role_id = rr.id
+		WHERE rr.entity_id = alarms.rule_id AND rrm.member_id = :user_id
+	)
+	OR EXISTS (
+		SELECT 1
+		FROM domains_roles dr
+		JOIN domains_role_members drm ON drm.role_id = dr.id
+		JOIN domains_role_actions dra ON dra.role_id = dr.id
+		WHERE dr.entity_id = alarms.domain_id
+			AND drm.member_id = :user_id
+			AND dra.action LIKE 'alarm%'
+	)
+)`
 
 type repository struct {
 	db *sqlx.DB
@@ -37,14 +60,16 @@ func NewAlarmsRepo(db *sqlx.DB) alarms.Repository {
 func (r *repository) CreateAlarm(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error) {
 	query := `
 	WITH existing AS (
-		SELECT status, severity
+		SELECT
+			CASE
+				WHEN status IN (2, 4) THEN 0
+				WHEN status = 3 THEN 1
+				ELSE status
+			END AS status,
+			severity, escalation_level
 		FROM alarms
 		WHERE domain_id = :domain_id
-			AND rule_id = :rule_id
-			AND channel_id = :channel_id
-			AND client_id = :client_id
-			AND subtopic = :subtopic
-			AND measurement = :measurement
+			AND dedup_key = :dedup_key
 			AND created_at <= :created_at
 		ORDER BY created_at DESC
 		LIMIT 1
@@ -53,18 +78,20 @@ func (r *repository) CreateAlarm(ctx context.Context, alarm alarms.Alarm) (alarm
 		id, rule_id, domain_id, channel_id, client_id, subtopic, measurement,
 		value, unit, threshold, cause, status, severity, assignee_id,
 		created_at, updated_at, updated_by, assigned_at, assigned_by,
-		acknowledged_at, acknowledged_by, resolved_at, resolved_by, metadata
+		acknowledged_at, acknowledged_by, resolved_at, resolved_by, dedup_key,
+		flapping, suppressed, suppression_id, metadata
 	)
 	SELECT
 		:id, :rule_id, :domain_id, :channel_id, :client_id, :subtopic, :measurement,
 		:value, :unit, :threshold, :cause, :status, :severity, :assignee_id,
 		:created_at, :updated_at, :updated_by, :assigned_at, :assigned_by,
-		:acknowledged_at, :acknowledged_by, :resolved_at, :resolved_by, :metadata
+		:acknowledged_at, :acknowledged_by, :resolved_at, :resolved_by, :dedup_key,
+		:flapping, :suppressed, :suppression_id, :metadata
 	WHERE (
 		EXISTS (
 			SELECT 1 FROM existing
 			WHERE existing.status IS DISTINCT FROM :status
-			OR (:status = 0 AND existing.status = 0 AND existing.severity IS DISTINCT FROM :severity)
+			OR (:status = 0 AND existing.status = 0 AND existing.escalation_level = 0 AND existing.severity IS DISTINCT FROM :severity)
 		)
 		OR (
 			NOT EXISTS (SELECT 1 FROM existing) AND :status = 0
@@ -74,7 +101,9 @@ func (r *repository) CreateAlarm(ctx context.Context, alarm alarms.Alarm) (alarm
 		id, rule_id, domain_id, channel_id, client_id, subtopic, measurement,
 		value, unit, threshold, cause, status, severity, created_at,
 		assignee_id, updated_at, updated_by, assigned_at, assigned_by,
-		acknowledged_at, acknowledged_by, resolved_at, resolved_by, metadata
+		acknowledged_at, acknowledged_by, resolved_at, resolved_by,
+		snoozed_until, snoozed_by, escalation_level, escalated_at, dedup_key,
+		flapping, suppressed, suppression_id, metadata
 	;
 	`
 	dba, err := toDBAlarm(alarm)
@@ -133,10 +162,11 @@ func (r *repository) UpdateAlarm(ctx context.Context, alarm alarms.Alarm) (alarm
 		upq = strings.Join(query, " ")
 	}
 
-	q := fmt.Sprintf(`UPDATE alarms SET %s updated_by = :updated_by, updated_at = :updated_at WHERE id = :id
+	q := fmt.Sprintf(`UPDATE alarms SET %s updated_by = :updated_by, updated_at = :updated_at WHERE id = :id AND domain_id = :domain_id
 		RETURNING id, rule_id, domain_id, channel_id, client_id, subtopic, measurement, value, unit, threshold,
 		cause, status, severity, assignee_id, assigned_at, assigned_by, acknowledged_at, acknowledged_by,
-		resolved_by, resolved_at, metadata, created_at, updated_by, updated_at;`, upq)
+		resolved_by, resolved_at, snoozed_until, snoozed_by, escalation_level, escalated_at, dedup_key, flapping,
+		suppressed, suppression_id, metadata, created_at, updated_by, updated_at;`, upq)
 
 	dba, err := toDBAlarm(alarm)
 	if err != nil {
@@ -199,27 +229,7 @@ func (r *repository) ListAllAlarms(ctx context.Context, pm alarms.PageMetadata)
 }
 
 func (r *repository) ListUserAlarms(ctx context.Context, userID string, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
-	clauses := []string{
-		`(
-			EXISTS (
-				SELECT 1
-				FROM rules_roles rr
-				JOIN rules_role_members rrm ON rrm.role_id = rr.id
-				WHERE rr.entity_id = alarms.rule_id AND rrm.member_id = :user_id
-			)
-			OR EXISTS (
-				SELECT 1
-				FROM domains_roles dr
-				JOIN domains_role_members drm ON drm.role_id = dr.id
-				JOIN domains_role_actions dra ON dra.role_id = dr.id
-				WHERE dr.entity_id = alarms.domain_id
-					AND drm.member_id = :user_id
-					AND dra.action LIKE 'alarm%'
-			)
-		)`,
-	}
-
-	clauses = append(clauses, pageQueryConditions(pm)...)
+	clauses := append([]string{userAlarmsClause}, pageQueryConditions(pm)...)
 	query := fmt.Sprintf("WHERE %s", strings.Join(clauses, " AND "))
 	pm.UserID = userID
 	comQuery := fmt.Sprintf(`SELECT DISTINCT %s FROM alarms %s`, alarmColumns, query)
@@ -227,6 +237,31 @@ func (r *repository) ListUserAlarms(ctx context.Context, userID string, pm alarm
 	return r.alarmsPage(ctx, comQuery, pm)
 }
 
+func (r *repository) IsUserAlarm(ctx context.Context, userID string, alarm alarms.Alarm) (bool, error) {
+	// The alarm is selected as the alarms row, so it's matched the same way
+	// as the listed user alarms.
+	q := fmt.Sprintf(`SELECT %s FROM (SELECT CAST(:rule_id AS VARCHAR(36)) AS rule_id, CAST(:domain_id AS VARCHAR(36)) AS domain_id) AS alarms;`, userAlarmsClause)
+	params := map[string]any{
+		"user_id":   userID,
+		"rule_id":   alarm.RuleID,
+		"domain_id": alarm.DomainID,
+	}
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return false, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var ok bool
+	if rows.Next() {
+		if err := rows.Scan(&ok); err != nil {
+			return false, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+	}
+
+	return ok, nil
+}
+
 func (r *repository) alarmsPage(ctx context.Context, comQuery string, pm alarms.PageMetadata) (alarms.AlarmsPage, error) {
 	dir := api.DescDir
 	if pm.Dir == api.AscDir {
@@ -244,7 +279,8 @@ func (r *repository) alarmsPage(ctx context.Context, comQuery string, pm alarms.
 	q := fmt.Sprintf(`SELECT * FROM (%s) AS sub_query %s LIMIT :limit OFFSET :offset;`, comQuery, orderClause)
 	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM (%s) AS sub_query;`, comQuery)
 
-	rows, err := r.db.NamedQueryContext(ctx, q, pm)
+	params := pageParams{PageMetadata: pm, IDs: pm.IDs}
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
 	if err != nil {
 		return alarms.AlarmsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
 	}
@@ -265,7 +301,7 @@ func (r *repository) alarmsPage(ctx context.Context, comQuery string, pm alarms.
 		items = append(items, a)
 	}
 
-	total, err := postgres.Total(ctx, r.db, cq, pm)
+	total, err := postgres.Total(ctx, r.db, cq, params)
 	if err != nil {
 		return alarms.AlarmsPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
 	}
@@ -278,9 +314,9 @@ func (r *repository) alarmsPage(ctx context.Context, comQuery string, pm alarms.
 	}, nil
 }
 
-func (r *repository) DeleteAlarm(ctx context.Context, id string) error {
-	query := `DELETE FROM alarms WHERE id = :id;`
-	result, err := r.db.NamedExecContext(ctx, query, map[string]any{"id": id})
+func (r *repository) DeleteAlarm(ctx context.Context, id, domainID string) error {
+	query := `DELETE FROM alarms WHERE id = :id AND domain_id = :domain_id;`
+	result, err := r.db.NamedExecContext(ctx, query, map[string]any{"id": id, "domain_id": domainID})
 	if err != nil {
 		return errors.Wrap(repoerr.ErrRemoveEntity, err)
 	}
@@ -297,31 +333,255 @@ func (r *repository) DeleteAlarm(ctx context.Context, id string) error {
 	return nil
 }
 
+func (r *repository) UpdateAlarmStatus(ctx context.Context, alarm alarms.Alarm, from alarms.Status) (alarms.Alarm, error) {
+	q := fmt.Sprintf(`UPDATE alarms SET status = :status, acknowledged_at = :acknowledged_at, acknowledged_by = :acknowledged_by,
+		resolved_at = :resolved_at, resolved_by = :resolved_by, snoozed_until = :snoozed_until, snoozed_by = :snoozed_by,
+		escalation_level = :escalation_level, escalated_at = :escalated_at, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id AND status = :from_status
+		RETURNING %s;`, strings.ReplaceAll(alarmColumns, "alarms.", ""))
+
+	dba, err := toDBAlarm(alarm)
+	if err != nil {
+		return alarms.Alarm{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	params := struct {
+		dbAlarm
+		From alarms.Status `db:"from_status"`
+	}{dba, from}
+
+	return r.updateAlarm(ctx, q, params)
+}
+
+func (r *repository) UpdateAlarms(ctx context.Context, changes []alarms.AlarmChange) (items []alarms.Alarm, retErr error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	q := fmt.Sprintf(`UPDATE alarms SET status = :status, assignee_id = :assignee_id, assigned_at = :assigned_at,
+		assigned_by = :assigned_by, acknowledged_at = :acknowledged_at, acknowledged_by = :acknowledged_by,
+		resolved_at = :resolved_at, resolved_by = :resolved_by, snoozed_until = :snoozed_until, snoozed_by = :snoozed_by,
+		updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id AND status = :from_status
+		RETURNING %s;`, strings.ReplaceAll(alarmColumns, "alarms.", ""))
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(apiutil.ErrRollbackTx, errRollBack))
+			}
+		}
+	}()
+
+	for _, c := range changes {
+		dba, err := toDBAlarm(c.Alarm)
+		if err != nil {
+			return nil, errors.Wrap(repoerr.ErrUpdateEntity, err)
+		}
+		params := struct {
+			dbAlarm
+			From alarms.Status `db:"from_status"`
+		}{dba, c.From}
+
+		a, err := updateAlarm(ctx, tx, q, params)
+		switch {
+		// The alarm status changed since the alarm was read.
+		case err == repoerr.ErrNotFound:
+			continue
+		case err != nil:
+			return nil, err
+		}
+		items = append(items, a)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return items, nil
+}
+
+func (r *repository) DeleteAlarms(ctx context.Context, domainID string, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	q := `DELETE FROM alarms WHERE domain_id = :domain_id AND id = ANY(:ids) RETURNING id;`
+	params := map[string]any{
+		"domain_id": domainID,
+		"ids":       pq.StringArray(ids),
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	defer rows.Close()
+
+	var removed []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(repoerr.ErrRemoveEntity, err)
+		}
+		removed = append(removed, id)
+	}
+
+	return removed, nil
+}
+
+func (r *repository) WakeSnoozedAlarms(ctx context.Context, before time.Time) error {
+	q := `UPDATE alarms SET status = :active, snoozed_until = NULL, snoozed_by = NULL, updated_at = :before
+		WHERE status = :snoozed AND snoozed_until <= :before;`
+	params := map[string]any{
+		"active":  alarms.ActiveStatus,
+		"snoozed": alarms.SnoozedStatus,
+		"before":  before,
+	}
+	if _, err := r.db.NamedExecContext(ctx, q, params); err != nil {
+		return postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return nil
+}
+
+func (r *repository) ListAlarmsToEscalate(ctx context.Context, policy alarms.EscalationPolicy, level uint8, raisedBefore time.Time) ([]alarms.Alarm, error) {
+	conditions := []string{
+		"domain_id = :domain_id",
+		"status = :status",
+		"severity >= :min_severity",
+		"escalation_level < :level",
+		"created_at <= :raised_before",
+		"NOT suppressed",
+	}
+	if policy.RuleID != "" {
+		conditions = append(conditions, "rule_id = :rule_id")
+	}
+	q := fmt.Sprintf(`SELECT %s FROM alarms WHERE %s ORDER BY created_at;`, alarmColumns, strings.Join(conditions, " AND "))
+	params := map[string]any{
+		"domain_id":     policy.DomainID,
+		"rule_id":       policy.RuleID,
+		"status":        alarms.ActiveStatus,
+		"min_severity":  policy.MinSeverity,
+		"level":         level,
+		"raised_before": raisedBefore,
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	var items []alarms.Alarm
+	for rows.Next() {
+		dba := dbAlarm{}
+		if err := rows.StructScan(&dba); err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		a, err := toAlarm(dba)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, a)
+	}
+
+	return items, nil
+}
+
+func (r *repository) CountAlarmChanges(ctx context.Context, domainID, dedupKey string, since time.Time) (uint64, error) {
+	q := `SELECT COUNT(*) AS total_count FROM alarms WHERE domain_id = :domain_id AND dedup_key = :dedup_key AND created_at >= :since;`
+	params := map[string]any{
+		"domain_id": domainID,
+		"dedup_key": dedupKey,
+		"since":     since,
+	}
+
+	total, err := postgres.Total(ctx, r.db, q, params)
+	if err != nil {
+		return 0, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return total, nil
+}
+
+func (r *repository) EscalateAlarm(ctx context.Context, alarm alarms.Alarm) (alarms.Alarm, error) {
+	q := fmt.Sprintf(`UPDATE alarms SET severity = GREATEST(severity, :severity), escalation_level = :escalation_level,
+		escalated_at = :escalated_at
+		WHERE id = :id AND status = :status AND escalation_level < :escalation_level
+		RETURNING %s;`, strings.ReplaceAll(alarmColumns, "alarms.", ""))
+
+	dba, err := toDBAlarm(alarm)
+	if err != nil {
+		return alarms.Alarm{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+	dba.Status = alarms.ActiveStatus
+
+	return r.updateAlarm(ctx, q, dba)
+}
+
+func (r *repository) updateAlarm(ctx context.Context, q string, params any) (alarms.Alarm, error) {
+	return updateAlarm(ctx, r.db, q, params)
+}
+
+func updateAlarm(ctx context.Context, db sqlx.ExtContext, q string, params any) (alarms.Alarm, error) {
+	row, err := sqlx.NamedQueryContext(ctx, db, q, params)
+	if err != nil {
+		return alarms.Alarm{}, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.Alarm{}, repoerr.ErrNotFound
+	}
+
+	dba := dbAlarm{}
+	if err := row.StructScan(&dba); err != nil {
+		return alarms.Alarm{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return toAlarm(dba)
+}
+
+// pageParams binds the page metadata with the alarm IDs as the array.
+type pageParams struct {
+	alarms.PageMetadata
+	IDs pq.StringArray `db:"ids"`
+}
+
 type dbAlarm struct {
-	ID             string        `db:"id"`
-	RuleID         string        `db:"rule_id"`
-	DomainID       string        `db:"domain_id"`
-	ChannelID      string        `db:"channel_id"`
-	ClientID       string        `db:"client_id"`
-	Subtopic       string        `db:"subtopic"`
-	Measurement    string        `db:"measurement"`
-	Value          string        `db:"value"`
-	Unit           string        `db:"unit"`
-	Cause          string        `db:"cause"`
-	Threshold      string        `db:"threshold"`
-	Status         alarms.Status `db:"status"`
-	Severity       uint8         `db:"severity"`
-	AssigneeID     string        `db:"assignee_id"`
-	CreatedAt      time.Time     `db:"created_at"`
-	UpdatedAt      sql.NullTime  `db:"updated_at,omitempty"`
-	UpdatedBy      *string       `db:"updated_by,omitempty"`
-	AssignedAt     sql.NullTime  `db:"assigned_at,omitempty"`
-	AssignedBy     *string       `db:"assigned_by,omitempty"`
-	AcknowledgedAt sql.NullTime  `db:"acknowledged_at,omitempty"`
-	AcknowledgedBy *string       `db:"acknowledged_by,omitempty"`
-	ResolvedAt     sql.NullTime  `db:"resolved_at,omitempty"`
-	ResolvedBy     *string       `db:"resolved_by,omitempty"`
-	Metadata       []byte        `db:"metadata,omitempty"`
+	ID              string        `db:"id"`
+	RuleID          string        `db:"rule_id"`
+	DomainID        string        `db:"domain_id"`
+	ChannelID       string        `db:"channel_id"`
+	ClientID        string        `db:"client_id"`
+	Subtopic        string        `db:"subtopic"`
+	Measurement     string        `db:"measurement"`
+	Value           string        `db:"value"`
+	Unit            string        `db:"unit"`
+	Cause           string        `db:"cause"`
+	Threshold       string        `db:"threshold"`
+	Status          alarms.Status `db:"status"`
+	Severity        uint8         `db:"severity"`
+	AssigneeID      string        `db:"assignee_id"`
+	CreatedAt       time.Time     `db:"created_at"`
+	UpdatedAt       sql.NullTime  `db:"updated_at,omitempty"`
+	UpdatedBy       *string       `db:"updated_by,omitempty"`
+	AssignedAt      sql.NullTime  `db:"assigned_at,omitempty"`
+	AssignedBy      *string       `db:"assigned_by,omitempty"`
+	AcknowledgedAt  sql.NullTime  `db:"acknowledged_at,omitempty"`
+	AcknowledgedBy  *string       `db:"acknowledged_by,omitempty"`
+	ResolvedAt      sql.NullTime  `db:"resolved_at,omitempty"`
+	ResolvedBy      *string       `db:"resolved_by,omitempty"`
+	SnoozedUntil    sql.NullTime  `db:"snoozed_until,omitempty"`
+	SnoozedBy       *string       `db:"snoozed_by,omitempty"`
+	EscalationLevel uint8         `db:"escalation_level"`
+	EscalatedAt     sql.NullTime  `db:"escalated_at,omitempty"`
+	DedupKey        string        `db:"dedup_key"`
+	Flapping        bool          `db:"flapping"`
+	Suppressed      bool          `db:"suppressed"`
+	SuppressionID   *string       `db:"suppression_id,omitempty"`
+	Metadata        []byte        `db:"metadata,omitempty"`
 }
 
 func toDBAlarm(a alarms.Alarm) (dbAlarm, error) {
@@ -364,6 +624,23 @@ func toDBAlarm(a alarms.Alarm) (dbAlarm, error) {
 		assignedAt = sql.NullTime{Time: a.AssignedAt, Valid: true}
 	}
 
+	var snoozedBy *string
+	if a.SnoozedBy != "" {
+		snoozedBy = &a.SnoozedBy
+	}
+	var snoozedUntil sql.NullTime
+	if !a.SnoozedUntil.IsZero() {
+		snoozedUntil = sql.NullTime{Time: a.SnoozedUntil, Valid: true}
+	}
+	var escalatedAt sql.NullTime
+	if !a.EscalatedAt.IsZero() {
+		escalatedAt = sql.NullTime{Time: a.EscalatedAt, Valid: true}
+	}
+	var suppressionID *string
+	if a.SuppressionID != "" {
+		suppressionID = &a.SuppressionID
+	}
+
 	metadata := []byte("{}")
 	if len(a.Metadata) > 0 {
 		b, err := json.Marshal(a.Metadata)
@@ -374,30 +651,38 @@ func toDBAlarm(a alarms.Alarm) (dbAlarm, error) {
 	}
 
 	return dbAlarm{
-		ID:             a.ID,
-		RuleID:         a.RuleID,
-		DomainID:       a.DomainID,
-		ChannelID:      a.ChannelID,
-		ClientID:       a.ClientID,
-		Subtopic:       a.Subtopic,
-		Measurement:    a.Measurement,
-		Value:          a.Value,
-		Unit:           a.Unit,
-		Cause:          a.Cause,
-		Threshold:      a.Threshold,
-		Status:         a.Status,
-		Severity:       a.Severity,
-		AssigneeID:     a.AssigneeID,
-		CreatedAt:      a.CreatedAt,
-		UpdatedAt:      updatedAt,
-		UpdatedBy:      updatedBy,
-		AssignedAt:     assignedAt,
-		AssignedBy:     assignedBy,
-		AcknowledgedAt: acknowledgedAt,
-		AcknowledgedBy: acknowledgedBy,
-		ResolvedAt:     resolvedAt,
-		ResolvedBy:     resolvedBy,
-		Metadata:       metadata,
+		ID:              a.ID,
+		RuleID:          a.RuleID,
+		DomainID:        a.DomainID,
+		ChannelID:       a.ChannelID,
+		ClientID:        a.ClientID,
+		Subtopic:        a.Subtopic,
+		Measurement:     a.Measurement,
+		Value:           a.Value,
+		Unit:            a.Unit,
+		Cause:           a.Cause,
+		Threshold:       a.Threshold,
+		Status:          a.Status,
+		Severity:        a.Severity,
+		AssigneeID:      a.AssigneeID,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       updatedAt,
+		UpdatedBy:       updatedBy,
+		AssignedAt:      assignedAt,
+		AssignedBy:      assignedBy,
+		AcknowledgedAt:  acknowledgedAt,
+		AcknowledgedBy:  acknowledgedBy,
+		ResolvedAt:      resolvedAt,
+		ResolvedBy:      resolvedBy,
+		SnoozedUntil:    snoozedUntil,
+		SnoozedBy:       snoozedBy,
+		EscalationLevel: a.EscalationLevel,
+		EscalatedAt:     escalatedAt,
+		DedupKey:        a.DedupKey,
+		Flapping:        a.Flapping,
+		Suppressed:      a.Suppressed,
+		SuppressionID:   suppressionID,
+		Metadata:        metadata,
 	}, nil
 }
 
@@ -438,6 +723,23 @@ func toAlarm(dbr dbAlarm) (alarms.Alarm, error) {
 		resolvedAt = dbr.ResolvedAt.Time
 	}
 
+	var snoozedBy string
+	if dbr.SnoozedBy != nil {
+		snoozedBy = *dbr.SnoozedBy
+	}
+	var snoozedUntil time.Time
+	if dbr.SnoozedUntil.Valid {
+		snoozedUntil = dbr.SnoozedUntil.Time
+	}
+	var escalatedAt time.Time
+	if dbr.EscalatedAt.Valid {
+		escalatedAt = dbr.EscalatedAt.Time
+	}
+	var suppressionID string
+	if dbr.SuppressionID != nil {
+		suppressionID = *dbr.SuppressionID
+	}
+
 	var metadata map[string]any
 	if len(dbr.Metadata) > 0 {
 		err := json.Unmarshal(dbr.Metadata, &metadata)
@@ -447,30 +749,38 @@ func toAlarm(dbr dbAlarm) (alarms.Alarm, error) {
 	}
 
 	return alarms.Alarm{
-		ID:             dbr.ID,
-		RuleID:         dbr.RuleID,
-		DomainID:       dbr.DomainID,
-		ChannelID:      dbr.ChannelID,
-		ClientID:       dbr.ClientID,
-		Subtopic:       dbr.Subtopic,
-		Measurement:    dbr.Measurement,
-		Value:          dbr.Value,
-		Unit:           dbr.Unit,
-		Threshold:      dbr.Threshold,
-		Cause:          dbr.Cause,
-		Status:         dbr.Status,
-		Severity:       dbr.Severity,
-		AssigneeID:     dbr.AssigneeID,
-		CreatedAt:      dbr.CreatedAt,
-		UpdatedAt:      updatedAt,
-		UpdatedBy:      updatedBy,
-		AssignedAt:     assignedAt,
-		AssignedBy:     assignedBy,
-		AcknowledgedAt: acknowledgedAt,
-		AcknowledgedBy: acknowledgedBy,
-		ResolvedAt:     resolvedAt,
-		ResolvedBy:     resolvedBy,
-		Metadata:       metadata,
+		ID:              dbr.ID,
+		RuleID:          dbr.RuleID,
+		DomainID:        dbr.DomainID,
+		ChannelID:       dbr.ChannelID,
+		ClientID:        dbr.ClientID,
+		Subtopic:        dbr.Subtopic,
+		Measurement:     dbr.Measurement,
+		Value:           dbr.Value,
+		Unit:            dbr.Unit,
+		Threshold:       dbr.Threshold,
+		Cause:           dbr.Cause,
+		Status:          dbr.Status,
+		Severity:        dbr.Severity,
+		AssigneeID:      dbr.AssigneeID,
+		CreatedAt:       dbr.CreatedAt,
+		UpdatedAt:       updatedAt,
+		UpdatedBy:       updatedBy,
+		AssignedAt:      assignedAt,
+		AssignedBy:      assignedBy,
+		AcknowledgedAt:  acknowledgedAt,
+		AcknowledgedBy:  acknowledgedBy,
+		ResolvedAt:      resolvedAt,
+		ResolvedBy:      resolvedBy,
+		SnoozedUntil:    snoozedUntil,
+		SnoozedBy:       snoozedBy,
+		EscalationLevel: dbr.EscalationLevel,
+		EscalatedAt:     escalatedAt,
+		DedupKey:        dbr.DedupKey,
+		Flapping:        dbr.Flapping,
+		Suppressed:      dbr.Suppressed,
+		SuppressionID:   suppressionID,
+		Metadata:        metadata,
 	}, nil
 }
 
@@ -490,6 +800,9 @@ func pageQueryConditions(pm alarms.PageMetadata) []string {
 	if pm.DomainID != "" {
 		query = append(query, "alarms.domain_id = :domain_id")
 	}
+	if len(pm.IDs) > 0 {
+		query = append(query, "alarms.id = ANY(:ids)")
+	}
 	if pm.RuleID != "" {
 		query = append(query, "alarms.rule_id = :rule_id")
 	}
@@ -207,13 +207,24 @@ func TestUpdateAlarm(t *testing.T) {
 			},
 			err: repoerr.ErrNotFound,
 		},
+		{
+			desc: "alarm of other domain",
+			alarm: alarms.Alarm{
+				ID:         alarm.ID,
+				DomainID:   generateUUID(t),
+				AssigneeID: generateUUID(t),
+				UpdatedAt:  time.Now().UTC(),
+				UpdatedBy:  generateUUID(t),
+			},
+			err: repoerr.ErrNotFound,
+		},
 		{
 			desc: "invalid alarm",
 			alarm: alarms.Alarm{
 				ID:         alarm.ID,
 				RuleID:     generateUUID(t),
 				Status:     0,
-				DomainID:   generateUUID(t),
+				DomainID:   alarm.DomainID,
 				AssigneeID: strings.Repeat("a", 40),
 				CreatedAt:  time.Now().UTC(),
 				Metadata: map[string]any{
@@ -506,8 +517,6 @@ func TestListUserAlarms(t *testing.T) {
 	_, err = db.Exec(`INSERT INTO domains_role_actions (role_id, action) VALUES ($1, $2)`, domainRoleID, "alarm_read")
 	require.Nil(t, err, fmt.Sprintf("insert domains_role_actions unexpected error: %s", err))
 
-	_ = createdAlarms
-
 	cases := []struct {
 		desc   string
 		userID string
@@ -622,6 +631,46 @@ func TestListUserAlarms(t *testing.T) {
 			assert.Equal(t, tc.count, len(page.Alarms), fmt.Sprintf("%s: expected %d alarms, got %d", tc.desc, tc.count, len(page.Alarms)))
 		})
 	}
+
+	userCases := []struct {
+		desc   string
+		userID string
+		alarm  alarms.Alarm
+		ok     bool
+	}{
+		{
+			desc:   "alarm of the rule the user is a member of",
+			userID: userID,
+			alarm:  createdAlarms[0],
+			ok:     true,
+		},
+		{
+			desc:   "alarm of the rule the user isn't a member of",
+			userID: userID,
+			alarm:  createdAlarms[9],
+			ok:     false,
+		},
+		{
+			desc:   "alarm for user with no role assignments",
+			userID: otherUserID,
+			alarm:  createdAlarms[0],
+			ok:     false,
+		},
+		{
+			desc:   "alarm for user with domain-level rule access",
+			userID: domainUserID,
+			alarm:  createdAlarms[9],
+			ok:     true,
+		},
+	}
+
+	for _, tc := range userCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ok, err := repo.IsUserAlarm(context.Background(), tc.userID, tc.alarm)
+			require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+			assert.Equal(t, tc.ok, ok, fmt.Sprintf("%s: expected %t got %t", tc.desc, tc.ok, ok))
+		})
+	}
 }
 
 func TestDeleteAlarm(t *testing.T) {
@@ -654,25 +703,34 @@ func TestDeleteAlarm(t *testing.T) {
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
 
 	cases := []struct {
-		desc string
-		id   string
-		err  error
+		desc     string
+		id       string
+		domainID string
+		err      error
 	}{
 		{
-			desc: "valid alarm",
-			id:   alarm.ID,
-			err:  nil,
+			desc:     "alarm of other domain",
+			id:       alarm.ID,
+			domainID: generateUUID(t),
+			err:      repoerr.ErrNotFound,
 		},
 		{
-			desc: "non existing alarm",
-			id:   generateUUID(t),
-			err:  repoerr.ErrNotFound,
+			desc:     "valid alarm",
+			id:       alarm.ID,
+			domainID: alarm.DomainID,
+			err:      nil,
+		},
+		{
+			desc:     "non existing alarm",
+			id:       generateUUID(t),
+			domainID: alarm.DomainID,
+			err:      repoerr.ErrNotFound,
 		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			err := repo.DeleteAlarm(context.Background(), tc.id)
+			err := repo.DeleteAlarm(context.Background(), tc.id, tc.domainID)
 			if tc.err != nil {
 				assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 
@@ -683,6 +741,87 @@ func TestDeleteAlarm(t *testing.T) {
 	}
 }
 
+func TestUpdateAlarms(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM alarms")
+		require.Nil(t, err, fmt.Sprintf("clean alarms unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+	active := createTestAlarm(t, repo)
+	changed := createTestAlarm(t, repo)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	userID := generateUUID(t)
+	acknowledge := func(a alarms.Alarm) alarms.Alarm {
+		a.Status = alarms.AcknowledgedStatus
+		a.AcknowledgedAt = now
+		a.AcknowledgedBy = userID
+		a.AssigneeID = userID
+		a.UpdatedAt = now
+		a.UpdatedBy = userID
+		return a
+	}
+
+	// The second alarm is saved only if it's still resolved, which it isn't.
+	updated, err := repo.UpdateAlarms(context.Background(), []alarms.AlarmChange{
+		{Alarm: acknowledge(active), From: alarms.ActiveStatus},
+		{Alarm: acknowledge(changed), From: alarms.ResolvedStatus},
+	})
+	require.Nil(t, err, fmt.Sprintf("update alarms unexpected error: %s", err))
+	require.Len(t, updated, 1)
+	assert.Equal(t, active.ID, updated[0].ID)
+	assert.Equal(t, alarms.AcknowledgedStatus, updated[0].Status)
+	assert.Equal(t, userID, updated[0].AcknowledgedBy)
+	assert.Equal(t, userID, updated[0].AssigneeID)
+	assert.Equal(t, now, updated[0].AcknowledgedAt.UTC())
+
+	got, err := repo.ViewAlarm(context.Background(), changed.ID, changed.DomainID)
+	require.Nil(t, err, fmt.Sprintf("view alarm unexpected error: %s", err))
+	assert.Equal(t, alarms.ActiveStatus, got.Status)
+}
+
+func TestDeleteAlarms(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM alarms")
+		require.Nil(t, err, fmt.Sprintf("clean alarms unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+	alarm := createTestAlarm(t, repo)
+	other := createTestAlarm(t, repo)
+
+	cases := []struct {
+		desc     string
+		domainID string
+		ids      []string
+		removed  []string
+	}{
+		{
+			desc:     "delete alarms of another domain",
+			domainID: generateUUID(t),
+			ids:      []string{alarm.ID},
+		},
+		{
+			desc:     "delete existing and non existing alarms",
+			domainID: alarm.DomainID,
+			ids:      []string{alarm.ID, other.ID, generateUUID(t)},
+			removed:  []string{alarm.ID},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			removed, err := repo.DeleteAlarms(context.Background(), tc.domainID, tc.ids)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.ElementsMatch(t, tc.removed, removed)
+		})
+	}
+
+	_, err := repo.ViewAlarm(context.Background(), other.ID, other.DomainID)
+	assert.Nil(t, err, fmt.Sprintf("view alarm unexpected error: %s", err))
+}
+
 func generateUUID(t *testing.T) string {
 	ulid, err := idProvider.ID()
 	require.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
@@ -0,0 +1,202 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+)
+
+const policyColumns = `id, name, domain_id, rule_id, min_severity, levels, created_at, created_by, updated_at, updated_by`
+
+func (r *repository) AddEscalationPolicy(ctx context.Context, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	q := fmt.Sprintf(`INSERT INTO escalation_policies (%s)
+		VALUES (:id, :name, :domain_id, :rule_id, :min_severity, :levels, :created_at, :created_by, :updated_at, :updated_by)
+		RETURNING %s;`, policyColumns, policyColumns)
+
+	dbp, err := toDBPolicy(policy)
+	if err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return r.queryPolicy(ctx, q, dbp, repoerr.ErrCreateEntity)
+}
+
+func (r *repository) ViewEscalationPolicy(ctx context.Context, domainID, id string) (alarms.EscalationPolicy, error) {
+	q := fmt.Sprintf(`SELECT %s FROM escalation_policies WHERE id = :id AND domain_id = :domain_id;`, policyColumns)
+
+	return r.queryPolicy(ctx, q, dbPolicy{ID: id, DomainID: domainID}, repoerr.ErrViewEntity)
+}
+
+func (r *repository) UpdateEscalationPolicy(ctx context.Context, policy alarms.EscalationPolicy) (alarms.EscalationPolicy, error) {
+	q := fmt.Sprintf(`UPDATE escalation_policies SET name = :name, rule_id = :rule_id, min_severity = :min_severity,
+		levels = :levels, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id
+		RETURNING %s;`, policyColumns)
+
+	dbp, err := toDBPolicy(policy)
+	if err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return r.queryPolicy(ctx, q, dbp, repoerr.ErrUpdateEntity)
+}
+
+func (r *repository) ListEscalationPolicies(ctx context.Context, pm alarms.EscalationPolicyPageMeta) (alarms.EscalationPolicyPage, error) {
+	var conditions []string
+	if pm.DomainID != "" {
+		conditions = append(conditions, "domain_id = :domain_id")
+	}
+	if pm.RuleID != "" {
+		conditions = append(conditions, "rule_id = :rule_id")
+	}
+	var where string
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM escalation_policies %s ORDER BY created_at, id LIMIT :limit OFFSET :offset;`, policyColumns, where)
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM escalation_policies %s;`, where)
+
+	rows, err := r.db.NamedQueryContext(ctx, q, pm)
+	if err != nil {
+		return alarms.EscalationPolicyPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	policies := []alarms.EscalationPolicy{}
+	for rows.Next() {
+		dbp := dbPolicy{}
+		if err := rows.StructScan(&dbp); err != nil {
+			return alarms.EscalationPolicyPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		p, err := toPolicy(dbp)
+		if err != nil {
+			return alarms.EscalationPolicyPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		policies = append(policies, p)
+	}
+
+	total, err := postgres.Total(ctx, r.db, cq, pm)
+	if err != nil {
+		return alarms.EscalationPolicyPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return alarms.EscalationPolicyPage{
+		Offset:   pm.Offset,
+		Limit:    pm.Limit,
+		Total:    total,
+		Policies: policies,
+	}, nil
+}
+
+func (r *repository) RemoveEscalationPolicy(ctx context.Context, domainID, id string) error {
+	q := `DELETE FROM escalation_policies WHERE id = :id AND domain_id = :domain_id;`
+	result, err := r.db.NamedExecContext(ctx, q, map[string]any{"id": id, "domain_id": domainID})
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repository) queryPolicy(ctx context.Context, q string, dbp dbPolicy, opErr error) (alarms.EscalationPolicy, error) {
+	row, err := r.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return alarms.EscalationPolicy{}, postgres.HandleError(opErr, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.EscalationPolicy{}, repoerr.ErrNotFound
+	}
+
+	dbp = dbPolicy{}
+	if err := row.StructScan(&dbp); err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(opErr, err)
+	}
+
+	return toPolicy(dbp)
+}
+
+type dbPolicy struct {
+	ID          string       `db:"id"`
+	Name        string       `db:"name"`
+	DomainID    string       `db:"domain_id"`
+	RuleID      string       `db:"rule_id"`
+	MinSeverity uint8        `db:"min_severity"`
+	Levels      []byte       `db:"levels"`
+	CreatedAt   time.Time    `db:"created_at"`
+	CreatedBy   string       `db:"created_by"`
+	UpdatedAt   sql.NullTime `db:"updated_at"`
+	UpdatedBy   *string      `db:"updated_by"`
+}
+
+func toDBPolicy(p alarms.EscalationPolicy) (dbPolicy, error) {
+	levels, err := json.Marshal(p.Levels)
+	if err != nil {
+		return dbPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var updatedAt sql.NullTime
+	if !p.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: p.UpdatedAt, Valid: true}
+	}
+	var updatedBy *string
+	if p.UpdatedBy != "" {
+		updatedBy = &p.UpdatedBy
+	}
+
+	return dbPolicy{
+		ID:          p.ID,
+		Name:        p.Name,
+		DomainID:    p.DomainID,
+		RuleID:      p.RuleID,
+		MinSeverity: p.MinSeverity,
+		Levels:      levels,
+		CreatedAt:   p.CreatedAt,
+		CreatedBy:   p.CreatedBy,
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   updatedBy,
+	}, nil
+}
+
+func toPolicy(dbp dbPolicy) (alarms.EscalationPolicy, error) {
+	var levels []alarms.EscalationLevel
+	if err := json.Unmarshal(dbp.Levels, &levels); err != nil {
+		return alarms.EscalationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var updatedAt time.Time
+	if dbp.UpdatedAt.Valid {
+		updatedAt = dbp.UpdatedAt.Time
+	}
+	var updatedBy string
+	if dbp.UpdatedBy != nil {
+		updatedBy = *dbp.UpdatedBy
+	}
+
+	return alarms.EscalationPolicy{
+		ID:          dbp.ID,
+		Name:        dbp.Name,
+		DomainID:    dbp.DomainID,
+		RuleID:      dbp.RuleID,
+		MinSeverity: dbp.MinSeverity,
+		Levels:      levels,
+		CreatedAt:   dbp.CreatedAt,
+		CreatedBy:   dbp.CreatedBy,
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   updatedBy,
+	}, nil
+}
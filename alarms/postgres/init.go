@@ -51,6 +51,129 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 					`DROP TABLE IF EXISTS alarms`,
 				},
 			},
+			{
+				Id: "alarms_02",
+				Up: []string{
+					`ALTER TABLE alarms
+						ADD COLUMN IF NOT EXISTS snoozed_until    TIMESTAMPTZ NULL,
+						ADD COLUMN IF NOT EXISTS snoozed_by       VARCHAR(36) NULL,
+						ADD COLUMN IF NOT EXISTS escalation_level SMALLINT NOT NULL DEFAULT 0 CHECK (escalation_level >= 0),
+						ADD COLUMN IF NOT EXISTS escalated_at     TIMESTAMPTZ NULL;`,
+					`CREATE TABLE IF NOT EXISTS escalation_policies (
+						id           VARCHAR(36) PRIMARY KEY,
+						name         TEXT NOT NULL,
+						domain_id    VARCHAR(36) NOT NULL,
+						rule_id      VARCHAR(36) NOT NULL DEFAULT '',
+						min_severity SMALLINT NOT NULL DEFAULT 0 CHECK (min_severity >= 0),
+						levels       JSONB NOT NULL,
+						created_at   TIMESTAMPTZ NOT NULL,
+						created_by   VARCHAR(36) NOT NULL,
+						updated_at   TIMESTAMPTZ NULL,
+						updated_by   VARCHAR(36) NULL
+					);`,
+					"CREATE INDEX IF NOT EXISTS idx_alarms_snoozed ON alarms (snoozed_until) WHERE status = 4;",
+					"CREATE INDEX IF NOT EXISTS idx_escalation_policies_domain ON escalation_policies (domain_id);",
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS escalation_policies`,
+					`ALTER TABLE alarms
+						DROP COLUMN IF EXISTS snoozed_until,
+						DROP COLUMN IF EXISTS snoozed_by,
+						DROP COLUMN IF EXISTS escalation_level,
+						DROP COLUMN IF EXISTS escalated_at;`,
+				},
+			},
+			{
+				Id: "alarms_03",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS notification_policies (
+						id           VARCHAR(36) PRIMARY KEY,
+						name         TEXT NOT NULL,
+						domain_id    VARCHAR(36) NOT NULL,
+						triggers     JSONB NOT NULL,
+						min_severity SMALLINT NOT NULL DEFAULT 0 CHECK (min_severity >= 0),
+						rule_id      VARCHAR(36) NOT NULL DEFAULT '',
+						channel_id   VARCHAR(36) NOT NULL DEFAULT '',
+						measurement  TEXT NOT NULL DEFAULT '',
+						receivers    JSONB NOT NULL,
+						quiet_hours  JSONB NULL,
+						rate_limit   JSONB NULL,
+						created_at   TIMESTAMPTZ NOT NULL,
+						created_by   VARCHAR(36) NOT NULL,
+						updated_at   TIMESTAMPTZ NULL,
+						updated_by   VARCHAR(36) NULL
+					);`,
+					"CREATE INDEX IF NOT EXISTS idx_notification_policies_domain ON notification_policies (domain_id, min_severity);",
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS notification_policies`,
+				},
+			},
+			{
+				Id: "alarms_04",
+				Up: []string{
+					`ALTER TABLE alarms
+						ADD COLUMN IF NOT EXISTS dedup_key TEXT NOT NULL DEFAULT '',
+						ADD COLUMN IF NOT EXISTS flapping BOOLEAN NOT NULL DEFAULT FALSE,
+						ADD COLUMN IF NOT EXISTS suppressed BOOLEAN NOT NULL DEFAULT FALSE,
+						ADD COLUMN IF NOT EXISTS suppression_id VARCHAR(36) NULL;`,
+					`UPDATE alarms SET dedup_key = concat_ws('/', rule_id, channel_id, client_id, subtopic, measurement) WHERE dedup_key = '';`,
+					"CREATE INDEX IF NOT EXISTS idx_alarms_dedup_key ON alarms (domain_id, dedup_key, created_at DESC);",
+					`CREATE TABLE IF NOT EXISTS suppression_windows (
+						id         VARCHAR(36) PRIMARY KEY,
+						name       TEXT NOT NULL,
+						domain_id  VARCHAR(36) NOT NULL,
+						channel_id VARCHAR(36) NOT NULL DEFAULT '',
+						client_id  VARCHAR(36) NOT NULL DEFAULT '',
+						reason     TEXT NOT NULL DEFAULT '',
+						starts_at  TIMESTAMPTZ NOT NULL,
+						ends_at    TIMESTAMPTZ NOT NULL CHECK (ends_at > starts_at),
+						created_at TIMESTAMPTZ NOT NULL,
+						created_by VARCHAR(36) NOT NULL,
+						updated_at TIMESTAMPTZ NULL,
+						updated_by VARCHAR(36) NULL
+					);`,
+					"CREATE INDEX IF NOT EXISTS idx_suppression_windows_domain ON suppression_windows (domain_id, ends_at);",
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS suppression_windows`,
+					`DROP INDEX IF EXISTS idx_alarms_dedup_key`,
+					`ALTER TABLE alarms
+						DROP COLUMN IF EXISTS dedup_key,
+						DROP COLUMN IF EXISTS flapping,
+						DROP COLUMN IF EXISTS suppressed,
+						DROP COLUMN IF EXISTS suppression_id;`,
+				},
+			},
+			{
+				Id: "alarms_05",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS alarm_comments (
+						id         VARCHAR(36) PRIMARY KEY,
+						alarm_id   VARCHAR(36) NOT NULL REFERENCES alarms (id) ON DELETE CASCADE,
+						domain_id  VARCHAR(36) NOT NULL,
+						parent_id  VARCHAR(36) NULL REFERENCES alarm_comments (id) ON DELETE CASCADE,
+						body       TEXT NOT NULL,
+						created_at TIMESTAMPTZ NOT NULL,
+						created_by VARCHAR(36) NOT NULL
+					);`,
+					"CREATE INDEX IF NOT EXISTS idx_alarm_comments_alarm ON alarm_comments (alarm_id, created_at);",
+					`CREATE TABLE IF NOT EXISTS alarm_activities (
+						id         VARCHAR(36) PRIMARY KEY,
+						alarm_id   VARCHAR(36) NOT NULL REFERENCES alarms (id) ON DELETE CASCADE,
+						domain_id  VARCHAR(36) NOT NULL,
+						type       TEXT NOT NULL,
+						actor_id   VARCHAR(36) NOT NULL DEFAULT '',
+						details    JSONB,
+						created_at TIMESTAMPTZ NOT NULL
+					);`,
+					"CREATE INDEX IF NOT EXISTS idx_alarm_activities_alarm ON alarm_activities (alarm_id, created_at);",
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS alarm_activities`,
+					`DROP TABLE IF EXISTS alarm_comments`,
+				},
+			},
 		},
 	}
 
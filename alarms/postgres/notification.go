@@ -0,0 +1,277 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+)
+
+const notificationColumns = `id, name, domain_id, triggers, min_severity, rule_id, channel_id, measurement,
+	receivers, quiet_hours, rate_limit, created_at, created_by, updated_at, updated_by`
+
+func (r *repository) AddNotificationPolicy(ctx context.Context, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	q := fmt.Sprintf(`INSERT INTO notification_policies (%s)
+		VALUES (:id, :name, :domain_id, :triggers, :min_severity, :rule_id, :channel_id, :measurement,
+			:receivers, :quiet_hours, :rate_limit, :created_at, :created_by, :updated_at, :updated_by)
+		RETURNING %s;`, notificationColumns, notificationColumns)
+
+	dbp, err := toDBNotificationPolicy(policy)
+	if err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+
+	return r.queryNotificationPolicy(ctx, q, dbp, repoerr.ErrCreateEntity)
+}
+
+func (r *repository) ViewNotificationPolicy(ctx context.Context, domainID, id string) (alarms.NotificationPolicy, error) {
+	q := fmt.Sprintf(`SELECT %s FROM notification_policies WHERE id = :id AND domain_id = :domain_id;`, notificationColumns)
+
+	return r.queryNotificationPolicy(ctx, q, dbNotificationPolicy{ID: id, DomainID: domainID}, repoerr.ErrViewEntity)
+}
+
+func (r *repository) UpdateNotificationPolicy(ctx context.Context, policy alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+	q := fmt.Sprintf(`UPDATE notification_policies SET name = :name, triggers = :triggers, min_severity = :min_severity,
+		rule_id = :rule_id, channel_id = :channel_id, measurement = :measurement, receivers = :receivers,
+		quiet_hours = :quiet_hours, rate_limit = :rate_limit, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id
+		RETURNING %s;`, notificationColumns)
+
+	dbp, err := toDBNotificationPolicy(policy)
+	if err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(repoerr.ErrUpdateEntity, err)
+	}
+
+	return r.queryNotificationPolicy(ctx, q, dbp, repoerr.ErrUpdateEntity)
+}
+
+func (r *repository) ListNotificationPolicies(ctx context.Context, pm alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+	var conditions []string
+	if pm.DomainID != "" {
+		conditions = append(conditions, "domain_id = :domain_id")
+	}
+	if pm.RuleID != "" {
+		conditions = append(conditions, "rule_id = :rule_id")
+	}
+	var where string
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM notification_policies %s ORDER BY created_at, id LIMIT :limit OFFSET :offset;`, notificationColumns, where)
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM notification_policies %s;`, where)
+
+	policies, err := r.listNotificationPolicies(ctx, q, pm)
+	if err != nil {
+		return alarms.NotificationPolicyPage{}, err
+	}
+
+	total, err := postgres.Total(ctx, r.db, cq, pm)
+	if err != nil {
+		return alarms.NotificationPolicyPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return alarms.NotificationPolicyPage{
+		Offset:   pm.Offset,
+		Limit:    pm.Limit,
+		Total:    total,
+		Policies: policies,
+	}, nil
+}
+
+func (r *repository) RemoveNotificationPolicy(ctx context.Context, domainID, id string) error {
+	q := `DELETE FROM notification_policies WHERE id = :id AND domain_id = :domain_id;`
+	result, err := r.db.NamedExecContext(ctx, q, map[string]any{"id": id, "domain_id": domainID})
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repository) MatchNotificationPolicies(ctx context.Context, alarm alarms.Alarm) ([]alarms.NotificationPolicy, error) {
+	q := fmt.Sprintf(`SELECT %s FROM notification_policies
+		WHERE domain_id = :domain_id AND min_severity <= :severity
+			AND rule_id IN ('', :rule_id) AND channel_id IN ('', :channel_id) AND measurement IN ('', :measurement)
+		ORDER BY created_at, id;`, notificationColumns)
+
+	params := map[string]any{
+		"domain_id":   alarm.DomainID,
+		"severity":    alarm.Severity,
+		"rule_id":     alarm.RuleID,
+		"channel_id":  alarm.ChannelID,
+		"measurement": alarm.Measurement,
+	}
+
+	return r.listNotificationPolicies(ctx, q, params)
+}
+
+func (r *repository) listNotificationPolicies(ctx context.Context, q string, params any) ([]alarms.NotificationPolicy, error) {
+	rows, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	policies := []alarms.NotificationPolicy{}
+	for rows.Next() {
+		dbp := dbNotificationPolicy{}
+		if err := rows.StructScan(&dbp); err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		p, err := toNotificationPolicy(dbp)
+		if err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		policies = append(policies, p)
+	}
+
+	return policies, nil
+}
+
+func (r *repository) queryNotificationPolicy(ctx context.Context, q string, dbp dbNotificationPolicy, opErr error) (alarms.NotificationPolicy, error) {
+	row, err := r.db.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return alarms.NotificationPolicy{}, postgres.HandleError(opErr, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.NotificationPolicy{}, repoerr.ErrNotFound
+	}
+
+	dbp = dbNotificationPolicy{}
+	if err := row.StructScan(&dbp); err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(opErr, err)
+	}
+
+	return toNotificationPolicy(dbp)
+}
+
+type dbNotificationPolicy struct {
+	ID          string       `db:"id"`
+	Name        string       `db:"name"`
+	DomainID    string       `db:"domain_id"`
+	Triggers    []byte       `db:"triggers"`
+	MinSeverity uint8        `db:"min_severity"`
+	RuleID      string       `db:"rule_id"`
+	ChannelID   string       `db:"channel_id"`
+	Measurement string       `db:"measurement"`
+	Receivers   []byte       `db:"receivers"`
+	QuietHours  []byte       `db:"quiet_hours"`
+	RateLimit   []byte       `db:"rate_limit"`
+	CreatedAt   time.Time    `db:"created_at"`
+	CreatedBy   string       `db:"created_by"`
+	UpdatedAt   sql.NullTime `db:"updated_at"`
+	UpdatedBy   *string      `db:"updated_by"`
+}
+
+func toDBNotificationPolicy(p alarms.NotificationPolicy) (dbNotificationPolicy, error) {
+	triggers, err := json.Marshal(p.Triggers)
+	if err != nil {
+		return dbNotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	receivers, err := json.Marshal(p.Receivers)
+	if err != nil {
+		return dbNotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var quietHours, rateLimit []byte
+	if p.QuietHours != nil {
+		if quietHours, err = json.Marshal(p.QuietHours); err != nil {
+			return dbNotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
+	if p.RateLimit != nil {
+		if rateLimit, err = json.Marshal(p.RateLimit); err != nil {
+			return dbNotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
+	var updatedAt sql.NullTime
+	if !p.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: p.UpdatedAt, Valid: true}
+	}
+	var updatedBy *string
+	if p.UpdatedBy != "" {
+		updatedBy = &p.UpdatedBy
+	}
+
+	return dbNotificationPolicy{
+		ID:          p.ID,
+		Name:        p.Name,
+		DomainID:    p.DomainID,
+		Triggers:    triggers,
+		MinSeverity: p.MinSeverity,
+		RuleID:      p.RuleID,
+		ChannelID:   p.ChannelID,
+		Measurement: p.Measurement,
+		Receivers:   receivers,
+		QuietHours:  quietHours,
+		RateLimit:   rateLimit,
+		CreatedAt:   p.CreatedAt,
+		CreatedBy:   p.CreatedBy,
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   updatedBy,
+	}, nil
+}
+
+func toNotificationPolicy(dbp dbNotificationPolicy) (alarms.NotificationPolicy, error) {
+	var triggers []alarms.Trigger
+	if err := json.Unmarshal(dbp.Triggers, &triggers); err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var receivers []alarms.Receiver
+	if err := json.Unmarshal(dbp.Receivers, &receivers); err != nil {
+		return alarms.NotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var quietHours *alarms.QuietHours
+	if len(dbp.QuietHours) > 0 {
+		if err := json.Unmarshal(dbp.QuietHours, &quietHours); err != nil {
+			return alarms.NotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
+	var rateLimit *alarms.RateLimit
+	if len(dbp.RateLimit) > 0 {
+		if err := json.Unmarshal(dbp.RateLimit, &rateLimit); err != nil {
+			return alarms.NotificationPolicy{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
+	var updatedAt time.Time
+	if dbp.UpdatedAt.Valid {
+		updatedAt = dbp.UpdatedAt.Time
+	}
+	var updatedBy string
+	if dbp.UpdatedBy != nil {
+		updatedBy = *dbp.UpdatedBy
+	}
+
+	return alarms.NotificationPolicy{
+		ID:          dbp.ID,
+		Name:        dbp.Name,
+		DomainID:    dbp.DomainID,
+		Triggers:    triggers,
+		MinSeverity: dbp.MinSeverity,
+		RuleID:      dbp.RuleID,
+		ChannelID:   dbp.ChannelID,
+		Measurement: dbp.Measurement,
+		Receivers:   receivers,
+		QuietHours:  quietHours,
+		RateLimit:   rateLimit,
+		CreatedAt:   dbp.CreatedAt,
+		CreatedBy:   dbp.CreatedBy,
+		UpdatedAt:   updatedAt,
+		UpdatedBy:   updatedBy,
+	}, nil
+}
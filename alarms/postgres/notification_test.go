@@ -0,0 +1,178 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/alarms/postgres"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNotificationPolicy(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM notification_policies")
+		require.Nil(t, err, fmt.Sprintf("clean notification policies unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+
+	policy := alarms.NotificationPolicy{
+		ID:          generateUUID(t),
+		Name:        namegen.Generate(),
+		DomainID:    generateUUID(t),
+		Triggers:    []alarms.Trigger{alarms.CreateTrigger, alarms.EscalateTrigger},
+		MinSeverity: 50,
+		Receivers:   []alarms.Receiver{{Type: alarms.EmailReceiver, To: []string{"ops@example.com"}}},
+		QuietHours:  &alarms.QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+		RateLimit:   &alarms.RateLimit{Count: 10, Period: 60},
+		CreatedAt:   time.Now().UTC().Truncate(time.Microsecond),
+		CreatedBy:   generateUUID(t),
+	}
+
+	cases := []struct {
+		desc   string
+		policy alarms.NotificationPolicy
+		err    error
+	}{
+		{
+			desc:   "add notification policy",
+			policy: policy,
+			err:    nil,
+		},
+		{
+			desc:   "add duplicate notification policy",
+			policy: policy,
+			err:    repoerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			p, err := repo.AddNotificationPolicy(context.Background(), tc.policy)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.policy.Triggers, p.Triggers)
+				assert.Equal(t, tc.policy.Receivers, p.Receivers)
+				assert.Equal(t, tc.policy.QuietHours, p.QuietHours)
+				assert.Equal(t, tc.policy.RateLimit, p.RateLimit)
+			}
+		})
+	}
+}
+
+func TestMatchNotificationPolicies(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM notification_policies")
+		require.Nil(t, err, fmt.Sprintf("clean notification policies unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+
+	domainID := generateUUID(t)
+	ruleID := generateUUID(t)
+	receivers := []alarms.Receiver{{Type: alarms.WebhookReceiver, URL: "https://example.com/alarms"}}
+	policies := []alarms.NotificationPolicy{
+		{MinSeverity: 0},
+		{MinSeverity: 90},
+		{RuleID: ruleID},
+		{RuleID: generateUUID(t)},
+		{Measurement: "temperature"},
+	}
+	for i, p := range policies {
+		p.ID = generateUUID(t)
+		p.Name = namegen.Generate()
+		p.DomainID = domainID
+		p.Triggers = []alarms.Trigger{alarms.CreateTrigger}
+		p.Receivers = receivers
+		p.CreatedAt = time.Now().UTC().Add(time.Duration(i) * time.Second)
+		p.CreatedBy = generateUUID(t)
+		_, err := repo.AddNotificationPolicy(context.Background(), p)
+		require.Nil(t, err, fmt.Sprintf("add notification policy unexpected error: %s", err))
+	}
+
+	cases := []struct {
+		desc  string
+		alarm alarms.Alarm
+		count int
+	}{
+		{
+			desc:  "match low severity alarm",
+			alarm: alarms.Alarm{DomainID: domainID, RuleID: ruleID, Measurement: "humidity", Severity: 10},
+			count: 2,
+		},
+		{
+			desc:  "match high severity alarm",
+			alarm: alarms.Alarm{DomainID: domainID, RuleID: ruleID, Measurement: "temperature", Severity: 95},
+			count: 4,
+		},
+		{
+			desc:  "match alarm of other domain",
+			alarm: alarms.Alarm{DomainID: generateUUID(t), RuleID: ruleID, Severity: 95},
+			count: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			matched, err := repo.MatchNotificationPolicies(context.Background(), tc.alarm)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.count, len(matched), fmt.Sprintf("%s: expected %d policies, got %d", tc.desc, tc.count, len(matched)))
+		})
+	}
+}
+
+func TestRemoveNotificationPolicy(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM notification_policies")
+		require.Nil(t, err, fmt.Sprintf("clean notification policies unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+
+	policy, err := repo.AddNotificationPolicy(context.Background(), alarms.NotificationPolicy{
+		ID:        generateUUID(t),
+		Name:      namegen.Generate(),
+		DomainID:  generateUUID(t),
+		Triggers:  []alarms.Trigger{alarms.ClearTrigger},
+		Receivers: []alarms.Receiver{{Type: alarms.SMSReceiver, To: []string{"+381600000000"}}},
+		CreatedAt: time.Now().UTC(),
+		CreatedBy: generateUUID(t),
+	})
+	require.Nil(t, err, fmt.Sprintf("add notification policy unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		id       string
+		err      error
+	}{
+		{
+			desc:     "remove notification policy",
+			domainID: policy.DomainID,
+			id:       policy.ID,
+			err:      nil,
+		},
+		{
+			desc:     "remove non existing notification policy",
+			domainID: policy.DomainID,
+			id:       generateUUID(t),
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.RemoveNotificationPolicy(context.Background(), tc.domainID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/lib/pq"
+)
+
+func (r *repository) AlarmStats(ctx context.Context, q alarms.StatsQuery) (alarms.Stats, error) {
+	return r.alarmStats(ctx, pageQueryConditions(q.PageMetadata), q)
+}
+
+func (r *repository) UserAlarmStats(ctx context.Context, userID string, q alarms.StatsQuery) (alarms.Stats, error) {
+	q.UserID = userID
+	conditions := append([]string{userAlarmsClause}, pageQueryConditions(q.PageMetadata)...)
+
+	return r.alarmStats(ctx, conditions, q)
+}
+
+func (r *repository) alarmStats(ctx context.Context, conditions []string, q alarms.StatsQuery) (alarms.Stats, error) {
+	// The grouping is put into the query, so it's checked here too.
+	if err := q.Validate(); err != nil {
+		return alarms.Stats{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	var groups, columns []string
+	for _, f := range q.GroupBy {
+		groups = append(groups, "alarms."+f)
+		columns = append(columns, "alarms."+f)
+	}
+	if q.Bucket != "" {
+		bucket := fmt.Sprintf("date_trunc('%s', alarms.created_at)", q.Bucket)
+		groups = append(groups, bucket)
+		columns = append(columns, bucket+" AS bucket")
+	}
+	columns = append(columns,
+		"COUNT(*) AS count",
+		"COUNT(alarms.acknowledged_at) AS acknowledged",
+		"COUNT(alarms.resolved_at) AS resolved",
+		"CAST(COALESCE(AVG(EXTRACT(EPOCH FROM alarms.acknowledged_at - alarms.created_at)), 0) AS DOUBLE PRECISION) AS mtta",
+		"CAST(COALESCE(AVG(EXTRACT(EPOCH FROM alarms.resolved_at - alarms.created_at)), 0) AS DOUBLE PRECISION) AS mttr",
+	)
+
+	var where, groupBy string
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	if len(groups) > 0 {
+		groupBy = fmt.Sprintf("GROUP BY %s ORDER BY %s", strings.Join(groups, ", "), strings.Join(groups, ", "))
+	}
+	query := fmt.Sprintf(`SELECT %s FROM alarms %s %s;`, strings.Join(columns, ", "), where, groupBy)
+
+	params := struct {
+		alarms.StatsQuery
+		IDs pq.StringArray `db:"ids"`
+	}{q, q.IDs}
+	rows, err := r.db.NamedQueryContext(ctx, query, params)
+	if err != nil {
+		return alarms.Stats{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	stats := alarms.Stats{Groups: []alarms.StatsGroup{}}
+	for rows.Next() {
+		dbg := dbStatsGroup{}
+		if err := rows.StructScan(&dbg); err != nil {
+			return alarms.Stats{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		stats.Groups = append(stats.Groups, alarms.StatsGroup(dbg))
+	}
+
+	return stats, nil
+}
+
+type dbStatsGroup struct {
+	RuleID       string         `db:"rule_id"`
+	ChannelID    string         `db:"channel_id"`
+	ClientID     string         `db:"client_id"`
+	Severity     *uint8         `db:"severity"`
+	Status       *alarms.Status `db:"status"`
+	Bucket       *time.Time     `db:"bucket"`
+	Count        uint64         `db:"count"`
+	Acknowledged uint64         `db:"acknowledged"`
+	Resolved     uint64         `db:"resolved"`
+	MTTA         float64        `db:"mtta"`
+	MTTR         float64        `db:"mttr"`
+}
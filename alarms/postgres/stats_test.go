@@ -0,0 +1,97 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/alarms/postgres"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlarmStats(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM alarms")
+		require.Nil(t, err, fmt.Sprintf("clean alarms unexpected error: %s", err))
+	})
+	repo := postgres.NewAlarmsRepo(db)
+
+	domainID := generateUUID(t)
+	channelID := generateUUID(t)
+	otherChannelID := generateUUID(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	items := []alarms.Alarm{
+		{ChannelID: channelID, Status: alarms.AcknowledgedStatus, Severity: 100, AcknowledgedAt: now.Add(time.Minute)},
+		{ChannelID: channelID, Status: alarms.ResolvedStatus, Severity: 100, AcknowledgedAt: now.Add(3 * time.Minute), ResolvedAt: now.Add(time.Hour)},
+		{ChannelID: channelID, Status: alarms.ActiveStatus, Severity: 50},
+		{ChannelID: otherChannelID, Status: alarms.ActiveStatus, Severity: 100},
+	}
+	for i, a := range items {
+		a.ID = generateUUID(t)
+		a.RuleID = generateUUID(t)
+		a.DomainID = domainID
+		a.ClientID = generateUUID(t)
+		a.Measurement = namegen.Generate()
+		a.CreatedAt = now
+		a.DedupKey = alarms.DedupKey(a, nil)
+		_, err := repo.CreateAlarm(context.Background(), a)
+		require.Nil(t, err, fmt.Sprintf("create alarm %d unexpected error: %s", i, err))
+	}
+
+	pm := alarms.PageMetadata{DomainID: domainID, Status: alarms.AllStatus, Severity: math.MaxUint8}
+	critical := uint8(100)
+
+	cases := []struct {
+		desc   string
+		query  alarms.StatsQuery
+		groups []alarms.StatsGroup
+		err    error
+	}{
+		{
+			desc:  "stats of all domain alarms",
+			query: alarms.StatsQuery{PageMetadata: pm},
+			groups: []alarms.StatsGroup{
+				{Count: 4, Acknowledged: 2, Resolved: 1, MTTA: 120, MTTR: 3600},
+			},
+		},
+		{
+			desc: "stats of critical alarms per channel",
+			query: alarms.StatsQuery{
+				PageMetadata: alarms.PageMetadata{DomainID: domainID, Status: alarms.AllStatus, Severity: critical},
+				GroupBy:      []string{alarms.GroupByChannel, alarms.GroupBySeverity},
+			},
+			groups: []alarms.StatsGroup{
+				{ChannelID: channelID, Severity: &critical, Count: 2, Acknowledged: 2, Resolved: 1, MTTA: 120, MTTR: 3600},
+				{ChannelID: otherChannelID, Severity: &critical, Count: 1},
+			},
+		},
+		{
+			desc:  "stats with invalid group by",
+			query: alarms.StatsQuery{PageMetadata: pm, GroupBy: []string{"id"}},
+			err:   repoerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			stats, err := repo.AlarmStats(context.Background(), tc.query)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err != nil {
+				return
+			}
+			require.Len(t, stats.Groups, len(tc.groups))
+			for _, g := range tc.groups {
+				assert.Contains(t, stats.Groups, g)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+)
+
+const suppressionColumns = `id, name, domain_id, channel_id, client_id, reason, starts_at, ends_at,
+	created_at, created_by, updated_at, updated_by`
+
+func (r *repository) AddSuppressionWindow(ctx context.Context, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	q := fmt.Sprintf(`INSERT INTO suppression_windows (%s)
+		VALUES (:id, :name, :domain_id, :channel_id, :client_id, :reason, :starts_at, :ends_at,
+			:created_at, :created_by, :updated_at, :updated_by)
+		RETURNING %s;`, suppressionColumns, suppressionColumns)
+
+	return r.querySuppressionWindow(ctx, q, toDBSuppressionWindow(window), repoerr.ErrCreateEntity)
+}
+
+func (r *repository) ViewSuppressionWindow(ctx context.Context, domainID, id string) (alarms.SuppressionWindow, error) {
+	q := fmt.Sprintf(`SELECT %s FROM suppression_windows WHERE id = :id AND domain_id = :domain_id;`, suppressionColumns)
+
+	return r.querySuppressionWindow(ctx, q, dbSuppressionWindow{ID: id, DomainID: domainID}, repoerr.ErrViewEntity)
+}
+
+func (r *repository) UpdateSuppressionWindow(ctx context.Context, window alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+	q := fmt.Sprintf(`UPDATE suppression_windows SET name = :name, channel_id = :channel_id, client_id = :client_id,
+		reason = :reason, starts_at = :starts_at, ends_at = :ends_at, updated_at = :updated_at, updated_by = :updated_by
+		WHERE id = :id AND domain_id = :domain_id
+		RETURNING %s;`, suppressionColumns)
+
+	return r.querySuppressionWindow(ctx, q, toDBSuppressionWindow(window), repoerr.ErrUpdateEntity)
+}
+
+func (r *repository) ListSuppressionWindows(ctx context.Context, pm alarms.SuppressionWindowPageMeta) (alarms.SuppressionWindowPage, error) {
+	var conditions []string
+	if pm.DomainID != "" {
+		conditions = append(conditions, "domain_id = :domain_id")
+	}
+	if pm.ChannelID != "" {
+		conditions = append(conditions, "channel_id = :channel_id")
+	}
+	if pm.ClientID != "" {
+		conditions = append(conditions, "client_id = :client_id")
+	}
+	var where string
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM suppression_windows %s ORDER BY starts_at, id LIMIT :limit OFFSET :offset;`, suppressionColumns, where)
+	cq := fmt.Sprintf(`SELECT COUNT(*) AS total_count FROM suppression_windows %s;`, where)
+
+	rows, err := r.db.NamedQueryContext(ctx, q, pm)
+	if err != nil {
+		return alarms.SuppressionWindowPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	windows := []alarms.SuppressionWindow{}
+	for rows.Next() {
+		dbw := dbSuppressionWindow{}
+		if err := rows.StructScan(&dbw); err != nil {
+			return alarms.SuppressionWindowPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		windows = append(windows, toSuppressionWindow(dbw))
+	}
+
+	total, err := postgres.Total(ctx, r.db, cq, pm)
+	if err != nil {
+		return alarms.SuppressionWindowPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return alarms.SuppressionWindowPage{
+		Offset:  pm.Offset,
+		Limit:   pm.Limit,
+		Total:   total,
+		Windows: windows,
+	}, nil
+}
+
+func (r *repository) RemoveSuppressionWindow(ctx context.Context, domainID, id string) error {
+	q := `DELETE FROM suppression_windows WHERE id = :id AND domain_id = :domain_id;`
+	result, err := r.db.NamedExecContext(ctx, q, map[string]any{"id": id, "domain_id": domainID})
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *repository) MatchSuppressionWindow(ctx context.Context, alarm alarms.Alarm) (alarms.SuppressionWindow, error) {
+	q := fmt.Sprintf(`SELECT %s FROM suppression_windows
+		WHERE domain_id = :domain_id AND channel_id IN ('', :channel_id) AND client_id IN ('', :client_id)
+			AND starts_at <= :created_at AND ends_at > :created_at
+		ORDER BY starts_at, id
+		LIMIT 1;`, suppressionColumns)
+
+	params := map[string]any{
+		"domain_id":  alarm.DomainID,
+		"channel_id": alarm.ChannelID,
+		"client_id":  alarm.ClientID,
+		"created_at": alarm.CreatedAt,
+	}
+
+	row, err := r.db.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return alarms.SuppressionWindow{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.SuppressionWindow{}, repoerr.ErrNotFound
+	}
+
+	dbw := dbSuppressionWindow{}
+	if err := row.StructScan(&dbw); err != nil {
+		return alarms.SuppressionWindow{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return toSuppressionWindow(dbw), nil
+}
+
+func (r *repository) querySuppressionWindow(ctx context.Context, q string, dbw dbSuppressionWindow, opErr error) (alarms.SuppressionWindow, error) {
+	row, err := r.db.NamedQueryContext(ctx, q, dbw)
+	if err != nil {
+		return alarms.SuppressionWindow{}, postgres.HandleError(opErr, err)
+	}
+	defer row.Close()
+
+	if !row.Next() {
+		return alarms.SuppressionWindow{}, repoerr.ErrNotFound
+	}
+
+	dbw = dbSuppressionWindow{}
+	if err := row.StructScan(&dbw); err != nil {
+		return alarms.SuppressionWindow{}, errors.Wrap(opErr, err)
+	}
+
+	return toSuppressionWindow(dbw), nil
+}
+
+type dbSuppressionWindow struct {
+	ID        string       `db:"id"`
+	Name      string       `db:"name"`
+	DomainID  string       `db:"domain_id"`
+	ChannelID string       `db:"channel_id"`
+	ClientID  string       `db:"client_id"`
+	Reason    string       `db:"reason"`
+	StartsAt  time.Time    `db:"starts_at"`
+	EndsAt    time.Time    `db:"ends_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	CreatedBy string       `db:"created_by"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+	UpdatedBy *string      `db:"updated_by"`
+}
+
+func toDBSuppressionWindow(w alarms.SuppressionWindow) dbSuppressionWindow {
+	var updatedAt sql.NullTime
+	if !w.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: w.UpdatedAt, Valid: true}
+	}
+	var updatedBy *string
+	if w.UpdatedBy != "" {
+		updatedBy = &w.UpdatedBy
+	}
+
+	return dbSuppressionWindow{
+		ID:        w.ID,
+		Name:      w.Name,
+		DomainID:  w.DomainID,
+		ChannelID: w.ChannelID,
+		ClientID:  w.ClientID,
+		Reason:    w.Reason,
+		StartsAt:  w.StartsAt,
+		EndsAt:    w.EndsAt,
+		CreatedAt: w.CreatedAt,
+		CreatedBy: w.CreatedBy,
+		UpdatedAt: updatedAt,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func toSuppressionWindow(dbw dbSuppressionWindow) alarms.SuppressionWindow {
+	var updatedAt time.Time
+	if dbw.UpdatedAt.Valid {
+		updatedAt = dbw.UpdatedAt.Time
+	}
+	var updatedBy string
+	if dbw.UpdatedBy != nil {
+		updatedBy = *dbw.UpdatedBy
+	}
+
+	return alarms.SuppressionWindow{
+		ID:        dbw.ID,
+		Name:      dbw.Name,
+		DomainID:  dbw.DomainID,
+		ChannelID: dbw.ChannelID,
+		ClientID:  dbw.ClientID,
+		Reason:    dbw.Reason,
+		StartsAt:  dbw.StartsAt,
+		EndsAt:    dbw.EndsAt,
+		CreatedAt: dbw.CreatedAt,
+		CreatedBy: dbw.CreatedBy,
+		UpdatedAt: updatedAt,
+		UpdatedBy: updatedBy,
+	}
+}
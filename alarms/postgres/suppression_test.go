@@ -0,0 +1,133 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/alarms/postgres"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSuppressionWindow(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM suppression_windows")
+		require.Nil(t, err, fmt.Sprintf("clean suppression windows unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	window := alarms.SuppressionWindow{
+		ID:        generateUUID(t),
+		Name:      namegen.Generate(),
+		DomainID:  generateUUID(t),
+		ChannelID: generateUUID(t),
+		Reason:    "maintenance",
+		StartsAt:  now,
+		EndsAt:    now.Add(time.Hour),
+		CreatedAt: now,
+		CreatedBy: generateUUID(t),
+	}
+
+	cases := []struct {
+		desc   string
+		window alarms.SuppressionWindow
+		err    error
+	}{
+		{
+			desc:   "add suppression window",
+			window: window,
+			err:    nil,
+		},
+		{
+			desc:   "add duplicate suppression window",
+			window: window,
+			err:    repoerr.ErrConflict,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			w, err := repo.AddSuppressionWindow(context.Background(), tc.window)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, tc.window.ChannelID, w.ChannelID)
+				assert.True(t, tc.window.StartsAt.Equal(w.StartsAt))
+				assert.True(t, tc.window.EndsAt.Equal(w.EndsAt))
+			}
+		})
+	}
+}
+
+func TestMatchSuppressionWindow(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM suppression_windows")
+		require.Nil(t, err, fmt.Sprintf("clean suppression windows unexpected error: %s", err))
+	})
+
+	repo := postgres.NewAlarmsRepo(db)
+
+	domainID := generateUUID(t)
+	channelID := generateUUID(t)
+	clientID := generateUUID(t)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	windows := []alarms.SuppressionWindow{
+		{ChannelID: channelID, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+		{ClientID: clientID, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)},
+	}
+	for i, w := range windows {
+		w.ID = generateUUID(t)
+		w.Name = namegen.Generate()
+		w.DomainID = domainID
+		w.CreatedAt = now
+		w.CreatedBy = generateUUID(t)
+		saved, err := repo.AddSuppressionWindow(context.Background(), w)
+		require.Nil(t, err, fmt.Sprintf("add suppression window unexpected error: %s", err))
+		windows[i] = saved
+	}
+
+	cases := []struct {
+		desc  string
+		alarm alarms.Alarm
+		id    string
+		err   error
+	}{
+		{
+			desc:  "match alarm of suppressed channel",
+			alarm: alarms.Alarm{DomainID: domainID, ChannelID: channelID, ClientID: generateUUID(t), CreatedAt: now},
+			id:    windows[0].ID,
+		},
+		{
+			desc:  "match alarm of suppressed client in window",
+			alarm: alarms.Alarm{DomainID: domainID, ChannelID: generateUUID(t), ClientID: clientID, CreatedAt: now.Add(90 * time.Minute)},
+			id:    windows[1].ID,
+		},
+		{
+			desc:  "match alarm of suppressed client out of window",
+			alarm: alarms.Alarm{DomainID: domainID, ChannelID: generateUUID(t), ClientID: clientID, CreatedAt: now},
+			err:   repoerr.ErrNotFound,
+		},
+		{
+			desc:  "match alarm of other domain",
+			alarm: alarms.Alarm{DomainID: generateUUID(t), ChannelID: channelID, CreatedAt: now},
+			err:   repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			w, err := repo.MatchSuppressionWindow(context.Background(), tc.alarm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.id, w.ID)
+		})
+	}
+}
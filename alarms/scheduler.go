@@ -0,0 +1,147 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+)
+
+const policiesBatch = 100
+
+func (s *service) StartScheduler(ctx context.Context) error {
+	defer s.ticker.Stop()
+
+	go s.deliver(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.ticker.Tick():
+			due := time.Now().UTC()
+
+			if err := s.repo.WakeSnoozedAlarms(ctx, due); err != nil {
+				s.runInfo <- pkglog.RunInfo{
+					Level:   slog.LevelError,
+					Message: fmt.Sprintf("failed to wake snoozed alarms: %s", err),
+					Details: []slog.Attr{slog.Time("due", due)},
+				}
+			}
+
+			s.escalate(ctx, due)
+		}
+	}
+}
+
+// escalate applies the escalation policies to the alarms raised before due.
+func (s *service) escalate(ctx context.Context, due time.Time) {
+	pm := EscalationPolicyPageMeta{Limit: policiesBatch}
+	for {
+		page, err := s.repo.ListEscalationPolicies(ctx, pm)
+		if err != nil {
+			s.runInfo <- pkglog.RunInfo{
+				Level:   slog.LevelError,
+				Message: fmt.Sprintf("failed to list escalation policies: %s", err),
+				Details: []slog.Attr{slog.Time("due", due)},
+			}
+			return
+		}
+		for _, p := range page.Policies {
+			s.escalatePolicy(ctx, p, due)
+		}
+		pm.Offset += uint64(len(page.Policies))
+		if len(page.Policies) == 0 || pm.Offset >= page.Total {
+			return
+		}
+	}
+}
+
+// escalatePolicy goes from the last level to the first one, so the alarm
+// that is late for several levels only gets the highest of them.
+func (s *service) escalatePolicy(ctx context.Context, p EscalationPolicy, due time.Time) {
+	for i := len(p.Levels) - 1; i >= 0; i-- {
+		level := p.Levels[i]
+		number := uint8(i + 1)
+		before := due.Add(-time.Duration(level.After) * time.Minute)
+
+		alarms, err := s.repo.ListAlarmsToEscalate(ctx, p, number, before)
+		if err != nil {
+			s.runInfo <- pkglog.RunInfo{
+				Level:   slog.LevelError,
+				Message: fmt.Sprintf("failed to list alarms to escalate: %s", err),
+				Details: []slog.Attr{slog.String("domain_id", p.DomainID), slog.String("policy_id", p.ID)},
+			}
+			continue
+		}
+
+		for _, a := range alarms {
+			severity := a.Severity
+			a.EscalationLevel = number
+			a.EscalatedAt = due
+			if level.Severity > a.Severity {
+				a.Severity = level.Severity
+			}
+			escalated, err := s.repo.EscalateAlarm(ctx, a)
+			switch {
+			// The alarm was acknowledged or escalated meanwhile.
+			case errors.Contains(err, repoerr.ErrNotFound):
+				continue
+			case err != nil:
+				s.runInfo <- pkglog.RunInfo{
+					Level:   slog.LevelError,
+					Message: fmt.Sprintf("failed to escalate alarm: %s", err),
+					Details: []slog.Attr{slog.String("domain_id", p.DomainID), slog.String("alarm_id", a.ID)},
+				}
+				continue
+			}
+
+			ret := pkglog.RunInfo{
+				Level:   slog.LevelInfo,
+				Message: "alarm escalated",
+				Details: []slog.Attr{
+					slog.String("domain_id", p.DomainID),
+					slog.String("policy_id", p.ID),
+					slog.String("alarm_id", a.ID),
+					slog.Int("level", int(number)),
+				},
+			}
+			if level.GroupID != "" {
+				if err := s.notifier.Notify(ctx, escalated, level.GroupID); err != nil {
+					ret.Level = slog.LevelError
+					ret.Message = fmt.Sprintf("failed to notify about escalated alarm: %s", err)
+				}
+			}
+			if err := s.recordEscalation(ctx, escalated, p.ID, number, severity); err != nil {
+				ret.Level = slog.LevelError
+				ret.Message = fmt.Sprintf("failed to record alarm escalation: %s", err)
+			}
+			s.runInfo <- ret
+			s.enqueue(escalated, EscalateTrigger)
+		}
+	}
+}
+
+// recordEscalation adds the escalation, and the severity change if the
+// escalation raised the severity, to the alarm timeline.
+func (s *service) recordEscalation(ctx context.Context, alarm Alarm, policyID string, level, previous uint8) error {
+	details := map[string]any{
+		"policy_id": policyID,
+		"level":     level,
+	}
+	if err := s.record(ctx, alarm, EscalatedActivity, "", details); err != nil {
+		return err
+	}
+	if alarm.Severity == previous {
+		return nil
+	}
+
+	return s.record(ctx, alarm, SeverityChangedActivity, "", map[string]any{"from": previous, "to": alarm.Severity})
+}
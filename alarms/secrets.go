@@ -0,0 +1,128 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// sealedPrefix marks the encrypted secrets, so the secrets of the policies
+// created before the encryption are still read as plain text.
+const sealedPrefix = "enc:"
+
+var (
+	ErrInvalidEncryptionKey = errors.New("invalid encryption key, must be 16, 24 or 32 bytes long")
+	errDecryptSecret        = errors.New("failed to decrypt receiver secret")
+)
+
+// secrets returns the receiver credentials. The credentials are stored
+// encrypted and are never returned by the API.
+func (r *Receiver) secrets() []*string {
+	return []*string{&r.Token, &r.Secret}
+}
+
+type secrets struct {
+	aead cipher.AEAD
+}
+
+func newSecrets(key []byte) (secrets, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return secrets{}, errors.Wrap(ErrInvalidEncryptionKey, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return secrets{}, errors.Wrap(ErrInvalidEncryptionKey, err)
+	}
+
+	return secrets{aead: aead}, nil
+}
+
+// seal encrypts the receiver secrets of the policy.
+func (s secrets) seal(p *NotificationPolicy) error {
+	p.Receivers = slices.Clone(p.Receivers)
+	for i := range p.Receivers {
+		for _, sec := range p.Receivers[i].secrets() {
+			if *sec == "" || strings.HasPrefix(*sec, sealedPrefix) {
+				continue
+			}
+			nonce := make([]byte, s.aead.NonceSize())
+			if _, err := rand.Read(nonce); err != nil {
+				return err
+			}
+			ct := s.aead.Seal(nonce, nonce, []byte(*sec), nil)
+			*sec = sealedPrefix + base64.StdEncoding.EncodeToString(ct)
+		}
+	}
+
+	return nil
+}
+
+// open decrypts the secrets of the receiver.
+func (s secrets) open(r *Receiver) error {
+	for _, sec := range r.secrets() {
+		if !strings.HasPrefix(*sec, sealedPrefix) {
+			continue
+		}
+		ct, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(*sec, sealedPrefix))
+		if err != nil {
+			return errors.Wrap(errDecryptSecret, err)
+		}
+		n := s.aead.NonceSize()
+		if len(ct) < n {
+			return errDecryptSecret
+		}
+		pt, err := s.aead.Open(nil, ct[:n], ct[n:], nil)
+		if err != nil {
+			return errors.Wrap(errDecryptSecret, err)
+		}
+		*sec = string(pt)
+	}
+
+	return nil
+}
+
+// keepSecrets copies the stored secrets left empty in the update. Secrets
+// are never returned, so the updates omit the ones that don't change.
+// Receivers are matched by position and type.
+func keepSecrets(p *NotificationPolicy, stored NotificationPolicy) {
+	for i := range p.Receivers {
+		if i >= len(stored.Receivers) || p.Receivers[i].Type != stored.Receivers[i].Type {
+			continue
+		}
+		old := stored.Receivers[i].secrets()
+		for j, sec := range p.Receivers[i].secrets() {
+			if *sec == "" {
+				*sec = *old[j]
+			}
+		}
+	}
+}
+
+// redact removes the receiver secrets from the policy.
+func redact(p NotificationPolicy) NotificationPolicy {
+	p.Receivers = slices.Clone(p.Receivers)
+	for i := range p.Receivers {
+		for _, sec := range p.Receivers[i].secrets() {
+			*sec = ""
+		}
+	}
+
+	return p
+}
+
+func redactPage(p NotificationPolicyPage) NotificationPolicyPage {
+	for i := range p.Policies {
+		p.Policies[i] = redact(p.Policies[i])
+	}
+
+	return p
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package senders delivers the alarm notifications by email, SMS, Slack
+// and webhooks.
+package senders
@@ -0,0 +1,117 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package senders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/consumers"
+	"github.com/absmach/magistrala/pkg/emailer"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/webhook"
+	"github.com/slack-go/slack"
+)
+
+const (
+	footer         = "Sent by Magistrala Alarms"
+	webhookRetries = 2
+)
+
+var (
+	errEmailDisabled = errors.New("email notifications are not configured")
+	errSMSDisabled   = errors.New("sms notifications are not configured")
+	errReceiverType  = errors.New("unsupported receiver type")
+)
+
+var _ alarms.Sender = (*sender)(nil)
+
+type sender struct {
+	emailer emailer.Emailer
+	sms     consumers.Notifier
+	smsFrom string
+	// webhooks shares the HTTP clients between the webhook notifications.
+	webhooks *webhook.Pool
+}
+
+// New returns the sender of the alarm notifications. The email and the SMS
+// notifications fail if the emailer or the SMS notifier is nil.
+func New(e emailer.Emailer, sms consumers.Notifier, smsFrom string) alarms.Sender {
+	return &sender{
+		emailer:  e,
+		sms:      sms,
+		smsFrom:  smsFrom,
+		webhooks: webhook.NewPool(0),
+	}
+}
+
+func (s *sender) Send(ctx context.Context, r alarms.Receiver, n alarms.Notification) error {
+	switch r.Type {
+	case alarms.EmailReceiver:
+		if s.emailer == nil {
+			return errEmailDisabled
+		}
+		return s.emailer.SendEmailNotification(r.To, "", subject(n), "", "", content(n), footer, map[string][]byte{})
+	case alarms.SMSReceiver:
+		if s.sms == nil {
+			return errSMSDisabled
+		}
+		msg := &messaging.Message{
+			Domain:  n.Alarm.DomainID,
+			Channel: n.Alarm.ChannelID,
+			Payload: []byte(subject(n)),
+		}
+		return s.sms.Notify(s.smsFrom, r.To, msg)
+	case alarms.SlackReceiver:
+		_, _, err := slack.New(r.Token).PostMessageContext(ctx, r.ChannelID, slack.MsgOptionText(content(n), false))
+		return err
+	case alarms.WebhookReceiver:
+		// The webhook receives the JSON encoded notification.
+		body, err := json.Marshal(n)
+		if err != nil {
+			return err
+		}
+		return s.webhooks.Send(ctx, webhook.Config{URL: r.URL, Secret: r.Secret, Retries: webhookRetries}, body)
+	default:
+		return errReceiverType
+	}
+}
+
+func subject(n alarms.Notification) string {
+	var action string
+	switch n.Trigger {
+	case alarms.EscalateTrigger:
+		action = fmt.Sprintf("escalated to level %d", n.Alarm.EscalationLevel)
+	case alarms.ClearTrigger:
+		action = "cleared"
+	default:
+		action = "raised"
+	}
+
+	return fmt.Sprintf("Alarm %s: %s (severity %d)", action, n.Alarm.Cause, n.Alarm.Severity)
+}
+
+func content(n alarms.Notification) string {
+	var b strings.Builder
+	fmt.Fprintln(&b, subject(n))
+	fmt.Fprintf(&b, "Measurement: %s = %s %s", n.Alarm.Measurement, n.Alarm.Value, n.Alarm.Unit)
+	if n.Alarm.Threshold != "" {
+		fmt.Fprintf(&b, " (threshold %s)", n.Alarm.Threshold)
+	}
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "Rule: %s\n", n.Alarm.RuleID)
+	fmt.Fprintf(&b, "Channel: %s\n", n.Alarm.ChannelID)
+	if n.Alarm.Subtopic != "" {
+		fmt.Fprintf(&b, "Subtopic: %s\n", n.Alarm.Subtopic)
+	}
+	fmt.Fprintf(&b, "Client: %s\n", n.Alarm.ClientID)
+	fmt.Fprintf(&b, "Alarm: %s\n", n.Alarm.ID)
+	fmt.Fprintf(&b, "Time: %s", n.Alarm.CreatedAt.UTC().Format("2006-01-02 15:04:05 MST"))
+
+	return b.String()
+}
@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package senders_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/absmach/magistrala/alarms"
+	"github.com/absmach/magistrala/alarms/senders"
+	"github.com/absmach/magistrala/pkg/emailer/mocks"
+	"github.com/absmach/magistrala/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var notification = alarms.Notification{
+	Trigger:  alarms.CreateTrigger,
+	PolicyID: "policy-id",
+	Alarm: alarms.Alarm{
+		ID:          "alarm-id",
+		DomainID:    "domain-id",
+		ChannelID:   "channel-id",
+		Measurement: "temperature",
+		Value:       "90",
+		Unit:        "C",
+		Cause:       "too hot",
+		Severity:    80,
+	},
+}
+
+func TestSendEmail(t *testing.T) {
+	e := new(mocks.Emailer)
+	to := []string{"ops@example.com"}
+	e.On("SendEmailNotification", to, "", "Alarm raised: too hot (severity 80)", "", "", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	cases := []struct {
+		desc   string
+		sender alarms.Sender
+		err    bool
+	}{
+		{
+			desc:   "send email",
+			sender: senders.New(e, nil, ""),
+			err:    false,
+		},
+		{
+			desc:   "send email without emailer",
+			sender: senders.New(nil, nil, ""),
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.sender.Send(context.Background(), alarms.Receiver{Type: alarms.EmailReceiver, To: to}, notification)
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("%s: unexpected error %v", tc.desc, err))
+		})
+	}
+	e.AssertNumberOfCalls(t, "SendEmailNotification", 1)
+}
+
+func TestSendWebhook(t *testing.T) {
+	var received alarms.Notification
+	var signature string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(webhook.SignatureHeader)
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	sender := senders.New(nil, nil, "")
+	err := sender.Send(context.Background(), alarms.Receiver{Type: alarms.WebhookReceiver, URL: ts.URL, Secret: "secret"}, notification)
+	assert.Nil(t, err, fmt.Sprintf("send webhook: unexpected error %s", err))
+	assert.Equal(t, notification.PolicyID, received.PolicyID)
+	assert.Equal(t, notification.Alarm.ID, received.Alarm.ID)
+	assert.NotEmpty(t, signature)
+}
+
+func TestSendSMSDisabled(t *testing.T) {
+	sender := senders.New(nil, nil, "")
+	err := sender.Send(context.Background(), alarms.Receiver{Type: alarms.SMSReceiver, To: []string{"+381600000000"}}, notification)
+	assert.NotNil(t, err, "send sms without notifier: expected error")
+}
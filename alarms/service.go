@@ -5,25 +5,57 @@ package alarms
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/absmach/magistrala"
 	"github.com/absmach/magistrala/pkg/authn"
 	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+	"github.com/absmach/magistrala/pkg/ticker"
 )
 
+// notificationsBuffer is the number of the notifications waiting for the
+// delivery. The notifications are dropped when the buffer is full.
+const notificationsBuffer = 1024
+
 type service struct {
-	idp  magistrala.IDProvider
-	repo Repository
+	idp           magistrala.IDProvider
+	repo          Repository
+	notifier      Notifier
+	sender        Sender
+	notifications chan Notification
+	limiter       *limiter
+	hub           *StreamHub
+	flapping      Flapping
+	runInfo       chan pkglog.RunInfo
+	ticker        ticker.Ticker
+	secrets       secrets
 }
 
 var _ Service = (*service)(nil)
 
-func NewService(idp magistrala.IDProvider, repo Repository) Service {
-	return &service{
-		idp:  idp,
-		repo: repo,
+func NewService(idp magistrala.IDProvider, repo Repository, notifier Notifier, sender Sender, hub *StreamHub, flapping Flapping, runInfo chan pkglog.RunInfo, tck ticker.Ticker, encKey []byte) (Service, error) {
+	sec, err := newSecrets(encKey)
+	if err != nil {
+		return nil, err
 	}
+
+	return &service{
+		idp:           idp,
+		repo:          repo,
+		notifier:      notifier,
+		sender:        sender,
+		notifications: make(chan Notification, notificationsBuffer),
+		limiter:       newLimiter(),
+		hub:           hub,
+		flapping:      flapping,
+		runInfo:       runInfo,
+		ticker:        tck,
+		secrets:       sec,
+	}, nil
 }
 
 func (s *service) CreateAlarm(ctx context.Context, alarm Alarm) error {
@@ -35,14 +67,55 @@ func (s *service) CreateAlarm(ctx context.Context, alarm Alarm) error {
 	if alarm.CreatedAt.IsZero() {
 		alarm.CreatedAt = time.Now()
 	}
+	if alarm.DedupKey == "" {
+		alarm.DedupKey = DedupKey(alarm, nil)
+	}
 
 	if err := alarm.Validate(); err != nil {
 		return err
 	}
+	if err := s.flag(ctx, &alarm); err != nil {
+		return err
+	}
+
+	created, err := s.repo.CreateAlarm(ctx, alarm)
+	switch {
+	// The alarm repeats the existing one.
+	case err == repoerr.ErrNotFound:
+		return nil
+	case err != nil:
+		return err
+	}
+
+	trigger := CreateTrigger
+	if created.Status == ClearedStatus {
+		trigger = ClearTrigger
+	}
+	s.enqueue(created, trigger)
+	s.broadcast(ctx, created)
+
+	return nil
+}
+
+// flag marks the alarm suppressed if a suppression window covers it, and
+// flapping if its dedup key changes the state too often.
+func (s *service) flag(ctx context.Context, alarm *Alarm) error {
+	switch w, err := s.repo.MatchSuppressionWindow(ctx, *alarm); {
+	case err == nil:
+		alarm.Suppressed = true
+		alarm.SuppressionID = w.ID
+	case err != repoerr.ErrNotFound:
+		return err
+	}
 
-	if _, err = s.repo.CreateAlarm(ctx, alarm); err != nil && err != repoerr.ErrNotFound {
+	if s.flapping.Threshold == 0 {
+		return nil
+	}
+	changes, err := s.repo.CountAlarmChanges(ctx, alarm.DomainID, alarm.DedupKey, alarm.CreatedAt.Add(-s.flapping.Window))
+	if err != nil {
 		return err
 	}
+	alarm.Flapping = changes+1 >= s.flapping.Threshold
 
 	return nil
 }
@@ -58,13 +131,593 @@ func (s *service) ListAlarms(ctx context.Context, session authn.Session, pm Page
 	return s.repo.ListUserAlarms(ctx, session.UserID, pm)
 }
 
+func (s *service) AlarmStats(ctx context.Context, session authn.Session, q StatsQuery) (Stats, error) {
+	if err := q.Validate(); err != nil {
+		return Stats{}, err
+	}
+	if session.SuperAdmin {
+		return s.repo.AlarmStats(ctx, q)
+	}
+	return s.repo.UserAlarmStats(ctx, session.UserID, q)
+}
+
 func (s *service) DeleteAlarm(ctx context.Context, session authn.Session, alarmID string) error {
-	return s.repo.DeleteAlarm(ctx, alarmID)
+	return s.repo.DeleteAlarm(ctx, alarmID, session.DomainID)
 }
 
 func (s *service) UpdateAlarm(ctx context.Context, session authn.Session, alarm Alarm) (Alarm, error) {
-	alarm.UpdatedAt = time.Now()
+	if alarm.HasLifecycleFields() {
+		return Alarm{}, ErrLifecycleUpdate
+	}
+
+	now := time.Now().UTC()
+	update := Alarm{
+		ID:        alarm.ID,
+		DomainID:  session.DomainID,
+		Metadata:  alarm.Metadata,
+		UpdatedAt: now,
+		UpdatedBy: session.UserID,
+	}
+
+	var fields []string
+	if alarm.AssigneeID != "" {
+		// The resolved alarms can't be assigned, like in AssignAlarm.
+		stored, err := s.repo.ViewAlarm(ctx, alarm.ID, session.DomainID)
+		if err != nil {
+			return Alarm{}, err
+		}
+		if stored.Status == ResolvedStatus {
+			return Alarm{}, ErrInvalidTransition
+		}
+		update.AssigneeID = alarm.AssigneeID
+		update.AssignedAt = now
+		update.AssignedBy = session.UserID
+		fields = append(fields, "assignee_id")
+	}
+	if alarm.Metadata != nil {
+		fields = append(fields, "metadata")
+	}
+
+	updated, err := s.repo.UpdateAlarm(ctx, update)
+	if err != nil {
+		return Alarm{}, err
+	}
+
+	return updated, s.record(ctx, updated, UpdatedActivity, session.UserID, map[string]any{"fields": fields})
+}
+
+func (s *service) AcknowledgeAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error) {
+	alarm, err := s.transition(ctx, session, id, AcknowledgedStatus, func(a *Alarm, now time.Time) {
+		a.AcknowledgedAt = now
+		a.AcknowledgedBy = session.UserID
+		a.SnoozedUntil = time.Time{}
+		a.SnoozedBy = ""
+	})
+	if err != nil {
+		return Alarm{}, err
+	}
+
+	return alarm, s.record(ctx, alarm, AcknowledgedActivity, session.UserID, nil)
+}
+
+func (s *service) AssignAlarm(ctx context.Context, session authn.Session, id, assigneeID string) (Alarm, error) {
+	alarm, err := s.repo.ViewAlarm(ctx, id, session.DomainID)
+	if err != nil {
+		return Alarm{}, err
+	}
+	if alarm.Status == ResolvedStatus {
+		return Alarm{}, ErrInvalidTransition
+	}
+
+	now := time.Now().UTC()
+	alarm, err = s.repo.UpdateAlarm(ctx, Alarm{
+		ID:         alarm.ID,
+		DomainID:   alarm.DomainID,
+		AssigneeID: assigneeID,
+		AssignedAt: now,
+		AssignedBy: session.UserID,
+		UpdatedAt:  now,
+		UpdatedBy:  session.UserID,
+	})
+	if err != nil {
+		return Alarm{}, err
+	}
+
+	return alarm, s.record(ctx, alarm, AssignedActivity, session.UserID, map[string]any{"assignee_id": assigneeID})
+}
+
+func (s *service) ResolveAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error) {
+	alarm, err := s.transition(ctx, session, id, ResolvedStatus, func(a *Alarm, now time.Time) {
+		a.ResolvedAt = now
+		a.ResolvedBy = session.UserID
+		a.SnoozedUntil = time.Time{}
+		a.SnoozedBy = ""
+	})
+	if err != nil {
+		return Alarm{}, err
+	}
+
+	return alarm, s.record(ctx, alarm, ResolvedActivity, session.UserID, nil)
+}
+
+func (s *service) ReopenAlarm(ctx context.Context, session authn.Session, id string) (Alarm, error) {
+	alarm, err := s.transition(ctx, session, id, ActiveStatus, func(a *Alarm, _ time.Time) {
+		a.AcknowledgedAt = time.Time{}
+		a.AcknowledgedBy = ""
+		a.ResolvedAt = time.Time{}
+		a.ResolvedBy = ""
+		a.EscalationLevel = 0
+		a.EscalatedAt = time.Time{}
+	})
+	if err != nil {
+		return Alarm{}, err
+	}
+
+	return alarm, s.record(ctx, alarm, ReopenedActivity, session.UserID, nil)
+}
+
+func (s *service) SnoozeAlarm(ctx context.Context, session authn.Session, id string, until time.Time) (Alarm, error) {
+	if !until.After(time.Now()) {
+		return Alarm{}, ErrSnoozeTime
+	}
+
+	alarm, err := s.transition(ctx, session, id, SnoozedStatus, func(a *Alarm, _ time.Time) {
+		a.SnoozedUntil = until.UTC()
+		a.SnoozedBy = session.UserID
+	})
+	if err != nil {
+		return Alarm{}, err
+	}
+
+	return alarm, s.record(ctx, alarm, SnoozedActivity, session.UserID, map[string]any{"snoozed_until": alarm.SnoozedUntil})
+}
+
+// transition moves the alarm to the status and saves the fields the update
+// function changes. The update fails if the alarm status changed meanwhile.
+func (s *service) transition(ctx context.Context, session authn.Session, id string, to Status, update func(a *Alarm, now time.Time)) (Alarm, error) {
+	alarm, err := s.repo.ViewAlarm(ctx, id, session.DomainID)
+	if err != nil {
+		return Alarm{}, err
+	}
+	if !alarm.Status.CanTransition(to) {
+		return Alarm{}, ErrInvalidTransition
+	}
+
+	from := alarm.Status
+	now := time.Now().UTC()
+	alarm.Status = to
+	alarm.UpdatedAt = now
 	alarm.UpdatedBy = session.UserID
+	update(&alarm, now)
+
+	return s.repo.UpdateAlarmStatus(ctx, alarm, from)
+}
+
+func (s *service) BulkAlarms(ctx context.Context, session authn.Session, req BulkRequest) (BulkReport, error) {
+	if err := req.Validate(); err != nil {
+		return BulkReport{}, err
+	}
+	page, err := s.ListAlarms(ctx, session, req.Selection(session.DomainID))
+	if err != nil {
+		return BulkReport{}, err
+	}
+
+	results := make(map[string]error, len(page.Alarms))
+	var recordErr error
+	switch req.Action {
+	case DeleteBulkAction:
+		ids := make([]string, len(page.Alarms))
+		for i, a := range page.Alarms {
+			ids[i] = a.ID
+			results[a.ID] = repoerr.ErrNotFound
+		}
+		removed, err := s.repo.DeleteAlarms(ctx, session.DomainID, ids)
+		if err != nil {
+			return BulkReport{}, err
+		}
+		for _, id := range removed {
+			results[id] = nil
+		}
+	default:
+		now := time.Now().UTC()
+		var changes []AlarmChange
+		for _, a := range page.Alarms {
+			change, err := bulkChange(a, req, session.UserID, now)
+			if err != nil {
+				results[a.ID] = err
+				continue
+			}
+			// The alarms whose status changed meanwhile aren't saved.
+			results[a.ID] = repoerr.ErrNotFound
+			changes = append(changes, change)
+		}
+		updated, err := s.repo.UpdateAlarms(ctx, changes)
+		if err != nil {
+			return BulkReport{}, err
+		}
+		for _, a := range updated {
+			results[a.ID] = nil
+			typ, details := bulkActivity(req)
+			if err := s.record(ctx, a, typ, session.UserID, details); err != nil && recordErr == nil {
+				recordErr = err
+			}
+		}
+	}
+
+	report := BulkReport{Action: req.Action}
+	if len(req.IDs) == 0 {
+		for _, a := range page.Alarms {
+			report.Add(a.ID, results[a.ID])
+		}
+		return report, recordErr
+	}
+	for _, id := range req.IDs {
+		err, ok := results[id]
+		// The alarms that don't exist or that the session can't list.
+		if !ok {
+			err = repoerr.ErrNotFound
+		}
+		report.Add(id, err)
+	}
+
+	return report, recordErr
+}
+
+// bulkChange applies the bulk action to the alarm as the corresponding
+// lifecycle operation does.
+func bulkChange(alarm Alarm, req BulkRequest, userID string, now time.Time) (AlarmChange, error) {
+	from := alarm.Status
+	switch req.Action {
+	case AcknowledgeBulkAction:
+		if !from.CanTransition(AcknowledgedStatus) {
+			return AlarmChange{}, ErrInvalidTransition
+		}
+		alarm.Status = AcknowledgedStatus
+		alarm.AcknowledgedAt = now
+		alarm.AcknowledgedBy = userID
+		alarm.SnoozedUntil = time.Time{}
+		alarm.SnoozedBy = ""
+	case ResolveBulkAction:
+		if !from.CanTransition(ResolvedStatus) {
+			return AlarmChange{}, ErrInvalidTransition
+		}
+		alarm.Status = ResolvedStatus
+		alarm.ResolvedAt = now
+		alarm.ResolvedBy = userID
+		alarm.SnoozedUntil = time.Time{}
+		alarm.SnoozedBy = ""
+	case AssignBulkAction:
+		if from == ResolvedStatus {
+			return AlarmChange{}, ErrInvalidTransition
+		}
+		alarm.AssigneeID = req.AssigneeID
+		alarm.AssignedAt = now
+		alarm.AssignedBy = userID
+	}
+	alarm.UpdatedAt = now
+	alarm.UpdatedBy = userID
+
+	return AlarmChange{Alarm: alarm, From: from}, nil
+}
+
+func bulkActivity(req BulkRequest) (ActivityType, map[string]any) {
+	switch req.Action {
+	case AcknowledgeBulkAction:
+		return AcknowledgedActivity, nil
+	case ResolveBulkAction:
+		return ResolvedActivity, nil
+	default:
+		return AssignedActivity, map[string]any{"assignee_id": req.AssigneeID}
+	}
+}
+
+func (s *service) AddComment(ctx context.Context, session authn.Session, comment Comment) (Comment, error) {
+	if err := comment.Validate(); err != nil {
+		return Comment{}, err
+	}
+	alarm, err := s.repo.ViewAlarm(ctx, comment.AlarmID, session.DomainID)
+	if err != nil {
+		return Comment{}, err
+	}
+	if comment.ParentID != "" {
+		parent, err := s.repo.ViewComment(ctx, session.DomainID, comment.ParentID)
+		switch {
+		case err == repoerr.ErrNotFound, err == nil && parent.AlarmID != alarm.ID:
+			return Comment{}, errCommentParent
+		case err != nil:
+			return Comment{}, err
+		case parent.ParentID != "":
+			return Comment{}, errCommentReplies
+		}
+	}
+
+	id, err := s.idp.ID()
+	if err != nil {
+		return Comment{}, err
+	}
+	comment.ID = id
+	comment.DomainID = session.DomainID
+	comment.CreatedAt = time.Now().UTC()
+	comment.CreatedBy = session.UserID
+
+	saved, err := s.repo.AddComment(ctx, comment)
+	if err != nil {
+		return Comment{}, err
+	}
+	details := map[string]any{"comment_id": saved.ID}
+	if saved.ParentID != "" {
+		details["parent_id"] = saved.ParentID
+	}
+
+	return saved, s.record(ctx, alarm, CommentedActivity, session.UserID, details)
+}
+
+func (s *service) ListComments(ctx context.Context, session authn.Session, pm CommentPageMeta) (CommentsPage, error) {
+	return s.repo.ListComments(ctx, session.DomainID, pm)
+}
+
+func (s *service) RemoveComment(ctx context.Context, session authn.Session, alarmID, id string) error {
+	comment, err := s.repo.ViewComment(ctx, session.DomainID, id)
+	if err != nil {
+		return err
+	}
+	if comment.AlarmID != alarmID {
+		return repoerr.ErrNotFound
+	}
+	if comment.CreatedBy != session.UserID && !session.SuperAdmin {
+		return svcerr.ErrAuthorization
+	}
+
+	return s.repo.RemoveComment(ctx, session.DomainID, id)
+}
+
+func (s *service) ListActivities(ctx context.Context, session authn.Session, pm ActivityPageMeta) (ActivityPage, error) {
+	return s.repo.ListActivities(ctx, session.DomainID, pm)
+}
+
+// record appends the activity to the alarm timeline and publishes it.
+func (s *service) record(ctx context.Context, alarm Alarm, typ ActivityType, actorID string, details map[string]any) error {
+	id, err := s.idp.ID()
+	if err != nil {
+		return err
+	}
+	activity := Activity{
+		ID:        id,
+		AlarmID:   alarm.ID,
+		DomainID:  alarm.DomainID,
+		Type:      typ,
+		ActorID:   actorID,
+		Details:   details,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.repo.AddActivity(ctx, activity); err != nil {
+		return err
+	}
+
+	return s.notifier.NotifyActivity(ctx, activity)
+}
+
+func (s *service) CreateEscalationPolicy(ctx context.Context, session authn.Session, policy EscalationPolicy) (EscalationPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return EscalationPolicy{}, err
+	}
+	id, err := s.idp.ID()
+	if err != nil {
+		return EscalationPolicy{}, err
+	}
+	policy.ID = id
+	policy.DomainID = session.DomainID
+	policy.CreatedAt = time.Now().UTC()
+	policy.CreatedBy = session.UserID
+	policy.UpdatedAt = time.Time{}
+	policy.UpdatedBy = ""
+
+	return s.repo.AddEscalationPolicy(ctx, policy)
+}
+
+func (s *service) ViewEscalationPolicy(ctx context.Context, session authn.Session, id string) (EscalationPolicy, error) {
+	return s.repo.ViewEscalationPolicy(ctx, session.DomainID, id)
+}
+
+func (s *service) UpdateEscalationPolicy(ctx context.Context, session authn.Session, policy EscalationPolicy) (EscalationPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return EscalationPolicy{}, err
+	}
+	policy.DomainID = session.DomainID
+	policy.UpdatedAt = time.Now().UTC()
+	policy.UpdatedBy = session.UserID
+
+	return s.repo.UpdateEscalationPolicy(ctx, policy)
+}
+
+func (s *service) ListEscalationPolicies(ctx context.Context, session authn.Session, pm EscalationPolicyPageMeta) (EscalationPolicyPage, error) {
+	pm.DomainID = session.DomainID
+
+	return s.repo.ListEscalationPolicies(ctx, pm)
+}
+
+func (s *service) RemoveEscalationPolicy(ctx context.Context, session authn.Session, id string) error {
+	return s.repo.RemoveEscalationPolicy(ctx, session.DomainID, id)
+}
+
+func (s *service) CreateNotificationPolicy(ctx context.Context, session authn.Session, policy NotificationPolicy) (NotificationPolicy, error) {
+	if err := policy.Validate(); err != nil {
+		return NotificationPolicy{}, err
+	}
+	id, err := s.idp.ID()
+	if err != nil {
+		return NotificationPolicy{}, err
+	}
+	policy.ID = id
+	policy.DomainID = session.DomainID
+	policy.CreatedAt = time.Now().UTC()
+	policy.CreatedBy = session.UserID
+	policy.UpdatedAt = time.Time{}
+	policy.UpdatedBy = ""
+	if err := s.secrets.seal(&policy); err != nil {
+		return NotificationPolicy{}, err
+	}
+
+	policy, err = s.repo.AddNotificationPolicy(ctx, policy)
+	if err != nil {
+		return NotificationPolicy{}, err
+	}
+
+	return redact(policy), nil
+}
+
+func (s *service) ViewNotificationPolicy(ctx context.Context, session authn.Session, id string) (NotificationPolicy, error) {
+	policy, err := s.repo.ViewNotificationPolicy(ctx, session.DomainID, id)
+	if err != nil {
+		return NotificationPolicy{}, err
+	}
+
+	return redact(policy), nil
+}
+
+func (s *service) UpdateNotificationPolicy(ctx context.Context, session authn.Session, policy NotificationPolicy) (NotificationPolicy, error) {
+	stored, err := s.repo.ViewNotificationPolicy(ctx, session.DomainID, policy.ID)
+	if err != nil {
+		return NotificationPolicy{}, err
+	}
+	keepSecrets(&policy, stored)
+	if err := policy.Validate(); err != nil {
+		return NotificationPolicy{}, err
+	}
+	policy.DomainID = session.DomainID
+	policy.UpdatedAt = time.Now().UTC()
+	policy.UpdatedBy = session.UserID
+	if err := s.secrets.seal(&policy); err != nil {
+		return NotificationPolicy{}, err
+	}
+
+	policy, err = s.repo.UpdateNotificationPolicy(ctx, policy)
+	if err != nil {
+		return NotificationPolicy{}, err
+	}
+
+	return redact(policy), nil
+}
+
+func (s *service) ListNotificationPolicies(ctx context.Context, session authn.Session, pm NotificationPolicyPageMeta) (NotificationPolicyPage, error) {
+	pm.DomainID = session.DomainID
+
+	page, err := s.repo.ListNotificationPolicies(ctx, pm)
+	if err != nil {
+		return NotificationPolicyPage{}, err
+	}
+
+	return redactPage(page), nil
+}
+
+func (s *service) RemoveNotificationPolicy(ctx context.Context, session authn.Session, id string) error {
+	return s.repo.RemoveNotificationPolicy(ctx, session.DomainID, id)
+}
 
-	return s.repo.UpdateAlarm(ctx, alarm)
+func (s *service) CreateSuppressionWindow(ctx context.Context, session authn.Session, window SuppressionWindow) (SuppressionWindow, error) {
+	if err := window.Validate(); err != nil {
+		return SuppressionWindow{}, err
+	}
+	id, err := s.idp.ID()
+	if err != nil {
+		return SuppressionWindow{}, err
+	}
+	window.ID = id
+	window.DomainID = session.DomainID
+	window.CreatedAt = time.Now().UTC()
+	window.CreatedBy = session.UserID
+	window.UpdatedAt = time.Time{}
+	window.UpdatedBy = ""
+
+	return s.repo.AddSuppressionWindow(ctx, window)
+}
+
+func (s *service) ViewSuppressionWindow(ctx context.Context, session authn.Session, id string) (SuppressionWindow, error) {
+	return s.repo.ViewSuppressionWindow(ctx, session.DomainID, id)
+}
+
+func (s *service) UpdateSuppressionWindow(ctx context.Context, session authn.Session, window SuppressionWindow) (SuppressionWindow, error) {
+	if err := window.Validate(); err != nil {
+		return SuppressionWindow{}, err
+	}
+	window.DomainID = session.DomainID
+	window.UpdatedAt = time.Now().UTC()
+	window.UpdatedBy = session.UserID
+
+	return s.repo.UpdateSuppressionWindow(ctx, window)
+}
+
+func (s *service) ListSuppressionWindows(ctx context.Context, session authn.Session, pm SuppressionWindowPageMeta) (SuppressionWindowPage, error) {
+	pm.DomainID = session.DomainID
+
+	return s.repo.ListSuppressionWindows(ctx, pm)
+}
+
+func (s *service) RemoveSuppressionWindow(ctx context.Context, session authn.Session, id string) error {
+	return s.repo.RemoveSuppressionWindow(ctx, session.DomainID, id)
+}
+
+func (s *service) StreamAlarms(ctx context.Context, session authn.Session, filter StreamFilter) (<-chan Alarm, error) {
+	st := s.hub.subscribe(session.DomainID, filter)
+	if session.SuperAdmin {
+		go func() {
+			<-ctx.Done()
+			s.hub.unsubscribe(session.DomainID, st)
+		}()
+
+		return st.alarms, nil
+	}
+
+	// The user alarms are checked off the hub, so the slow checks don't
+	// hold the alarm creation.
+	userAlarms := make(chan Alarm, streamBuffer)
+	go func() {
+		defer close(userAlarms)
+		defer s.hub.unsubscribe(session.DomainID, st)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case alarm := <-st.alarms:
+				ok, err := s.repo.IsUserAlarm(ctx, session.UserID, alarm)
+				if err != nil {
+					s.runInfo <- pkglog.RunInfo{
+						Level:   slog.LevelError,
+						Message: fmt.Sprintf("failed to check streamed alarm: %s", err),
+						Details: []slog.Attr{
+							slog.String("domain_id", alarm.DomainID),
+							slog.String("alarm_id", alarm.ID),
+							slog.String("user_id", session.UserID),
+						},
+					}
+					continue
+				}
+				if !ok {
+					continue
+				}
+				select {
+				case userAlarms <- alarm:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return userAlarms, nil
+}
+
+// broadcast publishes the created alarm, so every service instance sends
+// it to the domain streams of its hub.
+func (s *service) broadcast(ctx context.Context, alarm Alarm) {
+	if err := s.notifier.NotifyCreate(ctx, alarm); err != nil {
+		s.runInfo <- pkglog.RunInfo{
+			Level:   slog.LevelWarn,
+			Message: fmt.Sprintf("failed to publish created alarm to the streams: %s", err),
+			Details: []slog.Attr{
+				slog.String("domain_id", alarm.DomainID),
+				slog.String("alarm_id", alarm.ID),
+			},
+		}
+	}
 }
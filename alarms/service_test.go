@@ -6,6 +6,8 @@ package alarms_test
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,15 +16,40 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
 	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+	tmocks "github.com/absmach/magistrala/pkg/ticker/mocks"
 	"github.com/absmach/magistrala/pkg/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
-var idp = uuid.New()
+var (
+	idp    = uuid.New()
+	encKey = []byte("12345678910111213141516171819202")
+)
 
 func newService(t *testing.T, repo *mocks.Repository) alarms.Service {
-	return alarms.NewService(idp, repo)
+	repo.On("AddActivity", mock.Anything, mock.Anything).Return(nil).Maybe()
+	hub := alarms.NewStreamHub()
+
+	svc, err := alarms.NewService(idp, repo, newNotifier(hub), new(mocks.Sender), hub, alarms.Flapping{}, make(chan pkglog.RunInfo, 10), new(tmocks.Ticker), encKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating service: %s", err))
+
+	return svc
+}
+
+// newNotifier returns the notifier that sends the created alarms straight
+// to the hub, like the event store subscription of the instance does.
+func newNotifier(hub *alarms.StreamHub) *mocks.Notifier {
+	notifier := new(mocks.Notifier)
+	notifier.On("NotifyActivity", mock.Anything, mock.Anything).Return(nil).Maybe()
+	notifier.On("NotifyCreate", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		hub.Publish(args.Get(1).(alarms.Alarm))
+	}).Return(nil).Maybe()
+
+	return notifier
 }
 
 func TestCreateAlarm(t *testing.T) {
@@ -71,10 +98,12 @@ func TestCreateAlarm(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			repoCall := repo.On("CreateAlarm", context.Background(), mock.Anything).Return(tc.alarm, tc.err)
+			repoCall := repo.On("MatchSuppressionWindow", context.Background(), mock.Anything).Return(alarms.SuppressionWindow{}, repoerr.ErrNotFound)
+			repoCall1 := repo.On("CreateAlarm", context.Background(), mock.Anything).Return(tc.alarm, tc.err)
 			err := svc.CreateAlarm(context.Background(), tc.alarm)
 			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 			repoCall.Unset()
+			repoCall1.Unset()
 		})
 	}
 }
@@ -123,39 +152,109 @@ func TestUpdateAlarm(t *testing.T) {
 	svc := newService(t, repo)
 
 	cases := []struct {
-		desc  string
-		alarm alarms.Alarm
-		err   error
+		desc   string
+		alarm  alarms.Alarm
+		stored alarms.Alarm
+		update alarms.Alarm
+		err    error
 	}{
 		{
 			desc: "valid alarm",
 			alarm: alarms.Alarm{
+				ID:         "alarm-id",
+				DomainID:   "domain-id",
+				AssigneeID: "assignee-id",
+				Metadata:   alarms.Metadata{"key": "value"},
+			},
+			update: alarms.Alarm{
+				ID:         "alarm-id",
+				AssigneeID: "assignee-id",
+				Metadata:   alarms.Metadata{"key": "value"},
+			},
+			err: nil,
+		},
+		{
+			desc: "assign resolved alarm",
+			alarm: alarms.Alarm{
+				ID:         "alarm-id",
+				DomainID:   "domain-id",
+				AssigneeID: "assignee-id",
+			},
+			stored: alarms.Alarm{
+				ID:       "alarm-id",
+				DomainID: "domain-id",
+				Status:   alarms.ResolvedStatus,
+			},
+			err: alarms.ErrInvalidTransition,
+		},
+		{
+			desc: "alarm with fields that can't be updated",
+			alarm: alarms.Alarm{
+				ID:          "alarm-id",
 				RuleID:      "rule-id",
 				DomainID:    "domain-id",
-				ChannelID:   "channel-id",
-				ClientID:    "client-id",
-				Subtopic:    "subtopic",
 				Measurement: "measurement",
-				Value:       "value",
-				Unit:        "unit",
-				Cause:       "cause",
 				Severity:    100,
+				Metadata:    alarms.Metadata{"key": "value"},
+			},
+			update: alarms.Alarm{
+				ID:       "alarm-id",
+				Metadata: alarms.Metadata{"key": "value"},
 			},
 			err: nil,
 		},
+		{
+			desc: "alarm with status",
+			alarm: alarms.Alarm{
+				ID:       "alarm-id",
+				DomainID: "domain-id",
+				Status:   alarms.ResolvedStatus,
+			},
+			err: alarms.ErrLifecycleUpdate,
+		},
+		{
+			desc: "alarm with acknowledgement",
+			alarm: alarms.Alarm{
+				ID:             "alarm-id",
+				DomainID:       "domain-id",
+				AcknowledgedBy: "user-id",
+			},
+			err: alarms.ErrLifecycleUpdate,
+		},
+		{
+			desc: "alarm with resolution",
+			alarm: alarms.Alarm{
+				ID:         "alarm-id",
+				DomainID:   "domain-id",
+				ResolvedBy: "user-id",
+				ResolvedAt: time.Now(),
+			},
+			err: alarms.ErrLifecycleUpdate,
+		},
+		{
+			desc: "alarm with snooze",
+			alarm: alarms.Alarm{
+				ID:           "alarm-id",
+				DomainID:     "domain-id",
+				SnoozedUntil: time.Now().Add(time.Hour),
+			},
+			err: alarms.ErrLifecycleUpdate,
+		},
+		{
+			desc: "alarm with escalation",
+			alarm: alarms.Alarm{
+				ID:              "alarm-id",
+				DomainID:        "domain-id",
+				EscalationLevel: 2,
+			},
+			err: alarms.ErrLifecycleUpdate,
+		},
 		{
 			desc: "non existing alarm",
 			alarm: alarms.Alarm{
-				RuleID:      "rule-id",
-				DomainID:    "domain-id",
-				ChannelID:   "channel-id",
-				ClientID:    "client-id",
-				Subtopic:    "subtopic",
-				Measurement: "measurement",
-				Value:       "value",
-				Unit:        "unit",
-				Cause:       "cause",
-				Severity:    100,
+				ID:       "alarm-id",
+				DomainID: "domain-id",
+				Metadata: alarms.Metadata{"key": "value"},
 			},
 			err: repoerr.ErrNotFound,
 		},
@@ -163,15 +262,33 @@ func TestUpdateAlarm(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			s := authn.Session{DomainID: tc.alarm.DomainID}
-			repoCall := repo.On("UpdateAlarm", context.Background(), mock.Anything).Return(tc.alarm, tc.err)
+			s := authn.Session{DomainID: tc.alarm.DomainID, UserID: "user-id"}
+			var update alarms.Alarm
+			repoCall := repo.On("ViewAlarm", context.Background(), tc.alarm.ID, s.DomainID).Return(tc.stored, nil)
+			defer repoCall.Unset()
+			repoCall1 := repo.On("UpdateAlarm", context.Background(), mock.Anything).Run(func(args mock.Arguments) {
+				update = args.Get(1).(alarms.Alarm)
+			}).Return(tc.alarm, tc.err)
+			defer repoCall1.Unset()
 			_, err := svc.UpdateAlarm(context.Background(), s, tc.alarm)
 			if tc.err != nil {
 				assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
 
 				return
 			}
-			repoCall.Unset()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.update.ID, update.ID)
+			assert.Equal(t, s.DomainID, update.DomainID)
+			assert.Equal(t, tc.update.AssigneeID, update.AssigneeID)
+			assert.Equal(t, tc.update.Metadata, update.Metadata)
+			assert.Equal(t, alarms.ActiveStatus, update.Status)
+			assert.False(t, update.HasLifecycleFields(), fmt.Sprintf("%s: unexpected lifecycle fields in %+v", tc.desc, update))
+			assert.Empty(t, update.RuleID)
+			assert.Zero(t, update.Severity)
+			assert.Equal(t, s.UserID, update.UpdatedBy)
+			if tc.update.AssigneeID != "" {
+				assert.Equal(t, s.UserID, update.AssignedBy)
+			}
 		})
 	}
 }
@@ -240,8 +357,8 @@ func TestDeleteAlarm(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.desc, func(t *testing.T) {
-			s := authn.Session{DomainID: tc.id}
-			repoCall := repo.On("DeleteAlarm", context.Background(), tc.id).Return(tc.err)
+			s := authn.Session{DomainID: "domain-id"}
+			repoCall := repo.On("DeleteAlarm", context.Background(), tc.id, s.DomainID).Return(tc.err)
 			err := svc.DeleteAlarm(context.Background(), s, tc.id)
 			if tc.err != nil {
 				assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
@@ -252,3 +369,902 @@ func TestDeleteAlarm(t *testing.T) {
 		})
 	}
 }
+
+func TestAcknowledgeAlarm(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+
+	cases := []struct {
+		desc    string
+		status  alarms.Status
+		viewErr error
+		err     error
+	}{
+		{
+			desc:   "acknowledge active alarm",
+			status: alarms.ActiveStatus,
+			err:    nil,
+		},
+		{
+			desc:   "acknowledge snoozed alarm",
+			status: alarms.SnoozedStatus,
+			err:    nil,
+		},
+		{
+			desc:   "acknowledge resolved alarm",
+			status: alarms.ResolvedStatus,
+			err:    alarms.ErrInvalidTransition,
+		},
+		{
+			desc:   "acknowledge acknowledged alarm",
+			status: alarms.AcknowledgedStatus,
+			err:    alarms.ErrInvalidTransition,
+		},
+		{
+			desc:    "acknowledge non existing alarm",
+			viewErr: repoerr.ErrNotFound,
+			err:     repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+			alarm := alarms.Alarm{ID: "alarm-id", DomainID: s.DomainID, Status: tc.status}
+			viewCall := repo.On("ViewAlarm", context.Background(), alarm.ID, s.DomainID).Return(alarm, tc.viewErr)
+			updateCall := repo.On("UpdateAlarmStatus", context.Background(), mock.Anything, tc.status).Return(alarms.Alarm{Status: alarms.AcknowledgedStatus}, nil)
+			a, err := svc.AcknowledgeAlarm(context.Background(), s, alarm.ID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.Equal(t, alarms.AcknowledgedStatus, a.Status)
+				updateCall.Parent.AssertCalled(t, "UpdateAlarmStatus", context.Background(), mock.MatchedBy(func(a alarms.Alarm) bool {
+					return a.Status == alarms.AcknowledgedStatus && a.AcknowledgedBy == s.UserID
+				}), tc.status)
+			}
+			viewCall.Unset()
+			updateCall.Unset()
+		})
+	}
+}
+
+func TestSnoozeAlarm(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+
+	cases := []struct {
+		desc   string
+		status alarms.Status
+		until  time.Time
+		err    error
+	}{
+		{
+			desc:   "snooze active alarm",
+			status: alarms.ActiveStatus,
+			until:  time.Now().Add(time.Hour),
+			err:    nil,
+		},
+		{
+			desc:   "snooze alarm in the past",
+			status: alarms.ActiveStatus,
+			until:  time.Now().Add(-time.Hour),
+			err:    alarms.ErrSnoozeTime,
+		},
+		{
+			desc:   "snooze cleared alarm",
+			status: alarms.ClearedStatus,
+			until:  time.Now().Add(time.Hour),
+			err:    alarms.ErrInvalidTransition,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+			alarm := alarms.Alarm{ID: "alarm-id", DomainID: s.DomainID, Status: tc.status}
+			viewCall := repo.On("ViewAlarm", context.Background(), alarm.ID, s.DomainID).Return(alarm, nil)
+			updateCall := repo.On("UpdateAlarmStatus", context.Background(), mock.Anything, tc.status).Return(alarms.Alarm{Status: alarms.SnoozedStatus}, nil)
+			_, err := svc.SnoozeAlarm(context.Background(), s, alarm.ID, tc.until)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			viewCall.Unset()
+			updateCall.Unset()
+		})
+	}
+}
+
+func TestBulkAlarms(t *testing.T) {
+	s := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+	active := alarms.Alarm{ID: "active", DomainID: s.DomainID, Status: alarms.ActiveStatus}
+	resolved := alarms.Alarm{ID: "resolved", DomainID: s.DomainID, Status: alarms.ResolvedStatus}
+	changed := alarms.Alarm{ID: "changed", DomainID: s.DomainID, Status: alarms.SnoozedStatus}
+	selected := []alarms.Alarm{active, resolved, changed}
+
+	cases := []struct {
+		desc    string
+		req     alarms.BulkRequest
+		listErr error
+		repoErr error
+		results []alarms.BulkResult
+		err     error
+	}{
+		{
+			desc: "acknowledge alarms by ids",
+			req:  alarms.BulkRequest{Action: alarms.AcknowledgeBulkAction, IDs: []string{"active", "resolved", "changed", "missing"}},
+			results: []alarms.BulkResult{
+				{ID: "active"},
+				{ID: "resolved", Err: alarms.ErrInvalidTransition},
+				{ID: "changed", Err: repoerr.ErrNotFound},
+				{ID: "missing", Err: repoerr.ErrNotFound},
+			},
+		},
+		{
+			desc: "assign alarms by filter",
+			req:  alarms.BulkRequest{Action: alarms.AssignBulkAction, AssigneeID: "assignee-id", Filter: &alarms.PageMetadata{ChannelID: "channel-id"}},
+			results: []alarms.BulkResult{
+				{ID: "active"},
+				{ID: "resolved", Err: alarms.ErrInvalidTransition},
+				{ID: "changed", Err: repoerr.ErrNotFound},
+			},
+		},
+		{
+			desc: "delete alarms by ids",
+			req:  alarms.BulkRequest{Action: alarms.DeleteBulkAction, IDs: []string{"active", "resolved"}},
+			results: []alarms.BulkResult{
+				{ID: "active"},
+				{ID: "resolved"},
+			},
+		},
+		{
+			desc: "bulk operation with both ids and filter",
+			req:  alarms.BulkRequest{Action: alarms.ResolveBulkAction, IDs: []string{"active"}, Filter: &alarms.PageMetadata{}},
+			err:  errors.New("bulk operation requires either the alarm ids or the filter"),
+		},
+		{
+			desc: "bulk assign without assignee",
+			req:  alarms.BulkRequest{Action: alarms.AssignBulkAction, IDs: []string{"active"}},
+			err:  errors.New("bulk assign requires the assignee"),
+		},
+		{
+			desc: "bulk operation with invalid action",
+			req:  alarms.BulkRequest{Action: "reopen", IDs: []string{"active"}},
+			err:  errors.New("invalid bulk action"),
+		},
+		{
+			desc:    "bulk operation with failed transaction",
+			req:     alarms.BulkRequest{Action: alarms.ResolveBulkAction, IDs: []string{"active"}},
+			repoErr: repoerr.ErrUpdateEntity,
+			err:     repoerr.ErrUpdateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(mocks.Repository)
+			svc := newService(t, repo)
+			repo.On("ListUserAlarms", context.Background(), s.UserID, mock.MatchedBy(func(pm alarms.PageMetadata) bool {
+				return pm.DomainID == s.DomainID && pm.Limit == alarms.MaxBulkAlarms
+			})).Return(alarms.AlarmsPage{Alarms: selected}, tc.listErr)
+			// The changed alarm status changes before it's saved.
+			repo.On("UpdateAlarms", context.Background(), mock.Anything).Return([]alarms.Alarm{active}, tc.repoErr)
+			repo.On("DeleteAlarms", context.Background(), s.DomainID, mock.Anything).Return([]string{"active", "resolved", "changed"}, tc.repoErr)
+
+			report, err := svc.BulkAlarms(context.Background(), s, tc.req)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err != nil {
+				return
+			}
+			assert.Equal(t, tc.results, report.Results)
+			var failed uint64
+			for _, r := range tc.results {
+				if r.Err != nil {
+					failed++
+				}
+			}
+			assert.Equal(t, failed, report.Failed)
+			assert.Equal(t, uint64(len(tc.results))-failed, report.Succeeded)
+			if tc.req.Action == alarms.AssignBulkAction {
+				repo.AssertCalled(t, "UpdateAlarms", context.Background(), mock.MatchedBy(func(changes []alarms.AlarmChange) bool {
+					return len(changes) == 2 && changes[0].Alarm.AssigneeID == "assignee-id" && changes[0].Alarm.AssignedBy == s.UserID && changes[1].From == alarms.SnoozedStatus
+				}))
+			}
+		})
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+	session := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+	alarm := alarms.Alarm{ID: "alarm-id", DomainID: session.DomainID}
+
+	repo.On("ViewAlarm", context.Background(), alarm.ID, session.DomainID).Return(alarm, nil)
+	repo.On("ViewAlarm", context.Background(), "unknown-id", session.DomainID).Return(alarms.Alarm{}, repoerr.ErrNotFound)
+	repo.On("ViewComment", context.Background(), session.DomainID, "comment-id").Return(alarms.Comment{ID: "comment-id", AlarmID: alarm.ID}, nil)
+	repo.On("ViewComment", context.Background(), session.DomainID, "reply-id").Return(alarms.Comment{ID: "reply-id", AlarmID: alarm.ID, ParentID: "comment-id"}, nil)
+	repo.On("ViewComment", context.Background(), session.DomainID, "other-id").Return(alarms.Comment{ID: "other-id", AlarmID: "other-alarm-id"}, nil)
+	repo.On("ViewComment", context.Background(), session.DomainID, "unknown-id").Return(alarms.Comment{}, repoerr.ErrNotFound)
+
+	cases := []struct {
+		desc    string
+		comment alarms.Comment
+		err     error
+	}{
+		{
+			desc:    "valid comment",
+			comment: alarms.Comment{AlarmID: alarm.ID, Body: "pump restarted"},
+		},
+		{
+			desc:    "valid reply",
+			comment: alarms.Comment{AlarmID: alarm.ID, ParentID: "comment-id", Body: "still overheating"},
+		},
+		{
+			desc:    "empty comment",
+			comment: alarms.Comment{AlarmID: alarm.ID, Body: " "},
+			err:     errors.New("comment body is required"),
+		},
+		{
+			desc:    "comment of unknown alarm",
+			comment: alarms.Comment{AlarmID: "unknown-id", Body: "pump restarted"},
+			err:     repoerr.ErrNotFound,
+		},
+		{
+			desc:    "reply to comment of other alarm",
+			comment: alarms.Comment{AlarmID: alarm.ID, ParentID: "other-id", Body: "still overheating"},
+			err:     errors.New("comment parent must be a comment of the same alarm"),
+		},
+		{
+			desc:    "reply to unknown comment",
+			comment: alarms.Comment{AlarmID: alarm.ID, ParentID: "unknown-id", Body: "still overheating"},
+			err:     errors.New("comment parent must be a comment of the same alarm"),
+		},
+		{
+			desc:    "reply to reply",
+			comment: alarms.Comment{AlarmID: alarm.ID, ParentID: "reply-id", Body: "still overheating"},
+			err:     errors.New("comment replies can't be replied to"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("AddComment", context.Background(), mock.Anything).Return(func(_ context.Context, c alarms.Comment) (alarms.Comment, error) {
+				return c, nil
+			})
+			comment, err := svc.AddComment(context.Background(), session, tc.comment)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.NotEmpty(t, comment.ID)
+				assert.Equal(t, session.DomainID, comment.DomainID)
+				assert.Equal(t, session.UserID, comment.CreatedBy)
+				repo.AssertCalled(t, "AddActivity", context.Background(), mock.MatchedBy(func(a alarms.Activity) bool {
+					return a.AlarmID == alarm.ID && a.Type == alarms.CommentedActivity && a.ActorID == session.UserID && a.Details["comment_id"] == comment.ID
+				}))
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestRemoveComment(t *testing.T) {
+	comment := alarms.Comment{ID: "comment-id", AlarmID: "alarm-id", DomainID: "domain-id", CreatedBy: "user-id"}
+
+	cases := []struct {
+		desc    string
+		session authn.Session
+		alarmID string
+		err     error
+	}{
+		{
+			desc:    "remove own comment",
+			session: authn.Session{DomainID: comment.DomainID, UserID: comment.CreatedBy},
+			alarmID: comment.AlarmID,
+		},
+		{
+			desc:    "remove comment as super admin",
+			session: authn.Session{DomainID: comment.DomainID, UserID: "admin-id", SuperAdmin: true},
+			alarmID: comment.AlarmID,
+		},
+		{
+			desc:    "remove comment of other user",
+			session: authn.Session{DomainID: comment.DomainID, UserID: "other-id"},
+			alarmID: comment.AlarmID,
+			err:     svcerr.ErrAuthorization,
+		},
+		{
+			desc:    "remove comment of other alarm",
+			session: authn.Session{DomainID: comment.DomainID, UserID: comment.CreatedBy},
+			alarmID: "other-alarm-id",
+			err:     repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repo := new(mocks.Repository)
+			svc := newService(t, repo)
+			repo.On("ViewComment", context.Background(), tc.session.DomainID, comment.ID).Return(comment, nil)
+			repo.On("RemoveComment", context.Background(), tc.session.DomainID, comment.ID).Return(nil)
+			err := svc.RemoveComment(context.Background(), tc.session, tc.alarmID, comment.ID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err != nil {
+				repo.AssertNotCalled(t, "RemoveComment", context.Background(), tc.session.DomainID, comment.ID)
+			}
+		})
+	}
+}
+
+func TestCreateEscalationPolicy(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+
+	cases := []struct {
+		desc    string
+		policy  alarms.EscalationPolicy
+		invalid bool
+	}{
+		{
+			desc: "valid policy",
+			policy: alarms.EscalationPolicy{
+				Name:   "policy",
+				Levels: []alarms.EscalationLevel{{After: 10, Severity: 80}, {After: 30, GroupID: "group-id"}},
+			},
+		},
+		{
+			desc:    "policy without levels",
+			policy:  alarms.EscalationPolicy{Name: "policy"},
+			invalid: true,
+		},
+		{
+			desc: "policy with unordered levels",
+			policy: alarms.EscalationPolicy{
+				Name:   "policy",
+				Levels: []alarms.EscalationLevel{{After: 30, Severity: 80}, {After: 10, GroupID: "group-id"}},
+			},
+			invalid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+			repoCall := repo.On("AddEscalationPolicy", context.Background(), mock.Anything).Return(tc.policy, nil)
+			_, err := svc.CreateEscalationPolicy(context.Background(), s, tc.policy)
+			assert.Equal(t, tc.invalid, err != nil, fmt.Sprintf("%s: unexpected error %s\n", tc.desc, err))
+			if !tc.invalid {
+				repoCall.Parent.AssertCalled(t, "AddEscalationPolicy", context.Background(), mock.MatchedBy(func(p alarms.EscalationPolicy) bool {
+					return p.ID != "" && p.DomainID == s.DomainID && p.CreatedBy == s.UserID
+				}))
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestStartSchedulerEscalation(t *testing.T) {
+	repo := new(mocks.Repository)
+	notifier := new(mocks.Notifier)
+	tck := new(tmocks.Ticker)
+	runInfo := make(chan pkglog.RunInfo, 10)
+	svc, err := alarms.NewService(idp, repo, notifier, new(mocks.Sender), alarms.NewStreamHub(), alarms.Flapping{}, runInfo, tck, encKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating service: %s", err))
+
+	ticks := make(chan time.Time)
+	tck.On("Tick").Return((<-chan time.Time)(ticks))
+	tck.On("Stop").Return()
+
+	policy := alarms.EscalationPolicy{
+		ID:       "policy-id",
+		DomainID: "domain-id",
+		Levels:   []alarms.EscalationLevel{{After: 5, Severity: 80}, {After: 15, GroupID: "group-id"}},
+	}
+	alarm := alarms.Alarm{ID: "alarm-id", DomainID: policy.DomainID, Severity: 50}
+
+	repo.On("WakeSnoozedAlarms", mock.Anything, mock.Anything).Return(nil)
+	repo.On("ListEscalationPolicies", mock.Anything, mock.Anything).Return(alarms.EscalationPolicyPage{Total: 1, Policies: []alarms.EscalationPolicy{policy}}, nil)
+	repo.On("ListAlarmsToEscalate", mock.Anything, policy, uint8(2), mock.Anything).Return([]alarms.Alarm{alarm}, nil)
+	repo.On("ListAlarmsToEscalate", mock.Anything, policy, uint8(1), mock.Anything).Return([]alarms.Alarm{}, nil)
+	repo.On("EscalateAlarm", mock.Anything, mock.MatchedBy(func(a alarms.Alarm) bool {
+		return a.ID == alarm.ID && a.EscalationLevel == 2 && a.Severity == alarm.Severity
+	})).Return(alarm, nil)
+	notifier.On("Notify", mock.Anything, alarm, "group-id").Return(nil)
+	escalated := mock.MatchedBy(func(a alarms.Activity) bool {
+		return a.AlarmID == alarm.ID && a.Type == alarms.EscalatedActivity && a.ActorID == "" && a.Details["level"] == uint8(2)
+	})
+	repo.On("AddActivity", mock.Anything, escalated).Return(nil)
+	notifier.On("NotifyActivity", mock.Anything, escalated).Return(nil)
+	repo.On("MatchNotificationPolicies", mock.Anything, alarm).Return([]alarms.NotificationPolicy{}, nil).Maybe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- svc.StartScheduler(ctx)
+	}()
+
+	ticks <- time.Now()
+	info := <-runInfo
+	assert.Equal(t, "alarm escalated", info.Message)
+	cancel()
+	<-done
+
+	notifier.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestNotificationDelivery(t *testing.T) {
+	repo := new(mocks.Repository)
+	sender := new(mocks.Sender)
+	tck := new(tmocks.Ticker)
+	runInfo := make(chan pkglog.RunInfo, 10)
+	hub := alarms.NewStreamHub()
+	svc, err := alarms.NewService(idp, repo, newNotifier(hub), sender, hub, alarms.Flapping{}, runInfo, tck, encKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating service: %s", err))
+
+	tck.On("Tick").Return((<-chan time.Time)(make(chan time.Time)))
+	tck.On("Stop").Return()
+
+	alarm := alarms.Alarm{
+		RuleID:      "rule-id",
+		DomainID:    "domain-id",
+		ChannelID:   "channel-id",
+		ClientID:    "client-id",
+		Measurement: "temperature",
+		Value:       "90",
+		Cause:       "too hot",
+		Severity:    80,
+	}
+	email := alarms.Receiver{Type: alarms.EmailReceiver, To: []string{"ops@example.com"}}
+	webhook := alarms.Receiver{Type: alarms.WebhookReceiver, URL: "https://example.com/alarms"}
+	muted := alarms.Receiver{Type: alarms.SMSReceiver, To: []string{"+381600000000"}}
+	now := time.Now().UTC()
+	policies := []alarms.NotificationPolicy{
+		{
+			ID:        "policy-id",
+			DomainID:  alarm.DomainID,
+			Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+			Receivers: []alarms.Receiver{email, webhook},
+			RateLimit: &alarms.RateLimit{Count: 1, Period: 60},
+		},
+		{
+			ID:        "clear-policy-id",
+			DomainID:  alarm.DomainID,
+			Triggers:  []alarms.Trigger{alarms.ClearTrigger},
+			Receivers: []alarms.Receiver{muted},
+		},
+		{
+			ID:         "quiet-policy-id",
+			DomainID:   alarm.DomainID,
+			Triggers:   []alarms.Trigger{alarms.CreateTrigger},
+			Receivers:  []alarms.Receiver{muted},
+			QuietHours: &alarms.QuietHours{Start: now.Add(-time.Hour).Format("15:04"), End: now.Add(time.Hour).Format("15:04")},
+		},
+	}
+
+	repo.On("MatchSuppressionWindow", mock.Anything, mock.Anything).Return(alarms.SuppressionWindow{}, repoerr.ErrNotFound)
+	repo.On("CreateAlarm", mock.Anything, mock.Anything).Return(func(_ context.Context, a alarms.Alarm) (alarms.Alarm, error) {
+		return a, nil
+	})
+	repo.On("MatchNotificationPolicies", mock.Anything, mock.Anything).Return(policies, nil)
+	sent := make(chan alarms.Receiver, 10)
+	sender.On("Send", mock.Anything, mock.Anything, mock.MatchedBy(func(n alarms.Notification) bool {
+		return n.Trigger == alarms.CreateTrigger && n.PolicyID == "policy-id"
+	})).Run(func(args mock.Arguments) {
+		_, ok := args.Get(0).(context.Context).Deadline()
+		assert.True(t, ok, "expected the notification to be sent with a timeout")
+		sent <- args.Get(1).(alarms.Receiver)
+	}).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- svc.StartScheduler(ctx)
+	}()
+
+	err = svc.CreateAlarm(context.Background(), alarm)
+	assert.Nil(t, err, fmt.Sprintf("create alarm: unexpected error %s", err))
+	assert.Equal(t, email, <-sent)
+	assert.Equal(t, webhook, <-sent)
+
+	// The second alarm is over the policy rate limit.
+	err = svc.CreateAlarm(context.Background(), alarm)
+	assert.Nil(t, err, fmt.Sprintf("create alarm: unexpected error %s", err))
+	info := <-runInfo
+	assert.Equal(t, "notification skipped, the policy rate limit is reached", info.Message)
+
+	cancel()
+	<-done
+	sender.AssertNumberOfCalls(t, "Send", 2)
+}
+
+func TestNotificationPolicySecrets(t *testing.T) {
+	repo := new(mocks.Repository)
+	sender := new(mocks.Sender)
+	tck := new(tmocks.Ticker)
+	runInfo := make(chan pkglog.RunInfo, 10)
+	hub := alarms.NewStreamHub()
+	svc, err := alarms.NewService(idp, repo, newNotifier(hub), sender, hub, alarms.Flapping{}, runInfo, tck, encKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating service: %s", err))
+
+	tck.On("Tick").Return((<-chan time.Time)(make(chan time.Time)))
+	tck.On("Stop").Return()
+
+	session := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+	slack := alarms.Receiver{Type: alarms.SlackReceiver, Token: "xoxb-token", ChannelID: "C123"}
+	webhook := alarms.Receiver{Type: alarms.WebhookReceiver, URL: "https://example.com/alarms", Secret: "signing-secret"}
+	policy := alarms.NotificationPolicy{
+		Name:      "policy",
+		Triggers:  []alarms.Trigger{alarms.CreateTrigger},
+		Receivers: []alarms.Receiver{slack, webhook},
+	}
+
+	var stored alarms.NotificationPolicy
+	repo.On("AddNotificationPolicy", mock.Anything, mock.Anything).Return(func(_ context.Context, p alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+		stored = p
+		return p, nil
+	})
+	created, err := svc.CreateNotificationPolicy(context.Background(), session, policy)
+	require.Nil(t, err, fmt.Sprintf("create notification policy: unexpected error %s", err))
+	for i, r := range stored.Receivers {
+		assert.True(t, strings.HasPrefix(r.Token+r.Secret, "enc:"), fmt.Sprintf("receiver %d: expected sealed secret, got %+v", i, r))
+		assert.NotContains(t, r.Token+r.Secret, "xoxb-token")
+		assert.NotContains(t, r.Token+r.Secret, "signing-secret")
+		assert.Empty(t, created.Receivers[i].Token)
+		assert.Empty(t, created.Receivers[i].Secret)
+	}
+	assert.Equal(t, "C123", created.Receivers[0].ChannelID)
+	assert.Equal(t, "xoxb-token", policy.Receivers[0].Token, "the request receivers must not be changed")
+
+	// Every call returns a copy of the stored policy, like the repository does.
+	storedCopy := func(_ context.Context, _, _ string) (alarms.NotificationPolicy, error) {
+		p := stored
+		p.Receivers = slices.Clone(stored.Receivers)
+		return p, nil
+	}
+	repo.On("ViewNotificationPolicy", mock.Anything, session.DomainID, created.ID).Return(storedCopy)
+	viewed, err := svc.ViewNotificationPolicy(context.Background(), session, created.ID)
+	require.Nil(t, err, fmt.Sprintf("view notification policy: unexpected error %s", err))
+	for _, r := range viewed.Receivers {
+		assert.Empty(t, r.Token)
+		assert.Empty(t, r.Secret)
+	}
+
+	repo.On("ListNotificationPolicies", mock.Anything, mock.Anything).Return(func(_ context.Context, _ alarms.NotificationPolicyPageMeta) (alarms.NotificationPolicyPage, error) {
+		p, _ := storedCopy(context.Background(), "", "")
+		return alarms.NotificationPolicyPage{Total: 1, Policies: []alarms.NotificationPolicy{p}}, nil
+	})
+	page, err := svc.ListNotificationPolicies(context.Background(), session, alarms.NotificationPolicyPageMeta{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("list notification policies: unexpected error %s", err))
+	for _, r := range page.Policies[0].Receivers {
+		assert.Empty(t, r.Token)
+		assert.Empty(t, r.Secret)
+	}
+
+	// The update leaves the secrets out to keep the stored ones.
+	var updated alarms.NotificationPolicy
+	repo.On("UpdateNotificationPolicy", mock.Anything, mock.Anything).Return(func(_ context.Context, p alarms.NotificationPolicy) (alarms.NotificationPolicy, error) {
+		updated = p
+		return p, nil
+	})
+	viewed.Name = "renamed"
+	res, err := svc.UpdateNotificationPolicy(context.Background(), session, viewed)
+	require.Nil(t, err, fmt.Sprintf("update notification policy: unexpected error %s", err))
+	assert.Equal(t, stored.Receivers, updated.Receivers)
+	for _, r := range res.Receivers {
+		assert.Empty(t, r.Token)
+		assert.Empty(t, r.Secret)
+	}
+
+	// The receivers get the decrypted secrets.
+	stored.DomainID = session.DomainID
+	repo.On("MatchSuppressionWindow", mock.Anything, mock.Anything).Return(alarms.SuppressionWindow{}, repoerr.ErrNotFound)
+	repo.On("CreateAlarm", mock.Anything, mock.Anything).Return(func(_ context.Context, a alarms.Alarm) (alarms.Alarm, error) {
+		return a, nil
+	})
+	repo.On("MatchNotificationPolicies", mock.Anything, mock.Anything).Return([]alarms.NotificationPolicy{stored}, nil)
+	sent := make(chan alarms.Receiver, 10)
+	sender.On("Send", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sent <- args.Get(1).(alarms.Receiver)
+	}).Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- svc.StartScheduler(ctx)
+	}()
+
+	err = svc.CreateAlarm(context.Background(), alarms.Alarm{
+		RuleID:      "rule-id",
+		DomainID:    session.DomainID,
+		ChannelID:   "channel-id",
+		ClientID:    "client-id",
+		Measurement: "temperature",
+		Value:       "90",
+		Cause:       "too hot",
+		Severity:    80,
+	})
+	assert.Nil(t, err, fmt.Sprintf("create alarm: unexpected error %s", err))
+	assert.Equal(t, slack, <-sent)
+	assert.Equal(t, webhook, <-sent)
+
+	cancel()
+	<-done
+}
+
+func TestCreateAlarmFlags(t *testing.T) {
+	repo := new(mocks.Repository)
+	runInfo := make(chan pkglog.RunInfo, 10)
+	hub := alarms.NewStreamHub()
+	flapping := alarms.Flapping{Threshold: 3, Window: 10 * time.Minute}
+	svc, err := alarms.NewService(idp, repo, newNotifier(hub), new(mocks.Sender), hub, flapping, runInfo, new(tmocks.Ticker), encKey)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating service: %s", err))
+
+	alarm := alarms.Alarm{
+		RuleID:      "rule-id",
+		DomainID:    "domain-id",
+		ChannelID:   "channel-id",
+		ClientID:    "client-id",
+		Measurement: "temperature",
+		Value:       "90",
+		Cause:       "too hot",
+		Severity:    80,
+		CreatedAt:   time.Now(),
+	}
+	window := alarms.SuppressionWindow{ID: "window-id", DomainID: alarm.DomainID}
+
+	cases := []struct {
+		desc       string
+		alarm      alarms.Alarm
+		window     alarms.SuppressionWindow
+		windowErr  error
+		changes    uint64
+		changesErr error
+		dedupKey   string
+		suppressed bool
+		flapping   bool
+		err        error
+	}{
+		{
+			desc:      "alarm with default dedup key",
+			alarm:     alarm,
+			windowErr: repoerr.ErrNotFound,
+			changes:   1,
+			dedupKey:  "rule-id/channel-id/client-id//temperature",
+		},
+		{
+			desc:      "alarm with custom dedup key",
+			alarm:     alarms.Alarm{RuleID: alarm.RuleID, DomainID: alarm.DomainID, ChannelID: alarm.ChannelID, ClientID: alarm.ClientID, Measurement: alarm.Measurement, Value: alarm.Value, Cause: alarm.Cause, CreatedAt: alarm.CreatedAt, DedupKey: "rule-id"},
+			windowErr: repoerr.ErrNotFound,
+			dedupKey:  "rule-id",
+		},
+		{
+			desc:       "alarm in suppression window",
+			alarm:      alarm,
+			window:     window,
+			dedupKey:   "rule-id/channel-id/client-id//temperature",
+			suppressed: true,
+		},
+		{
+			desc:      "flapping alarm",
+			alarm:     alarm,
+			windowErr: repoerr.ErrNotFound,
+			changes:   2,
+			dedupKey:  "rule-id/channel-id/client-id//temperature",
+			flapping:  true,
+		},
+		{
+			desc:      "alarm with failed suppression window match",
+			alarm:     alarm,
+			windowErr: repoerr.ErrViewEntity,
+			err:       repoerr.ErrViewEntity,
+		},
+		{
+			desc:       "alarm with failed changes count",
+			alarm:      alarm,
+			windowErr:  repoerr.ErrNotFound,
+			changesErr: repoerr.ErrViewEntity,
+			err:        repoerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("MatchSuppressionWindow", context.Background(), mock.Anything).Return(tc.window, tc.windowErr)
+			repoCall1 := repo.On("CountAlarmChanges", context.Background(), alarm.DomainID, mock.Anything, alarm.CreatedAt.Add(-flapping.Window)).Return(tc.changes, tc.changesErr)
+			var created alarms.Alarm
+			repoCall2 := repo.On("CreateAlarm", context.Background(), mock.Anything).Return(func(_ context.Context, a alarms.Alarm) (alarms.Alarm, error) {
+				created = a
+				return a, nil
+			})
+			err := svc.CreateAlarm(context.Background(), tc.alarm)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.dedupKey, created.DedupKey)
+				assert.Equal(t, tc.suppressed, created.Suppressed)
+				assert.Equal(t, tc.window.ID, created.SuppressionID)
+				assert.Equal(t, tc.flapping, created.Flapping)
+			}
+			repoCall.Unset()
+			repoCall1.Unset()
+			repoCall2.Unset()
+		})
+	}
+}
+
+func TestCreateSuppressionWindow(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+	session := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+	now := time.Now()
+
+	cases := []struct {
+		desc   string
+		window alarms.SuppressionWindow
+		err    error
+	}{
+		{
+			desc:   "valid window",
+			window: alarms.SuppressionWindow{Name: "maintenance", ChannelID: "channel-id", StartsAt: now, EndsAt: now.Add(time.Hour)},
+		},
+		{
+			desc:   "window ending before it starts",
+			window: alarms.SuppressionWindow{Name: "maintenance", StartsAt: now, EndsAt: now.Add(-time.Hour)},
+			err:    errors.New("suppression window must start before it ends"),
+		},
+		{
+			desc:   "window without start",
+			window: alarms.SuppressionWindow{Name: "maintenance", EndsAt: now},
+			err:    errors.New("suppression window must start before it ends"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("AddSuppressionWindow", context.Background(), mock.Anything).Return(func(_ context.Context, w alarms.SuppressionWindow) (alarms.SuppressionWindow, error) {
+				return w, nil
+			})
+			window, err := svc.CreateSuppressionWindow(context.Background(), session, tc.window)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if err == nil {
+				assert.NotEmpty(t, window.ID)
+				assert.Equal(t, session.DomainID, window.DomainID)
+				assert.Equal(t, session.UserID, window.CreatedBy)
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestAlarmStats(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+	severity := uint8(80)
+	stats := alarms.Stats{
+		Groups: []alarms.StatsGroup{
+			{ChannelID: "channel-id", Severity: &severity, Count: 4, Acknowledged: 2, Resolved: 1, MTTA: 120, MTTR: 600},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		session  authn.Session
+		query    alarms.StatsQuery
+		repoOp   string
+		repoArgs []any
+		stats    alarms.Stats
+		repoErr  error
+		err      error
+	}{
+		{
+			desc:     "stats of user alarms",
+			session:  authn.Session{DomainID: "domain-id", UserID: "user-id"},
+			query:    alarms.StatsQuery{PageMetadata: alarms.PageMetadata{DomainID: "domain-id"}, GroupBy: []string{alarms.GroupByChannel, alarms.GroupBySeverity}, Bucket: alarms.WeekBucket},
+			repoOp:   "UserAlarmStats",
+			repoArgs: []any{"user-id"},
+			stats:    stats,
+		},
+		{
+			desc:    "stats of all alarms as super admin",
+			session: authn.Session{DomainID: "domain-id", UserID: "user-id", SuperAdmin: true},
+			query:   alarms.StatsQuery{PageMetadata: alarms.PageMetadata{DomainID: "domain-id"}, GroupBy: []string{alarms.GroupByStatus}},
+			repoOp:  "AlarmStats",
+			stats:   stats,
+		},
+		{
+			desc:    "stats with invalid group by",
+			session: authn.Session{DomainID: "domain-id", UserID: "user-id"},
+			query:   alarms.StatsQuery{GroupBy: []string{"subtopic"}},
+			repoOp:  "UserAlarmStats",
+			err:     alarms.ErrInvalidGroupBy,
+		},
+		{
+			desc:    "stats with invalid bucket",
+			session: authn.Session{DomainID: "domain-id", UserID: "user-id"},
+			query:   alarms.StatsQuery{Bucket: "year"},
+			repoOp:  "UserAlarmStats",
+			err:     alarms.ErrInvalidBucket,
+		},
+		{
+			desc:     "stats with repository error",
+			session:  authn.Session{DomainID: "domain-id", UserID: "user-id"},
+			query:    alarms.StatsQuery{PageMetadata: alarms.PageMetadata{DomainID: "domain-id"}},
+			repoOp:   "UserAlarmStats",
+			repoArgs: []any{"user-id"},
+			repoErr:  repoerr.ErrViewEntity,
+			err:      repoerr.ErrViewEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			args := append(append([]any{context.Background()}, tc.repoArgs...), tc.query)
+			repoCall := repo.On(tc.repoOp, args...).Return(tc.stats, tc.repoErr)
+			res, err := svc.AlarmStats(context.Background(), tc.session, tc.query)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err == nil {
+				assert.Equal(t, tc.stats, res)
+				repoCall.Parent.AssertCalled(t, tc.repoOp, args...)
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestStreamAlarms(t *testing.T) {
+	repo := new(mocks.Repository)
+	svc := newService(t, repo)
+
+	repo.On("MatchSuppressionWindow", mock.Anything, mock.Anything).Return(alarms.SuppressionWindow{}, repoerr.ErrNotFound)
+	repo.On("CreateAlarm", mock.Anything, mock.Anything).Return(func(_ context.Context, a alarms.Alarm) (alarms.Alarm, error) {
+		return a, nil
+	})
+	repo.On("IsUserAlarm", mock.Anything, "user-id", mock.Anything).Return(func(_ context.Context, _ string, a alarms.Alarm) (bool, error) {
+		return a.RuleID == "rule-id", nil
+	})
+
+	session := authn.Session{DomainID: "domain-id", UserID: "user-id"}
+	ctx, cancel := context.WithCancel(context.Background())
+	all, err := svc.StreamAlarms(ctx, session, alarms.StreamFilter{})
+	assert.Nil(t, err, fmt.Sprintf("stream alarms: unexpected error %s", err))
+	critical, err := svc.StreamAlarms(ctx, session, alarms.StreamFilter{ClientID: "client-id", MinSeverity: 90})
+	assert.Nil(t, err, fmt.Sprintf("stream alarms: unexpected error %s", err))
+	admin, err := svc.StreamAlarms(ctx, authn.Session{DomainID: "domain-id", UserID: "admin-id", SuperAdmin: true}, alarms.StreamFilter{})
+	assert.Nil(t, err, fmt.Sprintf("stream alarms: unexpected error %s", err))
+	other, err := svc.StreamAlarms(ctx, authn.Session{DomainID: "other-domain-id"}, alarms.StreamFilter{})
+	assert.Nil(t, err, fmt.Sprintf("stream alarms: unexpected error %s", err))
+
+	alarm := alarms.Alarm{
+		RuleID:      "rule-id",
+		DomainID:    session.DomainID,
+		ChannelID:   "channel-id",
+		ClientID:    "client-id",
+		Measurement: "temperature",
+		Value:       "60",
+		Cause:       "too hot",
+		Severity:    50,
+	}
+	err = svc.CreateAlarm(context.Background(), alarm)
+	assert.Nil(t, err, fmt.Sprintf("create alarm: unexpected error %s", err))
+
+	// The user isn't authorized for the rule of this alarm.
+	unauthorized := alarm
+	unauthorized.RuleID = "other-rule-id"
+	unauthorized.Severity = 99
+	err = svc.CreateAlarm(context.Background(), unauthorized)
+	assert.Nil(t, err, fmt.Sprintf("create alarm: unexpected error %s", err))
+
+	alarm.Severity = 95
+	err = svc.CreateAlarm(context.Background(), alarm)
+	assert.Nil(t, err, fmt.Sprintf("create alarm: unexpected error %s", err))
+
+	assert.Equal(t, uint8(50), (<-all).Severity)
+	assert.Equal(t, uint8(95), (<-all).Severity)
+	assert.Equal(t, uint8(95), (<-critical).Severity)
+	assert.Equal(t, uint8(50), (<-admin).Severity)
+	assert.Equal(t, uint8(99), (<-admin).Severity)
+	assert.Equal(t, uint8(95), (<-admin).Severity)
+
+	cancel()
+	for range all {
+	}
+	_, ok := <-critical
+	assert.False(t, ok, "stream alarms: expected the unauthorized alarm not to be streamed")
+	_, ok = <-other
+	assert.False(t, ok, "stream alarms: expected the other domain stream to get no alarms")
+}
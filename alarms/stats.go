@@ -0,0 +1,80 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"slices"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// The alarm fields the statistics are grouped by.
+const (
+	GroupByRule     = "rule_id"
+	GroupByChannel  = "channel_id"
+	GroupByClient   = "client_id"
+	GroupBySeverity = "severity"
+	GroupByStatus   = "status"
+)
+
+// The time buckets of the alarm creation time.
+const (
+	HourBucket  = "hour"
+	DayBucket   = "day"
+	WeekBucket  = "week"
+	MonthBucket = "month"
+)
+
+var (
+	groupByFields = []string{GroupByRule, GroupByChannel, GroupByClient, GroupBySeverity, GroupByStatus}
+	buckets       = []string{HourBucket, DayBucket, WeekBucket, MonthBucket}
+
+	ErrInvalidGroupBy = errors.NewRequestError("invalid group by field, must be rule_id, channel_id, client_id, severity or status")
+	ErrInvalidBucket  = errors.NewRequestError("invalid time bucket, must be hour, day, week or month")
+)
+
+// StatsQuery selects the alarms with the PageMetadata filters and groups
+// them by the GroupBy fields and the Bucket of their creation time. The
+// offset, the limit and the order of the page are ignored.
+type StatsQuery struct {
+	PageMetadata
+	GroupBy []string `json:"group_by,omitempty"`
+	Bucket  string   `json:"bucket,omitempty"`
+}
+
+func (q StatsQuery) Validate() error {
+	for _, f := range q.GroupBy {
+		if !slices.Contains(groupByFields, f) {
+			return ErrInvalidGroupBy
+		}
+	}
+	if q.Bucket != "" && !slices.Contains(buckets, q.Bucket) {
+		return ErrInvalidBucket
+	}
+
+	return nil
+}
+
+// StatsGroup holds the statistics of the alarms that share the group
+// fields. Only the fields the alarms are grouped by are set. MTTA and MTTR
+// are the mean seconds from raising the alarm to its acknowledgment and
+// resolution.
+type StatsGroup struct {
+	RuleID       string     `json:"rule_id,omitempty"`
+	ChannelID    string     `json:"channel_id,omitempty"`
+	ClientID     string     `json:"client_id,omitempty"`
+	Severity     *uint8     `json:"severity,omitempty"`
+	Status       *Status    `json:"status,omitempty"`
+	Bucket       *time.Time `json:"bucket,omitempty"`
+	Count        uint64     `json:"count"`
+	Acknowledged uint64     `json:"acknowledged"`
+	Resolved     uint64     `json:"resolved"`
+	MTTA         float64    `json:"mtta"`
+	MTTR         float64    `json:"mttr"`
+}
+
+type Stats struct {
+	Groups []StatsGroup `json:"groups"`
+}
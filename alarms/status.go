@@ -15,6 +15,9 @@ type Status uint8
 const (
 	ActiveStatus Status = iota
 	ClearedStatus
+	AcknowledgedStatus
+	ResolvedStatus
+	SnoozedStatus
 
 	// AllStatus is used for querying purposes to list alarms irrespective
 	// of their status. It is never stored in the database as the actual
@@ -23,10 +26,13 @@ const (
 )
 
 const (
-	Active  = "active"
-	Cleared = "cleared"
-	Unknown = "unknown"
-	All     = "all"
+	Active       = "active"
+	Cleared      = "cleared"
+	Acknowledged = "acknowledged"
+	Resolved     = "resolved"
+	Snoozed      = "snoozed"
+	Unknown      = "unknown"
+	All          = "all"
 )
 
 // String converts alarm status to string literal.
@@ -36,6 +42,12 @@ func (s Status) String() string {
 		return Active
 	case ClearedStatus:
 		return Cleared
+	case AcknowledgedStatus:
+		return Acknowledged
+	case ResolvedStatus:
+		return Resolved
+	case SnoozedStatus:
+		return Snoozed
 	default:
 		return Unknown
 	}
@@ -48,6 +60,12 @@ func ToStatus(status string) (Status, error) {
 		return ActiveStatus, nil
 	case Cleared:
 		return ClearedStatus, nil
+	case Acknowledged:
+		return AcknowledgedStatus, nil
+	case Resolved:
+		return ResolvedStatus, nil
+	case Snoozed:
+		return SnoozedStatus, nil
 	case All:
 		return AllStatus, nil
 	default:
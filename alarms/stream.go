@@ -0,0 +1,98 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"sync"
+)
+
+// streamBuffer is the number of the alarms waiting for the stream reader.
+// The alarms are dropped for the readers that fall behind.
+const streamBuffer = 64
+
+// StreamFilter selects the streamed domain alarms. Empty channel, client
+// and subtopic match all the alarms.
+type StreamFilter struct {
+	ChannelID   string
+	ClientID    string
+	Subtopic    string
+	MinSeverity uint8
+}
+
+// Matches reports whether the alarm is streamed.
+func (f StreamFilter) Matches(alarm Alarm) bool {
+	switch {
+	case alarm.Severity < f.MinSeverity,
+		f.ChannelID != "" && f.ChannelID != alarm.ChannelID,
+		f.ClientID != "" && f.ClientID != alarm.ClientID,
+		f.Subtopic != "" && f.Subtopic != alarm.Subtopic:
+		return false
+	default:
+		return true
+	}
+}
+
+// StreamHub fans the created alarms out to the streams of the alarm domain.
+// The streams are kept by each service instance, so the created alarms are
+// published to the hubs of all the instances through the event store.
+type StreamHub struct {
+	mu      sync.RWMutex
+	streams map[string]map[*stream]struct{}
+}
+
+type stream struct {
+	filter StreamFilter
+	alarms chan Alarm
+}
+
+// NewStreamHub returns the hub of the service instance streams.
+func NewStreamHub() *StreamHub {
+	return &StreamHub{streams: make(map[string]map[*stream]struct{})}
+}
+
+func (h *StreamHub) subscribe(domainID string, filter StreamFilter) *stream {
+	st := &stream{filter: filter, alarms: make(chan Alarm, streamBuffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.streams[domainID] == nil {
+		h.streams[domainID] = make(map[*stream]struct{})
+	}
+	h.streams[domainID][st] = struct{}{}
+
+	return st
+}
+
+// unsubscribe removes the stream and closes its channel.
+func (h *StreamHub) unsubscribe(domainID string, st *stream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.streams[domainID], st)
+	if len(h.streams[domainID]) == 0 {
+		delete(h.streams, domainID)
+	}
+	close(st.alarms)
+}
+
+// Publish sends the alarm to the matching streams and returns the number
+// of the streams that dropped it.
+func (h *StreamHub) Publish(alarm Alarm) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var dropped int
+	for st := range h.streams[alarm.DomainID] {
+		if !st.filter.Matches(alarm) {
+			continue
+		}
+		select {
+		case st.alarms <- alarm:
+		default:
+			dropped++
+		}
+	}
+
+	return dropped
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package alarms
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+var (
+	// DefaultDedupFields build the dedup key of the alarms whose rule
+	// doesn't configure one.
+	DefaultDedupFields = []string{"rule_id", "channel_id", "client_id", "subtopic", "measurement"}
+
+	ErrInvalidDedupField = errors.New("invalid dedup key field, must be rule_id, channel_id, client_id, subtopic or measurement")
+
+	errSuppressionTime = errors.NewRequestError("suppression window must start before it ends")
+)
+
+// ValidateDedupFields checks the alarm fields the dedup key is built from.
+func ValidateDedupFields(fields []string) error {
+	for _, f := range fields {
+		if !slices.Contains(DefaultDedupFields, f) {
+			return ErrInvalidDedupField
+		}
+	}
+
+	return nil
+}
+
+// DedupKey joins the values of the alarm fields, or of the default fields
+// if none are given. The repeated alarms with the same key are saved only
+// if their status or severity changes.
+func DedupKey(alarm Alarm, fields []string) string {
+	if len(fields) == 0 {
+		fields = DefaultDedupFields
+	}
+	values := make([]string, len(fields))
+	for i, f := range fields {
+		switch f {
+		case "rule_id":
+			values[i] = alarm.RuleID
+		case "channel_id":
+			values[i] = alarm.ChannelID
+		case "client_id":
+			values[i] = alarm.ClientID
+		case "subtopic":
+			values[i] = alarm.Subtopic
+		case "measurement":
+			values[i] = alarm.Measurement
+		}
+	}
+
+	return strings.Join(values, "/")
+}
+
+// Flapping marks the alarm as flapping if its dedup key changed the state
+// Threshold times in the Window, including the alarm itself. The flapping
+// alarms are saved without the notifications. The detection is disabled
+// if Threshold is 0.
+type Flapping struct {
+	Threshold uint64
+	Window    time.Duration
+}
+
+// SuppressionWindow flags the domain alarms raised from StartsAt to EndsAt
+// as suppressed, which saves them without the notifications and the
+// escalation. Empty channel and client match all the alarms.
+type SuppressionWindow struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	DomainID  string    `json:"domain_id"`
+	ChannelID string    `json:"channel_id,omitempty"`
+	ClientID  string    `json:"client_id,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+}
+
+func (w SuppressionWindow) Validate() error {
+	if w.StartsAt.IsZero() || !w.EndsAt.After(w.StartsAt) {
+		return errSuppressionTime
+	}
+
+	return nil
+}
+
+// Matches reports whether the window suppresses the alarm.
+func (w SuppressionWindow) Matches(alarm Alarm) bool {
+	switch {
+	case alarm.DomainID != w.DomainID,
+		w.ChannelID != "" && w.ChannelID != alarm.ChannelID,
+		w.ClientID != "" && w.ClientID != alarm.ClientID,
+		alarm.CreatedAt.Before(w.StartsAt),
+		!alarm.CreatedAt.Before(w.EndsAt):
+		return false
+	default:
+		return true
+	}
+}
+
+type SuppressionWindowPageMeta struct {
+	Offset    uint64 `json:"offset"`
+	Limit     uint64 `json:"limit"`
+	Total     uint64 `json:"total"`
+	DomainID  string `json:"domain_id,omitempty"`
+	ChannelID string `json:"channel_id,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+}
+
+type SuppressionWindowPage struct {
+	Offset  uint64              `json:"offset"`
+	Limit   uint64              `json:"limit"`
+	Total   uint64              `json:"total"`
+	Windows []SuppressionWindow `json:"windows"`
+}
@@ -14,6 +14,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
+	sync "sync"
 	unsafe "unsafe"
 )
 
@@ -24,23 +25,142 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RetrieveChannelIDsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId      string                 `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveChannelIDsReq) Reset() {
+	*x = RetrieveChannelIDsReq{}
+	mi := &file_groups_v1_groups_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveChannelIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveChannelIDsReq) ProtoMessage() {}
+
+func (x *RetrieveChannelIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_v1_groups_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveChannelIDsReq.ProtoReflect.Descriptor instead.
+func (*RetrieveChannelIDsReq) Descriptor() ([]byte, []int) {
+	return file_groups_v1_groups_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RetrieveChannelIDsReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RetrieveChannelIDsReq) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+type RetrieveChannelIDsRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetrieveChannelIDsRes) Reset() {
+	*x = RetrieveChannelIDsRes{}
+	mi := &file_groups_v1_groups_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetrieveChannelIDsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetrieveChannelIDsRes) ProtoMessage() {}
+
+func (x *RetrieveChannelIDsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_groups_v1_groups_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetrieveChannelIDsRes.ProtoReflect.Descriptor instead.
+func (*RetrieveChannelIDsRes) Descriptor() ([]byte, []int) {
+	return file_groups_v1_groups_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RetrieveChannelIDsRes) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 var File_groups_v1_groups_proto protoreflect.FileDescriptor
 
 const file_groups_v1_groups_proto_rawDesc = "" +
 	"\n" +
-	"\x16groups/v1/groups.proto\x12\tgroups.v1\x1a\x16common/v1/common.proto2_\n" +
+	"\x16groups/v1/groups.proto\x12\tgroups.v1\x1a\x16common/v1/common.proto\"D\n" +
+	"\x15RetrieveChannelIDsReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\")\n" +
+	"\x15RetrieveChannelIDsRes\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids2\xbb\x01\n" +
 	"\rGroupsService\x12N\n" +
-	"\x0eRetrieveEntity\x12\x1c.common.v1.RetrieveEntityReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00B2Z0github.com/absmach/magistrala/api/grpc/groups/v1b\x06proto3"
+	"\x0eRetrieveEntity\x12\x1c.common.v1.RetrieveEntityReq\x1a\x1c.common.v1.RetrieveEntityRes\"\x00\x12Z\n" +
+	"\x12RetrieveChannelIDs\x12 .groups.v1.RetrieveChannelIDsReq\x1a .groups.v1.RetrieveChannelIDsRes\"\x00B2Z0github.com/absmach/magistrala/api/grpc/groups/v1b\x06proto3"
+
+var (
+	file_groups_v1_groups_proto_rawDescOnce sync.Once
+	file_groups_v1_groups_proto_rawDescData []byte
+)
+
+func file_groups_v1_groups_proto_rawDescGZIP() []byte {
+	file_groups_v1_groups_proto_rawDescOnce.Do(func() {
+		file_groups_v1_groups_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_groups_v1_groups_proto_rawDesc), len(file_groups_v1_groups_proto_rawDesc)))
+	})
+	return file_groups_v1_groups_proto_rawDescData
+}
 
+var file_groups_v1_groups_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_groups_v1_groups_proto_goTypes = []any{
-	(*v1.RetrieveEntityReq)(nil), // 0: common.v1.RetrieveEntityReq
-	(*v1.RetrieveEntityRes)(nil), // 1: common.v1.RetrieveEntityRes
+	(*RetrieveChannelIDsReq)(nil), // 0: groups.v1.RetrieveChannelIDsReq
+	(*RetrieveChannelIDsRes)(nil), // 1: groups.v1.RetrieveChannelIDsRes
+	(*v1.RetrieveEntityReq)(nil),  // 2: common.v1.RetrieveEntityReq
+	(*v1.RetrieveEntityRes)(nil),  // 3: common.v1.RetrieveEntityRes
 }
 var file_groups_v1_groups_proto_depIdxs = []int32{
-	0, // 0: groups.v1.GroupsService.RetrieveEntity:input_type -> common.v1.RetrieveEntityReq
-	1, // 1: groups.v1.GroupsService.RetrieveEntity:output_type -> common.v1.RetrieveEntityRes
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 0: groups.v1.GroupsService.RetrieveEntity:input_type -> common.v1.RetrieveEntityReq
+	0, // 1: groups.v1.GroupsService.RetrieveChannelIDs:input_type -> groups.v1.RetrieveChannelIDsReq
+	3, // 2: groups.v1.GroupsService.RetrieveEntity:output_type -> common.v1.RetrieveEntityRes
+	1, // 3: groups.v1.GroupsService.RetrieveChannelIDs:output_type -> groups.v1.RetrieveChannelIDsRes
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -57,12 +177,13 @@ func file_groups_v1_groups_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_groups_v1_groups_proto_rawDesc), len(file_groups_v1_groups_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_groups_v1_groups_proto_goTypes,
 		DependencyIndexes: file_groups_v1_groups_proto_depIdxs,
+		MessageInfos:      file_groups_v1_groups_proto_msgTypes,
 	}.Build()
 	File_groups_v1_groups_proto = out.File
 	file_groups_v1_groups_proto_goTypes = nil
@@ -23,7 +23,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GroupsService_RetrieveEntity_FullMethodName = "/groups.v1.GroupsService/RetrieveEntity"
+	GroupsService_RetrieveEntity_FullMethodName     = "/groups.v1.GroupsService/RetrieveEntity"
+	GroupsService_RetrieveChannelIDs_FullMethodName = "/groups.v1.GroupsService/RetrieveChannelIDs"
 )
 
 // GroupsServiceClient is the client API for GroupsService service.
@@ -34,6 +35,7 @@ const (
 // functionalities for Magistrala services.
 type GroupsServiceClient interface {
 	RetrieveEntity(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveEntityRes, error)
+	RetrieveChannelIDs(ctx context.Context, in *RetrieveChannelIDsReq, opts ...grpc.CallOption) (*RetrieveChannelIDsRes, error)
 }
 
 type groupsServiceClient struct {
@@ -54,6 +56,16 @@ func (c *groupsServiceClient) RetrieveEntity(ctx context.Context, in *v1.Retriev
 	return out, nil
 }
 
+func (c *groupsServiceClient) RetrieveChannelIDs(ctx context.Context, in *RetrieveChannelIDsReq, opts ...grpc.CallOption) (*RetrieveChannelIDsRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetrieveChannelIDsRes)
+	err := c.cc.Invoke(ctx, GroupsService_RetrieveChannelIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupsServiceServer is the server API for GroupsService service.
 // All implementations must embed UnimplementedGroupsServiceServer
 // for forward compatibility.
@@ -62,6 +74,7 @@ func (c *groupsServiceClient) RetrieveEntity(ctx context.Context, in *v1.Retriev
 // functionalities for Magistrala services.
 type GroupsServiceServer interface {
 	RetrieveEntity(context.Context, *v1.RetrieveEntityReq) (*v1.RetrieveEntityRes, error)
+	RetrieveChannelIDs(context.Context, *RetrieveChannelIDsReq) (*RetrieveChannelIDsRes, error)
 	mustEmbedUnimplementedGroupsServiceServer()
 }
 
@@ -75,6 +88,9 @@ type UnimplementedGroupsServiceServer struct{}
 func (UnimplementedGroupsServiceServer) RetrieveEntity(context.Context, *v1.RetrieveEntityReq) (*v1.RetrieveEntityRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method RetrieveEntity not implemented")
 }
+func (UnimplementedGroupsServiceServer) RetrieveChannelIDs(context.Context, *RetrieveChannelIDsReq) (*RetrieveChannelIDsRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetrieveChannelIDs not implemented")
+}
 func (UnimplementedGroupsServiceServer) mustEmbedUnimplementedGroupsServiceServer() {}
 func (UnimplementedGroupsServiceServer) testEmbeddedByValue()                       {}
 
@@ -114,6 +130,24 @@ func _GroupsService_RetrieveEntity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupsService_RetrieveChannelIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrieveChannelIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServiceServer).RetrieveChannelIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupsService_RetrieveChannelIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServiceServer).RetrieveChannelIDs(ctx, req.(*RetrieveChannelIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupsService_ServiceDesc is the grpc.ServiceDesc for GroupsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +159,10 @@ var GroupsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RetrieveEntity",
 			Handler:    _GroupsService_RetrieveEntity_Handler,
 		},
+		{
+			MethodName: "RetrieveChannelIDs",
+			Handler:    _GroupsService_RetrieveChannelIDs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "groups/v1/groups.proto",
@@ -104,6 +104,8 @@ type PageMetadata struct {
 	Format        string                 `protobuf:"bytes,17,opt,name=format,proto3" json:"format,omitempty"`
 	Order         string                 `protobuf:"bytes,18,opt,name=order,proto3" json:"order,omitempty"`
 	Dir           string                 `protobuf:"bytes,19,opt,name=dir,proto3" json:"dir,omitempty"`
+	Domain        string                 `protobuf:"bytes,20,opt,name=domain,proto3" json:"domain,omitempty"`
+	ClientId      string                 `protobuf:"bytes,21,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -271,6 +273,20 @@ func (x *PageMetadata) GetDir() string {
 	return ""
 }
 
+func (x *PageMetadata) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PageMetadata) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
 type ReadMessagesRes struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -419,6 +435,8 @@ type BaseMessage struct {
 	Subtopic      string                 `protobuf:"bytes,2,opt,name=subtopic,proto3" json:"subtopic,omitempty"`
 	Publisher     string                 `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
 	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Domain        string                 `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain,omitempty"`
+	ClientId      string                 `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -481,6 +499,20 @@ func (x *BaseMessage) GetProtocol() string {
 	return ""
 }
 
+func (x *BaseMessage) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *BaseMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
 type SenMLMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *BaseMessage           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -717,12 +749,360 @@ func (x *ReadMessagesReq) GetPageMetadata() *PageMetadata {
 	return nil
 }
 
+type SeriesQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Subtopic      string                 `protobuf:"bytes,2,opt,name=subtopic,proto3" json:"subtopic,omitempty"`
+	Publisher     string                 `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	From          float64                `protobuf:"fixed64,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            float64                `protobuf:"fixed64,6,opt,name=to,proto3" json:"to,omitempty"`
+	Aggregation   Aggregation            `protobuf:"varint,7,opt,name=aggregation,proto3,enum=readers.v1.Aggregation" json:"aggregation,omitempty"`
+	Interval      string                 `protobuf:"bytes,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	Fill          string                 `protobuf:"bytes,9,opt,name=fill,proto3" json:"fill,omitempty"`
+	Limit         uint64                 `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeriesQuery) Reset() {
+	*x = SeriesQuery{}
+	mi := &file_readers_v1_readers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeriesQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeriesQuery) ProtoMessage() {}
+
+func (x *SeriesQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_readers_v1_readers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeriesQuery.ProtoReflect.Descriptor instead.
+func (*SeriesQuery) Descriptor() ([]byte, []int) {
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SeriesQuery) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *SeriesQuery) GetSubtopic() string {
+	if x != nil {
+		return x.Subtopic
+	}
+	return ""
+}
+
+func (x *SeriesQuery) GetPublisher() string {
+	if x != nil {
+		return x.Publisher
+	}
+	return ""
+}
+
+func (x *SeriesQuery) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *SeriesQuery) GetFrom() float64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *SeriesQuery) GetTo() float64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *SeriesQuery) GetAggregation() Aggregation {
+	if x != nil {
+		return x.Aggregation
+	}
+	return Aggregation_AGGREGATION_UNSPECIFIED
+}
+
+func (x *SeriesQuery) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *SeriesQuery) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
+}
+
+func (x *SeriesQuery) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReadSeriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DomainId      string                 `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	ChannelIds    []string               `protobuf:"bytes,2,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Query         *SeriesQuery           `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSeriesReq) Reset() {
+	*x = ReadSeriesReq{}
+	mi := &file_readers_v1_readers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSeriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSeriesReq) ProtoMessage() {}
+
+func (x *ReadSeriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_readers_v1_readers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSeriesReq.ProtoReflect.Descriptor instead.
+func (*ReadSeriesReq) Descriptor() ([]byte, []int) {
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReadSeriesReq) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+func (x *ReadSeriesReq) GetChannelIds() []string {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *ReadSeriesReq) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ReadSeriesReq) GetQuery() *SeriesQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type Point struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          float64                `protobuf:"fixed64,1,opt,name=time,proto3" json:"time,omitempty"`
+	Value         *float64               `protobuf:"fixed64,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_readers_v1_readers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_readers_v1_readers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Point) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Point) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+type Series struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Points        []*Point               `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Series) Reset() {
+	*x = Series{}
+	mi := &file_readers_v1_readers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Series) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Series) ProtoMessage() {}
+
+func (x *Series) ProtoReflect() protoreflect.Message {
+	mi := &file_readers_v1_readers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Series.ProtoReflect.Descriptor instead.
+func (*Series) Descriptor() ([]byte, []int) {
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Series) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Series) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Series) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Series) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ReadSeriesRes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*Series              `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSeriesRes) Reset() {
+	*x = ReadSeriesRes{}
+	mi := &file_readers_v1_readers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSeriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSeriesRes) ProtoMessage() {}
+
+func (x *ReadSeriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_readers_v1_readers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSeriesRes.ProtoReflect.Descriptor instead.
+func (*ReadSeriesRes) Descriptor() ([]byte, []int) {
+	return file_readers_v1_readers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReadSeriesRes) GetSeries() []*Series {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
 var File_readers_v1_readers_proto protoreflect.FileDescriptor
 
 const file_readers_v1_readers_proto_rawDesc = "" +
 	"\n" +
 	"\x18readers/v1/readers.proto\x12\n" +
-	"readers.v1\"\x8c\x04\n" +
+	"readers.v1\"\xc1\x04\n" +
 	"\fPageMetadata\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x04R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12\x1a\n" +
@@ -747,7 +1127,9 @@ const file_readers_v1_readers_proto_rawDesc = "" +
 	"comparator\x12\x16\n" +
 	"\x06format\x18\x11 \x01(\tR\x06format\x12\x14\n" +
 	"\x05order\x18\x12 \x01(\tR\x05order\x12\x10\n" +
-	"\x03dir\x18\x13 \x01(\tR\x03dir\"\x97\x01\n" +
+	"\x03dir\x18\x13 \x01(\tR\x03dir\x12\x16\n" +
+	"\x06domain\x18\x14 \x01(\tR\x06domain\x12\x1b\n" +
+	"\tclient_id\x18\x15 \x01(\tR\bclientId\"\x97\x01\n" +
 	"\x0fReadMessagesRes\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x04R\x05total\x12=\n" +
 	"\rpage_metadata\x18\x02 \x01(\v2\x18.readers.v1.PageMetadataR\fpageMetadata\x12/\n" +
@@ -755,12 +1137,14 @@ const file_readers_v1_readers_proto_rawDesc = "" +
 	"\aMessage\x120\n" +
 	"\x05senml\x18\x01 \x01(\v2\x18.readers.v1.SenMLMessageH\x00R\x05senml\x12-\n" +
 	"\x04json\x18\x02 \x01(\v2\x17.readers.v1.JsonMessageH\x00R\x04jsonB\t\n" +
-	"\apayload\"}\n" +
+	"\apayload\"\xb2\x01\n" +
 	"\vBaseMessage\x12\x18\n" +
 	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1a\n" +
 	"\bsubtopic\x18\x02 \x01(\tR\bsubtopic\x12\x1c\n" +
 	"\tpublisher\x18\x03 \x01(\tR\tpublisher\x12\x1a\n" +
-	"\bprotocol\x18\x04 \x01(\tR\bprotocol\"\xfb\x02\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12\x16\n" +
+	"\x06domain\x18\x05 \x01(\tR\x06domain\x12\x1b\n" +
+	"\tclient_id\x18\x06 \x01(\tR\bclientId\"\xfb\x02\n" +
 	"\fSenMLMessage\x12+\n" +
 	"\x04base\x18\x01 \x01(\v2\x17.readers.v1.BaseMessageR\x04base\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -789,16 +1173,47 @@ const file_readers_v1_readers_proto_rawDesc = "" +
 	"\n" +
 	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x1b\n" +
 	"\tdomain_id\x18\x02 \x01(\tR\bdomainId\x12=\n" +
-	"\rpage_metadata\x18\x03 \x01(\v2\x18.readers.v1.PageMetadataR\fpageMetadata*\x95\x01\n" +
+	"\rpage_metadata\x18\x03 \x01(\v2\x18.readers.v1.PageMetadataR\fpageMetadata\"\x9e\x02\n" +
+	"\vSeriesQuery\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12\x1a\n" +
+	"\bsubtopic\x18\x02 \x01(\tR\bsubtopic\x12\x1c\n" +
+	"\tpublisher\x18\x03 \x01(\tR\tpublisher\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\x01R\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\x01R\x02to\x129\n" +
+	"\vaggregation\x18\a \x01(\x0e2\x17.readers.v1.AggregationR\vaggregation\x12\x1a\n" +
+	"\binterval\x18\b \x01(\tR\binterval\x12\x12\n" +
+	"\x04fill\x18\t \x01(\tR\x04fill\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x04R\x05limit\"\x97\x01\n" +
+	"\rReadSeriesReq\x12\x1b\n" +
+	"\tdomain_id\x18\x01 \x01(\tR\bdomainId\x12\x1f\n" +
+	"\vchannel_ids\x18\x02 \x03(\tR\n" +
+	"channelIds\x12\x19\n" +
+	"\bgroup_id\x18\x03 \x01(\tR\agroupId\x12-\n" +
+	"\x05query\x18\x04 \x01(\v2\x17.readers.v1.SeriesQueryR\x05query\"@\n" +
+	"\x05Point\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x01R\x04time\x12\x19\n" +
+	"\x05value\x18\x02 \x01(\x01H\x00R\x05value\x88\x01\x01B\b\n" +
+	"\x06_value\"u\n" +
+	"\x06Series\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12)\n" +
+	"\x06points\x18\x04 \x03(\v2\x11.readers.v1.PointR\x06points\";\n" +
+	"\rReadSeriesRes\x12*\n" +
+	"\x06series\x18\x01 \x03(\v2\x12.readers.v1.SeriesR\x06series*\x95\x01\n" +
 	"\vAggregation\x12\x1b\n" +
 	"\x17AGGREGATION_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fAGGREGATION_MAX\x10\x01\x12\x13\n" +
 	"\x0fAGGREGATION_MIN\x10\x02\x12\x13\n" +
 	"\x0fAGGREGATION_SUM\x10\x03\x12\x15\n" +
 	"\x11AGGREGATION_COUNT\x10\x04\x12\x13\n" +
-	"\x0fAGGREGATION_AVG\x10\x052\\\n" +
+	"\x0fAGGREGATION_AVG\x10\x052\xa2\x01\n" +
 	"\x0eReadersService\x12J\n" +
-	"\fReadMessages\x12\x1b.readers.v1.ReadMessagesReq\x1a\x1b.readers.v1.ReadMessagesRes\"\x00B3Z1github.com/absmach/magistrala/api/grpc/readers/v1b\x06proto3"
+	"\fReadMessages\x12\x1b.readers.v1.ReadMessagesReq\x1a\x1b.readers.v1.ReadMessagesRes\"\x00\x12D\n" +
+	"\n" +
+	"ReadSeries\x12\x19.readers.v1.ReadSeriesReq\x1a\x19.readers.v1.ReadSeriesRes\"\x00B3Z1github.com/absmach/magistrala/api/grpc/readers/v1b\x06proto3"
 
 var (
 	file_readers_v1_readers_proto_rawDescOnce sync.Once
@@ -813,7 +1228,7 @@ func file_readers_v1_readers_proto_rawDescGZIP() []byte {
 }
 
 var file_readers_v1_readers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_readers_v1_readers_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_readers_v1_readers_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_readers_v1_readers_proto_goTypes = []any{
 	(Aggregation)(0),        // 0: readers.v1.Aggregation
 	(*PageMetadata)(nil),    // 1: readers.v1.PageMetadata
@@ -823,23 +1238,34 @@ var file_readers_v1_readers_proto_goTypes = []any{
 	(*SenMLMessage)(nil),    // 5: readers.v1.SenMLMessage
 	(*JsonMessage)(nil),     // 6: readers.v1.JsonMessage
 	(*ReadMessagesReq)(nil), // 7: readers.v1.ReadMessagesReq
+	(*SeriesQuery)(nil),     // 8: readers.v1.SeriesQuery
+	(*ReadSeriesReq)(nil),   // 9: readers.v1.ReadSeriesReq
+	(*Point)(nil),           // 10: readers.v1.Point
+	(*Series)(nil),          // 11: readers.v1.Series
+	(*ReadSeriesRes)(nil),   // 12: readers.v1.ReadSeriesRes
 }
 var file_readers_v1_readers_proto_depIdxs = []int32{
-	0, // 0: readers.v1.PageMetadata.aggregation:type_name -> readers.v1.Aggregation
-	1, // 1: readers.v1.ReadMessagesRes.page_metadata:type_name -> readers.v1.PageMetadata
-	3, // 2: readers.v1.ReadMessagesRes.messages:type_name -> readers.v1.Message
-	5, // 3: readers.v1.Message.senml:type_name -> readers.v1.SenMLMessage
-	6, // 4: readers.v1.Message.json:type_name -> readers.v1.JsonMessage
-	4, // 5: readers.v1.SenMLMessage.base:type_name -> readers.v1.BaseMessage
-	4, // 6: readers.v1.JsonMessage.base:type_name -> readers.v1.BaseMessage
-	1, // 7: readers.v1.ReadMessagesReq.page_metadata:type_name -> readers.v1.PageMetadata
-	7, // 8: readers.v1.ReadersService.ReadMessages:input_type -> readers.v1.ReadMessagesReq
-	2, // 9: readers.v1.ReadersService.ReadMessages:output_type -> readers.v1.ReadMessagesRes
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: readers.v1.PageMetadata.aggregation:type_name -> readers.v1.Aggregation
+	1,  // 1: readers.v1.ReadMessagesRes.page_metadata:type_name -> readers.v1.PageMetadata
+	3,  // 2: readers.v1.ReadMessagesRes.messages:type_name -> readers.v1.Message
+	5,  // 3: readers.v1.Message.senml:type_name -> readers.v1.SenMLMessage
+	6,  // 4: readers.v1.Message.json:type_name -> readers.v1.JsonMessage
+	4,  // 5: readers.v1.SenMLMessage.base:type_name -> readers.v1.BaseMessage
+	4,  // 6: readers.v1.JsonMessage.base:type_name -> readers.v1.BaseMessage
+	1,  // 7: readers.v1.ReadMessagesReq.page_metadata:type_name -> readers.v1.PageMetadata
+	0,  // 8: readers.v1.SeriesQuery.aggregation:type_name -> readers.v1.Aggregation
+	8,  // 9: readers.v1.ReadSeriesReq.query:type_name -> readers.v1.SeriesQuery
+	10, // 10: readers.v1.Series.points:type_name -> readers.v1.Point
+	11, // 11: readers.v1.ReadSeriesRes.series:type_name -> readers.v1.Series
+	7,  // 12: readers.v1.ReadersService.ReadMessages:input_type -> readers.v1.ReadMessagesReq
+	9,  // 13: readers.v1.ReadersService.ReadSeries:input_type -> readers.v1.ReadSeriesReq
+	2,  // 14: readers.v1.ReadersService.ReadMessages:output_type -> readers.v1.ReadMessagesRes
+	12, // 15: readers.v1.ReadersService.ReadSeries:output_type -> readers.v1.ReadSeriesRes
+	14, // [14:16] is the sub-list for method output_type
+	12, // [12:14] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_readers_v1_readers_proto_init() }
@@ -852,13 +1278,14 @@ func file_readers_v1_readers_proto_init() {
 		(*Message_Json)(nil),
 	}
 	file_readers_v1_readers_proto_msgTypes[4].OneofWrappers = []any{}
+	file_readers_v1_readers_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_readers_v1_readers_proto_rawDesc), len(file_readers_v1_readers_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
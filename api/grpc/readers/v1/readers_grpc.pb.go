@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ReadersService_ReadMessages_FullMethodName = "/readers.v1.ReadersService/ReadMessages"
+	ReadersService_ReadSeries_FullMethodName   = "/readers.v1.ReadersService/ReadSeries"
 )
 
 // ReadersServiceClient is the client API for ReadersService service.
@@ -33,6 +34,7 @@ const (
 // readers functionalities for Magistrala services.
 type ReadersServiceClient interface {
 	ReadMessages(ctx context.Context, in *ReadMessagesReq, opts ...grpc.CallOption) (*ReadMessagesRes, error)
+	ReadSeries(ctx context.Context, in *ReadSeriesReq, opts ...grpc.CallOption) (*ReadSeriesRes, error)
 }
 
 type readersServiceClient struct {
@@ -53,6 +55,16 @@ func (c *readersServiceClient) ReadMessages(ctx context.Context, in *ReadMessage
 	return out, nil
 }
 
+func (c *readersServiceClient) ReadSeries(ctx context.Context, in *ReadSeriesReq, opts ...grpc.CallOption) (*ReadSeriesRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadSeriesRes)
+	err := c.cc.Invoke(ctx, ReadersService_ReadSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReadersServiceServer is the server API for ReadersService service.
 // All implementations must embed UnimplementedReadersServiceServer
 // for forward compatibility.
@@ -61,6 +73,7 @@ func (c *readersServiceClient) ReadMessages(ctx context.Context, in *ReadMessage
 // readers functionalities for Magistrala services.
 type ReadersServiceServer interface {
 	ReadMessages(context.Context, *ReadMessagesReq) (*ReadMessagesRes, error)
+	ReadSeries(context.Context, *ReadSeriesReq) (*ReadSeriesRes, error)
 	mustEmbedUnimplementedReadersServiceServer()
 }
 
@@ -74,6 +87,9 @@ type UnimplementedReadersServiceServer struct{}
 func (UnimplementedReadersServiceServer) ReadMessages(context.Context, *ReadMessagesReq) (*ReadMessagesRes, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReadMessages not implemented")
 }
+func (UnimplementedReadersServiceServer) ReadSeries(context.Context, *ReadSeriesReq) (*ReadSeriesRes, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReadSeries not implemented")
+}
 func (UnimplementedReadersServiceServer) mustEmbedUnimplementedReadersServiceServer() {}
 func (UnimplementedReadersServiceServer) testEmbeddedByValue()                        {}
 
@@ -113,6 +129,24 @@ func _ReadersService_ReadMessages_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReadersService_ReadSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSeriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReadersServiceServer).ReadSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReadersService_ReadSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReadersServiceServer).ReadSeries(ctx, req.(*ReadSeriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ReadersService_ServiceDesc is the grpc.ServiceDesc for ReadersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -124,6 +158,10 @@ var ReadersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadMessages",
 			Handler:    _ReadersService_ReadMessages_Handler,
 		},
+		{
+			MethodName: "ReadSeries",
+			Handler:    _ReadersService_ReadSeries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "readers/v1/readers.proto",
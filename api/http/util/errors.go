@@ -210,6 +210,27 @@ var (
 	// ErrMissingTo indicates missing to value.
 	ErrMissingTo = errors.NewRequestError("missing to time value")
 
+	// ErrInvalidTimeRange indicates that the to time is not after the from time.
+	ErrInvalidTimeRange = errors.NewRequestError("to time must be after from time")
+
+	// ErrMissingMeasurementNames indicates missing measurement names.
+	ErrMissingMeasurementNames = errors.NewRequestError("missing measurement names")
+
+	// ErrInvalidFill indicates invalid gap filling method.
+	ErrInvalidFill = errors.NewRequestError("invalid gap filling method")
+
+	// ErrTooManySeries indicates that the query exceeds the number of series allowed.
+	ErrTooManySeries = errors.NewRequestError("too many channels or measurements requested")
+
+	// ErrTooManyPoints indicates that the query exceeds the number of points per series allowed.
+	ErrTooManyPoints = errors.NewRequestError("too many points requested, increase the interval or shorten the time range")
+
+	// ErrInvalidCursor indicates the cursor combined with the offset, aggregation or non-time ordering.
+	ErrInvalidCursor = errors.NewRequestError("cursor can be used only with time ordering, without offset and aggregation")
+
+	// ErrInvalidExportOutput indicates invalid export output format.
+	ErrInvalidExportOutput = errors.NewRequestError("invalid export output format")
+
 	// ErrEmptyMessage indicates empty message.
 	ErrEmptyMessage = errors.NewRequestError("empty message")
 
@@ -51,9 +51,27 @@ var (
 
 // alarmOnlyOperations are RulesType operations authorized at the domain level; only wildcard entity ID is valid.
 var alarmOnlyOperations = map[string]struct{}{
-	"alarm_assign":      {},
-	"alarm_acknowledge": {},
-	"alarm_resolve":     {},
+	"alarm_assign":        {},
+	"alarm_acknowledge":   {},
+	"alarm_resolve":       {},
+	"alarm_snooze":        {},
+	"alarm_reopen":        {},
+	"escalation_create":   {},
+	"escalation_view":     {},
+	"escalation_update":   {},
+	"escalation_delete":   {},
+	"notification_create": {},
+	"notification_view":   {},
+	"notification_update": {},
+	"notification_delete": {},
+	"suppression_create":  {},
+	"suppression_view":    {},
+	"suppression_update":  {},
+	"suppression_delete":  {},
+	"comment_create":      {},
+	"comment_view":        {},
+	"comment_delete":      {},
+	"timeline_view":       {},
 }
 
 type Operation = permissions.Operation
@@ -0,0 +1,176 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"time"
+
+	smqsdk "github.com/absmach/magistrala/pkg/sdk"
+	"github.com/spf13/cobra"
+)
+
+// NewAlarmsCmd returns alarms command.
+func NewAlarmsCmd() *cobra.Command {
+	var groupBy []string
+	var bucket, from, to string
+
+	statsCmd := cobra.Command{
+		Use:   "stats <domain_id> <user_auth_token>",
+		Short: "Alarm statistics",
+		Long: "Count the alarms and compute the mean time to acknowledge and resolve them\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli alarms stats <domain_id> <user_auth_token> - shows the statistics of all the alarms\n" +
+			"\tmagistrala-cli alarms stats <domain_id> <user_auth_token> --group-by channel_id,severity --bucket week - shows the weekly statistics per channel and severity\n" +
+			"\tmagistrala-cli alarms stats <domain_id> <user_auth_token> --status resolved --from 2025-01-01T00:00:00Z - shows the statistics of the alarms resolved since the given time\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			pageMetadata := smqsdk.PageMetadata{
+				Status:  Status,
+				GroupBy: groupBy,
+				Bucket:  bucket,
+			}
+			var err error
+			if from != "" {
+				if pageMetadata.CreatedFrom, err = time.Parse(time.RFC3339, from); err != nil {
+					logErrorCmd(*cmd, err)
+					return
+				}
+			}
+			if to != "" {
+				if pageMetadata.CreatedTo, err = time.Parse(time.RFC3339, to); err != nil {
+					logErrorCmd(*cmd, err)
+					return
+				}
+			}
+
+			stats, err := sdk.AlarmStats(cmd.Context(), pageMetadata, args[0], args[1])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, stats)
+		},
+	}
+	statsCmd.Flags().StringSliceVar(&groupBy, "group-by", nil, "group by rule_id, channel_id, client_id, severity or status")
+	statsCmd.Flags().StringVar(&bucket, "bucket", "", "time bucket: hour, day, week or month")
+	statsCmd.Flags().StringVar(&from, "from", "", "alarms created from the RFC3339 time")
+	statsCmd.Flags().StringVar(&to, "to", "", "alarms created up to the RFC3339 time")
+
+	var parentID string
+
+	commentCmd := cobra.Command{
+		Use:   "comment <alarm_id> <body> <domain_id> <user_auth_token>",
+		Short: "Comment alarm",
+		Long: "Add the comment to the alarm\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli alarms comment <alarm_id> <body> <domain_id> <user_auth_token> - adds the comment to the alarm\n" +
+			"\tmagistrala-cli alarms comment <alarm_id> <body> <domain_id> <user_auth_token> --parent <comment_id> - replies to the comment\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 4 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			comment := smqsdk.AlarmComment{
+				AlarmID:  args[0],
+				Body:     args[1],
+				ParentID: parentID,
+			}
+
+			c, err := sdk.AddAlarmComment(cmd.Context(), comment, args[2], args[3])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, c)
+		},
+	}
+	commentCmd.Flags().StringVar(&parentID, "parent", "", "ID of the comment to reply to")
+
+	commentsCmd := cobra.Command{
+		Use:   "comments <alarm_id> <domain_id> <user_auth_token>",
+		Short: "List alarm comments",
+		Long: "List the comments of the alarm, from the oldest one\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli alarms comments <alarm_id> <domain_id> <user_auth_token> - lists the alarm comments\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 3 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			pageMetadata := smqsdk.PageMetadata{
+				Offset: Offset,
+				Limit:  Limit,
+			}
+
+			cp, err := sdk.AlarmComments(cmd.Context(), args[0], pageMetadata, args[1], args[2])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, cp)
+		},
+	}
+
+	uncommentCmd := cobra.Command{
+		Use:   "uncomment <alarm_id> <comment_id> <domain_id> <user_auth_token>",
+		Short: "Remove alarm comment",
+		Long: "Remove the alarm comment and its replies\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli alarms uncomment <alarm_id> <comment_id> <domain_id> <user_auth_token> - removes the alarm comment\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 4 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+
+			if err := sdk.RemoveAlarmComment(cmd.Context(), args[0], args[1], args[2], args[3]); err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logOKCmd(*cmd)
+		},
+	}
+
+	timelineCmd := cobra.Command{
+		Use:   "timeline <alarm_id> <domain_id> <user_auth_token>",
+		Short: "Alarm timeline",
+		Long: "List the activities of the alarm, from the oldest one\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli alarms timeline <alarm_id> <domain_id> <user_auth_token> - shows who assigned, acknowledged, resolved and commented the alarm\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 3 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			pageMetadata := smqsdk.PageMetadata{
+				Offset: Offset,
+				Limit:  Limit,
+			}
+
+			tl, err := sdk.AlarmTimeline(cmd.Context(), args[0], pageMetadata, args[1], args[2])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, tl)
+		},
+	}
+
+	cmd := cobra.Command{
+		Use:   "alarms [stats | comment | comments | uncomment | timeline]",
+		Short: "Alarms management",
+		Long:  `Alarms management: alarm statistics, comments and timeline`,
+	}
+	cmd.AddCommand(&statsCmd, &commentCmd, &commentsCmd, &uncommentCmd, &timelineCmd)
+
+	return &cmd
+}
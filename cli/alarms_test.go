@@ -0,0 +1,370 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/absmach/magistrala/cli"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	mgsdk "github.com/absmach/magistrala/pkg/sdk"
+	sdkmocks "github.com/absmach/magistrala/pkg/sdk/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	statsCmd     = "stats"
+	commentCmd   = "comment"
+	uncommentCmd = "uncomment"
+	timelineCmd  = "timeline"
+)
+
+func TestAlarmStatsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	alarmsCmd := cli.NewAlarmsCmd()
+	rootCmd := setFlags(alarmsCmd)
+
+	domainID := testsutil.GenerateUUID(t)
+	severity := uint8(80)
+	stats := mgsdk.AlarmStats{
+		Groups: []mgsdk.AlarmStatsGroup{
+			{
+				ChannelID:    testsutil.GenerateUUID(t),
+				Severity:     &severity,
+				Count:        4,
+				Acknowledged: 2,
+				Resolved:     1,
+				MTTA:         120,
+				MTTR:         600,
+			},
+		},
+	}
+
+	var res mgsdk.AlarmStats
+
+	cases := []struct {
+		desc          string
+		args          []string
+		pm            mgsdk.PageMetadata
+		sdkErr        errors.SDKError
+		stats         mgsdk.AlarmStats
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "alarm stats successfully",
+			args: []string{
+				domainID,
+				token,
+				"--group-by",
+				"channel_id,severity",
+				"--bucket",
+				"week",
+			},
+			pm:      mgsdk.PageMetadata{GroupBy: []string{"channel_id", "severity"}, Bucket: "week"},
+			logType: entityLog,
+			stats:   stats,
+		},
+		{
+			desc: "alarm stats with invalid args",
+			args: []string{
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "alarm stats with invalid token",
+			args: []string{
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+		{
+			desc: "alarm stats with invalid time",
+			args: []string{
+				domainID,
+				token,
+				"--from",
+				"yesterday",
+			},
+			logType:       errLog,
+			errLogMessage: "\nerror: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\"\n\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("AlarmStats", mock.Anything, mock.Anything, tc.args[0], tc.args[1]).Return(tc.stats, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{statsCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &res)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.stats, res, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.stats, res))
+				sdkCall.Parent.AssertCalled(t, "AlarmStats", mock.Anything, tc.pm, tc.args[0], tc.args[1])
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestAlarmCommentCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	alarmsCmd := cli.NewAlarmsCmd()
+	rootCmd := setFlags(alarmsCmd)
+
+	domainID := testsutil.GenerateUUID(t)
+	alarmID := testsutil.GenerateUUID(t)
+	parentID := testsutil.GenerateUUID(t)
+	comment := mgsdk.AlarmComment{
+		ID:        testsutil.GenerateUUID(t),
+		AlarmID:   alarmID,
+		DomainID:  domainID,
+		ParentID:  parentID,
+		Body:      "sensor replaced",
+		CreatedBy: testsutil.GenerateUUID(t),
+	}
+
+	var res mgsdk.AlarmComment
+
+	cases := []struct {
+		desc          string
+		args          []string
+		comment       mgsdk.AlarmComment
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "comment alarm successfully",
+			args: []string{
+				alarmID,
+				"sensor replaced",
+				domainID,
+				token,
+				"--parent",
+				parentID,
+			},
+			comment: comment,
+			logType: entityLog,
+		},
+		{
+			desc: "comment alarm with invalid args",
+			args: []string{
+				alarmID,
+				"sensor replaced",
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "comment alarm with invalid token",
+			args: []string{
+				alarmID,
+				"sensor replaced",
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("AddAlarmComment", mock.Anything, mock.Anything, domainID, tc.args[3]).Return(tc.comment, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{commentCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &res)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.comment, res, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.comment, res))
+				sdkCall.Parent.AssertCalled(t, "AddAlarmComment", mock.Anything, mgsdk.AlarmComment{AlarmID: alarmID, Body: "sensor replaced", ParentID: parentID}, domainID, token)
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestAlarmUncommentCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	alarmsCmd := cli.NewAlarmsCmd()
+	rootCmd := setFlags(alarmsCmd)
+
+	domainID := testsutil.GenerateUUID(t)
+	alarmID := testsutil.GenerateUUID(t)
+	commentID := testsutil.GenerateUUID(t)
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "remove alarm comment successfully",
+			args: []string{
+				alarmID,
+				commentID,
+				domainID,
+				token,
+			},
+			logType: okLog,
+		},
+		{
+			desc: "remove alarm comment with invalid args",
+			args: []string{
+				alarmID,
+				commentID,
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "remove alarm comment with invalid token",
+			args: []string{
+				alarmID,
+				commentID,
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("RemoveAlarmComment", mock.Anything, tc.args[0], tc.args[1], tc.args[2], tc.args[3]).Return(tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{uncommentCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case okLog:
+				assert.True(t, strings.Contains(out, "ok"), fmt.Sprintf("%s unexpected response: expected success message, got: %v", tc.desc, out))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestAlarmTimelineCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	alarmsCmd := cli.NewAlarmsCmd()
+	rootCmd := setFlags(alarmsCmd)
+
+	domainID := testsutil.GenerateUUID(t)
+	alarmID := testsutil.GenerateUUID(t)
+	timeline := mgsdk.AlarmTimeline{
+		Limit: 10,
+		Total: 1,
+		Activities: []mgsdk.AlarmActivity{
+			{
+				ID:       testsutil.GenerateUUID(t),
+				AlarmID:  alarmID,
+				DomainID: domainID,
+				Type:     "acknowledged",
+				ActorID:  testsutil.GenerateUUID(t),
+			},
+		},
+	}
+
+	var res mgsdk.AlarmTimeline
+
+	cases := []struct {
+		desc          string
+		args          []string
+		timeline      mgsdk.AlarmTimeline
+		sdkErr        errors.SDKError
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "alarm timeline successfully",
+			args: []string{
+				alarmID,
+				domainID,
+				token,
+			},
+			timeline: timeline,
+			logType:  entityLog,
+		},
+		{
+			desc: "alarm timeline with invalid args",
+			args: []string{
+				alarmID,
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "alarm timeline with invalid token",
+			args: []string{
+				alarmID,
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("AlarmTimeline", mock.Anything, tc.args[0], mock.Anything, tc.args[1], tc.args[2]).Return(tc.timeline, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{timelineCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &res)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.timeline, res, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.timeline, res))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
@@ -26,6 +26,8 @@ const (
 	defGroupsURL       string = defURL + ":9004"
 	defHTTPURL         string = defURL + ":8008"
 	defJournalURL      string = defURL + ":9021"
+	defRulesEngineURL  string = defURL + ":9008"
+	defAlarmsURL       string = defURL + ":8050"
 	defTLSVerification bool   = false
 	defOffset          string = "0"
 	defLimit           string = "10"
@@ -42,6 +44,8 @@ type remotes struct {
 	HTTPAdapterURL  string `toml:"http_adapter_url"`
 	CertsURL        string `toml:"certs_url"`
 	JournalURL      string `toml:"journal_url"`
+	RulesEngineURL  string `toml:"re_url"`
+	AlarmsURL       string `toml:"alarms_url"`
 	HostURL         string `toml:"host_url"`
 	TLSVerification bool   `toml:"tls_verification"`
 }
@@ -111,6 +115,8 @@ func ParseConfig(sdkConf smqsdk.Config) (smqsdk.Config, error) {
 				GroupsURL:       defGroupsURL,
 				HTTPAdapterURL:  defHTTPURL,
 				JournalURL:      defJournalURL,
+				RulesEngineURL:  defRulesEngineURL,
+				AlarmsURL:       defAlarmsURL,
 				HostURL:         defURL,
 				TLSVerification: defTLSVerification,
 			},
@@ -198,6 +204,14 @@ func ParseConfig(sdkConf smqsdk.Config) (smqsdk.Config, error) {
 		sdkConf.JournalURL = config.Remotes.JournalURL
 	}
 
+	if sdkConf.RulesEngineURL == "" && config.Remotes.RulesEngineURL != "" {
+		sdkConf.RulesEngineURL = config.Remotes.RulesEngineURL
+	}
+
+	if sdkConf.AlarmsURL == "" && config.Remotes.AlarmsURL != "" {
+		sdkConf.AlarmsURL = config.Remotes.AlarmsURL
+	}
+
 	if sdkConf.HostURL == "" && config.Remotes.HostURL != "" {
 		sdkConf.HostURL = config.Remotes.HostURL
 	}
@@ -253,6 +267,8 @@ func setConfigValue(key, value string) error {
 		"users_url":        &config.Remotes.UsersURL,
 		"http_adapter_url": &config.Remotes.HTTPAdapterURL,
 		"certs_url":        &config.Remotes.CertsURL,
+		"re_url":           &config.Remotes.RulesEngineURL,
+		"alarms_url":       &config.Remotes.AlarmsURL,
 		"tls_verification": &config.Remotes.TLSVerification,
 		"offset":           &config.Filter.Offset,
 		"limit":            &config.Filter.Limit,
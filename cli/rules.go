@@ -0,0 +1,139 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"strconv"
+
+	smqsdk "github.com/absmach/magistrala/pkg/sdk"
+	"github.com/spf13/cobra"
+)
+
+var cmdRules = []cobra.Command{
+	{
+		Use:   "executions <rule_id> <domain_id> <user_auth_token>",
+		Short: "List rule executions",
+		Long: "List the execution history of a rule\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli rules executions <rule_id> <domain_id> <user_auth_token> - lists rule executions\n" +
+			"\tmagistrala-cli rules executions <rule_id> <domain_id> <user_auth_token> --status failed --offset <offset> --limit <limit> - lists failed rule executions with provided offset and limit\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 3 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			pageMetadata := smqsdk.PageMetadata{
+				Offset: Offset,
+				Limit:  Limit,
+				Status: Status,
+			}
+
+			page, err := sdk.ListRuleExecutions(cmd.Context(), args[0], pageMetadata, args[1], args[2])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, page)
+		},
+	},
+	{
+		Use:   "revisions <rule_id> <domain_id> <user_auth_token>",
+		Short: "List rule revisions",
+		Long: "List the revisions of a rule, newest first\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli rules revisions <rule_id> <domain_id> <user_auth_token> - lists rule revisions\n" +
+			"\tmagistrala-cli rules revisions <rule_id> <domain_id> <user_auth_token> --offset <offset> --limit <limit> - lists rule revisions with provided offset and limit\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 3 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			pageMetadata := smqsdk.PageMetadata{
+				Offset: Offset,
+				Limit:  Limit,
+			}
+
+			page, err := sdk.ListRuleRevisions(cmd.Context(), args[0], pageMetadata, args[1], args[2])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, page)
+		},
+	},
+	{
+		Use:   "diff <rule_id> <from_revision> <to_revision> <domain_id> <user_auth_token>",
+		Short: "Diff rule revisions",
+		Long: "Show the rule changes from one revision to another\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli rules diff <rule_id> 1 3 <domain_id> <user_auth_token> - shows the changes from revision 1 to revision 3\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 5 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			from, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+			to, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			diff, err := sdk.DiffRuleRevisions(cmd.Context(), args[0], from, to, args[3], args[4])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, diff)
+		},
+	},
+	{
+		Use:   "rollback <rule_id> <revision> <domain_id> <user_auth_token>",
+		Short: "Rollback rule",
+		Long: "Restore the rule as it was in the revision\n" +
+			"Usage:\n" +
+			"\tmagistrala-cli rules rollback <rule_id> 2 <domain_id> <user_auth_token> - restores revision 2 of the rule\n",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 4 {
+				logUsageCmd(*cmd, cmd.Use)
+				return
+			}
+			revision, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			rule, err := sdk.RollbackRule(cmd.Context(), args[0], revision, args[2], args[3])
+			if err != nil {
+				logErrorCmd(*cmd, err)
+				return
+			}
+
+			logJSONCmd(*cmd, rule)
+		},
+	},
+}
+
+// NewRulesCmd returns rules command.
+func NewRulesCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "rules [executions | revisions | diff | rollback]",
+		Short: "Rules engine management",
+		Long:  `Rules engine management: list rule executions and revisions, diff and roll back revisions`,
+	}
+
+	for i := range cmdRules {
+		cmd.AddCommand(&cmdRules[i])
+	}
+
+	return &cmd
+}
@@ -0,0 +1,283 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/absmach/magistrala/cli"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	mgsdk "github.com/absmach/magistrala/pkg/sdk"
+	sdkmocks "github.com/absmach/magistrala/pkg/sdk/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	executionsCmd = "executions"
+	revisionsCmd  = "revisions"
+	rollbackCmd   = "rollback"
+)
+
+func TestListRuleExecutionsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	ruleID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	execution := mgsdk.Execution{
+		ID:       testsutil.GenerateUUID(t),
+		RuleID:   ruleID,
+		DomainID: domainID,
+		Status:   "success",
+		Outputs:  []string{"channels"},
+	}
+
+	var page mgsdk.ExecutionsPage
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		page          mgsdk.ExecutionsPage
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "list rule executions successfully",
+			args: []string{
+				ruleID,
+				domainID,
+				token,
+			},
+			logType: entityLog,
+			page: mgsdk.ExecutionsPage{
+				Total:      1,
+				Offset:     0,
+				Limit:      10,
+				Executions: []mgsdk.Execution{execution},
+			},
+		},
+		{
+			desc: "list rule executions with invalid args",
+			args: []string{
+				ruleID,
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "list rule executions with invalid token",
+			args: []string{
+				ruleID,
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("ListRuleExecutions", mock.Anything, tc.args[0], mock.Anything, tc.args[1], tc.args[2]).Return(tc.page, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{executionsCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &page)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.page, page, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.page, page))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestListRuleRevisionsCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	ruleID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	revision := mgsdk.Revision{
+		RuleID:   ruleID,
+		Revision: 2,
+		Spec:     mgsdk.Rule{Name: "rule"},
+	}
+
+	var page mgsdk.RevisionsPage
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		page          mgsdk.RevisionsPage
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "list rule revisions successfully",
+			args: []string{
+				ruleID,
+				domainID,
+				token,
+			},
+			logType: entityLog,
+			page: mgsdk.RevisionsPage{
+				Total:     1,
+				Limit:     10,
+				Revisions: []mgsdk.Revision{revision},
+			},
+		},
+		{
+			desc: "list rule revisions with invalid args",
+			args: []string{
+				ruleID,
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "list rule revisions with invalid token",
+			args: []string{
+				ruleID,
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("ListRuleRevisions", mock.Anything, tc.args[0], mock.Anything, tc.args[1], tc.args[2]).Return(tc.page, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{revisionsCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &page)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.page, page, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.page, page))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
+
+func TestRollbackRuleCmd(t *testing.T) {
+	sdkMock := new(sdkmocks.SDK)
+	cli.SetSDK(sdkMock)
+	rulesCmd := cli.NewRulesCmd()
+	rootCmd := setFlags(rulesCmd)
+
+	ruleID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	rule := mgsdk.Rule{
+		ID:       ruleID,
+		Name:     "rule",
+		Revision: 3,
+	}
+
+	var res mgsdk.Rule
+
+	cases := []struct {
+		desc          string
+		args          []string
+		sdkErr        errors.SDKError
+		rule          mgsdk.Rule
+		logType       outputLog
+		errLogMessage string
+	}{
+		{
+			desc: "rollback rule successfully",
+			args: []string{
+				ruleID,
+				"1",
+				domainID,
+				token,
+			},
+			logType: entityLog,
+			rule:    rule,
+		},
+		{
+			desc: "rollback rule with invalid args",
+			args: []string{
+				ruleID,
+				"1",
+				domainID,
+				token,
+				extraArg,
+			},
+			logType: usageLog,
+		},
+		{
+			desc: "rollback rule with invalid revision",
+			args: []string{
+				ruleID,
+				"invalid",
+				domainID,
+				token,
+			},
+			logType:       errLog,
+			errLogMessage: "\nerror: strconv.ParseUint: parsing \"invalid\": invalid syntax\n\n",
+		},
+		{
+			desc: "rollback rule with invalid token",
+			args: []string{
+				ruleID,
+				"1",
+				domainID,
+				invalidToken,
+			},
+			logType:       errLog,
+			sdkErr:        errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden),
+			errLogMessage: fmt.Sprintf("\nerror: %s\n\n", errors.NewSDKErrorWithStatus(svcerr.ErrAuthorization, http.StatusForbidden)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sdkCall := sdkMock.On("RollbackRule", mock.Anything, tc.args[0], uint64(1), tc.args[2], tc.args[3]).Return(tc.rule, tc.sdkErr)
+			out := executeCommand(t, rootCmd, append([]string{rollbackCmd}, tc.args...)...)
+
+			switch tc.logType {
+			case entityLog:
+				err := json.Unmarshal([]byte(out), &res)
+				assert.Nil(t, err)
+				assert.Equal(t, tc.rule, res, fmt.Sprintf("%v unexpected response, expected: %v, got: %v", tc.desc, tc.rule, res))
+			case errLog:
+				assert.Equal(t, tc.errLogMessage, out, fmt.Sprintf("%s unexpected error response: expected %s got errLogMessage:%s", tc.desc, tc.errLogMessage, out))
+			case usageLog:
+				assert.False(t, strings.Contains(out, rootCmd.Use), fmt.Sprintf("%s invalid usage: %s", tc.desc, out))
+			}
+			sdkCall.Unset()
+		})
+	}
+}
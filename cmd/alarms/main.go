@@ -7,26 +7,36 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/absmach/magistrala/alarms"
 	httpAPI "github.com/absmach/magistrala/alarms/api"
 	"github.com/absmach/magistrala/alarms/brokers"
 	"github.com/absmach/magistrala/alarms/consumer"
+	"github.com/absmach/magistrala/alarms/events"
 	"github.com/absmach/magistrala/alarms/middleware"
 	"github.com/absmach/magistrala/alarms/operations"
 	alarmsRepo "github.com/absmach/magistrala/alarms/postgres"
+	"github.com/absmach/magistrala/alarms/senders"
+	"github.com/absmach/magistrala/consumers"
+	"github.com/absmach/magistrala/consumers/notifiers/smpp"
 	dpostgres "github.com/absmach/magistrala/domains/postgres"
+	"github.com/absmach/magistrala/internal/email"
 	mglog "github.com/absmach/magistrala/logger"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/authn/authsvc"
 	authsvcAuthz "github.com/absmach/magistrala/pkg/authz/authsvc"
 	dconsumer "github.com/absmach/magistrala/pkg/domains/events/consumer"
 	domainsAuthz "github.com/absmach/magistrala/pkg/domains/grpcclient"
+	"github.com/absmach/magistrala/pkg/emailer"
 	"github.com/absmach/magistrala/pkg/grpcclient"
 	"github.com/absmach/magistrala/pkg/jaeger"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
 	"github.com/absmach/magistrala/pkg/messaging"
+	smqbrokers "github.com/absmach/magistrala/pkg/messaging/brokers"
 	brokerstracing "github.com/absmach/magistrala/pkg/messaging/brokers/tracing"
 	"github.com/absmach/magistrala/pkg/permissions"
 	"github.com/absmach/magistrala/pkg/postgres"
@@ -34,6 +44,7 @@ import (
 	rconsumer "github.com/absmach/magistrala/pkg/re/events/consumer"
 	"github.com/absmach/magistrala/pkg/server"
 	httpserver "github.com/absmach/magistrala/pkg/server/http"
+	"github.com/absmach/magistrala/pkg/ticker"
 	"github.com/absmach/magistrala/pkg/uuid"
 	rpostgres "github.com/absmach/magistrala/re/postgres"
 	"github.com/caarlos0/env/v11"
@@ -41,25 +52,31 @@ import (
 )
 
 const (
-	svcName          = "alarms"
-	envPrefixDB      = "MG_ALARMS_DB_"
-	envPrefixHTTP    = "MG_ALARMS_HTTP_"
-	envPrefixAuth    = "MG_AUTH_GRPC_"
-	defDB            = "alarms"
-	defSvcHTTPPort   = "8050"
-	envPrefixDomains = "MG_DOMAINS_GRPC_"
-	alarmEntity      = "alarm"
+	svcName           = "alarms"
+	envPrefixDB       = "MG_ALARMS_DB_"
+	envPrefixHTTP     = "MG_ALARMS_HTTP_"
+	envPrefixAuth     = "MG_AUTH_GRPC_"
+	defDB             = "alarms"
+	defSvcHTTPPort    = "8050"
+	envPrefixDomains  = "MG_DOMAINS_GRPC_"
+	envPrefixChannels = "MG_CHANNELS_GRPC_"
+	alarmEntity       = "alarm"
+	channBuffer       = 256
 )
 
 type config struct {
-	LogLevel        string  `env:"MG_ALARMS_LOG_LEVEL"    envDefault:"info"`
-	BrokerURL       string  `env:"MG_MESSAGE_BROKER_URL" envDefault:"nats://localhost:4222"`
-	InstanceID      string  `env:"MG_ALARMS_INSTANCE_ID"  envDefault:""`
-	JaegerURL       url.URL `env:"MG_JAEGER_URL"         envDefault:"http://localhost:4318/v1/traces"`
-	TraceRatio      float64 `env:"MG_JAEGER_TRACE_RATIO" envDefault:"1.0"`
-	ESURL           string  `env:"MG_ES_URL"             envDefault:"nats://localhost:4222"`
-	ESConsumerName  string  `env:"MG_ALARMS_EVENT_CONSUMER" envDefault:"alarms"`
-	PermissionsFile string  `env:"MG_PERMISSIONS_FILE"             envDefault:"permission.yaml"`
+	LogLevel        string        `env:"MG_ALARMS_LOG_LEVEL"    envDefault:"info"`
+	BrokerURL       string        `env:"MG_MESSAGE_BROKER_URL" envDefault:"nats://localhost:4222"`
+	InstanceID      string        `env:"MG_ALARMS_INSTANCE_ID"  envDefault:""`
+	JaegerURL       url.URL       `env:"MG_JAEGER_URL"         envDefault:"http://localhost:4318/v1/traces"`
+	TraceRatio      float64       `env:"MG_JAEGER_TRACE_RATIO" envDefault:"1.0"`
+	ESURL           string        `env:"MG_ES_URL"             envDefault:"nats://localhost:4222"`
+	ESConsumerName  string        `env:"MG_ALARMS_EVENT_CONSUMER" envDefault:"alarms"`
+	PermissionsFile string        `env:"MG_PERMISSIONS_FILE"             envDefault:"permission.yaml"`
+	SMSFrom         string        `env:"MG_ALARMS_SMS_FROM"    envDefault:""`
+	FlapThreshold   uint64        `env:"MG_ALARMS_FLAPPING_THRESHOLD" envDefault:"5"`
+	FlapWindow      time.Duration `env:"MG_ALARMS_FLAPPING_WINDOW"    envDefault:"10m"`
+	EncKey          string        `env:"MG_ALARMS_ENCRYPT_KEY"        envDefault:"12345678910111213141516171819202"`
 }
 
 func main() {
@@ -79,6 +96,14 @@ func main() {
 	var exitCode int
 	defer mglog.ExitWithError(&exitCode)
 
+	if cfg.InstanceID == "" {
+		if cfg.InstanceID, err = uuid.New().ID(); err != nil {
+			logger.Error(fmt.Sprintf("failed to generate instanceID: %s", err))
+			exitCode = 1
+			return
+		}
+	}
+
 	tp, err := jaeger.NewProvider(ctx, svcName, cfg.JaegerURL, cfg.InstanceID, cfg.TraceRatio)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to init Jaeger: %s", err))
@@ -175,7 +200,41 @@ func main() {
 
 	idp := uuid.New()
 
-	svc := alarms.NewService(idp, repo)
+	notifier, err := events.NewNotifier(ctx, cfg.ESURL)
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create escalation notifier: %s", err))
+		exitCode = 1
+		return
+	}
+
+	runInfo := make(chan pkglog.RunInfo, channBuffer)
+	go func() {
+		for info := range runInfo {
+			logger.LogAttrs(context.Background(), info.Level, info.Message, info.Details...)
+		}
+	}()
+
+	sender, err := newSender(cfg, logger)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+
+	hub := alarms.NewStreamHub()
+	streamConsumer := fmt.Sprintf("%s-stream-%s", cfg.ESConsumerName, cfg.InstanceID)
+	if err := events.StreamEventsSubscribe(ctx, hub, cfg.ESURL, streamConsumer, logger); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to created alarms: %s", err))
+		exitCode = 1
+		return
+	}
+
+	svc, err := alarms.NewService(idp, repo, notifier, sender, hub, alarms.Flapping{Threshold: cfg.FlapThreshold, Window: cfg.FlapWindow}, runInfo, ticker.NewTicker(time.Second*30), []byte(cfg.EncKey))
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to create service: %s", err))
+		exitCode = 1
+		return
+	}
 
 	permConfig, err := permissions.ParsePermissionsFile(cfg.PermissionsFile)
 	if err != nil {
@@ -223,7 +282,32 @@ func main() {
 		exitCode = 1
 		return
 	}
-	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpAPI.MakeHandler(svc, logger, idp, cfg.InstanceID, am), logger)
+
+	channelsClientCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&channelsClientCfg, env.Options{Prefix: envPrefixChannels}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load channels gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+	channelsClient, channelsHandler, err := grpcclient.SetupChannelsClient(ctx, channelsClientCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer channelsHandler.Close()
+	logger.Info("Channels service gRPC client successfully connected to channels gRPC server " + channelsHandler.Secure())
+
+	msgSub, err := smqbrokers.NewPubSub(ctx, cfg.BrokerURL, logger, smqbrokers.ConnectionName("alarms-msg-pubsub"))
+	if err != nil {
+		logger.Error(fmt.Sprintf("failed to connect to message broker for messages pubSub: %s", err))
+		exitCode = 1
+		return
+	}
+	defer msgSub.Close()
+	msgSub = brokerstracing.NewPubSub(httpServerConfig, tracer, msgSub)
+
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpAPI.MakeHandler(svc, msgSub, channelsClient, logger, idp, cfg.InstanceID, am), logger)
 
 	pubSub, err := brokers.NewPubSub(ctx, cfg.BrokerURL, logger)
 	if err != nil {
@@ -253,6 +337,10 @@ func main() {
 		return hs.Start()
 	})
 
+	g.Go(func() error {
+		return svc.StartScheduler(ctx)
+	})
+
 	g.Go(func() error {
 		return server.StopSignalHandler(ctx, cancel, logger, svcName, hs)
 	})
@@ -261,3 +349,30 @@ func main() {
 		logger.Error(fmt.Sprintf("billing service terminated: %s", err))
 	}
 }
+
+// newSender creates the notification sender. The email and the SMS
+// notifications are disabled if they aren't configured.
+func newSender(cfg config, logger *slog.Logger) (alarms.Sender, error) {
+	ec := email.Config{}
+	if err := env.Parse(&ec); err != nil {
+		return nil, fmt.Errorf("failed to load email configuration : %w", err)
+	}
+	e, err := emailer.New(&ec)
+	if err != nil {
+		logger.Warn(fmt.Sprintf("email notifications are disabled: %s", err))
+		e = nil
+	}
+
+	sc := smpp.Config{}
+	if err := env.Parse(&sc); err != nil {
+		return nil, fmt.Errorf("failed to load SMPP configuration : %w", err)
+	}
+	var sms consumers.Notifier
+	if sc.Address != "" {
+		sms = smpp.New(sc)
+	} else {
+		logger.Warn("sms notifications are disabled: SMPP address is not set")
+	}
+
+	return senders.New(e, sms, cfg.SMSFrom), nil
+}
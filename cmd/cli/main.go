@@ -45,6 +45,8 @@ func main() {
 	invitationsCmd := cli.NewInvitationsCmd()
 	journalCmd := cli.NewJournalCmd()
 	certsCmd := cli.NewCertsCmd()
+	rulesCmd := cli.NewRulesCmd()
+	alarmsCmd := cli.NewAlarmsCmd()
 
 	// Root Commands
 	rootCmd.AddCommand(healthCmd)
@@ -58,6 +60,8 @@ func main() {
 	rootCmd.AddCommand(invitationsCmd)
 	rootCmd.AddCommand(journalCmd)
 	rootCmd.AddCommand(certsCmd)
+	rootCmd.AddCommand(rulesCmd)
+	rootCmd.AddCommand(alarmsCmd)
 
 	// Root Flags
 	rootCmd.PersistentFlags().StringVarP(
@@ -108,6 +112,22 @@ func main() {
 		"Certs service URL",
 	)
 
+	rootCmd.PersistentFlags().StringVarP(
+		&sdkConf.RulesEngineURL,
+		"re-url",
+		"",
+		sdkConf.RulesEngineURL,
+		"Rules engine service URL",
+	)
+
+	rootCmd.PersistentFlags().StringVarP(
+		&sdkConf.AlarmsURL,
+		"alarms-url",
+		"",
+		sdkConf.AlarmsURL,
+		"Alarms service URL",
+	)
+
 	rootCmd.PersistentFlags().StringVarP(
 		&sdkConf.HostURL,
 		"host-url",
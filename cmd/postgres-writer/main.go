@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/url"
 	"os"
+	"time"
 
 	chclient "github.com/absmach/callhome/pkg/client"
 	"github.com/absmach/magistrala"
@@ -28,7 +29,9 @@ import (
 	httpserver "github.com/absmach/magistrala/pkg/server/http"
 	"github.com/absmach/magistrala/pkg/uuid"
 	"github.com/caarlos0/env/v11"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	"github.com/jmoiron/sqlx"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -41,13 +44,16 @@ const (
 )
 
 type config struct {
-	LogLevel      string  `env:"MG_POSTGRES_WRITER_LOG_LEVEL"     envDefault:"info"`
-	ConfigPath    string  `env:"MG_POSTGRES_WRITER_CONFIG_PATH"   envDefault:"/config.toml"`
-	BrokerURL     string  `env:"MG_MESSAGE_BROKER_URL"            envDefault:"nats://localhost:4222"`
-	JaegerURL     url.URL `env:"MG_JAEGER_URL"                    envDefault:"http://localhost:4318/v1/traces"`
-	SendTelemetry bool    `env:"MG_SEND_TELEMETRY"                envDefault:"true"`
-	InstanceID    string  `env:"MG_POSTGRES_WRITER_INSTANCE_ID"   envDefault:""`
-	TraceRatio    float64 `env:"MG_JAEGER_TRACE_RATIO"            envDefault:"1.0"`
+	LogLevel        string        `env:"MG_POSTGRES_WRITER_LOG_LEVEL"     envDefault:"info"`
+	ConfigPath      string        `env:"MG_POSTGRES_WRITER_CONFIG_PATH"   envDefault:"/config.toml"`
+	BrokerURL       string        `env:"MG_MESSAGE_BROKER_URL"            envDefault:"nats://localhost:4222"`
+	JaegerURL       url.URL       `env:"MG_JAEGER_URL"                    envDefault:"http://localhost:4318/v1/traces"`
+	SendTelemetry   bool          `env:"MG_SEND_TELEMETRY"                envDefault:"true"`
+	InstanceID      string        `env:"MG_POSTGRES_WRITER_INSTANCE_ID"   envDefault:""`
+	TraceRatio      float64       `env:"MG_JAEGER_TRACE_RATIO"            envDefault:"1.0"`
+	BatchSize       int           `env:"MG_POSTGRES_WRITER_BATCH_SIZE" envDefault:"500"`
+	BatchLinger     time.Duration `env:"MG_POSTGRES_WRITER_BATCH_LINGER" envDefault:"100ms"`
+	BatchBufferSize int           `env:"MG_POSTGRES_WRITER_BATCH_BUFFER_SIZE" envDefault:"5000"`
 }
 
 func main() {
@@ -119,7 +125,28 @@ func main() {
 	repo := newService(db, logger)
 	repo = consumertracing.NewBlocking(tracer, repo, httpServerConfig)
 
-	if err = consumers.Start(ctx, svcName, pubSub, repo, cfg.ConfigPath, brokers.AllTopic, logger); err != nil {
+	batchCfg := consumers.BatchConfig{
+		Size:       cfg.BatchSize,
+		Linger:     cfg.BatchLinger,
+		BufferSize: cfg.BatchBufferSize,
+		BatchSize: kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "postgres",
+			Subsystem: "message_writer",
+			Name:      "batch_size",
+			Help:      "Number of messages in the flushed batch.",
+			Buckets:   stdprometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{}),
+		FlushLatency: kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "postgres",
+			Subsystem: "message_writer",
+			Name:      "flush_duration_seconds",
+			Help:      "Duration of the batch flushes in seconds.",
+			Buckets:   stdprometheus.DefBuckets,
+		}, []string{}),
+	}
+	batcher := consumers.NewBatcher(ctx, repo, batchCfg)
+
+	if err = consumers.Start(ctx, svcName, pubSub, batcher, cfg.ConfigPath, brokers.AllTopic, logger); err != nil {
 		logger.Error(fmt.Sprintf("failed to create Postgres writer: %s", err))
 		exitCode = 1
 		return
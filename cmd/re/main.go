@@ -57,6 +57,8 @@ import (
 	"github.com/authzed/grpcutil"
 	"github.com/caarlos0/env/v11"
 	"github.com/go-chi/chi/v5"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -89,6 +91,17 @@ type config struct {
 	ESConsumerName      string        `env:"MG_RE_EVENT_CONSUMER"        envDefault:"rules_engine"`
 	CacheURL            string        `env:"MG_RE_CACHE_URL"             envDefault:"redis://localhost:6379/0"`
 	CacheKeyDuration    time.Duration `env:"MG_RE_CACHE_KEY_DURATION"    envDefault:"10m"`
+	ExecutionRetention  time.Duration `env:"MG_RE_EXECUTION_RETENTION"   envDefault:"168h"`
+	EncKey              string        `env:"MG_RE_ENCRYPT_KEY"           envDefault:"12345678910111213141516171819202"`
+	IndexSyncInterval   time.Duration `env:"MG_RE_INDEX_SYNC_INTERVAL"   envDefault:"5m"`
+	Workers             int           `env:"MG_RE_WORKERS"               envDefault:"0"`
+	QueueSize           int           `env:"MG_RE_QUEUE_SIZE"            envDefault:"10000"`
+	DomainQueueSize     int           `env:"MG_RE_DOMAIN_QUEUE_SIZE"     envDefault:"1000"`
+	ProgramCacheSize    int           `env:"MG_RE_PROGRAM_CACHE_SIZE"    envDefault:"1000"`
+	ScriptTimeout       time.Duration `env:"MG_RE_SCRIPT_TIMEOUT"        envDefault:"5s"`
+	ScriptMemoryLimit   uint64        `env:"MG_RE_SCRIPT_MEMORY_LIMIT"   envDefault:"67108864"`
+	GoPackages          []string      `env:"MG_RE_GO_PACKAGES"           envDefault:"" envSeparator:","`
+	LuaModules          []string      `env:"MG_RE_LUA_MODULES"           envDefault:"" envSeparator:","`
 	TraceRatio          float64       `env:"MG_JAEGER_TRACE_RATIO"      envDefault:"1.0"`
 	BrokerURL           string        `env:"MG_MESSAGE_BROKER_URL"      envDefault:"nats://localhost:4222"`
 	SpicedbHost         string        `env:"MG_SPICEDB_HOST"            envDefault:"localhost"`
@@ -292,13 +305,27 @@ func main() {
 	readersClient := grpcClient.NewReadersClient(client.Connection(), regrpcCfg.Timeout)
 	logger.Info("Readers gRPC client successfully connected to readers gRPC server " + client.Secure())
 
-	svc, err := newService(ctx, cfg, database, runInfo, msgSub, writersPub, alarmsPub, authz, ec, logger, readersClient, callout, tracer)
+	index := re.NewRuleIndex(repg.NewRepository(database))
+	// Every instance keeps its own rule index, so it needs its own events consumer.
+	indexConsumer := fmt.Sprintf("%s-index-%s", cfg.ESConsumerName, cfg.InstanceID)
+	if err := events.IndexEventsSubscribe(ctx, index, cfg.ESURL, indexConsumer, logger); err != nil {
+		logger.Error(fmt.Sprintf("failed to subscribe to rule events: %s", err))
+		exitCode = 1
+		return
+	}
+
+	svc, err := newService(ctx, cfg, database, index, runInfo, msgSub, writersPub, alarmsPub, authz, ec, logger, readersClient, callout, tracer)
 	if err != nil {
 		logger.Error(fmt.Sprintf("failed to create services: %s", err))
 		exitCode = 1
 
 		return
 	}
+	defer func() {
+		if err := svc.Cancel(); err != nil {
+			logger.Error(fmt.Sprintf("Error closing rules engine service: %v", err))
+		}
+	}()
 	subCfg := messaging.SubscriberConfig{
 		ID:             svcName,
 		Topic:          smqbrokers.SubjectAllMessages,
@@ -331,6 +358,12 @@ func main() {
 		return svc.StartScheduler(ctx)
 	})
 
+	if cfg.IndexSyncInterval > 0 {
+		g.Go(func() error {
+			return index.Sync(ctx, cfg.IndexSyncInterval)
+		})
+	}
+
 	g.Go(func() error {
 		return httpSvc.Start()
 	})
@@ -344,7 +377,7 @@ func main() {
 	}
 }
 
-func newService(ctx context.Context, cfg config, db pgclient.Database, runInfo chan pkglog.RunInfo, rePubSub messaging.PubSub, writersPub, alarmsPub messaging.Publisher, authz mgauthz.Authorization, ec email.Config, logger *slog.Logger, readersClient grpcReadersV1.ReadersServiceClient, callout callout.Callout, tracer trace.Tracer) (re.Service, error) {
+func newService(ctx context.Context, cfg config, db pgclient.Database, index *re.RuleIndex, runInfo chan pkglog.RunInfo, rePubSub messaging.PubSub, writersPub, alarmsPub messaging.Publisher, authz mgauthz.Authorization, ec email.Config, logger *slog.Logger, readersClient grpcReadersV1.ReadersServiceClient, callout callout.Callout, tracer trace.Tracer) (re.Service, error) {
 	repo := repg.NewRepository(db)
 	idp := uuid.New()
 
@@ -364,7 +397,38 @@ func newService(ctx context.Context, cfg config, db pgclient.Database, runInfo c
 		return nil, fmt.Errorf("failed to get available actions and built-in roles: %w", err)
 	}
 
-	csvc, err := re.NewService(repo, runInfo, policyService, idp, rePubSub, writersPub, alarmsPub, ticker.NewTicker(time.Second*30), emailerClient, readersClient, availableActions, builtInRoles)
+	history := re.HistoryConfig{
+		Retention: cfg.ExecutionRetention,
+		Counter: kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "re",
+			Subsystem: "rules",
+			Name:      "executions_total",
+			Help:      "Number of rule executions by rule and status.",
+		}, []string{"rule_id", "status"}),
+		Latency: kitprometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: "re",
+			Subsystem: "rules",
+			Name:      "execution_duration_seconds",
+			Help:      "Duration of rule executions in seconds.",
+			Buckets:   stdprometheus.DefBuckets,
+		}, []string{"rule_id"}),
+	}
+
+	workers := re.WorkerConfig{
+		Workers:          cfg.Workers,
+		QueueSize:        cfg.QueueSize,
+		DomainQueueSize:  cfg.DomainQueueSize,
+		ProgramCacheSize: cfg.ProgramCacheSize,
+	}
+
+	sandbox := re.SandboxConfig{
+		Timeout:     cfg.ScriptTimeout,
+		MemoryLimit: cfg.ScriptMemoryLimit,
+		GoPackages:  cfg.GoPackages,
+		LuaModules:  cfg.LuaModules,
+	}
+
+	csvc, err := re.NewService(repo, index, runInfo, policyService, idp, rePubSub, writersPub, alarmsPub, ticker.NewTicker(time.Second*30), emailerClient, readersClient, history, workers, sandbox, []byte(cfg.EncKey), availableActions, builtInRoles)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create RE service: %w", err)
 	}
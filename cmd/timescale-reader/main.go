@@ -42,6 +42,7 @@ const (
 	envPrefixAuth     = "MG_AUTH_GRPC_"
 	envPrefixClients  = "MG_CLIENTS_GRPC_"
 	envPrefixChannels = "MG_CHANNELS_GRPC_"
+	envPrefixGroups   = "MG_GROUPS_GRPC_"
 	defDB             = "messages"
 	defSvcHTTPPort    = "9011"
 	defSvcGRPCPort    = "7011"
@@ -101,10 +102,6 @@ func main() {
 		exitCode = 1
 		return
 	}
-	registerReadersServiceServer := func(srv *grpc.Server) {
-		reflection.Register(srv)
-		grpcReadersV1.RegisterReadersServiceServer(srv, readersgrpcapi.NewReadersServer(repo))
-	}
 
 	clientsClientCfg := grpcclient.Config{}
 	if err := env.ParseWithOptions(&clientsClientCfg, env.Options{Prefix: envPrefixClients}); err != nil {
@@ -139,6 +136,22 @@ func main() {
 	defer channelsHandler.Close()
 	logger.Info("Channels service gRPC client successfully connected to channels gRPC server " + channelsHandler.Secure())
 
+	groupsClientCfg := grpcclient.Config{}
+	if err := env.ParseWithOptions(&groupsClientCfg, env.Options{Prefix: envPrefixGroups}); err != nil {
+		logger.Error(fmt.Sprintf("failed to load groups gRPC client configuration : %s", err))
+		exitCode = 1
+		return
+	}
+
+	groupsClient, groupsHandler, err := grpcclient.SetupGroupsClient(ctx, groupsClientCfg)
+	if err != nil {
+		logger.Error(err.Error())
+		exitCode = 1
+		return
+	}
+	defer groupsHandler.Close()
+	logger.Info("Groups service gRPC client successfully connected to groups gRPC server " + groupsHandler.Secure())
+
 	authnCfg := grpcclient.Config{}
 	if err := env.ParseWithOptions(&authnCfg, env.Options{Prefix: envPrefixAuth}); err != nil {
 		logger.Error(fmt.Sprintf("failed to load auth gRPC client configuration : %s", err))
@@ -161,13 +174,18 @@ func main() {
 		exitCode = 1
 		return
 	}
-	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(repo, authn, clientsClient, channelsClient, svcName, cfg.InstanceID), logger)
+	hs := httpserver.NewServer(ctx, cancel, svcName, httpServerConfig, httpapi.MakeHandler(repo, authn, clientsClient, channelsClient, groupsClient, svcName, cfg.InstanceID), logger)
 
 	if cfg.SendTelemetry {
 		chc := chclient.New(svcName, magistrala.Version, logger, cancel)
 		go chc.CallHome(ctx)
 	}
 
+	registerReadersServiceServer := func(srv *grpc.Server) {
+		reflection.Register(srv)
+		grpcReadersV1.RegisterReadersServiceServer(srv, readersgrpcapi.NewReadersServer(repo, groupsClient))
+	}
+
 	gs := grpcserver.NewServer(ctx, cancel, svcName, grpcServerConfig, registerReadersServiceServer, logger)
 
 	g.Go(func() error {
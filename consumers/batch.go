@@ -0,0 +1,173 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumers
+
+import (
+	"context"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+)
+
+const (
+	defBatchSize       = 500
+	defBatchLinger     = 100 * time.Millisecond
+	defBatchBufferSize = 5000
+)
+
+var errBatcherStopped = errors.New("batch consumer is stopped")
+
+// Batch is the batch of messages passed to BlockingConsumer in a single
+// ConsumeBlocking call. Each batch element holds the messages of one broker
+// message, as returned by the transformer.
+type Batch []any
+
+// BatchConfig configures the batching of the blocking consumer writes.
+type BatchConfig struct {
+	// Size is the number of broker messages which triggers the batch flush.
+	Size int
+	// Linger is the longest time a message waits for the batch flush.
+	Linger time.Duration
+	// BufferSize bounds the number of messages waiting to be batched.
+	// Consuming blocks while the buffer is full.
+	BufferSize int
+	// BatchSize observes the number of messages in the flushed batch.
+	BatchSize metrics.Histogram
+	// FlushLatency observes the batch flush duration in seconds.
+	FlushLatency metrics.Histogram
+}
+
+type batchItem struct {
+	messages any
+	done     func(error)
+}
+
+var _ BatchConsumer = (*batcher)(nil)
+
+type batcher struct {
+	ctx      context.Context
+	consumer BlockingConsumer
+	cfg      BatchConfig
+	items    chan batchItem
+}
+
+// NewBatcher returns a BatchConsumer which buffers the messages and writes them
+// to the blocking consumer in batches, as soon as either the batch size or the
+// linger time is reached. Pending messages are flushed when the context is done.
+func NewBatcher(ctx context.Context, consumer BlockingConsumer, cfg BatchConfig) BatchConsumer {
+	if cfg.Size <= 0 {
+		cfg.Size = defBatchSize
+	}
+	if cfg.Linger <= 0 {
+		cfg.Linger = defBatchLinger
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = defBatchBufferSize
+	}
+	if cfg.BatchSize == nil {
+		cfg.BatchSize = discard.NewHistogram()
+	}
+	if cfg.FlushLatency == nil {
+		cfg.FlushLatency = discard.NewHistogram()
+	}
+
+	b := &batcher{
+		ctx:      ctx,
+		consumer: consumer,
+		cfg:      cfg,
+		items:    make(chan batchItem, cfg.BufferSize),
+	}
+	go b.run()
+
+	return b
+}
+
+func (b *batcher) ConsumeBatched(ctx context.Context, messages any, done func(error)) {
+	select {
+	case b.items <- batchItem{messages: messages, done: done}:
+	case <-ctx.Done():
+		done(messaging.NewError(ctx.Err(), messaging.Nack))
+	case <-b.ctx.Done():
+		done(messaging.NewError(errBatcherStopped, messaging.Nack))
+	}
+}
+
+func (b *batcher) run() {
+	items := make([]batchItem, 0, b.cfg.Size)
+	linger := time.NewTimer(b.cfg.Linger)
+	linger.Stop()
+
+	flush := func(ctx context.Context) {
+		linger.Stop()
+		if len(items) == 0 {
+			return
+		}
+		b.flush(ctx, items)
+		items = make([]batchItem, 0, b.cfg.Size)
+	}
+
+	for {
+		select {
+		case item := <-b.items:
+			items = append(items, item)
+			if len(items) == 1 {
+				linger.Reset(b.cfg.Linger)
+			}
+			if len(items) >= b.cfg.Size {
+				flush(b.ctx)
+			}
+		case <-linger.C:
+			flush(b.ctx)
+		case <-b.ctx.Done():
+			// Store the messages which are already received, so they are
+			// not redelivered.
+			for len(b.items) > 0 {
+				items = append(items, <-b.items)
+			}
+			flush(context.WithoutCancel(b.ctx))
+			return
+		}
+	}
+}
+
+func (b *batcher) flush(ctx context.Context, items []batchItem) {
+	batch := make(Batch, len(items))
+	for i, item := range items {
+		batch[i] = item.messages
+	}
+
+	begin := time.Now()
+	err := b.consumer.ConsumeBlocking(ctx, batch)
+	b.cfg.FlushLatency.Observe(time.Since(begin).Seconds())
+	b.cfg.BatchSize.Observe(float64(len(items)))
+
+	if err == nil || len(items) == 1 || AckType(err) != messaging.Term {
+		for _, item := range items {
+			item.done(err)
+		}
+		return
+	}
+
+	// The batch holds at least one message which can never be stored, so
+	// the messages are stored one by one to terminate only the invalid ones.
+	for _, item := range items {
+		item.done(b.consumer.ConsumeBlocking(ctx, item.messages))
+	}
+}
+
+// AckType returns the acknowledgement type of the message whose consuming
+// ended with the given error. Failures which don't specify the
+// acknowledgement type are negatively acknowledged to be redelivered.
+func AckType(err error) messaging.AckType {
+	if err == nil {
+		return messaging.Ack
+	}
+	if e, ok := err.(messaging.Error); ok && e != nil {
+		return e.Ack()
+	}
+	return messaging.Nack
+}
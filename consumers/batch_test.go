@@ -0,0 +1,189 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package consumers_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/consumers"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+)
+
+const waitTimeout = 5 * time.Second
+
+var (
+	errFailed  = errors.New("failed to store messages")
+	errInvalid = messaging.NewError(errors.New("invalid message"), messaging.Term)
+)
+
+// blockingConsumer stores the batches and fails the ones containing
+// the messages from the fail map.
+type blockingConsumer struct {
+	mu      sync.Mutex
+	batches []consumers.Batch
+	fail    map[any]error
+}
+
+func (bc *blockingConsumer) ConsumeBlocking(ctx context.Context, messages any) error {
+	batch, ok := messages.(consumers.Batch)
+	if !ok {
+		batch = consumers.Batch{messages}
+	}
+
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	bc.batches = append(bc.batches, batch)
+	for _, m := range batch {
+		if err, ok := bc.fail[m]; ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *blockingConsumer) consumed() []consumers.Batch {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	return append([]consumers.Batch{}, bc.batches...)
+}
+
+func consume(t *testing.T, bc consumers.BatchConsumer, messages ...any) []error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(messages))
+	for i, m := range messages {
+		wg.Add(1)
+		bc.ConsumeBatched(context.Background(), m, func(err error) {
+			errs[i] = err
+			wg.Done()
+		})
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(waitTimeout):
+		t.Fatal("timed out waiting for the batch flush")
+	}
+
+	return errs
+}
+
+func TestConsumeBatched(t *testing.T) {
+	cases := []struct {
+		desc     string
+		cfg      consumers.BatchConfig
+		messages []any
+		fail     map[any]error
+		batches  []consumers.Batch
+		errs     []error
+	}{
+		{
+			desc:     "flush the batch when the batch size is reached",
+			cfg:      consumers.BatchConfig{Size: 2, Linger: time.Hour},
+			messages: []any{"m1", "m2", "m3", "m4"},
+			batches:  []consumers.Batch{{"m1", "m2"}, {"m3", "m4"}},
+			errs:     []error{nil, nil, nil, nil},
+		},
+		{
+			desc:     "flush the batch when the linger time passes",
+			cfg:      consumers.BatchConfig{Size: 10, Linger: 10 * time.Millisecond},
+			messages: []any{"m1", "m2", "m3"},
+			batches:  []consumers.Batch{{"m1", "m2", "m3"}},
+			errs:     []error{nil, nil, nil},
+		},
+		{
+			desc:     "fail all the messages of the failed batch",
+			cfg:      consumers.BatchConfig{Size: 3, Linger: time.Hour},
+			messages: []any{"m1", "m2", "m3"},
+			fail:     map[any]error{"m2": errFailed},
+			batches:  []consumers.Batch{{"m1", "m2", "m3"}},
+			errs:     []error{errFailed, errFailed, errFailed},
+		},
+		{
+			desc:     "store the messages one by one when the batch holds an invalid message",
+			cfg:      consumers.BatchConfig{Size: 3, Linger: time.Hour},
+			messages: []any{"m1", "m2", "m3"},
+			fail:     map[any]error{"m2": errInvalid},
+			batches:  []consumers.Batch{{"m1", "m2", "m3"}, {"m1"}, {"m2"}, {"m3"}},
+			errs:     []error{nil, errInvalid, nil},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			repo := &blockingConsumer{fail: tc.fail}
+			bc := consumers.NewBatcher(ctx, repo, tc.cfg)
+
+			errs := consume(t, bc, tc.messages...)
+			assert.Equal(t, tc.errs, errs, fmt.Sprintf("%s: expected errors %v got %v", tc.desc, tc.errs, errs))
+			assert.Equal(t, tc.batches, repo.consumed(), fmt.Sprintf("%s: unexpected batches", tc.desc))
+		})
+	}
+}
+
+func TestConsumeBatchedStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := &blockingConsumer{}
+	bc := consumers.NewBatcher(ctx, repo, consumers.BatchConfig{Size: 10, Linger: time.Hour})
+
+	errs := make(chan error, 1)
+	bc.ConsumeBatched(context.Background(), "m1", func(err error) {
+		errs <- err
+	})
+	cancel()
+
+	select {
+	case err := <-errs:
+		assert.Nil(t, err, fmt.Sprintf("expected pending message to be stored on stop, got %s", err))
+	case <-time.After(waitTimeout):
+		t.Fatal("timed out waiting for the pending messages flush")
+	}
+	assert.Equal(t, []consumers.Batch{{"m1"}}, repo.consumed())
+}
+
+func TestAckType(t *testing.T) {
+	cases := []struct {
+		desc string
+		err  error
+		ack  messaging.AckType
+	}{
+		{
+			desc: "ack the stored message",
+			err:  nil,
+			ack:  messaging.Ack,
+		},
+		{
+			desc: "nack the message on failure",
+			err:  errFailed,
+			ack:  messaging.Nack,
+		},
+		{
+			desc: "use the acknowledgement type of the failure",
+			err:  errInvalid,
+			ack:  messaging.Term,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ack := consumers.AckType(tc.err)
+			assert.Equal(t, tc.ack, ack, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.ack, ack))
+		})
+	}
+}
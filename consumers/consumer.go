@@ -28,3 +28,12 @@ type BlockingConsumer interface {
 	// A non-nil error is returned to indicate operation failure.
 	ConsumeBlocking(ctx context.Context, messages any) error
 }
+
+// BatchConsumer specifies a buffered message-consuming API, which writes
+// received messages in batches. The outcome of the batch write is reported
+// per message, so the message is acknowledged only after it is stored.
+type BatchConsumer interface {
+	// ConsumeBatched adds the messages to the pending batch. The done callback
+	// is called exactly once with the error of the batch write.
+	ConsumeBatched(ctx context.Context, messages any, done func(error))
+}
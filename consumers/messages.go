@@ -52,6 +52,11 @@ func Start(ctx context.Context, id string, sub messaging.Subscriber, consumer an
 			if err := sub.Subscribe(ctx, subCfg); err != nil {
 				return err
 			}
+		case BatchConsumer:
+			subCfg.Handler = handleBatch(ctx, transformer, c)
+			if err := sub.Subscribe(ctx, subCfg); err != nil {
+				return err
+			}
 		case BlockingConsumer:
 			subCfg.Handler = handleSync(ctx, transformer, c)
 			if err := sub.Subscribe(ctx, subCfg); err != nil {
@@ -94,6 +99,65 @@ func handleAsync(ctx context.Context, t transformers.Transformer, ac AsyncConsum
 	}
 }
 
+func handleBatch(ctx context.Context, t transformers.Transformer, bc BatchConsumer) batchHandler {
+	return batchHandler{
+		ctx:         ctx,
+		transformer: t,
+		consumer:    bc,
+	}
+}
+
+// batchHandler acknowledges the message once the batch it belongs to is
+// written. If the subscriber doesn't support the deferred acknowledgement,
+// handling blocks until the batch is written.
+type batchHandler struct {
+	ctx         context.Context
+	transformer transformers.Transformer
+	consumer    BatchConsumer
+}
+
+var _ messaging.DeferredHandler = (*batchHandler)(nil)
+
+func (h batchHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) error {
+	m, err := h.transform(msg)
+	if err != nil {
+		return err
+	}
+	h.consumer.ConsumeBatched(h.ctx, m, func(err error) {
+		ack(AckType(err))
+	})
+
+	return nil
+}
+
+func (h batchHandler) Handle(msg *messaging.Message) error {
+	m, err := h.transform(msg)
+	if err != nil {
+		return err
+	}
+	errs := make(chan error, 1)
+	h.consumer.ConsumeBatched(h.ctx, m, func(err error) {
+		errs <- err
+	})
+	if err := <-errs; err != nil {
+		return messaging.NewError(err, AckType(err))
+	}
+
+	return nil
+}
+
+func (h batchHandler) Cancel() error {
+	return nil
+}
+
+func (h batchHandler) transform(msg *messaging.Message) (any, error) {
+	if h.transformer == nil {
+		return msg, nil
+	}
+
+	return h.transformer.Transform(msg)
+}
+
 type handleFunc func(msg *messaging.Message) error
 
 func (h handleFunc) Handle(msg *messaging.Message) error {
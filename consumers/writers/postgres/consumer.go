@@ -10,6 +10,7 @@ import (
 
 	"github.com/absmach/magistrala/consumers"
 	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
 	smqjson "github.com/absmach/magistrala/pkg/transformers/json"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/gofrs/uuid/v5"
@@ -23,40 +24,68 @@ var (
 	errSaveMessage    = errors.New("failed to save message to postgres database")
 	errTransRollback  = errors.New("failed to rollback transaction")
 	errNoTable        = errors.New("relation does not exist")
+	errNoColumn       = errors.New("column does not exist")
 )
 
+// maxInsertRows bounds the rows of a single multi-row insert, so the statement
+// stays below the Postgres limit of the bind parameters.
+const maxInsertRows = 1000
+
 var _ consumers.BlockingConsumer = (*postgresRepo)(nil)
 
 type postgresRepo struct {
 	db *sqlx.DB
 }
 
-// New returns new PostgreSQL writer.
+// New returns new PostgreSQL writer. Besides the transformed messages, the
+// writer consumes consumers.Batch and stores the whole batch in a single
+// transaction using multi-row inserts.
 func New(db *sqlx.DB) consumers.BlockingConsumer {
 	return &postgresRepo{db: db}
 }
 
 func (pr postgresRepo) ConsumeBlocking(ctx context.Context, message any) (err error) {
-	switch m := message.(type) {
-	case smqjson.Messages:
-		return pr.saveJSON(ctx, m)
-	default:
-		return pr.saveSenml(ctx, m)
+	batch, ok := message.(consumers.Batch)
+	if !ok {
+		batch = consumers.Batch{message}
+	}
+	err = pr.saveBatch(ctx, batch)
+	if err != nil && errors.Contains(err, errInvalidMessage) {
+		return messaging.NewError(err, messaging.Term)
 	}
+	return err
 }
 
-func (pr postgresRepo) saveSenml(ctx context.Context, messages any) (err error) {
-	msgs, ok := messages.([]senml.Message)
-	if !ok {
-		return errSaveMessage
+// saveBatch stores all the messages of the batch in a single transaction.
+func (pr postgresRepo) saveBatch(ctx context.Context, batch consumers.Batch) error {
+	var senmls []senml.Message
+	jsons := make(map[string][]smqjson.Message)
+	for _, message := range batch {
+		switch m := message.(type) {
+		case smqjson.Messages:
+			jsons[m.Format] = append(jsons[m.Format], m.Data...)
+		case []senml.Message:
+			senmls = append(senmls, m...)
+		default:
+			return errSaveMessage
+		}
 	}
-	q := `INSERT INTO messages (id, channel, subtopic, publisher, protocol,
-          name, unit, value, string_value, bool_value, data_value, sum,
-          time, update_time)
-          VALUES (:id, :channel, :subtopic, :publisher, :protocol, :name, :unit,
-          :value, :string_value, :bool_value, :data_value, :sum,
-          :time, :update_time);`
 
+	if err := pr.insertBatch(ctx, senmls, jsons); err != nil {
+		if err == errNoTable || err == errNoColumn {
+			for format := range jsons {
+				if err := pr.createTable(format); err != nil {
+					return err
+				}
+			}
+			return pr.insertBatch(ctx, senmls, jsons)
+		}
+		return err
+	}
+	return nil
+}
+
+func (pr postgresRepo) insertBatch(ctx context.Context, senmls []senml.Message, jsons map[string][]smqjson.Message) (err error) {
 	tx, err := pr.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(errSaveMessage, err)
@@ -74,92 +103,90 @@ func (pr postgresRepo) saveSenml(ctx context.Context, messages any) (err error)
 		}
 	}()
 
+	if err = insertSenml(ctx, tx, senmls); err != nil {
+		return err
+	}
+	for format, msgs := range jsons {
+		if err = insertJSON(ctx, tx, format, msgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertSenml(ctx context.Context, tx *sqlx.Tx, msgs []senml.Message) error {
+	q := `INSERT INTO messages (id, channel, domain, client_id, subtopic, publisher,
+          protocol, name, unit, value, string_value, bool_value, data_value, sum,
+          time, update_time)
+          VALUES (:id, :channel, :domain, :client_id, :subtopic, :publisher,
+          :protocol, :name, :unit, :value, :string_value, :bool_value, :data_value,
+          :sum, :time, :update_time)`
+
+	rows := make([]senmlMessage, 0, len(msgs))
 	for _, msg := range msgs {
 		id, err := uuid.NewV4()
 		if err != nil {
 			return err
 		}
-		m := senmlMessage{Message: msg, ID: id.String()}
-		if _, err := tx.NamedExec(q, m); err != nil {
-			pgErr, ok := err.(*pgconn.PgError)
-			if ok {
-				if pgErr.Code == pgerrcode.InvalidTextRepresentation {
-					return errors.Wrap(errSaveMessage, errInvalidMessage)
-				}
-			}
-
-			return errors.Wrap(errSaveMessage, err)
-		}
+		rows = append(rows, senmlMessage{Message: msg, ID: id.String()})
 	}
-	return err
-}
 
-func (pr postgresRepo) saveJSON(ctx context.Context, msgs smqjson.Messages) error {
-	if err := pr.insertJSON(ctx, msgs); err != nil {
-		if err == errNoTable {
-			if err := pr.createTable(msgs.Format); err != nil {
-				return err
-			}
-			return pr.insertJSON(ctx, msgs)
+	for i := 0; i < len(rows); i += maxInsertRows {
+		chunk := rows[i:min(i+maxInsertRows, len(rows))]
+		if _, err := tx.NamedExecContext(ctx, q, chunk); err != nil {
+			return handleError(err)
 		}
-		return err
 	}
 	return nil
 }
 
-func (pr postgresRepo) insertJSON(ctx context.Context, msgs smqjson.Messages) error {
-	tx, err := pr.db.BeginTxx(ctx, nil)
-	if err != nil {
-		return errors.Wrap(errSaveMessage, err)
-	}
-	defer func() {
-		if err != nil {
-			if txErr := tx.Rollback(); txErr != nil {
-				err = errors.Wrap(err, errors.Wrap(errTransRollback, txErr))
-			}
-			return
-		}
-
-		if err = tx.Commit(); err != nil {
-			err = errors.Wrap(errSaveMessage, err)
-		}
-	}()
+func insertJSON(ctx context.Context, tx *sqlx.Tx, format string, msgs []smqjson.Message) error {
+	q := `INSERT INTO %s (id, channel, domain, client_id, created, subtopic, publisher, protocol, payload)
+          VALUES (:id, :channel, :domain, :client_id, :created, :subtopic, :publisher, :protocol, :payload)`
+	q = fmt.Sprintf(q, format)
 
-	q := `INSERT INTO %s (id, channel, created, subtopic, publisher, protocol, payload)
-          VALUES (:id, :channel, :created, :subtopic, :publisher, :protocol, :payload);`
-	q = fmt.Sprintf(q, msgs.Format)
-
-	for _, m := range msgs.Data {
-		var dbmsg jsonMessage
-		dbmsg, err = toJSONMessage(m)
+	rows := make([]jsonMessage, 0, len(msgs))
+	for _, m := range msgs {
+		dbmsg, err := toJSONMessage(m)
 		if err != nil {
 			return errors.Wrap(errSaveMessage, err)
 		}
+		rows = append(rows, dbmsg)
+	}
 
-		if _, err = tx.NamedExec(q, dbmsg); err != nil {
-			if preErr, ok := err.(*pgconn.PrepareError); ok {
-				err = preErr.Unwrap()
-			}
-			pgErr, ok := err.(*pgconn.PgError)
-			if ok {
-				switch pgErr.Code {
-				case pgerrcode.InvalidTextRepresentation:
-					return errors.Wrap(errSaveMessage, errInvalidMessage)
-				case pgerrcode.UndefinedTable:
-					return errNoTable
-				}
-			}
-			return err
+	for i := 0; i < len(rows); i += maxInsertRows {
+		chunk := rows[i:min(i+maxInsertRows, len(rows))]
+		if _, err := tx.NamedExecContext(ctx, q, chunk); err != nil {
+			return handleError(err)
 		}
 	}
 	return nil
 }
 
+func handleError(err error) error {
+	if preErr, ok := err.(*pgconn.PrepareError); ok {
+		err = preErr.Unwrap()
+	}
+	if pgErr, ok := err.(*pgconn.PgError); ok {
+		switch pgErr.Code {
+		case pgerrcode.InvalidTextRepresentation:
+			return errors.Wrap(errSaveMessage, errInvalidMessage)
+		case pgerrcode.UndefinedTable:
+			return errNoTable
+		case pgerrcode.UndefinedColumn:
+			return errNoColumn
+		}
+	}
+	return errors.Wrap(errSaveMessage, err)
+}
+
 func (pr postgresRepo) createTable(name string) error {
 	q := `CREATE TABLE IF NOT EXISTS %s (
             id            UUID,
             created       BIGINT,
             channel       VARCHAR(254),
+            domain        VARCHAR(254) NOT NULL DEFAULT '',
+            client_id     VARCHAR(254) NOT NULL DEFAULT '',
             subtopic      VARCHAR(254),
             publisher     VARCHAR(254),
             protocol      TEXT,
@@ -168,6 +195,15 @@ func (pr postgresRepo) createTable(name string) error {
         )`
 	q = fmt.Sprintf(q, name)
 
+	if _, err := pr.db.Exec(q); err != nil {
+		return err
+	}
+
+	// Tables created before the domain and client columns were added.
+	q = `ALTER TABLE %s ADD COLUMN IF NOT EXISTS domain VARCHAR(254) NOT NULL DEFAULT '',
+            ADD COLUMN IF NOT EXISTS client_id VARCHAR(254) NOT NULL DEFAULT ''`
+	q = fmt.Sprintf(q, name)
+
 	_, err := pr.db.Exec(q)
 	return err
 }
@@ -180,6 +216,8 @@ type senmlMessage struct {
 type jsonMessage struct {
 	ID        string `db:"id"`
 	Channel   string `db:"channel"`
+	Domain    string `db:"domain"`
+	ClientID  string `db:"client_id"`
 	Created   int64  `db:"created"`
 	Subtopic  string `db:"subtopic"`
 	Publisher string `db:"publisher"`
@@ -205,6 +243,8 @@ func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
 	m := jsonMessage{
 		ID:        id.String(),
 		Channel:   msg.Channel,
+		Domain:    msg.Domain,
+		ClientID:  msg.ClientID,
 		Created:   msg.Created,
 		Subtopic:  msg.Subtopic,
 		Publisher: msg.Publisher,
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/absmach/magistrala/consumers"
 	"github.com/absmach/magistrala/consumers/writers/postgres"
 	"github.com/absmach/magistrala/pkg/transformers/json"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
@@ -110,3 +111,71 @@ func TestSaveJSON(t *testing.T) {
 	err = repo.ConsumeBlocking(context.TODO(), msgs)
 	assert.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
 }
+
+func TestSaveBatch(t *testing.T) {
+	repo := postgres.New(db)
+
+	chid, err := uuid.NewV4()
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+	pubid, err := uuid.NewV4()
+	assert.Nil(t, err, fmt.Sprintf("got unexpected error: %s", err))
+
+	now := time.Now().Unix()
+	var senmlBatch consumers.Batch
+	for i := 0; i < msgsNum; i++ {
+		msg := senml.Message{
+			Channel:   chid.String(),
+			Publisher: pubid.String(),
+			Subtopic:  subtopic,
+			Value:     &v,
+			Time:      float64(now + int64(i)),
+		}
+		senmlBatch = append(senmlBatch, []senml.Message{msg})
+	}
+
+	var jsonBatch consumers.Batch
+	for i := 0; i < msgsNum; i++ {
+		jsonBatch = append(jsonBatch, json.Messages{
+			Format: "batch_json",
+			Data: []json.Message{
+				{
+					Channel:   chid.String(),
+					Publisher: pubid.String(),
+					Created:   now + int64(i),
+					Subtopic:  "subtopic/format/batch_json",
+					Protocol:  "mqtt",
+					Payload:   map[string]any{"field_1": i},
+				},
+			},
+		})
+	}
+
+	cases := []struct {
+		desc  string
+		batch consumers.Batch
+		err   error
+	}{
+		{
+			desc:  "save batch of senml messages",
+			batch: senmlBatch,
+			err:   nil,
+		},
+		{
+			desc:  "save batch of json messages",
+			batch: jsonBatch,
+			err:   nil,
+		},
+		{
+			desc:  "save empty batch",
+			batch: consumers.Batch{},
+			err:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.ConsumeBlocking(context.TODO(), tc.batch)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
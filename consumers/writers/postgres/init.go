@@ -41,6 +41,19 @@ func Migration() *migrate.MemoryMigrationSource {
 					`ALTER TABLE messages ADD PRIMARY KEY (time, publisher, subtopic, name)`,
 				},
 			},
+			{
+				Id: "messages_3",
+				Up: []string{
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS domain VARCHAR(254) NOT NULL DEFAULT ''`,
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS client_id VARCHAR(254) NOT NULL DEFAULT ''`,
+					`CREATE INDEX IF NOT EXISTS idx_messages_domain_time ON messages (domain, time DESC)`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_messages_domain_time`,
+					`ALTER TABLE messages DROP COLUMN IF EXISTS client_id`,
+					`ALTER TABLE messages DROP COLUMN IF EXISTS domain`,
+				},
+			},
 		},
 	}
 }
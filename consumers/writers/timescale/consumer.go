@@ -25,44 +25,74 @@ var (
 	errSaveMessage    = errors.New("failed to save message to timescale database")
 	errTransRollback  = errors.New("failed to rollback transaction")
 	errNoTable        = errors.New("relation does not exist")
+	errNoColumn       = errors.New("column does not exist")
 )
 
+// maxInsertRows bounds the rows of a single multi-row insert, so the statement
+// stays below the Postgres limit of the bind parameters.
+const maxInsertRows = 1000
+
 var _ consumers.BlockingConsumer = (*timescaleRepo)(nil)
 
 type timescaleRepo struct {
 	db *sqlx.DB
 }
 
-// New returns new TimescaleSQL writer.
+// New returns new TimescaleSQL writer. Besides the transformed messages, the
+// writer consumes consumers.Batch and stores the whole batch in a single
+// transaction using multi-row inserts.
 func New(db *sqlx.DB) consumers.BlockingConsumer {
 	return &timescaleRepo{db: db}
 }
 
 func (tr *timescaleRepo) ConsumeBlocking(ctx context.Context, message any) (err error) {
-	switch m := message.(type) {
-	case smqjson.Messages:
-		return tr.saveJSON(ctx, m)
+	batch, ok := message.(consumers.Batch)
+	if !ok {
+		batch = consumers.Batch{message}
+	}
+	err = tr.saveBatch(ctx, batch)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Contains(err, repoerr.ErrConflict):
+		return messaging.NewError(repoerr.ErrConflict, messaging.Term)
+	case errors.Contains(err, errInvalidMessage):
+		return messaging.NewError(err, messaging.Term)
 	default:
-		err := tr.saveSenml(ctx, m)
-		if err != nil && errors.Contains(err, repoerr.ErrConflict) {
-			return messaging.NewError(repoerr.ErrConflict, messaging.Term)
-		}
 		return err
 	}
 }
 
-func (tr timescaleRepo) saveSenml(ctx context.Context, messages any) (err error) {
-	msgs, ok := messages.([]senml.Message)
-	if !ok {
-		return errSaveMessage
+// saveBatch stores all the messages of the batch in a single transaction.
+func (tr timescaleRepo) saveBatch(ctx context.Context, batch consumers.Batch) error {
+	var senmls []senml.Message
+	jsons := make(map[string][]smqjson.Message)
+	for _, message := range batch {
+		switch m := message.(type) {
+		case smqjson.Messages:
+			jsons[m.Format] = append(jsons[m.Format], m.Data...)
+		case []senml.Message:
+			senmls = append(senmls, m...)
+		default:
+			return errSaveMessage
+		}
 	}
-	q := `INSERT INTO messages (channel, subtopic, publisher, protocol,
-          name, unit, value, string_value, bool_value, data_value, sum,
-          time, update_time)
-          VALUES (:channel, :subtopic, :publisher, :protocol, :name, :unit,
-          :value, :string_value, :bool_value, :data_value, :sum,
-          :time, :update_time);`
 
+	if err := tr.insertBatch(ctx, senmls, jsons); err != nil {
+		if err == errNoTable || err == errNoColumn {
+			for format := range jsons {
+				if err := tr.createTable(format); err != nil {
+					return err
+				}
+			}
+			return tr.insertBatch(ctx, senmls, jsons)
+		}
+		return err
+	}
+	return nil
+}
+
+func (tr timescaleRepo) insertBatch(ctx context.Context, senmls []senml.Message, jsons map[string][]smqjson.Message) (err error) {
 	tx, err := tr.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return errors.Wrap(errSaveMessage, err)
@@ -80,87 +110,85 @@ func (tr timescaleRepo) saveSenml(ctx context.Context, messages any) (err error)
 		}
 	}()
 
-	for _, msg := range msgs {
-		m := senmlMessage{Message: msg}
-		if _, err := tx.NamedExec(q, m); err != nil {
-			pgErr, ok := err.(*pgconn.PgError)
-			if ok {
-				if pgErr.Code == pgerrcode.InvalidTextRepresentation {
-					return errors.Wrap(errSaveMessage, errInvalidMessage)
-				}
-				return postgres.HandleError(errSaveMessage, err)
-			}
-
-			return errors.Wrap(errSaveMessage, err)
-		}
+	if err = insertSenml(ctx, tx, senmls); err != nil {
+		return err
 	}
-	return err
-}
-
-func (tr timescaleRepo) saveJSON(ctx context.Context, msgs smqjson.Messages) error {
-	if err := tr.insertJSON(ctx, msgs); err != nil {
-		if err == errNoTable {
-			if err := tr.createTable(msgs.Format); err != nil {
-				return err
-			}
-			return tr.insertJSON(ctx, msgs)
+	for format, msgs := range jsons {
+		if err = insertJSON(ctx, tx, format, msgs); err != nil {
+			return err
 		}
-		return err
 	}
 	return nil
 }
 
-func (tr timescaleRepo) insertJSON(ctx context.Context, msgs smqjson.Messages) error {
-	tx, err := tr.db.BeginTxx(ctx, nil)
-	if err != nil {
-		return errors.Wrap(errSaveMessage, err)
+func insertSenml(ctx context.Context, tx *sqlx.Tx, msgs []senml.Message) error {
+	q := `INSERT INTO messages (channel, domain, client_id, subtopic, publisher,
+          protocol, name, unit, value, string_value, bool_value, data_value, sum,
+          time, update_time)
+          VALUES (:channel, :domain, :client_id, :subtopic, :publisher, :protocol,
+          :name, :unit, :value, :string_value, :bool_value, :data_value, :sum,
+          :time, :update_time)`
+
+	rows := make([]senmlMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		rows = append(rows, senmlMessage{Message: msg})
 	}
-	defer func() {
-		if err != nil {
-			if txErr := tx.Rollback(); txErr != nil {
-				err = errors.Wrap(err, errors.Wrap(errTransRollback, txErr))
-			}
-			return
-		}
 
-		if err = tx.Commit(); err != nil {
-			err = errors.Wrap(errSaveMessage, err)
+	for i := 0; i < len(rows); i += maxInsertRows {
+		chunk := rows[i:min(i+maxInsertRows, len(rows))]
+		if _, err := tx.NamedExecContext(ctx, q, chunk); err != nil {
+			return handleError(err)
 		}
-	}()
+	}
+	return nil
+}
 
-	q := `INSERT INTO %s (channel, created, subtopic, publisher, protocol, payload)
-          VALUES (:channel, :created, :subtopic, :publisher, :protocol, :payload);`
-	q = fmt.Sprintf(q, msgs.Format)
+func insertJSON(ctx context.Context, tx *sqlx.Tx, format string, msgs []smqjson.Message) error {
+	q := `INSERT INTO %s (channel, domain, client_id, created, subtopic, publisher, protocol, payload)
+          VALUES (:channel, :domain, :client_id, :created, :subtopic, :publisher, :protocol, :payload)`
+	q = fmt.Sprintf(q, format)
 
-	for _, m := range msgs.Data {
-		var dbmsg jsonMessage
-		dbmsg, err = toJSONMessage(m)
+	rows := make([]jsonMessage, 0, len(msgs))
+	for _, m := range msgs {
+		dbmsg, err := toJSONMessage(m)
 		if err != nil {
 			return errors.Wrap(errSaveMessage, err)
 		}
-		if _, err = tx.NamedExec(q, dbmsg); err != nil {
-			if preErr, ok := err.(*pgconn.PrepareError); ok {
-				err = preErr.Unwrap()
-			}
-			pgErr, ok := err.(*pgconn.PgError)
-			if ok {
-				switch pgErr.Code {
-				case pgerrcode.InvalidTextRepresentation:
-					return errors.Wrap(errSaveMessage, errInvalidMessage)
-				case pgerrcode.UndefinedTable:
-					return errNoTable
-				}
-			}
-			return err
+		rows = append(rows, dbmsg)
+	}
+
+	for i := 0; i < len(rows); i += maxInsertRows {
+		chunk := rows[i:min(i+maxInsertRows, len(rows))]
+		if _, err := tx.NamedExecContext(ctx, q, chunk); err != nil {
+			return handleError(err)
 		}
 	}
 	return nil
 }
 
+func handleError(err error) error {
+	if preErr, ok := err.(*pgconn.PrepareError); ok {
+		err = preErr.Unwrap()
+	}
+	if pgErr, ok := err.(*pgconn.PgError); ok {
+		switch pgErr.Code {
+		case pgerrcode.InvalidTextRepresentation:
+			return errors.Wrap(errSaveMessage, errInvalidMessage)
+		case pgerrcode.UndefinedTable:
+			return errNoTable
+		case pgerrcode.UndefinedColumn:
+			return errNoColumn
+		}
+	}
+	return postgres.HandleError(errSaveMessage, err)
+}
+
 func (tr timescaleRepo) createTable(name string) error {
 	q := `CREATE TABLE IF NOT EXISTS %s (
             created       BIGINT NOT NULL,
             channel       VARCHAR(254),
+            domain        VARCHAR(254) NOT NULL DEFAULT '',
+            client_id     VARCHAR(254) NOT NULL DEFAULT '',
             subtopic      VARCHAR(254),
             publisher     VARCHAR(254),
             protocol      TEXT,
@@ -169,6 +197,15 @@ func (tr timescaleRepo) createTable(name string) error {
         );`
 	q = fmt.Sprintf(q, name)
 
+	if _, err := tr.db.Exec(q); err != nil {
+		return err
+	}
+
+	// Tables created before the domain and client columns were added.
+	q = `ALTER TABLE %s ADD COLUMN IF NOT EXISTS domain VARCHAR(254) NOT NULL DEFAULT '',
+            ADD COLUMN IF NOT EXISTS client_id VARCHAR(254) NOT NULL DEFAULT ''`
+	q = fmt.Sprintf(q, name)
+
 	_, err := tr.db.Exec(q)
 	return err
 }
@@ -179,6 +216,8 @@ type senmlMessage struct {
 
 type jsonMessage struct {
 	Channel   string `db:"channel"`
+	Domain    string `db:"domain"`
+	ClientID  string `db:"client_id"`
 	Created   int64  `db:"created"`
 	Subtopic  string `db:"subtopic"`
 	Publisher string `db:"publisher"`
@@ -198,6 +237,8 @@ func toJSONMessage(msg smqjson.Message) (jsonMessage, error) {
 
 	m := jsonMessage{
 		Channel:   msg.Channel,
+		Domain:    msg.Domain,
+		ClientID:  msg.ClientID,
 		Created:   msg.Created,
 		Subtopic:  msg.Subtopic,
 		Publisher: msg.Publisher,
@@ -67,6 +67,25 @@ func Migration() *migrate.MemoryMigrationSource {
 					"DROP INDEX IF EXISTS idx_channel_subtopic_publisher_name_time ;",
 				},
 			},
+			{
+				Id: "messages_3",
+				Up: []string{
+					"ALTER TABLE messages ADD COLUMN IF NOT EXISTS domain VARCHAR(254) NOT NULL DEFAULT '';",
+
+					"ALTER TABLE messages ADD COLUMN IF NOT EXISTS client_id VARCHAR(254) NOT NULL DEFAULT '';",
+
+					// Index on domain, time
+					"CREATE INDEX IF NOT EXISTS idx_domain_time ON messages (domain, time DESC) WITH (timescaledb.transaction_per_chunk);",
+				},
+				DisableTransactionUp: true,
+				Down: []string{
+					"DROP INDEX IF EXISTS idx_domain_time ;",
+
+					"ALTER TABLE messages DROP COLUMN IF EXISTS client_id ;",
+
+					"ALTER TABLE messages DROP COLUMN IF EXISTS domain ;",
+				},
+			},
 		},
 	}
 }
@@ -24,8 +24,9 @@ const svcName = "groups.v1.GroupsService"
 var _ grpcGroupsV1.GroupsServiceClient = (*grpcClient)(nil)
 
 type grpcClient struct {
-	timeout        time.Duration
-	retrieveEntity endpoint.Endpoint
+	timeout            time.Duration
+	retrieveEntity     endpoint.Endpoint
+	retrieveChannelIDs endpoint.Endpoint
 }
 
 // NewClient returns new gRPC client instance.
@@ -39,6 +40,14 @@ func NewClient(conn *grpc.ClientConn, timeout time.Duration) grpcGroupsV1.Groups
 			decodeRetrieveEntityResponse,
 			grpcCommonV1.RetrieveEntityRes{},
 		).Endpoint(),
+		retrieveChannelIDs: kitgrpc.NewClient(
+			conn,
+			svcName,
+			"RetrieveChannelIDs",
+			encodeRetrieveChannelIDsRequest,
+			decodeRetrieveChannelIDsResponse,
+			grpcGroupsV1.RetrieveChannelIDsRes{},
+		).Endpoint(),
 
 		timeout: timeout,
 	}
@@ -65,6 +74,34 @@ func decodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
 	return grpcRes, nil
 }
 
+func (client grpcClient) RetrieveChannelIDs(ctx context.Context, req *grpcGroupsV1.RetrieveChannelIDsReq, _ ...grpc.CallOption) (*grpcGroupsV1.RetrieveChannelIDsRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.retrieveChannelIDs(ctx, retrieveChannelIDsReq{
+		id:       req.GetId(),
+		domainID: req.GetDomainId(),
+	})
+	if err != nil {
+		return &grpcGroupsV1.RetrieveChannelIDsRes{}, decodeError(err)
+	}
+
+	return &grpcGroupsV1.RetrieveChannelIDsRes{Ids: res.(retrieveChannelIDsRes).ids}, nil
+}
+
+func encodeRetrieveChannelIDsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(retrieveChannelIDsReq)
+	return &grpcGroupsV1.RetrieveChannelIDsReq{
+		Id:       req.id,
+		DomainId: req.domainID,
+	}, nil
+}
+
+func decodeRetrieveChannelIDsResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcGroupsV1.RetrieveChannelIDsRes)
+	return retrieveChannelIDsRes{ids: res.GetIds()}, nil
+}
+
 func decodeError(err error) error {
 	if st, ok := status.FromError(err); ok {
 		switch st.Code() {
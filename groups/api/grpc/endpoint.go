@@ -21,3 +21,19 @@ func retrieveEntityEndpoint(svc groups.Service) endpoint.Endpoint {
 		return retrieveEntityRes{id: group.ID, domain: group.Domain, parentGroup: group.Parent, status: uint8(group.Status)}, nil
 	}
 }
+
+func retrieveChannelIDsEndpoint(svc groups.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(retrieveChannelIDsReq)
+		if err := req.validate(); err != nil {
+			return retrieveChannelIDsRes{}, err
+		}
+
+		ids, err := svc.RetrieveChannelIDs(ctx, req.domainID, req.id)
+		if err != nil {
+			return retrieveChannelIDsRes{}, err
+		}
+
+		return retrieveChannelIDsRes{ids: ids}, nil
+	}
+}
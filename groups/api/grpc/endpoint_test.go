@@ -160,3 +160,88 @@ func TestRetrieveEntityEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestRetrieveChannelIDsEndpoint(t *testing.T) {
+	svc := new(prmocks.Service)
+	startGRPCServer(svc, port+1)
+	grpAddr := fmt.Sprintf("localhost:%d", port+1)
+	conn, _ := grpc.NewClient(grpAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client := grpcapi.NewClient(conn, time.Second)
+
+	domainID := testsutil.GenerateUUID(t)
+	channelIDs := []string{testsutil.GenerateUUID(t), testsutil.GenerateUUID(t)}
+
+	cases := []struct {
+		desc   string
+		req    *grpcGroupsV1.RetrieveChannelIDsReq
+		svcRes []string
+		svcErr error
+		res    *grpcGroupsV1.RetrieveChannelIDsRes
+		err    error
+	}{
+		{
+			desc: "retrieve group channel ids successfully",
+			req: &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id:       validID,
+				DomainId: domainID,
+			},
+			svcRes: channelIDs,
+			res:    &grpcGroupsV1.RetrieveChannelIDsRes{Ids: channelIDs},
+		},
+		{
+			desc: "retrieve channel ids of group without channels",
+			req: &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id:       validID,
+				DomainId: domainID,
+			},
+			svcRes: []string{},
+			res:    &grpcGroupsV1.RetrieveChannelIDsRes{},
+		},
+		{
+			desc: "retrieve group channel ids with missing group id",
+			req: &grpcGroupsV1.RetrieveChannelIDsReq{
+				DomainId: domainID,
+			},
+			res: &grpcGroupsV1.RetrieveChannelIDsRes{},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "retrieve group channel ids with missing domain id",
+			req: &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id: validID,
+			},
+			res: &grpcGroupsV1.RetrieveChannelIDsRes{},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "retrieve group channel ids with not found error",
+			req: &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id:       validID,
+				DomainId: domainID,
+			},
+			svcErr: svcerr.ErrNotFound,
+			res:    &grpcGroupsV1.RetrieveChannelIDsRes{},
+			err:    svcerr.ErrNotFound,
+		},
+		{
+			desc: "retrieve group channel ids with authorization error",
+			req: &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id:       validID,
+				DomainId: domainID,
+			},
+			svcErr: svcerr.ErrAuthorization,
+			res:    &grpcGroupsV1.RetrieveChannelIDsRes{},
+			err:    svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			svcCall := svc.On("RetrieveChannelIDs", mock.Anything, tc.req.GetDomainId(), tc.req.GetId()).Return(tc.svcRes, tc.svcErr)
+			res, err := client.RetrieveChannelIDs(context.Background(), tc.req)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			assert.ElementsMatch(t, tc.res.GetIds(), res.GetIds(), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res.GetIds(), res.GetIds()))
+			svcCall.Unset()
+		})
+	}
+}
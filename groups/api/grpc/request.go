@@ -3,6 +3,24 @@
 
 package grpc
 
+import apiutil "github.com/absmach/magistrala/api/http/util"
+
 type retrieveEntityReq struct {
 	Id string
 }
+
+type retrieveChannelIDsReq struct {
+	id       string
+	domainID string
+}
+
+func (req retrieveChannelIDsReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.domainID == "" {
+		return apiutil.ErrMissingDomainID
+	}
+
+	return nil
+}
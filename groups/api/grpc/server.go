@@ -22,7 +22,8 @@ var _ grpcGroupsV1.GroupsServiceServer = (*grpcServer)(nil)
 
 type grpcServer struct {
 	grpcGroupsV1.UnimplementedGroupsServiceServer
-	retrieveEntity kitgrpc.Handler
+	retrieveEntity     kitgrpc.Handler
+	retrieveChannelIDs kitgrpc.Handler
 }
 
 // NewServer returns new AuthServiceServer instance.
@@ -33,6 +34,11 @@ func NewServer(svc groups.Service) grpcGroupsV1.GroupsServiceServer {
 			decodeRetrieveEntityRequest,
 			encodeRetrieveEntityResponse,
 		),
+		retrieveChannelIDs: kitgrpc.NewServer(
+			retrieveChannelIDsEndpoint(svc),
+			decodeRetrieveChannelIDsRequest,
+			encodeRetrieveChannelIDsResponse,
+		),
 	}
 }
 
@@ -44,6 +50,14 @@ func (s *grpcServer) RetrieveEntity(ctx context.Context, req *grpcCommonV1.Retri
 	return res.(*grpcCommonV1.RetrieveEntityRes), nil
 }
 
+func (s *grpcServer) RetrieveChannelIDs(ctx context.Context, req *grpcGroupsV1.RetrieveChannelIDsReq) (*grpcGroupsV1.RetrieveChannelIDsRes, error) {
+	_, res, err := s.retrieveChannelIDs.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+	return res.(*grpcGroupsV1.RetrieveChannelIDsRes), nil
+}
+
 func decodeRetrieveEntityRequest(_ context.Context, grpcReq any) (any, error) {
 	req := grpcReq.(*grpcCommonV1.RetrieveEntityReq)
 	return retrieveEntityReq{
@@ -64,6 +78,20 @@ func encodeRetrieveEntityResponse(_ context.Context, grpcRes any) (any, error) {
 	}, nil
 }
 
+func decodeRetrieveChannelIDsRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcGroupsV1.RetrieveChannelIDsReq)
+	return retrieveChannelIDsReq{
+		id:       req.GetId(),
+		domainID: req.GetDomainId(),
+	}, nil
+}
+
+func encodeRetrieveChannelIDsResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(retrieveChannelIDsRes)
+
+	return &grpcGroupsV1.RetrieveChannelIDsRes{Ids: res.ids}, nil
+}
+
 func encodeError(err error) error {
 	switch {
 	case errors.Contains(err, nil):
@@ -71,6 +99,7 @@ func encodeError(err error) error {
 	case errors.Contains(err, errors.ErrMalformedEntity),
 		err == apiutil.ErrInvalidAuthKey,
 		err == apiutil.ErrMissingID,
+		err == apiutil.ErrMissingDomainID,
 		err == apiutil.ErrMissingMemberType,
 		err == apiutil.ErrMissingPolicySub,
 		err == apiutil.ErrMissingPolicyObj,
@@ -11,7 +11,8 @@ package mocks
 import (
 	"context"
 
-	"github.com/absmach/magistrala/api/grpc/common/v1"
+	v10 "github.com/absmach/magistrala/api/grpc/common/v1"
+	"github.com/absmach/magistrala/api/grpc/groups/v1"
 	mock "github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
 )
@@ -43,8 +44,91 @@ func (_m *GroupsServiceClient) EXPECT() *GroupsServiceClient_Expecter {
 	return &GroupsServiceClient_Expecter{mock: &_m.Mock}
 }
 
+// RetrieveChannelIDs provides a mock function for the type GroupsServiceClient
+func (_mock *GroupsServiceClient) RetrieveChannelIDs(ctx context.Context, in *v1.RetrieveChannelIDsReq, opts ...grpc.CallOption) (*v1.RetrieveChannelIDsRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveChannelIDs")
+	}
+
+	var r0 *v1.RetrieveChannelIDsRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveChannelIDsReq, ...grpc.CallOption) (*v1.RetrieveChannelIDsRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveChannelIDsReq, ...grpc.CallOption) *v1.RetrieveChannelIDsRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.RetrieveChannelIDsRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.RetrieveChannelIDsReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// GroupsServiceClient_RetrieveChannelIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveChannelIDs'
+type GroupsServiceClient_RetrieveChannelIDs_Call struct {
+	*mock.Call
+}
+
+// RetrieveChannelIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.RetrieveChannelIDsReq
+//   - opts ...grpc.CallOption
+func (_e *GroupsServiceClient_Expecter) RetrieveChannelIDs(ctx interface{}, in interface{}, opts ...interface{}) *GroupsServiceClient_RetrieveChannelIDs_Call {
+	return &GroupsServiceClient_RetrieveChannelIDs_Call{Call: _e.mock.On("RetrieveChannelIDs",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *GroupsServiceClient_RetrieveChannelIDs_Call) Run(run func(ctx context.Context, in *v1.RetrieveChannelIDsReq, opts ...grpc.CallOption)) *GroupsServiceClient_RetrieveChannelIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.RetrieveChannelIDsReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.RetrieveChannelIDsReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *GroupsServiceClient_RetrieveChannelIDs_Call) Return(retrieveChannelIDsRes *v1.RetrieveChannelIDsRes, err error) *GroupsServiceClient_RetrieveChannelIDs_Call {
+	_c.Call.Return(retrieveChannelIDsRes, err)
+	return _c
+}
+
+func (_c *GroupsServiceClient_RetrieveChannelIDs_Call) RunAndReturn(run func(ctx context.Context, in *v1.RetrieveChannelIDsReq, opts ...grpc.CallOption) (*v1.RetrieveChannelIDsRes, error)) *GroupsServiceClient_RetrieveChannelIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveEntity provides a mock function for the type GroupsServiceClient
-func (_mock *GroupsServiceClient) RetrieveEntity(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveEntityRes, error) {
+func (_mock *GroupsServiceClient) RetrieveEntity(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption) (*v10.RetrieveEntityRes, error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, in, opts)
@@ -57,19 +141,19 @@ func (_mock *GroupsServiceClient) RetrieveEntity(ctx context.Context, in *v1.Ret
 		panic("no return value specified for RetrieveEntity")
 	}
 
-	var r0 *v1.RetrieveEntityRes
+	var r0 *v10.RetrieveEntityRes
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveEntityReq, ...grpc.CallOption) (*v1.RetrieveEntityRes, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v10.RetrieveEntityReq, ...grpc.CallOption) (*v10.RetrieveEntityRes, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.RetrieveEntityReq, ...grpc.CallOption) *v1.RetrieveEntityRes); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v10.RetrieveEntityReq, ...grpc.CallOption) *v10.RetrieveEntityRes); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.RetrieveEntityRes)
+			r0 = ret.Get(0).(*v10.RetrieveEntityRes)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.RetrieveEntityReq, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v10.RetrieveEntityReq, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -84,22 +168,22 @@ type GroupsServiceClient_RetrieveEntity_Call struct {
 
 // RetrieveEntity is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *v1.RetrieveEntityReq
+//   - in *v10.RetrieveEntityReq
 //   - opts ...grpc.CallOption
 func (_e *GroupsServiceClient_Expecter) RetrieveEntity(ctx interface{}, in interface{}, opts ...interface{}) *GroupsServiceClient_RetrieveEntity_Call {
 	return &GroupsServiceClient_RetrieveEntity_Call{Call: _e.mock.On("RetrieveEntity",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *GroupsServiceClient_RetrieveEntity_Call) Run(run func(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption)) *GroupsServiceClient_RetrieveEntity_Call {
+func (_c *GroupsServiceClient_RetrieveEntity_Call) Run(run func(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption)) *GroupsServiceClient_RetrieveEntity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *v1.RetrieveEntityReq
+		var arg1 *v10.RetrieveEntityReq
 		if args[1] != nil {
-			arg1 = args[1].(*v1.RetrieveEntityReq)
+			arg1 = args[1].(*v10.RetrieveEntityReq)
 		}
 		var arg2 []grpc.CallOption
 		var variadicArgs []grpc.CallOption
@@ -116,12 +200,12 @@ func (_c *GroupsServiceClient_RetrieveEntity_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *GroupsServiceClient_RetrieveEntity_Call) Return(retrieveEntityRes *v1.RetrieveEntityRes, err error) *GroupsServiceClient_RetrieveEntity_Call {
+func (_c *GroupsServiceClient_RetrieveEntity_Call) Return(retrieveEntityRes *v10.RetrieveEntityRes, err error) *GroupsServiceClient_RetrieveEntity_Call {
 	_c.Call.Return(retrieveEntityRes, err)
 	return _c
 }
 
-func (_c *GroupsServiceClient_RetrieveEntity_Call) RunAndReturn(run func(ctx context.Context, in *v1.RetrieveEntityReq, opts ...grpc.CallOption) (*v1.RetrieveEntityRes, error)) *GroupsServiceClient_RetrieveEntity_Call {
+func (_c *GroupsServiceClient_RetrieveEntity_Call) RunAndReturn(run func(ctx context.Context, in *v10.RetrieveEntityReq, opts ...grpc.CallOption) (*v10.RetrieveEntityRes, error)) *GroupsServiceClient_RetrieveEntity_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -107,3 +107,77 @@ func (_c *Service_RetrieveById_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// RetrieveChannelIDs provides a mock function for the type Service
+func (_mock *Service) RetrieveChannelIDs(ctx context.Context, domainID string, id string) ([]string, error) {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveChannelIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) ([]string, error)); ok {
+		return returnFunc(ctx, domainID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RetrieveChannelIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveChannelIDs'
+type Service_RetrieveChannelIDs_Call struct {
+	*mock.Call
+}
+
+// RetrieveChannelIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Service_Expecter) RetrieveChannelIDs(ctx interface{}, domainID interface{}, id interface{}) *Service_RetrieveChannelIDs_Call {
+	return &Service_RetrieveChannelIDs_Call{Call: _e.mock.On("RetrieveChannelIDs", ctx, domainID, id)}
+}
+
+func (_c *Service_RetrieveChannelIDs_Call) Run(run func(ctx context.Context, domainID string, id string)) *Service_RetrieveChannelIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RetrieveChannelIDs_Call) Return(strings []string, err error) *Service_RetrieveChannelIDs_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Service_RetrieveChannelIDs_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) ([]string, error)) *Service_RetrieveChannelIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,22 +7,52 @@ import (
 	"context"
 
 	"github.com/absmach/magistrala/groups"
+	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/policies"
 )
 
 type Service interface {
 	RetrieveById(ctx context.Context, id string) (groups.Group, error)
+
+	// RetrieveChannelIDs retrieves the IDs of the channels whose parent is
+	// the group with the given ID from the given domain.
+	RetrieveChannelIDs(ctx context.Context, domainID, id string) ([]string, error)
 }
 
 var _ Service = (*service)(nil)
 
-func New(repo groups.Repository) Service {
-	return service{repo}
+func New(repo groups.Repository, policy policies.Service) Service {
+	return service{repo: repo, policy: policy}
 }
 
 type service struct {
-	repo groups.Repository
+	repo   groups.Repository
+	policy policies.Service
 }
 
 func (svc service) RetrieveById(ctx context.Context, ids string) (groups.Group, error) {
 	return svc.repo.RetrieveByID(ctx, ids)
 }
+
+func (svc service) RetrieveChannelIDs(ctx context.Context, domainID, id string) ([]string, error) {
+	group, err := svc.repo.RetrieveByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	if group.Domain != domainID {
+		return nil, svcerr.ErrNotFound
+	}
+
+	page, err := svc.policy.ListAllObjects(ctx, policies.Policy{
+		SubjectType: policies.GroupType,
+		Subject:     id,
+		Permission:  policies.ParentGroupRelation,
+		ObjectType:  policies.ChannelType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page.Policies, nil
+}
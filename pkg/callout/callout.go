@@ -87,7 +87,7 @@ func New(cfg Config) (Callout, error) {
 		return nil, fmt.Errorf("unsupported auth callout method: %s", cfg.Method)
 	}
 
-	httpClient, err := newCalloutClient(cfg.TLSVerification, cfg.Cert, cfg.Key, cfg.CACert, cfg.Timeout)
+	httpClient, err := NewHTTPClient(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize http client: %w", err)
 	}
@@ -125,29 +125,32 @@ func (c *callout) Callout(ctx context.Context, req Request) error {
 	return nil
 }
 
-func newCalloutClient(skipInsecure bool, certPath, keyPath, caPath string, timeout time.Duration) (*http.Client, error) {
+// NewHTTPClient creates an HTTP client from the TLS and timeout options of the config.
+// It is used by the callouts and can be reused by other outgoing HTTP integrations.
+func NewHTTPClient(cfg Config) (*http.Client, error) {
 	tlsConfig := &tls.Config{
-		InsecureSkipVerify: !skipInsecure,
+		InsecureSkipVerify: !cfg.TLSVerification,
 	}
-	if certPath != "" || keyPath != "" {
-		clientTLSCert, err := server.LoadX509KeyPair(certPath, keyPath)
+	if cfg.Cert != "" || cfg.Key != "" {
+		clientTLSCert, err := server.LoadX509KeyPair(cfg.Cert, cfg.Key)
 		if err != nil {
 			return nil, err
 		}
-
-		caCert, err := server.LoadRootCACerts(caPath)
+		tlsConfig.Certificates = []tls.Certificate{clientTLSCert}
+	}
+	if cfg.CACert != "" {
+		caCert, err := server.LoadRootCACerts(cfg.CACert)
 		if err != nil {
 			return nil, err
 		}
 		tlsConfig.RootCAs = caCert
-		tlsConfig.Certificates = []tls.Certificate{clientTLSCert}
 	}
 
 	httpClient := &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: tlsConfig,
 		},
-		Timeout: timeout,
+		Timeout: cfg.Timeout,
 	}
 
 	return httpClient, nil
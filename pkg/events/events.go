@@ -45,6 +45,9 @@ type SubscriberConfig struct {
 	Handler        EventHandler
 	Ordered        bool
 	DeliveryPolicy messaging.DeliveryPolicy
+	// InactiveThreshold removes the consumer when it's inactive for the
+	// duration, if the event store supports it. Zero keeps it forever.
+	InactiveThreshold time.Duration
 }
 
 // Subscriber specifies event subscription API.
@@ -193,7 +193,19 @@ func (ps *pubsub) handle(h messaging.MessageHandler, msg *fluxamqp.QueueMessage)
 		return err
 	}
 
-	handleErr := h.Handle(m)
+	var handleErr error
+	if dh, ok := h.(messaging.DeferredHandler); ok {
+		handleErr = dh.HandleDeferred(m, func(at messaging.AckType) {
+			if ackErr := ps.handleAck(at, msg); ackErr != nil {
+				ps.logWarn("failed to acknowledge message", "ack_type", at.String(), "error", ackErr)
+			}
+		})
+		if handleErr == nil {
+			return nil
+		}
+	} else {
+		handleErr = h.Handle(m)
+	}
 	ackType := ps.errAckType(handleErr)
 	if handleErr != nil {
 		ps.logWarn("failed to handle message", "ack_type", ackType.String(), "error", handleErr)
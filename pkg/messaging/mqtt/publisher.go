@@ -12,31 +12,60 @@ import (
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 )
 
-var errPublishTimeout = errors.New("failed to publish due to timeout reached")
+var (
+	errPublishTimeout = errors.New("failed to publish due to timeout reached")
+
+	// ErrInvalidType is returned when the provided value is not of the expected type.
+	ErrInvalidType = errors.New("invalid type")
+)
+
+const defPublisherID = "mqtt-publisher"
 
 var _ messaging.Publisher = (*publisher)(nil)
 
 type publisher struct {
-	client  mqtt.Client
-	timeout time.Duration
-	qos     uint8
+	client   mqtt.Client
+	timeout  time.Duration
+	qos      uint8
+	clientID string
 }
 
 // NewPublisher returns a new MQTT message publisher.
-func NewPublisher(address, username, password string, qos uint8, timeout time.Duration) (messaging.Publisher, error) {
-	client, err := newClient(address, username, password, "mqtt-publisher", timeout)
+func NewPublisher(address, username, password string, qos uint8, timeout time.Duration, opts ...messaging.Option) (messaging.Publisher, error) {
+	ret := publisher{
+		timeout:  timeout,
+		qos:      qos,
+		clientID: defPublisherID,
+	}
+	for _, opt := range opts {
+		if err := opt(&ret); err != nil {
+			return nil, err
+		}
+	}
+
+	client, err := newClient(address, username, password, ret.clientID, timeout)
 	if err != nil {
 		return nil, err
 	}
+	ret.client = client
 
-	ret := publisher{
-		client:  client,
-		timeout: timeout,
-		qos:     qos,
-	}
 	return ret, nil
 }
 
+// ClientID sets the MQTT client ID of the publisher. Brokers disconnect
+// the clients with the same ID, so each connection needs a unique one.
+func ClientID(id string) messaging.Option {
+	return func(val any) error {
+		p, ok := val.(*publisher)
+		if !ok {
+			return ErrInvalidType
+		}
+		p.clientID = id
+
+		return nil
+	}
+}
+
 func (pub publisher) Publish(ctx context.Context, topic string, msg *messaging.Message) error {
 	if topic == "" {
 		return ErrEmptyTopic
@@ -186,6 +186,8 @@ func newClient(address, username, password, id string, timeout time.Duration) (m
 	}
 
 	if ok := token.WaitTimeout(timeout); !ok {
+		// Stop the connect retries in the background.
+		client.Disconnect(0)
 		return nil, ErrConnect
 	}
 
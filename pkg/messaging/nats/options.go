@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/absmach/magistrala/pkg/messaging"
+	broker "github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 )
 
@@ -33,6 +34,8 @@ const msgPrefix = "m"
 type options struct {
 	prefix         string
 	jsStreamConfig jetstream.StreamConfig
+	raw            bool
+	connOpts       []broker.Option
 }
 
 func defaultOptions() options {
@@ -73,3 +76,32 @@ func JSStreamConfig(jsStreamConfig jetstream.StreamConfig) messaging.Option {
 		return nil
 	}
 }
+
+// Raw makes the publisher send the message payload as-is to the topic using
+// core NATS. The prefix and the JetStream stream are not used, so it can
+// publish to the NATS servers that are not managed by Magistrala.
+func Raw() messaging.Option {
+	return func(val any) error {
+		v, ok := val.(*publisher)
+		if !ok {
+			return ErrInvalidType
+		}
+		v.raw = true
+
+		return nil
+	}
+}
+
+// ConnOptions sets additional NATS connection options, such as credentials,
+// for the publisher.
+func ConnOptions(opts ...broker.Option) messaging.Option {
+	return func(val any) error {
+		v, ok := val.(*publisher)
+		if !ok {
+			return ErrInvalidType
+		}
+		v.connOpts = append(v.connOpts, opts...)
+
+		return nil
+	}
+}
@@ -45,17 +45,23 @@ func NewPublisher(ctx context.Context, url string, opts ...messaging.Option) (me
 		}
 	}
 
-	conn, err := broker.Connect(url, broker.MaxReconnects(maxReconnects), broker.ReconnectBufSize(int(reconnectBufSize)))
+	connOpts := append([]broker.Option{broker.MaxReconnects(maxReconnects), broker.ReconnectBufSize(int(reconnectBufSize))}, pub.connOpts...)
+	conn, err := broker.Connect(url, connOpts...)
 	if err != nil {
 		return nil, err
 	}
 	pub.conn = conn
+	if pub.raw {
+		return pub, nil
+	}
 
 	js, err := jetstream.New(conn)
 	if err != nil {
+		conn.Close()
 		return nil, err
 	}
 	if _, err := js.CreateStream(ctx, pub.jsStreamConfig); err != nil {
+		conn.Close()
 		return nil, err
 	}
 	pub.js = js
@@ -68,6 +74,10 @@ func (pub *publisher) Publish(ctx context.Context, topic string, msg *messaging.
 		return ErrEmptyTopic
 	}
 
+	if pub.raw {
+		return pub.conn.Publish(topic, msg.GetPayload())
+	}
+
 	data, err := proto.Marshal(msg)
 	if err != nil {
 		return err
@@ -85,11 +85,12 @@ func (ps *pubsub) Subscribe(ctx context.Context, cfg messaging.SubscriberConfig)
 
 	natsTopic := toNATSTopic(cfg.Topic)
 	consumerConfig := jetstream.ConsumerConfig{
-		Name:          formatConsumerName(cfg.Topic, cfg.ID),
-		Durable:       formatConsumerName(cfg.Topic, cfg.ID),
-		Description:   fmt.Sprintf("Magistrala consumer of id %s for cfg.Topic %s", cfg.ID, cfg.Topic),
-		DeliverPolicy: jetstream.DeliverNewPolicy,
-		FilterSubject: natsTopic,
+		Name:              formatConsumerName(cfg.Topic, cfg.ID),
+		Durable:           formatConsumerName(cfg.Topic, cfg.ID),
+		Description:       fmt.Sprintf("Magistrala consumer of id %s for cfg.Topic %s", cfg.ID, cfg.Topic),
+		DeliverPolicy:     jetstream.DeliverNewPolicy,
+		FilterSubject:     natsTopic,
+		InactiveThreshold: cfg.InactiveThreshold,
 	}
 
 	if cfg.Ordered {
@@ -161,7 +162,16 @@ func (ps *pubsub) natsHandler(h messaging.MessageHandler) func(m jetstream.Msg)
 			return
 		}
 
-		err = h.Handle(&msg)
+		if dh, ok := h.(messaging.DeferredHandler); ok {
+			err = dh.HandleDeferred(&msg, func(at messaging.AckType) {
+				ps.handleAck(at, m)
+			})
+			if err == nil {
+				return
+			}
+		} else {
+			err = h.Handle(&msg)
+		}
 		ackType := ps.errAckType(err)
 		if err != nil {
 			args = append(args, slog.String("ack_type", ackType.String()), slog.String("error", err.Error()))
@@ -48,7 +48,7 @@ func (pm *pubsubMiddleware) Subscribe(ctx context.Context, cfg messaging.Subscri
 
 	span.SetAttributes(defaultAttributes...)
 
-	cfg.Handler = &traceHandler{
+	th := &traceHandler{
 		ctx:      ctx,
 		handler:  cfg.Handler,
 		tracer:   pm.tracer,
@@ -56,6 +56,10 @@ func (pm *pubsubMiddleware) Subscribe(ctx context.Context, cfg messaging.Subscri
 		topic:    cfg.Topic,
 		clientID: cfg.ID,
 	}
+	cfg.Handler = th
+	if dh, ok := th.handler.(messaging.DeferredHandler); ok {
+		cfg.Handler = &deferredTraceHandler{traceHandler: th, handler: dh}
+	}
 
 	return pm.pubsub.Subscribe(ctx, cfg)
 }
@@ -94,3 +98,19 @@ func (h *traceHandler) Handle(msg *messaging.Message) error {
 func (h *traceHandler) Cancel() error {
 	return h.handler.Cancel()
 }
+
+// deferredTraceHandler preserves the deferred acknowledgement of the traced handler.
+type deferredTraceHandler struct {
+	*traceHandler
+	handler messaging.DeferredHandler
+}
+
+// HandleDeferred instruments the deferred message handling operation.
+func (h *deferredTraceHandler) HandleDeferred(msg *messaging.Message, ack messaging.AckFunc) error {
+	_, span := tracing.CreateSpan(h.ctx, processOp, h.clientID, h.topic, msg.GetSubtopic(), len(msg.GetPayload()), h.host, trace.SpanKindConsumer, h.tracer)
+	defer span.End()
+
+	span.SetAttributes(defaultAttributes...)
+
+	return h.handler.HandleDeferred(msg, ack)
+}
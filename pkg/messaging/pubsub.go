@@ -6,6 +6,7 @@ package messaging
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type DeliveryPolicy uint8
@@ -69,14 +70,31 @@ type MessageHandler interface {
 	Cancel() error
 }
 
+// AckFunc acknowledges the message with the given acknowledgement type.
+type AckFunc func(ack AckType)
+
+// DeferredHandler represents Message handler which acknowledges messages
+// once their handling completes, possibly after HandleDeferred returns.
+// Subscribers supporting the deferred acknowledgement call HandleDeferred
+// instead of Handle.
+type DeferredHandler interface {
+	MessageHandler
+
+	// HandleDeferred handles the message and calls ack exactly once when the
+	// handling completes. If it returns an error, the ack is not called and
+	// the subscriber acknowledges the message with respect to the error.
+	HandleDeferred(msg *Message, ack AckFunc) error
+}
+
 // SubscriberConfig defines the configuration for a subscriber that processes messages from a topic.
 type SubscriberConfig struct {
-	ID             string         // Unique identifier for the subscriber.
-	ClientID       string         // Identifier of the client associated with this subscriber.
-	Topic          string         // Topic to subscribe to.
-	Handler        MessageHandler // Function that handles incoming messages.
-	DeliveryPolicy DeliveryPolicy // DeliverPolicy defines from which point to start delivering messages.
-	Ordered        bool           // Whether message delivery must preserve order.
+	ID                string         // Unique identifier for the subscriber.
+	ClientID          string         // Identifier of the client associated with this subscriber.
+	Topic             string         // Topic to subscribe to.
+	Handler           MessageHandler // Function that handles incoming messages.
+	DeliveryPolicy    DeliveryPolicy // DeliverPolicy defines from which point to start delivering messages.
+	Ordered           bool           // Whether message delivery must preserve order.
+	InactiveThreshold time.Duration  // Inactivity after which the broker removes the subscriber state, zero keeps it.
 }
 
 // Subscriber specifies message subscription API.
@@ -0,0 +1,84 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/jmoiron/sqlx"
+)
+
+const cursorName = "query_cursor"
+
+var errTransRollback = errors.New("failed to rollback transaction")
+
+// QueryCursor calls fn for each row of the query. The rows are fetched in
+// batches through a cursor declared in a read only transaction, so large
+// results are not loaded all at once. Errors returned by fn stop the query
+// and are returned as they are, while the database errors are passed to
+// handleErr first. The query ends without error if handleErr returns nil.
+//
+// For example:
+//
+//	err := QueryCursor(ctx, db, "SELECT * FROM table WHERE id = :id", params, 1000, handleErr, func(rows *sqlx.Rows) error {
+//		return rows.StructScan(&row)
+//	})
+func QueryCursor(ctx context.Context, db *sqlx.DB, query string, params any, batchSize int, handleErr func(error) error, fn func(*sqlx.Rows) error) (err error) {
+	tx, err := db.BeginTxx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return handleErr(err)
+	}
+	// Nothing is written, so the transaction is always rolled back, which
+	// closes the cursor as well.
+	defer func() {
+		if txErr := tx.Rollback(); txErr != nil {
+			txErr = errors.Wrap(errTransRollback, txErr)
+			if err == nil {
+				err = handleErr(txErr)
+				return
+			}
+			err = errors.Wrap(err, txErr)
+		}
+	}()
+
+	q, args, err := tx.BindNamed(fmt.Sprintf(`DECLARE %s NO SCROLL CURSOR FOR %s;`, cursorName, query), params)
+	if err != nil {
+		return handleErr(err)
+	}
+	if _, err := tx.ExecContext(ctx, q, args...); err != nil {
+		return handleErr(err)
+	}
+
+	fetch := fmt.Sprintf(`FETCH %d FROM %s;`, batchSize, cursorName)
+	for {
+		n, err := fetchRows(ctx, tx, fetch, handleErr, fn)
+		if err != nil || n < batchSize {
+			return err
+		}
+	}
+}
+
+func fetchRows(ctx context.Context, tx *sqlx.Tx, fetch string, handleErr func(error) error, fn func(*sqlx.Rows) error) (int, error) {
+	rows, err := tx.QueryxContext(ctx, fetch)
+	if err != nil {
+		return 0, handleErr(err)
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		n++
+		if err := fn(rows); err != nil {
+			return n, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return n, handleErr(err)
+	}
+
+	return n, nil
+}
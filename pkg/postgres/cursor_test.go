@@ -0,0 +1,116 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cursorRows  = 25
+	cursorBatch = 10
+)
+
+func TestQueryCursor(t *testing.T) {
+	_, err := db.Exec(`CREATE TABLE cursor_rows (id INTEGER PRIMARY KEY)`)
+	require.Nil(t, err, fmt.Sprintf("unexpected error creating table: %s", err))
+	_, err = db.Exec(`INSERT INTO cursor_rows SELECT generate_series(1, $1)`, cursorRows)
+	require.Nil(t, err, fmt.Sprintf("unexpected error inserting rows: %s", err))
+
+	var all []int
+	for i := 1; i <= cursorRows; i++ {
+		all = append(all, i)
+	}
+	errStop := errors.New("stop query")
+	errQuery := errors.New("query failed")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := []struct {
+		desc      string
+		ctx       context.Context
+		query     string
+		params    any
+		handleErr func(error) error
+		stopAt    int
+		res       []int
+		err       error
+	}{
+		{
+			desc:  "query rows in batches",
+			ctx:   context.Background(),
+			query: `SELECT id FROM cursor_rows ORDER BY id`,
+			res:   all,
+		},
+		{
+			desc:   "query rows with named params",
+			ctx:    context.Background(),
+			query:  `SELECT id FROM cursor_rows WHERE id > :from ORDER BY id`,
+			params: map[string]any{"from": cursorRows - 5},
+			res:    all[cursorRows-5:],
+		},
+		{
+			desc:  "query rows matching a full batch",
+			ctx:   context.Background(),
+			query: fmt.Sprintf(`SELECT id FROM cursor_rows WHERE id <= %d ORDER BY id`, cursorBatch),
+			res:   all[:cursorBatch],
+		},
+		{
+			desc:   "query rows stopped by callback",
+			ctx:    context.Background(),
+			query:  `SELECT id FROM cursor_rows ORDER BY id`,
+			stopAt: cursorBatch + 2,
+			res:    all[:cursorBatch+2],
+			err:    errStop,
+		},
+		{
+			desc:      "query missing table with ignored error",
+			ctx:       context.Background(),
+			query:     `SELECT id FROM missing_rows`,
+			handleErr: func(error) error { return nil },
+		},
+		{
+			desc:  "query missing table",
+			ctx:   context.Background(),
+			query: `SELECT id FROM missing_rows`,
+			err:   errQuery,
+		},
+		{
+			desc:  "query with canceled context",
+			ctx:   canceled,
+			query: `SELECT id FROM cursor_rows ORDER BY id`,
+			err:   errQuery,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.handleErr == nil {
+				tc.handleErr = func(err error) error { return errors.Wrap(errQuery, err) }
+			}
+			var res []int
+			err := postgres.QueryCursor(tc.ctx, db, tc.query, tc.params, cursorBatch, tc.handleErr, func(rows *sqlx.Rows) error {
+				var id int
+				if err := rows.Scan(&id); err != nil {
+					return err
+				}
+				res = append(res, id)
+				if len(res) == tc.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.res, res, fmt.Sprintf("%s: got incorrect rows", tc.desc))
+		})
+	}
+}
@@ -22,6 +22,7 @@ var (
 	errDecodeEnableRuleEvent         = errors.New("failed to decode rule enable event")
 	errDecodeDisableRuleEvent        = errors.New("failed to decode rule disable event")
 	errDecodeRemoveRuleEvent         = errors.New("failed to decode rule remove event")
+	errDecodeRollbackRuleEvent       = errors.New("failed to decode rule rollback event")
 
 	errID             = errors.New("missing or invalid 'id'")
 	errName           = errors.New("missing or invalid 'name'")
@@ -33,6 +34,7 @@ var (
 	errUpdatedAt      = errors.New("failed to parse 'updated_at' time")
 	errDecodeLogic    = errors.New("failed to decode 'logic'")
 	errDecodeSchedule = errors.New("failed to decode 'schedule'")
+	errRestoredFrom   = errors.New("missing or invalid 'restored_from'")
 )
 
 // ToRule decodes a map[string]any event payload into a re.Rule.
@@ -202,3 +204,15 @@ func decodeRemoveRuleEvent(data map[string]any) (string, error) {
 	}
 	return id, nil
 }
+
+func decodeRollbackRuleEvent(data map[string]any) (re.Rule, uint64, error) {
+	r, err := ToRule(data)
+	if err != nil {
+		return re.Rule{}, 0, errors.Wrap(errDecodeRollbackRuleEvent, err)
+	}
+	revision, ok := data["restored_from"].(float64)
+	if !ok {
+		return re.Rule{}, 0, errors.Wrap(errDecodeRollbackRuleEvent, errRestoredFrom)
+	}
+	return r, uint64(revision), nil
+}
@@ -24,6 +24,7 @@ const (
 	enable         = "rule.enable"
 	disable        = "rule.disable"
 	remove         = "rule.remove"
+	rollback       = "rule.rollback"
 )
 
 var (
@@ -35,6 +36,7 @@ var (
 	errEnableRuleEvent         = errors.New("failed to consume rule enable event")
 	errDisableRuleEvent        = errors.New("failed to consume rule disable event")
 	errRemoveRuleEvent         = errors.New("failed to consume rule remove event")
+	errRollbackRuleEvent       = errors.New("failed to consume rule rollback event")
 )
 
 type eventHandler struct {
@@ -92,6 +94,8 @@ func (es *eventHandler) Handle(ctx context.Context, event events.Event) error {
 		return es.disableRuleHandler(ctx, msg)
 	case remove:
 		return es.removeRuleHandler(ctx, msg)
+	case rollback:
+		return es.rollbackRuleHandler(ctx, msg)
 	}
 
 	return es.rolesEventHandler.Handle(ctx, op, msg)
@@ -191,3 +195,16 @@ func (es *eventHandler) removeRuleHandler(ctx context.Context, data map[string]a
 
 	return nil
 }
+
+func (es *eventHandler) rollbackRuleHandler(ctx context.Context, data map[string]any) error {
+	r, revision, err := decodeRollbackRuleEvent(data)
+	if err != nil {
+		return errors.Wrap(errRollbackRuleEvent, err)
+	}
+
+	if _, err := es.repo.RollbackRule(ctx, r, revision); err != nil {
+		return errors.Wrap(errRollbackRuleEvent, err)
+	}
+
+	return nil
+}
@@ -5,9 +5,11 @@ package schedule
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/robfig/cron/v3"
 )
 
 const (
@@ -16,14 +18,23 @@ const (
 	dailyType   = "daily"
 	weeklyType  = "weekly"
 	monthlyType = "monthly"
+	cronType    = "cron"
+
+	// maxSteps limits the number of the skipped occurrences when looking
+	// for the next due time outside of the exclusion windows.
+	maxSteps = 10000
 )
 
 var (
 	ErrInvalidRecurringType = errors.NewRequestError("invalid recurring type")
 	ErrStartDateTimeInPast  = errors.NewRequestError("start_datetime must be greater than or equal to current time")
+	ErrInvalidCron          = errors.NewRequestError("invalid cron expression")
+	ErrInvalidTimezone      = errors.NewRequestError("invalid timezone")
+	ErrInvalidEndDateTime   = errors.NewRequestError("end_datetime must be after start_datetime")
+	ErrInvalidExclusion     = errors.NewRequestError("exclusion end must be after its start")
 )
 
-// Type can be daily, weekly or monthly.
+// Type can be hourly, daily, weekly, monthly or cron.
 type Recurring uint
 
 const (
@@ -32,6 +43,7 @@ const (
 	Daily
 	Weekly
 	Monthly
+	Cron
 )
 
 func (rt Recurring) String() string {
@@ -44,6 +56,8 @@ func (rt Recurring) String() string {
 		return weeklyType
 	case Monthly:
 		return monthlyType
+	case Cron:
+		return cronType
 	default:
 		return noneType
 	}
@@ -68,6 +82,8 @@ func (rt *Recurring) UnmarshalJSON(data []byte) error {
 		*rt = Weekly
 	case monthlyType:
 		*rt = Monthly
+	case cronType:
+		*rt = Cron
 	case noneType:
 		*rt = None
 	default:
@@ -77,10 +93,23 @@ func (rt *Recurring) UnmarshalJSON(data []byte) error {
 }
 
 type Schedule struct {
-	StartDateTime   time.Time `json:"start_datetime,omitempty"`   // When the schedule becomes active
-	Time            time.Time `json:"time,omitempty"`             // Specific time for the rule to run
-	Recurring       Recurring `json:"recurring,omitempty"`        // None, Daily, Weekly, Monthly
-	RecurringPeriod uint      `json:"recurring_period,omitempty"` // Controls how many intervals to skip between executions: 1 = every interval, 2 = every second interval, etc.
+	StartDateTime   time.Time   `json:"start_datetime,omitempty"`   // When the schedule becomes active
+	Time            time.Time   `json:"time,omitempty"`             // Specific time for the rule to run
+	Recurring       Recurring   `json:"recurring,omitempty"`        // None, Hourly, Daily, Weekly, Monthly, Cron
+	RecurringPeriod uint        `json:"recurring_period,omitempty"` // Controls how many intervals to skip between executions: 1 = every interval, 2 = every second interval, etc.
+	Cron            string      `json:"cron,omitempty"`             // Standard 5-field cron expression or descriptor, used with the Cron recurring type
+	Timezone        string      `json:"timezone,omitempty"`         // IANA time zone the schedule is evaluated in, UTC by default
+	EndDateTime     time.Time   `json:"end_datetime,omitempty"`     // When the schedule stops
+	MaxOccurrences  uint        `json:"max_occurrences,omitempty"`  // Number of runs after which the schedule stops, 0 for no limit
+	Occurrences     uint        `json:"occurrences,omitempty"`      // Number of runs so far
+	Exclusions      []Exclusion `json:"exclusions,omitempty"`       // Windows in which the scheduled runs are skipped
+}
+
+// Exclusion is a time window in which the schedule doesn't run.
+// The start is inclusive and the end is exclusive.
+type Exclusion struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
 }
 
 func (s Schedule) Validate() error {
@@ -90,14 +119,46 @@ func (s Schedule) Validate() error {
 			return ErrStartDateTimeInPast
 		}
 	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return errors.Wrap(ErrInvalidTimezone, err)
+	}
+	switch {
+	case s.Recurring == Cron:
+		if _, err := parseCron(s.Cron); err != nil {
+			return err
+		}
+	case s.Cron != "":
+		return errors.Wrap(ErrInvalidCron, errors.New("cron expression requires cron recurring type"))
+	}
+	if !s.EndDateTime.IsZero() && !s.StartDateTime.IsZero() && !s.EndDateTime.After(s.StartDateTime) {
+		return ErrInvalidEndDateTime
+	}
+	for _, e := range s.Exclusions {
+		if !e.End.After(e.Start) {
+			return ErrInvalidExclusion
+		}
+	}
 	return nil
 }
 
+func parseCron(expr string) (cron.Schedule, error) {
+	// The time zone is set with the schedule timezone instead.
+	if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+		return nil, errors.Wrap(ErrInvalidCron, errors.New("use timezone to set the cron time zone"))
+	}
+	sched, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidCron, err)
+	}
+	return sched, nil
+}
+
 func (s Schedule) MarshalJSON() ([]byte, error) {
 	type Alias Schedule
 	jTimes := struct {
 		StartDateTime *string `json:"start_datetime"`
 		Time          string  `json:"time"`
+		EndDateTime   *string `json:"end_datetime,omitempty"`
 		*Alias
 	}{
 		Time:  s.Time.Format(time.RFC3339),
@@ -107,6 +168,10 @@ func (s Schedule) MarshalJSON() ([]byte, error) {
 		formatted := s.StartDateTime.Format(time.RFC3339)
 		jTimes.StartDateTime = &formatted
 	}
+	if !s.EndDateTime.IsZero() {
+		formatted := s.EndDateTime.Format(time.RFC3339)
+		jTimes.EndDateTime = &formatted
+	}
 
 	return json.Marshal(jTimes)
 }
@@ -116,6 +181,7 @@ func (s *Schedule) UnmarshalJSON(data []byte) error {
 	temp := struct {
 		StartDateTime string `json:"start_datetime,omitempty"`
 		Time          string `json:"time,omitempty"`
+		EndDateTime   string `json:"end_datetime,omitempty"`
 		*Alias
 	}{
 		Alias: (*Alias)(s),
@@ -138,24 +204,115 @@ func (s *Schedule) UnmarshalJSON(data []byte) error {
 		}
 		s.Time = parsedTime
 	}
+	if temp.EndDateTime != "" {
+		endDateTime, err := time.Parse(time.RFC3339, temp.EndDateTime)
+		if err != nil {
+			return err
+		}
+		s.EndDateTime = endDateTime
+	}
+	// The recurring type can be omitted for the cron schedules.
+	if s.Cron != "" && s.Recurring == None {
+		s.Recurring = Cron
+	}
 	return nil
 }
 
+// FirstDue returns the first due time at or after the start date time,
+// or zero time if the schedule never runs.
+func (s Schedule) FirstDue() time.Time {
+	if s.StartDateTime.IsZero() {
+		return time.Time{}
+	}
+	if s.Recurring == Cron {
+		// Cron times are found after the given time, so start just before.
+		return s.after(s.StartDateTime.Add(-time.Nanosecond))
+	}
+	if s.active(s.StartDateTime) && s.excluded(s.StartDateTime).IsZero() {
+		return s.StartDateTime.UTC()
+	}
+	return s.after(s.StartDateTime)
+}
+
+// NextDue returns the due time following the current one, or zero time if
+// the schedule doesn't recur or it ends with the current run. Times are
+// evaluated in the schedule time zone, so the daily and the cron schedules
+// keep the same local time across the DST changes.
 func (s Schedule) NextDue() time.Time {
+	// The current run is the occurrence number Occurrences+1.
+	if s.MaxOccurrences > 0 && s.Occurrences+1 >= s.MaxOccurrences {
+		return time.Time{}
+	}
+	return s.after(s.Time)
+}
+
+// after returns the first due time after t outside of the exclusion windows.
+func (s Schedule) after(t time.Time) time.Time {
+	next := t
+	for range maxSteps {
+		next = s.step(next)
+		if next.IsZero() || !s.active(next) {
+			return time.Time{}
+		}
+		end := s.excluded(next)
+		if end.IsZero() {
+			return next.UTC()
+		}
+		if s.Recurring == Cron {
+			// Skip the whole window instead of each cron time in it.
+			next = end.Add(-time.Nanosecond)
+		}
+	}
+	return time.Time{}
+}
+
+// step returns the recurrence following t, or zero time if there is none.
+func (s Schedule) step(t time.Time) time.Time {
+	t = t.In(s.location())
 	switch s.Recurring {
 	case Hourly:
-		return s.Time.Add(time.Hour * time.Duration(s.RecurringPeriod))
+		return t.Add(time.Hour * time.Duration(s.RecurringPeriod))
 	case Daily:
-		return s.Time.AddDate(0, 0, int(s.RecurringPeriod))
+		return t.AddDate(0, 0, int(s.RecurringPeriod))
 	case Weekly:
-		return s.Time.AddDate(0, 0, int(s.RecurringPeriod)*7)
+		return t.AddDate(0, 0, int(s.RecurringPeriod)*7)
 	case Monthly:
-		return s.Time.AddDate(0, int(s.RecurringPeriod), 0)
+		return t.AddDate(0, int(s.RecurringPeriod), 0)
+	case Cron:
+		sched, err := parseCron(s.Cron)
+		if err != nil {
+			return time.Time{}
+		}
+		return sched.Next(t)
 	default:
 		return time.Time{}
 	}
 }
 
+// active reports whether t is before the schedule end.
+func (s Schedule) active(t time.Time) bool {
+	return s.EndDateTime.IsZero() || !t.After(s.EndDateTime)
+}
+
+// excluded returns the end of the exclusion window containing t,
+// or zero time if t is not excluded.
+func (s Schedule) excluded(t time.Time) time.Time {
+	for _, e := range s.Exclusions {
+		if !t.Before(e.Start) && t.Before(e.End) {
+			return e.End
+		}
+	}
+	return time.Time{}
+}
+
+func (s Schedule) location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // EventEncode converts a schedule.Schedule struct to map[string]any.
 func (s Schedule) EventEncode() map[string]any {
 	m := map[string]any{
@@ -168,5 +325,28 @@ func (s Schedule) EventEncode() map[string]any {
 	if !s.Time.IsZero() {
 		m["time"] = s.Time.Format(time.RFC3339)
 	}
+	if s.Cron != "" {
+		m["cron"] = s.Cron
+	}
+	if s.Timezone != "" {
+		m["timezone"] = s.Timezone
+	}
+	if !s.EndDateTime.IsZero() {
+		m["end_datetime"] = s.EndDateTime.Format(time.RFC3339)
+	}
+	if s.MaxOccurrences > 0 {
+		m["max_occurrences"] = s.MaxOccurrences
+		m["occurrences"] = s.Occurrences
+	}
+	if len(s.Exclusions) > 0 {
+		exclusions := make([]map[string]any, len(s.Exclusions))
+		for i, e := range s.Exclusions {
+			exclusions[i] = map[string]any{
+				"start": e.Start.Format(time.RFC3339),
+				"end":   e.End.Format(time.RFC3339),
+			}
+		}
+		m["exclusions"] = exclusions
+	}
 	return m
 }
@@ -0,0 +1,261 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package schedule_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func parse(t *testing.T, s string) time.Time {
+	tm, err := time.Parse(time.RFC3339, s)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error parsing time: %s", err))
+	return tm
+}
+
+func TestNextDue(t *testing.T) {
+	cases := []struct {
+		desc  string
+		sched schedule.Schedule
+		due   string
+	}{
+		{
+			desc:  "next due of non recurring schedule",
+			sched: schedule.Schedule{Time: parse(t, "2026-03-28T07:30:00Z")},
+		},
+		{
+			desc:  "next due of daily schedule in UTC",
+			sched: schedule.Schedule{Time: parse(t, "2026-03-28T07:30:00Z"), Recurring: schedule.Daily, RecurringPeriod: 2},
+			due:   "2026-03-30T07:30:00Z",
+		},
+		{
+			desc:  "next due of daily schedule across DST start",
+			sched: schedule.Schedule{Time: parse(t, "2026-03-28T07:30:00Z"), Recurring: schedule.Daily, RecurringPeriod: 1, Timezone: "Europe/Belgrade"},
+			due:   "2026-03-29T06:30:00Z",
+		},
+		{
+			desc:  "next due of hourly schedule across DST start",
+			sched: schedule.Schedule{Time: parse(t, "2026-03-29T00:30:00Z"), Recurring: schedule.Hourly, RecurringPeriod: 1, Timezone: "Europe/Belgrade"},
+			due:   "2026-03-29T01:30:00Z",
+		},
+		{
+			desc:  "next due of cron schedule every 15 minutes",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Cron, Cron: "*/15 * * * *"},
+			due:   "2026-10-18T10:15:00Z",
+		},
+		{
+			desc:  "next due of weekday cron schedule across DST end",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-23T06:30:00Z"), Recurring: schedule.Cron, Cron: "30 8 * * 1-5", Timezone: "Europe/Belgrade"},
+			due:   "2026-10-26T07:30:00Z",
+		},
+		{
+			desc:  "next due of cron schedule with descriptor",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Cron, Cron: "@daily", Timezone: "America/New_York"},
+			due:   "2026-10-19T04:00:00Z",
+		},
+		{
+			desc:  "next due of schedule with remaining occurrences",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Hourly, RecurringPeriod: 1, MaxOccurrences: 3, Occurrences: 1},
+			due:   "2026-10-18T11:00:00Z",
+		},
+		{
+			desc:  "next due of schedule with last occurrence",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Hourly, RecurringPeriod: 1, MaxOccurrences: 3, Occurrences: 2},
+		},
+		{
+			desc:  "next due after end date time",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Daily, RecurringPeriod: 1, EndDateTime: parse(t, "2026-10-19T09:00:00Z")},
+		},
+		{
+			desc:  "next due at end date time",
+			sched: schedule.Schedule{Time: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Daily, RecurringPeriod: 1, EndDateTime: parse(t, "2026-10-19T10:00:00Z")},
+			due:   "2026-10-19T10:00:00Z",
+		},
+		{
+			desc: "next due of daily schedule with exclusion",
+			sched: schedule.Schedule{
+				Time:            parse(t, "2026-12-23T08:00:00Z"),
+				Recurring:       schedule.Daily,
+				RecurringPeriod: 1,
+				Exclusions:      []schedule.Exclusion{{Start: parse(t, "2026-12-24T00:00:00Z"), End: parse(t, "2026-12-27T00:00:00Z")}},
+			},
+			due: "2026-12-27T08:00:00Z",
+		},
+		{
+			desc: "next due of cron schedule with exclusion",
+			sched: schedule.Schedule{
+				Time:       parse(t, "2026-10-18T10:00:00Z"),
+				Recurring:  schedule.Cron,
+				Cron:       "* * * * *",
+				Exclusions: []schedule.Exclusion{{Start: parse(t, "2026-10-18T10:00:30Z"), End: parse(t, "2026-10-20T12:00:00Z")}},
+			},
+			due: "2026-10-20T12:00:00Z",
+		},
+		{
+			desc: "next due of schedule excluded until end date time",
+			sched: schedule.Schedule{
+				Time:            parse(t, "2026-10-18T10:00:00Z"),
+				Recurring:       schedule.Daily,
+				RecurringPeriod: 1,
+				EndDateTime:     parse(t, "2026-10-25T00:00:00Z"),
+				Exclusions:      []schedule.Exclusion{{Start: parse(t, "2026-10-19T00:00:00Z"), End: parse(t, "2026-11-01T00:00:00Z")}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var due time.Time
+			if tc.due != "" {
+				due = parse(t, tc.due)
+			}
+			next := tc.sched.NextDue()
+			assert.True(t, due.Equal(next), fmt.Sprintf("%s: expected %s got %s", tc.desc, due, next))
+		})
+	}
+}
+
+func TestFirstDue(t *testing.T) {
+	cases := []struct {
+		desc  string
+		sched schedule.Schedule
+		due   string
+	}{
+		{
+			desc:  "first due without start date time",
+			sched: schedule.Schedule{Recurring: schedule.Daily, RecurringPeriod: 1},
+		},
+		{
+			desc:  "first due of daily schedule",
+			sched: schedule.Schedule{StartDateTime: parse(t, "2026-10-18T10:00:00Z"), Recurring: schedule.Daily, RecurringPeriod: 1},
+			due:   "2026-10-18T10:00:00Z",
+		},
+		{
+			desc:  "first due of cron schedule",
+			sched: schedule.Schedule{StartDateTime: parse(t, "2026-10-18T10:07:00Z"), Recurring: schedule.Cron, Cron: "*/15 * * * *"},
+			due:   "2026-10-18T10:15:00Z",
+		},
+		{
+			desc:  "first due of cron schedule at start date time",
+			sched: schedule.Schedule{StartDateTime: parse(t, "2026-10-18T10:15:00Z"), Recurring: schedule.Cron, Cron: "*/15 * * * *"},
+			due:   "2026-10-18T10:15:00Z",
+		},
+		{
+			desc: "first due of schedule starting in exclusion",
+			sched: schedule.Schedule{
+				StartDateTime:   parse(t, "2026-10-18T10:00:00Z"),
+				Recurring:       schedule.Hourly,
+				RecurringPeriod: 1,
+				Exclusions:      []schedule.Exclusion{{Start: parse(t, "2026-10-18T09:00:00Z"), End: parse(t, "2026-10-18T11:30:00Z")}},
+			},
+			due: "2026-10-18T12:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var due time.Time
+			if tc.due != "" {
+				due = parse(t, tc.due)
+			}
+			first := tc.sched.FirstDue()
+			assert.True(t, due.Equal(first), fmt.Sprintf("%s: expected %s got %s", tc.desc, due, first))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	start := time.Now().Add(time.Hour).UTC()
+
+	cases := []struct {
+		desc  string
+		sched schedule.Schedule
+		err   error
+	}{
+		{
+			desc:  "validate cron schedule",
+			sched: schedule.Schedule{StartDateTime: start, Recurring: schedule.Cron, Cron: "30 8 * * 1-5", Timezone: "Europe/Belgrade", EndDateTime: start.Add(time.Hour)},
+		},
+		{
+			desc:  "validate schedule with start date time in past",
+			sched: schedule.Schedule{StartDateTime: start.Add(-2 * time.Hour)},
+			err:   schedule.ErrStartDateTimeInPast,
+		},
+		{
+			desc:  "validate schedule with invalid cron expression",
+			sched: schedule.Schedule{Recurring: schedule.Cron, Cron: "61 * * * *"},
+			err:   schedule.ErrInvalidCron,
+		},
+		{
+			desc:  "validate schedule with cron time zone",
+			sched: schedule.Schedule{Recurring: schedule.Cron, Cron: "CRON_TZ=Europe/Belgrade 30 8 * * *"},
+			err:   schedule.ErrInvalidCron,
+		},
+		{
+			desc:  "validate schedule with cron expression and daily recurring",
+			sched: schedule.Schedule{Recurring: schedule.Daily, RecurringPeriod: 1, Cron: "30 8 * * *"},
+			err:   schedule.ErrInvalidCron,
+		},
+		{
+			desc:  "validate schedule with invalid time zone",
+			sched: schedule.Schedule{Timezone: "Europe/Nowhere"},
+			err:   schedule.ErrInvalidTimezone,
+		},
+		{
+			desc:  "validate schedule with end before start",
+			sched: schedule.Schedule{StartDateTime: start, EndDateTime: start.Add(-time.Minute)},
+			err:   schedule.ErrInvalidEndDateTime,
+		},
+		{
+			desc:  "validate schedule with invalid exclusion",
+			sched: schedule.Schedule{Exclusions: []schedule.Exclusion{{Start: start, End: start}}},
+			err:   schedule.ErrInvalidExclusion,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.sched.Validate()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestScheduleJSON(t *testing.T) {
+	data := `{
+		"start_datetime": "2026-10-18T10:00:00Z",
+		"recurring": "cron",
+		"cron": "30 8 * * 1-5",
+		"timezone": "Europe/Belgrade",
+		"end_datetime": "2026-12-31T00:00:00Z",
+		"max_occurrences": 10,
+		"exclusions": [{"start": "2026-12-24T00:00:00Z", "end": "2026-12-27T00:00:00Z"}]
+	}`
+	var sched schedule.Schedule
+	err := json.Unmarshal([]byte(data), &sched)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, schedule.Cron, sched.Recurring)
+	assert.Equal(t, "30 8 * * 1-5", sched.Cron)
+	assert.Equal(t, "Europe/Belgrade", sched.Timezone)
+	assert.True(t, parse(t, "2026-12-31T00:00:00Z").Equal(sched.EndDateTime))
+	assert.Equal(t, uint(10), sched.MaxOccurrences)
+	assert.Len(t, sched.Exclusions, 1)
+
+	b, err := json.Marshal(sched)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	var decoded schedule.Schedule
+	err = json.Unmarshal(b, &decoded)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, sched, decoded)
+
+	err = json.Unmarshal([]byte(`{"cron": "0 * * * *"}`), &decoded)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, schedule.Cron, decoded.Recurring, "expected cron recurring type for schedule with cron expression")
+}
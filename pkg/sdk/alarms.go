@@ -13,7 +13,13 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 )
 
-const alarmsEndpoint = "alarms"
+const (
+	alarmsEndpoint   = "alarms"
+	statsEndpoint    = "stats"
+	commentsEndpoint = "comments"
+	timelineEndpoint = "timeline"
+	bulkEndpoint     = "bulk"
+)
 
 // Alarm represents an alarm instance.
 type Alarm struct {
@@ -50,8 +56,93 @@ type AlarmsPage struct {
 	Alarms []Alarm `json:"alarms"`
 }
 
+// AlarmStatsGroup holds the statistics of the alarms that share the group
+// fields. MTTA and MTTR are in seconds.
+type AlarmStatsGroup struct {
+	RuleID       string     `json:"rule_id,omitempty"`
+	ChannelID    string     `json:"channel_id,omitempty"`
+	ClientID     string     `json:"client_id,omitempty"`
+	Severity     *uint8     `json:"severity,omitempty"`
+	Status       string     `json:"status,omitempty"`
+	Bucket       *time.Time `json:"bucket,omitempty"`
+	Count        uint64     `json:"count"`
+	Acknowledged uint64     `json:"acknowledged"`
+	Resolved     uint64     `json:"resolved"`
+	MTTA         float64    `json:"mtta"`
+	MTTR         float64    `json:"mttr"`
+}
+
+type AlarmStats struct {
+	Groups []AlarmStatsGroup `json:"groups"`
+}
+
+// BulkAlarmsRequest selects the alarms of the bulk action either by IDs or
+// by the filter with the list alarms fields.
+type BulkAlarmsRequest struct {
+	IDs        []string      `json:"ids,omitempty"`
+	Filter     *PageMetadata `json:"filter,omitempty"`
+	AssigneeID string        `json:"assignee_id,omitempty"`
+}
+
+// BulkAlarmResult is the outcome of the bulk action for one alarm, which
+// failed if the error is set.
+type BulkAlarmResult struct {
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+}
+
+type BulkAlarmsReport struct {
+	Action    string            `json:"action"`
+	Succeeded uint64            `json:"succeeded"`
+	Failed    uint64            `json:"failed"`
+	Results   []BulkAlarmResult `json:"results"`
+}
+
+// AlarmComment is a finding shared on the alarm. Replies refer to the
+// comment they answer with ParentID.
+type AlarmComment struct {
+	ID        string    `json:"id,omitempty"`
+	AlarmID   string    `json:"alarm_id,omitempty"`
+	DomainID  string    `json:"domain_id,omitempty"`
+	ParentID  string    `json:"parent_id,omitempty"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	CreatedBy string    `json:"created_by,omitempty"`
+}
+
+type AlarmCommentsPage struct {
+	Offset   uint64         `json:"offset"`
+	Limit    uint64         `json:"limit"`
+	Total    uint64         `json:"total"`
+	Comments []AlarmComment `json:"comments"`
+}
+
+// AlarmActivity is an entry of the alarm timeline.
+type AlarmActivity struct {
+	ID        string         `json:"id"`
+	AlarmID   string         `json:"alarm_id"`
+	DomainID  string         `json:"domain_id"`
+	Type      string         `json:"type"`
+	ActorID   string         `json:"actor_id,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+type AlarmTimeline struct {
+	Offset     uint64          `json:"offset"`
+	Limit      uint64          `json:"limit"`
+	Total      uint64          `json:"total"`
+	Activities []AlarmActivity `json:"activities"`
+}
+
 func (sdk mgSDK) UpdateAlarm(ctx context.Context, alarm Alarm, domainID, token string) (Alarm, errors.SDKError) {
-	data, err := json.Marshal(alarm)
+	// Only the assignee and the metadata can be updated, the status changes
+	// through the lifecycle operations.
+	ureq := struct {
+		AssigneeID string   `json:"assignee_id,omitempty"`
+		Metadata   Metadata `json:"metadata,omitempty"`
+	}{alarm.AssigneeID, alarm.Metadata}
+	data, err := json.Marshal(ureq)
 	if err != nil {
 		return Alarm{}, errors.NewSDKError(err)
 	}
@@ -107,9 +198,118 @@ func (sdk mgSDK) ListAlarms(ctx context.Context, pm PageMetadata, domainID, toke
 	return ap, nil
 }
 
+func (sdk mgSDK) AlarmStats(ctx context.Context, pm PageMetadata, domainID, token string) (AlarmStats, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s/%s", domainID, alarmsEndpoint, statsEndpoint)
+	url, err := sdk.withQueryParams(sdk.alarmsURL, endpoint, pm)
+	if err != nil {
+		return AlarmStats{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return AlarmStats{}, sdkerr
+	}
+
+	var stats AlarmStats
+	if err := json.Unmarshal(body, &stats); err != nil {
+		return AlarmStats{}, errors.NewSDKError(err)
+	}
+
+	return stats, nil
+}
+
+func (sdk mgSDK) BulkAlarms(ctx context.Context, action string, req BulkAlarmsRequest, domainID, token string) (BulkAlarmsReport, errors.SDKError) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return BulkAlarmsReport{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.alarmsURL, domainID, alarmsEndpoint, bulkEndpoint, action)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusOK)
+	if sdkerr != nil {
+		return BulkAlarmsReport{}, sdkerr
+	}
+
+	var report BulkAlarmsReport
+	if err := json.Unmarshal(body, &report); err != nil {
+		return BulkAlarmsReport{}, errors.NewSDKError(err)
+	}
+
+	return report, nil
+}
+
 func (sdk mgSDK) DeleteAlarm(ctx context.Context, id, domainID, token string) errors.SDKError {
 	url := fmt.Sprintf("%s/%s/%s/%s", sdk.alarmsURL, domainID, alarmsEndpoint, id)
 
 	_, _, sdkerr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusNoContent, http.StatusOK)
 	return sdkerr
 }
+
+func (sdk mgSDK) AddAlarmComment(ctx context.Context, comment AlarmComment, domainID, token string) (AlarmComment, errors.SDKError) {
+	data, err := json.Marshal(comment)
+	if err != nil {
+		return AlarmComment{}, errors.NewSDKError(err)
+	}
+
+	url := fmt.Sprintf("%s/%s/%s/%s/%s", sdk.alarmsURL, domainID, alarmsEndpoint, comment.AlarmID, commentsEndpoint)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodPost, url, token, data, nil, http.StatusCreated)
+	if sdkerr != nil {
+		return AlarmComment{}, sdkerr
+	}
+
+	var c AlarmComment
+	if err := json.Unmarshal(body, &c); err != nil {
+		return AlarmComment{}, errors.NewSDKError(err)
+	}
+
+	return c, nil
+}
+
+func (sdk mgSDK) AlarmComments(ctx context.Context, alarmID string, pm PageMetadata, domainID, token string) (AlarmCommentsPage, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s", domainID, alarmsEndpoint, alarmID, commentsEndpoint)
+	url, err := sdk.withQueryParams(sdk.alarmsURL, endpoint, pm)
+	if err != nil {
+		return AlarmCommentsPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return AlarmCommentsPage{}, sdkerr
+	}
+
+	var cp AlarmCommentsPage
+	if err := json.Unmarshal(body, &cp); err != nil {
+		return AlarmCommentsPage{}, errors.NewSDKError(err)
+	}
+
+	return cp, nil
+}
+
+func (sdk mgSDK) RemoveAlarmComment(ctx context.Context, alarmID, id, domainID, token string) errors.SDKError {
+	url := fmt.Sprintf("%s/%s/%s/%s/%s/%s", sdk.alarmsURL, domainID, alarmsEndpoint, alarmID, commentsEndpoint, id)
+
+	_, _, sdkerr := sdk.processRequest(ctx, http.MethodDelete, url, token, nil, nil, http.StatusNoContent)
+	return sdkerr
+}
+
+func (sdk mgSDK) AlarmTimeline(ctx context.Context, alarmID string, pm PageMetadata, domainID, token string) (AlarmTimeline, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s", domainID, alarmsEndpoint, alarmID, timelineEndpoint)
+	url, err := sdk.withQueryParams(sdk.alarmsURL, endpoint, pm)
+	if err != nil {
+		return AlarmTimeline{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return AlarmTimeline{}, sdkerr
+	}
+
+	var tl AlarmTimeline
+	if err := json.Unmarshal(body, &tl); err != nil {
+		return AlarmTimeline{}, errors.NewSDKError(err)
+	}
+
+	return tl, nil
+}
@@ -5,6 +5,7 @@ package sdk_test
 
 import (
 	"context"
+	"math"
 	"net/http/httptest"
 	"testing"
 	"time"
@@ -12,10 +13,12 @@ import (
 	"github.com/absmach/magistrala/alarms"
 	"github.com/absmach/magistrala/alarms/api"
 	amocks "github.com/absmach/magistrala/alarms/mocks"
+	chmocks "github.com/absmach/magistrala/channels/mocks"
 	mglog "github.com/absmach/magistrala/logger"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
 	"github.com/absmach/magistrala/pkg/errors"
+	msgmocks "github.com/absmach/magistrala/pkg/messaging/mocks"
 	"github.com/absmach/magistrala/pkg/sdk"
 	"github.com/absmach/magistrala/pkg/uuid"
 	"github.com/stretchr/testify/assert"
@@ -48,7 +51,7 @@ func setupAlarms() (*httptest.Server, *amocks.Service, *authnmocks.Authenticatio
 	authn := new(authnmocks.Authentication)
 	am := smqauthn.NewAuthNMiddleware(authn, smqauthn.WithAllowUnverifiedUser(true))
 	idp := uuid.NewMock()
-	mux := api.MakeHandler(asvc, logger, idp, "", am)
+	mux := api.MakeHandler(asvc, new(msgmocks.PubSub), new(chmocks.ChannelsServiceClient), logger, idp, "", am)
 	return httptest.NewServer(mux), asvc, authn
 }
 
@@ -336,6 +339,114 @@ func TestListAlarms(t *testing.T) {
 	}
 }
 
+func TestAlarmStats(t *testing.T) {
+	as, asvc, auth := setupAlarms()
+	defer as.Close()
+
+	conf := sdk.Config{
+		AlarmsURL: as.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	severity := uint8(80)
+	status := alarms.ResolvedStatus
+	bucket := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	svcStats := alarms.Stats{
+		Groups: []alarms.StatsGroup{
+			{
+				ChannelID:    "chan-1",
+				Severity:     &severity,
+				Status:       &status,
+				Bucket:       &bucket,
+				Count:        4,
+				Acknowledged: 2,
+				Resolved:     1,
+				MTTA:         120,
+				MTTR:         600,
+			},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		query           alarms.StatsQuery
+		svcRes          alarms.Stats
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc: "alarm stats successfully",
+			pm: sdk.PageMetadata{
+				GroupBy:   []string{"channel_id", "severity", "status"},
+				Bucket:    "week",
+				ChannelID: "chan-1",
+			},
+			token: validToken,
+			query: alarms.StatsQuery{
+				PageMetadata: alarms.PageMetadata{
+					Limit:     10,
+					ChannelID: "chan-1",
+					Dir:       "desc",
+					Order:     "updated_at",
+					Status:    alarms.AllStatus,
+					Severity:  math.MaxUint8,
+				},
+				GroupBy: []string{"channel_id", "severity", "status"},
+				Bucket:  "week",
+			},
+			svcRes: svcStats,
+		},
+		{
+			desc:    "alarm stats with invalid group by",
+			pm:      sdk.PageMetadata{GroupBy: []string{"subtopic"}},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "alarm stats with invalid bucket",
+			pm:      sdk.PageMetadata{Bucket: "year"},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "alarm stats with empty token",
+			pm:      sdk.PageMetadata{},
+			token:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := asvc.On("AlarmStats", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.AlarmStats(context.Background(), tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				svcCall.Parent.AssertCalled(t, "AlarmStats", mock.Anything, tc.session, tc.query)
+				assert.Len(t, result.Groups, 1)
+				group := result.Groups[0]
+				assert.Equal(t, "chan-1", group.ChannelID)
+				assert.Equal(t, &severity, group.Severity)
+				assert.Equal(t, status.String(), group.Status)
+				assert.True(t, bucket.Equal(*group.Bucket))
+				assert.Equal(t, uint64(4), group.Count)
+				assert.Equal(t, 120.0, group.MTTA)
+				assert.Equal(t, 600.0, group.MTTR)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestDeleteAlarm(t *testing.T) {
 	as, asvc, auth := setupAlarms()
 	defer as.Close()
@@ -388,3 +499,378 @@ func TestDeleteAlarm(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAlarmComment(t *testing.T) {
+	as, asvc, auth := setupAlarms()
+	defer as.Close()
+
+	conf := sdk.Config{
+		AlarmsURL: as.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	svcComment := alarms.Comment{
+		ID:        "comment-1",
+		AlarmID:   alarmID,
+		DomainID:  domainID,
+		Body:      "sensor replaced",
+		CreatedAt: now,
+		CreatedBy: validID,
+	}
+
+	cases := []struct {
+		desc            string
+		comment         sdk.AlarmComment
+		token           string
+		session         smqauthn.Session
+		svcRes          alarms.Comment
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+		resp            sdk.AlarmComment
+	}{
+		{
+			desc:    "add alarm comment successfully",
+			comment: sdk.AlarmComment{AlarmID: alarmID, Body: "sensor replaced"},
+			token:   validToken,
+			svcRes:  svcComment,
+			resp: sdk.AlarmComment{
+				ID:        "comment-1",
+				AlarmID:   alarmID,
+				DomainID:  domainID,
+				Body:      "sensor replaced",
+				CreatedAt: now,
+				CreatedBy: validID,
+			},
+		},
+		{
+			desc:    "add alarm comment with empty token",
+			comment: sdk.AlarmComment{AlarmID: alarmID, Body: "sensor replaced"},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "add alarm comment with empty body",
+			comment: sdk.AlarmComment{AlarmID: alarmID},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "add alarm comment with service error",
+			comment: sdk.AlarmComment{AlarmID: alarmID, Body: "sensor replaced"},
+			token:   validToken,
+			svcErr:  errors.New("not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := asvc.On("AddComment", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.AddAlarmComment(context.Background(), tc.comment, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.resp, resp)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestAlarmComments(t *testing.T) {
+	as, asvc, auth := setupAlarms()
+	defer as.Close()
+
+	conf := sdk.Config{
+		AlarmsURL: as.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcPage := alarms.CommentsPage{
+		Offset: 0,
+		Limit:  10,
+		Total:  2,
+		Comments: []alarms.Comment{
+			{ID: "comment-1", AlarmID: alarmID, DomainID: domainID, Body: "sensor replaced", CreatedBy: validID},
+			{ID: "comment-2", AlarmID: alarmID, DomainID: domainID, ParentID: "comment-1", Body: "confirmed", CreatedBy: validID},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		svcRes          alarms.CommentsPage
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "list alarm comments successfully",
+			pm:     sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:  validToken,
+			svcRes: svcPage,
+		},
+		{
+			desc:    "list alarm comments with empty token",
+			pm:      sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "list alarm comments with invalid limit",
+			pm:      sdk.PageMetadata{Offset: 0, Limit: 1000},
+			token:   validToken,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := asvc.On("ListComments", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.AlarmComments(context.Background(), alarmID, tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.svcRes.Total, resp.Total)
+				assert.Len(t, resp.Comments, len(tc.svcRes.Comments))
+				assert.Equal(t, "comment-1", resp.Comments[1].ParentID)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRemoveAlarmComment(t *testing.T) {
+	as, asvc, auth := setupAlarms()
+	defer as.Close()
+
+	conf := sdk.Config{
+		AlarmsURL: as.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	cases := []struct {
+		desc            string
+		id              string
+		token           string
+		session         smqauthn.Session
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:  "remove alarm comment successfully",
+			id:    "comment-1",
+			token: validToken,
+		},
+		{
+			desc:    "remove alarm comment with empty token",
+			id:      "comment-1",
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "remove non-existent alarm comment",
+			id:      "non-existent",
+			token:   validToken,
+			svcErr:  errors.New("not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := asvc.On("RemoveComment", mock.Anything, tc.session, alarmID, tc.id).Return(tc.svcErr)
+			err := mgsdk.RemoveAlarmComment(context.Background(), alarmID, tc.id, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestAlarmTimeline(t *testing.T) {
+	as, asvc, auth := setupAlarms()
+	defer as.Close()
+
+	conf := sdk.Config{
+		AlarmsURL: as.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcPage := alarms.ActivityPage{
+		Offset: 0,
+		Limit:  10,
+		Total:  2,
+		Activities: []alarms.Activity{
+			{ID: "activity-1", AlarmID: alarmID, DomainID: domainID, Type: alarms.AssignedActivity, ActorID: validID, Details: map[string]any{"assignee_id": "user-1"}},
+			{ID: "activity-2", AlarmID: alarmID, DomainID: domainID, Type: alarms.EscalatedActivity},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		svcRes          alarms.ActivityPage
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "view alarm timeline successfully",
+			pm:     sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:  validToken,
+			svcRes: svcPage,
+		},
+		{
+			desc:    "view alarm timeline with empty token",
+			pm:      sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "view alarm timeline with service error",
+			pm:      sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:   validToken,
+			svcErr:  errors.New("not found"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := asvc.On("ListActivities", mock.Anything, tc.session, alarms.ActivityPageMeta{Offset: tc.pm.Offset, Limit: tc.pm.Limit, AlarmID: alarmID}).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.AlarmTimeline(context.Background(), alarmID, tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.svcRes.Total, resp.Total)
+				assert.Equal(t, "assigned", resp.Activities[0].Type)
+				assert.Equal(t, "user-1", resp.Activities[0].Details["assignee_id"])
+				assert.Empty(t, resp.Activities[1].ActorID)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestBulkAlarms(t *testing.T) {
+	as, asvc, auth := setupAlarms()
+	defer as.Close()
+
+	conf := sdk.Config{
+		AlarmsURL: as.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcReport := alarms.BulkReport{
+		Action:    alarms.ResolveBulkAction,
+		Succeeded: 1,
+		Failed:    1,
+		Results: []alarms.BulkResult{
+			{ID: alarmID},
+			{ID: "alarm-2", Err: alarms.ErrInvalidTransition},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		action          string
+		req             sdk.BulkAlarmsRequest
+		svcReq          alarms.BulkRequest
+		token           string
+		session         smqauthn.Session
+		svcRes          alarms.BulkReport
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "resolve alarms by ids successfully",
+			action: "resolve",
+			req:    sdk.BulkAlarmsRequest{IDs: []string{alarmID, "alarm-2"}},
+			svcReq: alarms.BulkRequest{Action: alarms.ResolveBulkAction, IDs: []string{alarmID, "alarm-2"}},
+			token:  validToken,
+			svcRes: svcReport,
+		},
+		{
+			desc:   "resolve alarms by filter successfully",
+			action: "resolve",
+			req:    sdk.BulkAlarmsRequest{Filter: &sdk.PageMetadata{ChannelID: "chan-1", Status: "active"}},
+			svcReq: alarms.BulkRequest{Action: alarms.ResolveBulkAction, Filter: &alarms.PageMetadata{ChannelID: "chan-1", Status: alarms.ActiveStatus, Severity: math.MaxUint8}},
+			token:  validToken,
+			svcRes: svcReport,
+		},
+		{
+			desc:    "bulk alarms with empty token",
+			action:  "resolve",
+			req:     sdk.BulkAlarmsRequest{IDs: []string{alarmID}},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "bulk alarms with invalid action",
+			action:  "reopen",
+			req:     sdk.BulkAlarmsRequest{IDs: []string{alarmID}},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "bulk alarms without selection",
+			action:  "acknowledge",
+			req:     sdk.BulkAlarmsRequest{},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "bulk alarms with service error",
+			action:  "delete",
+			req:     sdk.BulkAlarmsRequest{IDs: []string{alarmID}},
+			svcReq:  alarms.BulkRequest{Action: alarms.DeleteBulkAction, IDs: []string{alarmID}},
+			token:   validToken,
+			svcErr:  errors.New("failed"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := asvc.On("BulkAlarms", mock.Anything, tc.session, tc.svcReq).Return(tc.svcRes, tc.svcErr)
+			resp, err := mgsdk.BulkAlarms(context.Background(), tc.action, tc.req, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, "resolve", resp.Action)
+				assert.Equal(t, uint64(1), resp.Failed)
+				assert.Equal(t, []sdk.BulkAlarmResult{{ID: alarmID}, {ID: "alarm-2", Error: alarms.ErrInvalidTransition.Error()}}, resp.Results)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
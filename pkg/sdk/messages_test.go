@@ -13,6 +13,7 @@ import (
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	chmocks "github.com/absmach/magistrala/channels/mocks"
 	climocks "github.com/absmach/magistrala/clients/mocks"
+	gpmocks "github.com/absmach/magistrala/groups/mocks"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
 	"github.com/absmach/magistrala/pkg/errors"
@@ -31,8 +32,9 @@ func setupReaders() (*httptest.Server, *authnmocks.Authentication, *readersmocks
 	authn := new(authnmocks.Authentication)
 	clientsGRPCClient = new(climocks.ClientsServiceClient)
 	channelsGRPCClient = new(chmocks.ChannelsServiceClient)
+	groupsGRPCClient := new(gpmocks.GroupsServiceClient)
 
-	mux := readersapi.MakeHandler(repo, authn, clientsGRPCClient, channelsGRPCClient, "test", "")
+	mux := readersapi.MakeHandler(repo, authn, clientsGRPCClient, channelsGRPCClient, groupsGRPCClient, "test", "")
 	return httptest.NewServer(mux), authn, repo
 }
 
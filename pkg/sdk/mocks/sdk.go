@@ -106,6 +106,86 @@ func (_c *SDK_AcceptInvitation_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// AddAlarmComment provides a mock function for the type SDK
+func (_mock *SDK) AddAlarmComment(ctx context.Context, comment sdk.AlarmComment, domainID string, token string) (sdk.AlarmComment, errors.SDKError) {
+	ret := _mock.Called(ctx, comment, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAlarmComment")
+	}
+
+	var r0 sdk.AlarmComment
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.AlarmComment, string, string) (sdk.AlarmComment, errors.SDKError)); ok {
+		return returnFunc(ctx, comment, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.AlarmComment, string, string) sdk.AlarmComment); ok {
+		r0 = returnFunc(ctx, comment, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.AlarmComment)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.AlarmComment, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, comment, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_AddAlarmComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAlarmComment'
+type SDK_AddAlarmComment_Call struct {
+	*mock.Call
+}
+
+// AddAlarmComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - comment sdk.AlarmComment
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) AddAlarmComment(ctx interface{}, comment interface{}, domainID interface{}, token interface{}) *SDK_AddAlarmComment_Call {
+	return &SDK_AddAlarmComment_Call{Call: _e.mock.On("AddAlarmComment", ctx, comment, domainID, token)}
+}
+
+func (_c *SDK_AddAlarmComment_Call) Run(run func(ctx context.Context, comment sdk.AlarmComment, domainID string, token string)) *SDK_AddAlarmComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.AlarmComment
+		if args[1] != nil {
+			arg1 = args[1].(sdk.AlarmComment)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_AddAlarmComment_Call) Return(alarmComment sdk.AlarmComment, sDKError errors.SDKError) *SDK_AddAlarmComment_Call {
+	_c.Call.Return(alarmComment, sDKError)
+	return _c
+}
+
+func (_c *SDK_AddAlarmComment_Call) RunAndReturn(run func(ctx context.Context, comment sdk.AlarmComment, domainID string, token string) (sdk.AlarmComment, errors.SDKError)) *SDK_AddAlarmComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBootstrap provides a mock function for the type SDK
 func (_mock *SDK) AddBootstrap(ctx context.Context, cfg sdk.BootstrapConfig, domainID string, token string) (string, errors.SDKError) {
 	ret := _mock.Called(ctx, cfg, domainID, token)
@@ -975,6 +1055,258 @@ func (_c *SDK_AddRule_Call) RunAndReturn(run func(ctx context.Context, r sdk.Rul
 	return _c
 }
 
+// AlarmComments provides a mock function for the type SDK
+func (_mock *SDK) AlarmComments(ctx context.Context, alarmID string, pm sdk.PageMetadata, domainID string, token string) (sdk.AlarmCommentsPage, errors.SDKError) {
+	ret := _mock.Called(ctx, alarmID, pm, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlarmComments")
+	}
+
+	var r0 sdk.AlarmCommentsPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) (sdk.AlarmCommentsPage, errors.SDKError)); ok {
+		return returnFunc(ctx, alarmID, pm, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) sdk.AlarmCommentsPage); ok {
+		r0 = returnFunc(ctx, alarmID, pm, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.AlarmCommentsPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, alarmID, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_AlarmComments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlarmComments'
+type SDK_AlarmComments_Call struct {
+	*mock.Call
+}
+
+// AlarmComments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarmID string
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) AlarmComments(ctx interface{}, alarmID interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_AlarmComments_Call {
+	return &SDK_AlarmComments_Call{Call: _e.mock.On("AlarmComments", ctx, alarmID, pm, domainID, token)}
+}
+
+func (_c *SDK_AlarmComments_Call) Run(run func(ctx context.Context, alarmID string, pm sdk.PageMetadata, domainID string, token string)) *SDK_AlarmComments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(sdk.PageMetadata)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_AlarmComments_Call) Return(alarmCommentsPage sdk.AlarmCommentsPage, sDKError errors.SDKError) *SDK_AlarmComments_Call {
+	_c.Call.Return(alarmCommentsPage, sDKError)
+	return _c
+}
+
+func (_c *SDK_AlarmComments_Call) RunAndReturn(run func(ctx context.Context, alarmID string, pm sdk.PageMetadata, domainID string, token string) (sdk.AlarmCommentsPage, errors.SDKError)) *SDK_AlarmComments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlarmStats provides a mock function for the type SDK
+func (_mock *SDK) AlarmStats(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.AlarmStats, errors.SDKError) {
+	ret := _mock.Called(ctx, pm, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlarmStats")
+	}
+
+	var r0 sdk.AlarmStats
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) (sdk.AlarmStats, errors.SDKError)); ok {
+		return returnFunc(ctx, pm, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.AlarmStats); ok {
+		r0 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.AlarmStats)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_AlarmStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlarmStats'
+type SDK_AlarmStats_Call struct {
+	*mock.Call
+}
+
+// AlarmStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) AlarmStats(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_AlarmStats_Call {
+	return &SDK_AlarmStats_Call{Call: _e.mock.On("AlarmStats", ctx, pm, domainID, token)}
+}
+
+func (_c *SDK_AlarmStats_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_AlarmStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.PageMetadata
+		if args[1] != nil {
+			arg1 = args[1].(sdk.PageMetadata)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_AlarmStats_Call) Return(alarmStats sdk.AlarmStats, sDKError errors.SDKError) *SDK_AlarmStats_Call {
+	_c.Call.Return(alarmStats, sDKError)
+	return _c
+}
+
+func (_c *SDK_AlarmStats_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.AlarmStats, errors.SDKError)) *SDK_AlarmStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlarmTimeline provides a mock function for the type SDK
+func (_mock *SDK) AlarmTimeline(ctx context.Context, alarmID string, pm sdk.PageMetadata, domainID string, token string) (sdk.AlarmTimeline, errors.SDKError) {
+	ret := _mock.Called(ctx, alarmID, pm, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlarmTimeline")
+	}
+
+	var r0 sdk.AlarmTimeline
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) (sdk.AlarmTimeline, errors.SDKError)); ok {
+		return returnFunc(ctx, alarmID, pm, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) sdk.AlarmTimeline); ok {
+		r0 = returnFunc(ctx, alarmID, pm, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.AlarmTimeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, alarmID, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_AlarmTimeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlarmTimeline'
+type SDK_AlarmTimeline_Call struct {
+	*mock.Call
+}
+
+// AlarmTimeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarmID string
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) AlarmTimeline(ctx interface{}, alarmID interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_AlarmTimeline_Call {
+	return &SDK_AlarmTimeline_Call{Call: _e.mock.On("AlarmTimeline", ctx, alarmID, pm, domainID, token)}
+}
+
+func (_c *SDK_AlarmTimeline_Call) Run(run func(ctx context.Context, alarmID string, pm sdk.PageMetadata, domainID string, token string)) *SDK_AlarmTimeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(sdk.PageMetadata)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_AlarmTimeline_Call) Return(alarmTimeline sdk.AlarmTimeline, sDKError errors.SDKError) *SDK_AlarmTimeline_Call {
+	_c.Call.Return(alarmTimeline, sDKError)
+	return _c
+}
+
+func (_c *SDK_AlarmTimeline_Call) RunAndReturn(run func(ctx context.Context, alarmID string, pm sdk.PageMetadata, domainID string, token string) (sdk.AlarmTimeline, errors.SDKError)) *SDK_AlarmTimeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AvailableClientRoleActions provides a mock function for the type SDK
 func (_mock *SDK) AvailableClientRoleActions(ctx context.Context, domainID string, token string) ([]string, errors.SDKError) {
 	ret := _mock.Called(ctx, domainID, token)
@@ -1364,13 +1696,93 @@ func (_mock *SDK) Bootstraps(ctx context.Context, pm sdk.PageMetadata, domainID
 	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) (sdk.BootstrapPage, errors.SDKError)); ok {
 		return returnFunc(ctx, pm, domainID, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.BootstrapPage); ok {
-		r0 = returnFunc(ctx, pm, domainID, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.BootstrapPage); ok {
+		r0 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.BootstrapPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, pm, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_Bootstraps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bootstraps'
+type SDK_Bootstraps_Call struct {
+	*mock.Call
+}
+
+// Bootstraps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm sdk.PageMetadata
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) Bootstraps(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_Bootstraps_Call {
+	return &SDK_Bootstraps_Call{Call: _e.mock.On("Bootstraps", ctx, pm, domainID, token)}
+}
+
+func (_c *SDK_Bootstraps_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_Bootstraps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 sdk.PageMetadata
+		if args[1] != nil {
+			arg1 = args[1].(sdk.PageMetadata)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_Bootstraps_Call) Return(bootstrapPage sdk.BootstrapPage, sDKError errors.SDKError) *SDK_Bootstraps_Call {
+	_c.Call.Return(bootstrapPage, sDKError)
+	return _c
+}
+
+func (_c *SDK_Bootstraps_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.BootstrapPage, errors.SDKError)) *SDK_Bootstraps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkAlarms provides a mock function for the type SDK
+func (_mock *SDK) BulkAlarms(ctx context.Context, action string, req sdk.BulkAlarmsRequest, domainID string, token string) (sdk.BulkAlarmsReport, errors.SDKError) {
+	ret := _mock.Called(ctx, action, req, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkAlarms")
+	}
+
+	var r0 sdk.BulkAlarmsReport
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.BulkAlarmsRequest, string, string) (sdk.BulkAlarmsReport, errors.SDKError)); ok {
+		return returnFunc(ctx, action, req, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.BulkAlarmsRequest, string, string) sdk.BulkAlarmsReport); ok {
+		r0 = returnFunc(ctx, action, req, domainID, token)
 	} else {
-		r0 = ret.Get(0).(sdk.BootstrapPage)
+		r0 = ret.Get(0).(sdk.BulkAlarmsReport)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
-		r1 = returnFunc(ctx, pm, domainID, token)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.BulkAlarmsRequest, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, action, req, domainID, token)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.SDKError)
@@ -1379,54 +1791,60 @@ func (_mock *SDK) Bootstraps(ctx context.Context, pm sdk.PageMetadata, domainID
 	return r0, r1
 }
 
-// SDK_Bootstraps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bootstraps'
-type SDK_Bootstraps_Call struct {
+// SDK_BulkAlarms_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkAlarms'
+type SDK_BulkAlarms_Call struct {
 	*mock.Call
 }
 
-// Bootstraps is a helper method to define mock.On call
+// BulkAlarms is a helper method to define mock.On call
 //   - ctx context.Context
-//   - pm sdk.PageMetadata
+//   - action string
+//   - req sdk.BulkAlarmsRequest
 //   - domainID string
 //   - token string
-func (_e *SDK_Expecter) Bootstraps(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_Bootstraps_Call {
-	return &SDK_Bootstraps_Call{Call: _e.mock.On("Bootstraps", ctx, pm, domainID, token)}
+func (_e *SDK_Expecter) BulkAlarms(ctx interface{}, action interface{}, req interface{}, domainID interface{}, token interface{}) *SDK_BulkAlarms_Call {
+	return &SDK_BulkAlarms_Call{Call: _e.mock.On("BulkAlarms", ctx, action, req, domainID, token)}
 }
 
-func (_c *SDK_Bootstraps_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_Bootstraps_Call {
+func (_c *SDK_BulkAlarms_Call) Run(run func(ctx context.Context, action string, req sdk.BulkAlarmsRequest, domainID string, token string)) *SDK_BulkAlarms_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 sdk.PageMetadata
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(sdk.PageMetadata)
+			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 sdk.BulkAlarmsRequest
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(sdk.BulkAlarmsRequest)
 		}
 		var arg3 string
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *SDK_Bootstraps_Call) Return(bootstrapPage sdk.BootstrapPage, sDKError errors.SDKError) *SDK_Bootstraps_Call {
-	_c.Call.Return(bootstrapPage, sDKError)
+func (_c *SDK_BulkAlarms_Call) Return(bulkAlarmsReport sdk.BulkAlarmsReport, sDKError errors.SDKError) *SDK_BulkAlarms_Call {
+	_c.Call.Return(bulkAlarmsReport, sDKError)
 	return _c
 }
 
-func (_c *SDK_Bootstraps_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.BootstrapPage, errors.SDKError)) *SDK_Bootstraps_Call {
+func (_c *SDK_BulkAlarms_Call) RunAndReturn(run func(ctx context.Context, action string, req sdk.BulkAlarmsRequest, domainID string, token string) (sdk.BulkAlarmsReport, errors.SDKError)) *SDK_BulkAlarms_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4219,6 +4637,98 @@ func (_c *SDK_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id str
 	return _c
 }
 
+// DiffRuleRevisions provides a mock function for the type SDK
+func (_mock *SDK) DiffRuleRevisions(ctx context.Context, id string, from uint64, to uint64, domainID string, token string) (sdk.RevisionDiff, errors.SDKError) {
+	ret := _mock.Called(ctx, id, from, to, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRuleRevisions")
+	}
+
+	var r0 sdk.RevisionDiff
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, uint64, string, string) (sdk.RevisionDiff, errors.SDKError)); ok {
+		return returnFunc(ctx, id, from, to, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, uint64, string, string) sdk.RevisionDiff); ok {
+		r0 = returnFunc(ctx, id, from, to, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.RevisionDiff)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64, uint64, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, from, to, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_DiffRuleRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRuleRevisions'
+type SDK_DiffRuleRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRuleRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - from uint64
+//   - to uint64
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) DiffRuleRevisions(ctx interface{}, id interface{}, from interface{}, to interface{}, domainID interface{}, token interface{}) *SDK_DiffRuleRevisions_Call {
+	return &SDK_DiffRuleRevisions_Call{Call: _e.mock.On("DiffRuleRevisions", ctx, id, from, to, domainID, token)}
+}
+
+func (_c *SDK_DiffRuleRevisions_Call) Run(run func(ctx context.Context, id string, from uint64, to uint64, domainID string, token string)) *SDK_DiffRuleRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uint64
+		if args[2] != nil {
+			arg2 = args[2].(uint64)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_DiffRuleRevisions_Call) Return(revisionDiff sdk.RevisionDiff, sDKError errors.SDKError) *SDK_DiffRuleRevisions_Call {
+	_c.Call.Return(revisionDiff, sDKError)
+	return _c
+}
+
+func (_c *SDK_DiffRuleRevisions_Call) RunAndReturn(run func(ctx context.Context, id string, from uint64, to uint64, domainID string, token string) (sdk.RevisionDiff, errors.SDKError)) *SDK_DiffRuleRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisableChannel provides a mock function for the type SDK
 func (_mock *SDK) DisableChannel(ctx context.Context, id string, domainID string, token string) (sdk.Channel, errors.SDKError) {
 	ret := _mock.Called(ctx, id, domainID, token)
@@ -7757,7 +8267,173 @@ func (_e *SDK_Expecter) ListClientMembers(ctx interface{}, clientID interface{},
 	return &SDK_ListClientMembers_Call{Call: _e.mock.On("ListClientMembers", ctx, clientID, domainID, pm, token)}
 }
 
-func (_c *SDK_ListClientMembers_Call) Run(run func(ctx context.Context, clientID string, domainID string, pm sdk.PageMetadata, token string)) *SDK_ListClientMembers_Call {
+func (_c *SDK_ListClientMembers_Call) Run(run func(ctx context.Context, clientID string, domainID string, pm sdk.PageMetadata, token string)) *SDK_ListClientMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 sdk.PageMetadata
+		if args[3] != nil {
+			arg3 = args[3].(sdk.PageMetadata)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ListClientMembers_Call) Return(entityMembersPage sdk.EntityMembersPage, sDKError errors.SDKError) *SDK_ListClientMembers_Call {
+	_c.Call.Return(entityMembersPage, sDKError)
+	return _c
+}
+
+func (_c *SDK_ListClientMembers_Call) RunAndReturn(run func(ctx context.Context, clientID string, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError)) *SDK_ListClientMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDomainMembers provides a mock function for the type SDK
+func (_mock *SDK) ListDomainMembers(ctx context.Context, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError) {
+	ret := _mock.Called(ctx, domainID, pm, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDomainMembers")
+	}
+
+	var r0 sdk.EntityMembersPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string) (sdk.EntityMembersPage, errors.SDKError)); ok {
+		return returnFunc(ctx, domainID, pm, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string) sdk.EntityMembersPage); ok {
+		r0 = returnFunc(ctx, domainID, pm, token)
+	} else {
+		r0 = ret.Get(0).(sdk.EntityMembersPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, domainID, pm, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ListDomainMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDomainMembers'
+type SDK_ListDomainMembers_Call struct {
+	*mock.Call
+}
+
+// ListDomainMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - pm sdk.PageMetadata
+//   - token string
+func (_e *SDK_Expecter) ListDomainMembers(ctx interface{}, domainID interface{}, pm interface{}, token interface{}) *SDK_ListDomainMembers_Call {
+	return &SDK_ListDomainMembers_Call{Call: _e.mock.On("ListDomainMembers", ctx, domainID, pm, token)}
+}
+
+func (_c *SDK_ListDomainMembers_Call) Run(run func(ctx context.Context, domainID string, pm sdk.PageMetadata, token string)) *SDK_ListDomainMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 sdk.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(sdk.PageMetadata)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_ListDomainMembers_Call) Return(entityMembersPage sdk.EntityMembersPage, sDKError errors.SDKError) *SDK_ListDomainMembers_Call {
+	_c.Call.Return(entityMembersPage, sDKError)
+	return _c
+}
+
+func (_c *SDK_ListDomainMembers_Call) RunAndReturn(run func(ctx context.Context, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError)) *SDK_ListDomainMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListGroupMembers provides a mock function for the type SDK
+func (_mock *SDK) ListGroupMembers(ctx context.Context, groupID string, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError) {
+	ret := _mock.Called(ctx, groupID, domainID, pm, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListGroupMembers")
+	}
+
+	var r0 sdk.EntityMembersPage
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, sdk.PageMetadata, string) (sdk.EntityMembersPage, errors.SDKError)); ok {
+		return returnFunc(ctx, groupID, domainID, pm, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, sdk.PageMetadata, string) sdk.EntityMembersPage); ok {
+		r0 = returnFunc(ctx, groupID, domainID, pm, token)
+	} else {
+		r0 = ret.Get(0).(sdk.EntityMembersPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, sdk.PageMetadata, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, groupID, domainID, pm, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_ListGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroupMembers'
+type SDK_ListGroupMembers_Call struct {
+	*mock.Call
+}
+
+// ListGroupMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID string
+//   - domainID string
+//   - pm sdk.PageMetadata
+//   - token string
+func (_e *SDK_Expecter) ListGroupMembers(ctx interface{}, groupID interface{}, domainID interface{}, pm interface{}, token interface{}) *SDK_ListGroupMembers_Call {
+	return &SDK_ListGroupMembers_Call{Call: _e.mock.On("ListGroupMembers", ctx, groupID, domainID, pm, token)}
+}
+
+func (_c *SDK_ListGroupMembers_Call) Run(run func(ctx context.Context, groupID string, domainID string, pm sdk.PageMetadata, token string)) *SDK_ListGroupMembers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -7790,36 +8466,36 @@ func (_c *SDK_ListClientMembers_Call) Run(run func(ctx context.Context, clientID
 	return _c
 }
 
-func (_c *SDK_ListClientMembers_Call) Return(entityMembersPage sdk.EntityMembersPage, sDKError errors.SDKError) *SDK_ListClientMembers_Call {
+func (_c *SDK_ListGroupMembers_Call) Return(entityMembersPage sdk.EntityMembersPage, sDKError errors.SDKError) *SDK_ListGroupMembers_Call {
 	_c.Call.Return(entityMembersPage, sDKError)
 	return _c
 }
 
-func (_c *SDK_ListClientMembers_Call) RunAndReturn(run func(ctx context.Context, clientID string, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError)) *SDK_ListClientMembers_Call {
+func (_c *SDK_ListGroupMembers_Call) RunAndReturn(run func(ctx context.Context, groupID string, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError)) *SDK_ListGroupMembers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListDomainMembers provides a mock function for the type SDK
-func (_mock *SDK) ListDomainMembers(ctx context.Context, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError) {
-	ret := _mock.Called(ctx, domainID, pm, token)
+// ListReportsConfig provides a mock function for the type SDK
+func (_mock *SDK) ListReportsConfig(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.ReportConfigPage, errors.SDKError) {
+	ret := _mock.Called(ctx, pm, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListDomainMembers")
+		panic("no return value specified for ListReportsConfig")
 	}
 
-	var r0 sdk.EntityMembersPage
+	var r0 sdk.ReportConfigPage
 	var r1 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string) (sdk.EntityMembersPage, errors.SDKError)); ok {
-		return returnFunc(ctx, domainID, pm, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) (sdk.ReportConfigPage, errors.SDKError)); ok {
+		return returnFunc(ctx, pm, domainID, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string) sdk.EntityMembersPage); ok {
-		r0 = returnFunc(ctx, domainID, pm, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.ReportConfigPage); ok {
+		r0 = returnFunc(ctx, pm, domainID, token)
 	} else {
-		r0 = ret.Get(0).(sdk.EntityMembersPage)
+		r0 = ret.Get(0).(sdk.ReportConfigPage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string) errors.SDKError); ok {
-		r1 = returnFunc(ctx, domainID, pm, token)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, pm, domainID, token)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.SDKError)
@@ -7828,33 +8504,33 @@ func (_mock *SDK) ListDomainMembers(ctx context.Context, domainID string, pm sdk
 	return r0, r1
 }
 
-// SDK_ListDomainMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDomainMembers'
-type SDK_ListDomainMembers_Call struct {
+// SDK_ListReportsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReportsConfig'
+type SDK_ListReportsConfig_Call struct {
 	*mock.Call
 }
 
-// ListDomainMembers is a helper method to define mock.On call
+// ListReportsConfig is a helper method to define mock.On call
 //   - ctx context.Context
-//   - domainID string
 //   - pm sdk.PageMetadata
+//   - domainID string
 //   - token string
-func (_e *SDK_Expecter) ListDomainMembers(ctx interface{}, domainID interface{}, pm interface{}, token interface{}) *SDK_ListDomainMembers_Call {
-	return &SDK_ListDomainMembers_Call{Call: _e.mock.On("ListDomainMembers", ctx, domainID, pm, token)}
+func (_e *SDK_Expecter) ListReportsConfig(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_ListReportsConfig_Call {
+	return &SDK_ListReportsConfig_Call{Call: _e.mock.On("ListReportsConfig", ctx, pm, domainID, token)}
 }
 
-func (_c *SDK_ListDomainMembers_Call) Run(run func(ctx context.Context, domainID string, pm sdk.PageMetadata, token string)) *SDK_ListDomainMembers_Call {
+func (_c *SDK_ListReportsConfig_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_ListReportsConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 sdk.PageMetadata
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(sdk.PageMetadata)
 		}
-		var arg2 sdk.PageMetadata
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(sdk.PageMetadata)
+			arg2 = args[2].(string)
 		}
 		var arg3 string
 		if args[3] != nil {
@@ -7870,36 +8546,36 @@ func (_c *SDK_ListDomainMembers_Call) Run(run func(ctx context.Context, domainID
 	return _c
 }
 
-func (_c *SDK_ListDomainMembers_Call) Return(entityMembersPage sdk.EntityMembersPage, sDKError errors.SDKError) *SDK_ListDomainMembers_Call {
-	_c.Call.Return(entityMembersPage, sDKError)
+func (_c *SDK_ListReportsConfig_Call) Return(reportConfigPage sdk.ReportConfigPage, sDKError errors.SDKError) *SDK_ListReportsConfig_Call {
+	_c.Call.Return(reportConfigPage, sDKError)
 	return _c
 }
 
-func (_c *SDK_ListDomainMembers_Call) RunAndReturn(run func(ctx context.Context, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError)) *SDK_ListDomainMembers_Call {
+func (_c *SDK_ListReportsConfig_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.ReportConfigPage, errors.SDKError)) *SDK_ListReportsConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListGroupMembers provides a mock function for the type SDK
-func (_mock *SDK) ListGroupMembers(ctx context.Context, groupID string, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError) {
-	ret := _mock.Called(ctx, groupID, domainID, pm, token)
+// ListRuleExecutions provides a mock function for the type SDK
+func (_mock *SDK) ListRuleExecutions(ctx context.Context, id string, pm sdk.PageMetadata, domainID string, token string) (sdk.ExecutionsPage, errors.SDKError) {
+	ret := _mock.Called(ctx, id, pm, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListGroupMembers")
+		panic("no return value specified for ListRuleExecutions")
 	}
 
-	var r0 sdk.EntityMembersPage
+	var r0 sdk.ExecutionsPage
 	var r1 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, sdk.PageMetadata, string) (sdk.EntityMembersPage, errors.SDKError)); ok {
-		return returnFunc(ctx, groupID, domainID, pm, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) (sdk.ExecutionsPage, errors.SDKError)); ok {
+		return returnFunc(ctx, id, pm, domainID, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, sdk.PageMetadata, string) sdk.EntityMembersPage); ok {
-		r0 = returnFunc(ctx, groupID, domainID, pm, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) sdk.ExecutionsPage); ok {
+		r0 = returnFunc(ctx, id, pm, domainID, token)
 	} else {
-		r0 = ret.Get(0).(sdk.EntityMembersPage)
+		r0 = ret.Get(0).(sdk.ExecutionsPage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, sdk.PageMetadata, string) errors.SDKError); ok {
-		r1 = returnFunc(ctx, groupID, domainID, pm, token)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, pm, domainID, token)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.SDKError)
@@ -7908,22 +8584,22 @@ func (_mock *SDK) ListGroupMembers(ctx context.Context, groupID string, domainID
 	return r0, r1
 }
 
-// SDK_ListGroupMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListGroupMembers'
-type SDK_ListGroupMembers_Call struct {
+// SDK_ListRuleExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRuleExecutions'
+type SDK_ListRuleExecutions_Call struct {
 	*mock.Call
 }
 
-// ListGroupMembers is a helper method to define mock.On call
+// ListRuleExecutions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - groupID string
-//   - domainID string
+//   - id string
 //   - pm sdk.PageMetadata
+//   - domainID string
 //   - token string
-func (_e *SDK_Expecter) ListGroupMembers(ctx interface{}, groupID interface{}, domainID interface{}, pm interface{}, token interface{}) *SDK_ListGroupMembers_Call {
-	return &SDK_ListGroupMembers_Call{Call: _e.mock.On("ListGroupMembers", ctx, groupID, domainID, pm, token)}
+func (_e *SDK_Expecter) ListRuleExecutions(ctx interface{}, id interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_ListRuleExecutions_Call {
+	return &SDK_ListRuleExecutions_Call{Call: _e.mock.On("ListRuleExecutions", ctx, id, pm, domainID, token)}
 }
 
-func (_c *SDK_ListGroupMembers_Call) Run(run func(ctx context.Context, groupID string, domainID string, pm sdk.PageMetadata, token string)) *SDK_ListGroupMembers_Call {
+func (_c *SDK_ListRuleExecutions_Call) Run(run func(ctx context.Context, id string, pm sdk.PageMetadata, domainID string, token string)) *SDK_ListRuleExecutions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -7933,13 +8609,13 @@ func (_c *SDK_ListGroupMembers_Call) Run(run func(ctx context.Context, groupID s
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 sdk.PageMetadata
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(sdk.PageMetadata)
 		}
-		var arg3 sdk.PageMetadata
+		var arg3 string
 		if args[3] != nil {
-			arg3 = args[3].(sdk.PageMetadata)
+			arg3 = args[3].(string)
 		}
 		var arg4 string
 		if args[4] != nil {
@@ -7956,36 +8632,36 @@ func (_c *SDK_ListGroupMembers_Call) Run(run func(ctx context.Context, groupID s
 	return _c
 }
 
-func (_c *SDK_ListGroupMembers_Call) Return(entityMembersPage sdk.EntityMembersPage, sDKError errors.SDKError) *SDK_ListGroupMembers_Call {
-	_c.Call.Return(entityMembersPage, sDKError)
+func (_c *SDK_ListRuleExecutions_Call) Return(executionsPage sdk.ExecutionsPage, sDKError errors.SDKError) *SDK_ListRuleExecutions_Call {
+	_c.Call.Return(executionsPage, sDKError)
 	return _c
 }
 
-func (_c *SDK_ListGroupMembers_Call) RunAndReturn(run func(ctx context.Context, groupID string, domainID string, pm sdk.PageMetadata, token string) (sdk.EntityMembersPage, errors.SDKError)) *SDK_ListGroupMembers_Call {
+func (_c *SDK_ListRuleExecutions_Call) RunAndReturn(run func(ctx context.Context, id string, pm sdk.PageMetadata, domainID string, token string) (sdk.ExecutionsPage, errors.SDKError)) *SDK_ListRuleExecutions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListReportsConfig provides a mock function for the type SDK
-func (_mock *SDK) ListReportsConfig(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.ReportConfigPage, errors.SDKError) {
-	ret := _mock.Called(ctx, pm, domainID, token)
+// ListRuleRevisions provides a mock function for the type SDK
+func (_mock *SDK) ListRuleRevisions(ctx context.Context, id string, pm sdk.PageMetadata, domainID string, token string) (sdk.RevisionsPage, errors.SDKError) {
+	ret := _mock.Called(ctx, id, pm, domainID, token)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListReportsConfig")
+		panic("no return value specified for ListRuleRevisions")
 	}
 
-	var r0 sdk.ReportConfigPage
+	var r0 sdk.RevisionsPage
 	var r1 errors.SDKError
-	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) (sdk.ReportConfigPage, errors.SDKError)); ok {
-		return returnFunc(ctx, pm, domainID, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) (sdk.RevisionsPage, errors.SDKError)); ok {
+		return returnFunc(ctx, id, pm, domainID, token)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, sdk.PageMetadata, string, string) sdk.ReportConfigPage); ok {
-		r0 = returnFunc(ctx, pm, domainID, token)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, sdk.PageMetadata, string, string) sdk.RevisionsPage); ok {
+		r0 = returnFunc(ctx, id, pm, domainID, token)
 	} else {
-		r0 = ret.Get(0).(sdk.ReportConfigPage)
+		r0 = ret.Get(0).(sdk.RevisionsPage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, sdk.PageMetadata, string, string) errors.SDKError); ok {
-		r1 = returnFunc(ctx, pm, domainID, token)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, sdk.PageMetadata, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, pm, domainID, token)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.SDKError)
@@ -7994,54 +8670,60 @@ func (_mock *SDK) ListReportsConfig(ctx context.Context, pm sdk.PageMetadata, do
 	return r0, r1
 }
 
-// SDK_ListReportsConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReportsConfig'
-type SDK_ListReportsConfig_Call struct {
+// SDK_ListRuleRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRuleRevisions'
+type SDK_ListRuleRevisions_Call struct {
 	*mock.Call
 }
 
-// ListReportsConfig is a helper method to define mock.On call
+// ListRuleRevisions is a helper method to define mock.On call
 //   - ctx context.Context
+//   - id string
 //   - pm sdk.PageMetadata
 //   - domainID string
 //   - token string
-func (_e *SDK_Expecter) ListReportsConfig(ctx interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_ListReportsConfig_Call {
-	return &SDK_ListReportsConfig_Call{Call: _e.mock.On("ListReportsConfig", ctx, pm, domainID, token)}
+func (_e *SDK_Expecter) ListRuleRevisions(ctx interface{}, id interface{}, pm interface{}, domainID interface{}, token interface{}) *SDK_ListRuleRevisions_Call {
+	return &SDK_ListRuleRevisions_Call{Call: _e.mock.On("ListRuleRevisions", ctx, id, pm, domainID, token)}
 }
 
-func (_c *SDK_ListReportsConfig_Call) Run(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string)) *SDK_ListReportsConfig_Call {
+func (_c *SDK_ListRuleRevisions_Call) Run(run func(ctx context.Context, id string, pm sdk.PageMetadata, domainID string, token string)) *SDK_ListRuleRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 sdk.PageMetadata
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(sdk.PageMetadata)
+			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 sdk.PageMetadata
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(sdk.PageMetadata)
 		}
 		var arg3 string
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *SDK_ListReportsConfig_Call) Return(reportConfigPage sdk.ReportConfigPage, sDKError errors.SDKError) *SDK_ListReportsConfig_Call {
-	_c.Call.Return(reportConfigPage, sDKError)
+func (_c *SDK_ListRuleRevisions_Call) Return(revisionsPage sdk.RevisionsPage, sDKError errors.SDKError) *SDK_ListRuleRevisions_Call {
+	_c.Call.Return(revisionsPage, sDKError)
 	return _c
 }
 
-func (_c *SDK_ListReportsConfig_Call) RunAndReturn(run func(ctx context.Context, pm sdk.PageMetadata, domainID string, token string) (sdk.ReportConfigPage, errors.SDKError)) *SDK_ListReportsConfig_Call {
+func (_c *SDK_ListRuleRevisions_Call) RunAndReturn(run func(ctx context.Context, id string, pm sdk.PageMetadata, domainID string, token string) (sdk.RevisionsPage, errors.SDKError)) *SDK_ListRuleRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8491,6 +9173,83 @@ func (_c *SDK_RejectInvitation_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// RemoveAlarmComment provides a mock function for the type SDK
+func (_mock *SDK) RemoveAlarmComment(ctx context.Context, alarmID string, id string, domainID string, token string) errors.SDKError {
+	ret := _mock.Called(ctx, alarmID, id, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAlarmComment")
+	}
+
+	var r0 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) errors.SDKError); ok {
+		r0 = returnFunc(ctx, alarmID, id, domainID, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.SDKError)
+		}
+	}
+	return r0
+}
+
+// SDK_RemoveAlarmComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAlarmComment'
+type SDK_RemoveAlarmComment_Call struct {
+	*mock.Call
+}
+
+// RemoveAlarmComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - alarmID string
+//   - id string
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RemoveAlarmComment(ctx interface{}, alarmID interface{}, id interface{}, domainID interface{}, token interface{}) *SDK_RemoveAlarmComment_Call {
+	return &SDK_RemoveAlarmComment_Call{Call: _e.mock.On("RemoveAlarmComment", ctx, alarmID, id, domainID, token)}
+}
+
+func (_c *SDK_RemoveAlarmComment_Call) Run(run func(ctx context.Context, alarmID string, id string, domainID string, token string)) *SDK_RemoveAlarmComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RemoveAlarmComment_Call) Return(sDKError errors.SDKError) *SDK_RemoveAlarmComment_Call {
+	_c.Call.Return(sDKError)
+	return _c
+}
+
+func (_c *SDK_RemoveAlarmComment_Call) RunAndReturn(run func(ctx context.Context, alarmID string, id string, domainID string, token string) errors.SDKError) *SDK_RemoveAlarmComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveAllChildren provides a mock function for the type SDK
 func (_mock *SDK) RemoveAllChildren(ctx context.Context, id string, domainID string, token string) errors.SDKError {
 	ret := _mock.Called(ctx, id, domainID, token)
@@ -10371,6 +11130,92 @@ func (_c *SDK_RevokeCert_Call) RunAndReturn(run func(ctx context.Context, serial
 	return _c
 }
 
+// RollbackRule provides a mock function for the type SDK
+func (_mock *SDK) RollbackRule(ctx context.Context, id string, revision uint64, domainID string, token string) (sdk.Rule, errors.SDKError) {
+	ret := _mock.Called(ctx, id, revision, domainID, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackRule")
+	}
+
+	var r0 sdk.Rule
+	var r1 errors.SDKError
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, string, string) (sdk.Rule, errors.SDKError)); ok {
+		return returnFunc(ctx, id, revision, domainID, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64, string, string) sdk.Rule); ok {
+		r0 = returnFunc(ctx, id, revision, domainID, token)
+	} else {
+		r0 = ret.Get(0).(sdk.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64, string, string) errors.SDKError); ok {
+		r1 = returnFunc(ctx, id, revision, domainID, token)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.SDKError)
+		}
+	}
+	return r0, r1
+}
+
+// SDK_RollbackRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackRule'
+type SDK_RollbackRule_Call struct {
+	*mock.Call
+}
+
+// RollbackRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - revision uint64
+//   - domainID string
+//   - token string
+func (_e *SDK_Expecter) RollbackRule(ctx interface{}, id interface{}, revision interface{}, domainID interface{}, token interface{}) *SDK_RollbackRule_Call {
+	return &SDK_RollbackRule_Call{Call: _e.mock.On("RollbackRule", ctx, id, revision, domainID, token)}
+}
+
+func (_c *SDK_RollbackRule_Call) Run(run func(ctx context.Context, id string, revision uint64, domainID string, token string)) *SDK_RollbackRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uint64
+		if args[2] != nil {
+			arg2 = args[2].(uint64)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *SDK_RollbackRule_Call) Return(rule sdk.Rule, sDKError errors.SDKError) *SDK_RollbackRule_Call {
+	_c.Call.Return(rule, sDKError)
+	return _c
+}
+
+func (_c *SDK_RollbackRule_Call) RunAndReturn(run func(ctx context.Context, id string, revision uint64, domainID string, token string) (sdk.Rule, errors.SDKError)) *SDK_RollbackRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchUsers provides a mock function for the type SDK
 func (_mock *SDK) SearchUsers(ctx context.Context, pm sdk.PageMetadata, token string) (sdk.UsersPage, errors.SDKError) {
 	ret := _mock.Called(ctx, pm, token)
@@ -27,11 +27,13 @@ type Rule struct {
 	Logic        any                       `json:"logic,omitempty"`
 	Outputs      any                       `json:"outputs,omitempty"`
 	Schedule     any                       `json:"schedule,omitempty"`
+	Window       any                       `json:"window,omitempty"`
 	Status       string                    `json:"status,omitempty"`
 	CreatedAt    string                    `json:"created_at,omitempty"`
 	CreatedBy    string                    `json:"created_by,omitempty"`
 	UpdatedAt    string                    `json:"updated_at,omitempty"`
 	UpdatedBy    string                    `json:"updated_by,omitempty"`
+	Revision     uint64                    `json:"revision,omitempty"`
 	Roles        []roles.MemberRoleActions `json:"roles,omitempty"`
 }
 
@@ -42,6 +44,62 @@ type Page struct {
 	Rules  []Rule `json:"rules"`
 }
 
+// Execution represents a single run of a rule.
+type Execution struct {
+	ID             string   `json:"id"`
+	RuleID         string   `json:"rule_id"`
+	DomainID       string   `json:"domain_id"`
+	Channel        string   `json:"channel,omitempty"`
+	Subtopic       string   `json:"subtopic,omitempty"`
+	ClientID       string   `json:"client_id,omitempty"`
+	MessageCreated int64    `json:"message_created,omitempty"`
+	Status         string   `json:"status"`
+	Error          string   `json:"error,omitempty"`
+	Outputs        []string `json:"outputs,omitempty"`
+	Duration       int64    `json:"duration"`
+	CreatedAt      string   `json:"created_at"`
+}
+
+type ExecutionsPage struct {
+	Offset     uint64      `json:"offset"`
+	Limit      uint64      `json:"limit"`
+	Total      uint64      `json:"total"`
+	Executions []Execution `json:"executions"`
+}
+
+// Revision represents an immutable snapshot of the rule spec.
+type Revision struct {
+	RuleID       string `json:"rule_id"`
+	Revision     uint64 `json:"revision"`
+	Spec         Rule   `json:"spec"`
+	RestoredFrom uint64 `json:"restored_from,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	CreatedBy    string `json:"created_by"`
+}
+
+type RevisionsPage struct {
+	Offset    uint64     `json:"offset"`
+	Limit     uint64     `json:"limit"`
+	Total     uint64     `json:"total"`
+	Revisions []Revision `json:"revisions"`
+}
+
+// RevisionChange represents a change of a single rule spec field.
+type RevisionChange struct {
+	Path  string `json:"path"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+	Patch string `json:"patch,omitempty"`
+}
+
+// RevisionDiff represents the rule spec changes between two revisions.
+type RevisionDiff struct {
+	RuleID  string           `json:"rule_id"`
+	From    uint64           `json:"from"`
+	To      uint64           `json:"to"`
+	Changes []RevisionChange `json:"changes"`
+}
+
 func (sdk mgSDK) AddRule(ctx context.Context, r Rule, domainID, token string) (Rule, errors.SDKError) {
 	data, err := json.Marshal(r)
 	if err != nil {
@@ -162,6 +220,78 @@ func (sdk mgSDK) ListRules(ctx context.Context, pm PageMetadata, domainID, token
 	return ap, nil
 }
 
+func (sdk mgSDK) ListRuleExecutions(ctx context.Context, id string, pm PageMetadata, domainID, token string) (ExecutionsPage, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s/%s/executions", domainID, rulesEndpoint, id)
+	url, err := sdk.withQueryParams(sdk.rulesEngineURL, endpoint, pm)
+	if err != nil {
+		return ExecutionsPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return ExecutionsPage{}, sdkerr
+	}
+
+	var ep ExecutionsPage
+	if err := json.Unmarshal(body, &ep); err != nil {
+		return ExecutionsPage{}, errors.NewSDKError(err)
+	}
+
+	return ep, nil
+}
+
+func (sdk mgSDK) ListRuleRevisions(ctx context.Context, id string, pm PageMetadata, domainID, token string) (RevisionsPage, errors.SDKError) {
+	endpoint := fmt.Sprintf("%s/%s/%s/revisions", domainID, rulesEndpoint, id)
+	url, err := sdk.withQueryParams(sdk.rulesEngineURL, endpoint, pm)
+	if err != nil {
+		return RevisionsPage{}, errors.NewSDKError(err)
+	}
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return RevisionsPage{}, sdkerr
+	}
+
+	var rp RevisionsPage
+	if err := json.Unmarshal(body, &rp); err != nil {
+		return RevisionsPage{}, errors.NewSDKError(err)
+	}
+
+	return rp, nil
+}
+
+func (sdk mgSDK) DiffRuleRevisions(ctx context.Context, id string, from, to uint64, domainID, token string) (RevisionDiff, errors.SDKError) {
+	url := fmt.Sprintf("%s/%s/%s/%s/revisions/diff?from=%d&to=%d", sdk.rulesEngineURL, domainID, rulesEndpoint, id, from, to)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodGet, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return RevisionDiff{}, sdkerr
+	}
+
+	var d RevisionDiff
+	if err := json.Unmarshal(body, &d); err != nil {
+		return RevisionDiff{}, errors.NewSDKError(err)
+	}
+
+	return d, nil
+}
+
+func (sdk mgSDK) RollbackRule(ctx context.Context, id string, revision uint64, domainID, token string) (Rule, errors.SDKError) {
+	url := fmt.Sprintf("%s/%s/%s/%s/revisions/%d/rollback", sdk.rulesEngineURL, domainID, rulesEndpoint, id, revision)
+
+	_, body, sdkerr := sdk.processRequest(ctx, http.MethodPost, url, token, nil, nil, http.StatusOK)
+	if sdkerr != nil {
+		return Rule{}, sdkerr
+	}
+
+	var r Rule
+	if err := json.Unmarshal(body, &r); err != nil {
+		return Rule{}, errors.NewSDKError(err)
+	}
+
+	return r, nil
+}
+
 func (sdk mgSDK) RemoveRule(ctx context.Context, id, domainID, token string) errors.SDKError {
 	url := fmt.Sprintf("%s/%s/%s/%s", sdk.rulesEngineURL, domainID, rulesEndpoint, id)
 
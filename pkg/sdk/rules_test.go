@@ -12,6 +12,7 @@ import (
 	mglog "github.com/absmach/magistrala/logger"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/absmach/magistrala/pkg/sdk"
 	"github.com/absmach/magistrala/re"
@@ -420,6 +421,343 @@ func TestListRules(t *testing.T) {
 	}
 }
 
+func TestListRuleExecutions(t *testing.T) {
+	rs, rsvc, auth := setupRules()
+	defer rs.Close()
+
+	conf := sdk.Config{
+		RulesEngineURL: rs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcPage := re.ExecutionPage{
+		Limit: 10,
+		Total: 1,
+		Executions: []re.Execution{
+			{
+				ID:       validID,
+				RuleID:   validID,
+				DomainID: domainID,
+				Status:   re.FailedExecution,
+				Error:    "failed to run rule logic",
+			},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		id              string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		svcRes          re.ExecutionPage
+		svcErr          error
+		authenticateErr error
+		response        sdk.ExecutionsPage
+		wantErr         bool
+	}{
+		{
+			desc:   "list rule executions successfully",
+			id:     validID,
+			pm:     sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:  validToken,
+			svcRes: svcPage,
+			response: sdk.ExecutionsPage{
+				Limit: 10,
+				Total: 1,
+				Executions: []sdk.Execution{
+					{
+						ID:        validID,
+						RuleID:    validID,
+						DomainID:  domainID,
+						Status:    "failed",
+						Error:     "failed to run rule logic",
+						CreatedAt: "0001-01-01T00:00:00Z",
+					},
+				},
+			},
+		},
+		{
+			desc: "list rule executions with filters",
+			id:   validID,
+			pm: sdk.PageMetadata{
+				Limit:     5,
+				Status:    "failed",
+				Direction: "asc",
+				From:      1700000000,
+				To:        1700003600,
+			},
+			token:    validToken,
+			svcRes:   re.ExecutionPage{Limit: 5},
+			response: sdk.ExecutionsPage{Limit: 5},
+		},
+		{
+			desc:    "list rule executions with invalid status",
+			id:      validID,
+			pm:      sdk.PageMetadata{Limit: 10, Status: "invalid"},
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "list rule executions with empty token",
+			id:      validID,
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "list rule executions with service error",
+			id:      validID,
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   validToken,
+			svcErr:  svcerr.ErrAuthorization,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := rsvc.On("ListExecutions", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ListRuleExecutions(context.Background(), tc.id, tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.response, result)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListRuleRevisions(t *testing.T) {
+	rs, rsvc, auth := setupRules()
+	defer rs.Close()
+
+	conf := sdk.Config{
+		RulesEngineURL: rs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcPage := re.RevisionPage{
+		Limit: 10,
+		Total: 1,
+		Revisions: []re.Revision{
+			{
+				RuleID:    ruleID,
+				Revision:  1,
+				Spec:      re.Spec{Name: "rule"},
+				CreatedBy: validID,
+			},
+		},
+	}
+
+	cases := []struct {
+		desc            string
+		id              string
+		pm              sdk.PageMetadata
+		token           string
+		session         smqauthn.Session
+		svcRes          re.RevisionPage
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:   "list rule revisions successfully",
+			id:     ruleID,
+			pm:     sdk.PageMetadata{Offset: 0, Limit: 10},
+			token:  validToken,
+			svcRes: svcPage,
+		},
+		{
+			desc:    "list rule revisions with empty token",
+			id:      ruleID,
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   "",
+			wantErr: true,
+		},
+		{
+			desc:    "list rule revisions with service error",
+			id:      ruleID,
+			pm:      sdk.PageMetadata{Limit: 10},
+			token:   validToken,
+			svcErr:  svcerr.ErrAuthorization,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := rsvc.On("ListRevisions", mock.Anything, tc.session, re.RevisionPageMeta{RuleID: tc.id, Offset: tc.pm.Offset, Limit: tc.pm.Limit}).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.ListRuleRevisions(context.Background(), tc.id, tc.pm, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.svcRes.Total, result.Total)
+				assert.Equal(t, uint64(1), result.Revisions[0].Revision)
+				assert.Equal(t, "rule", result.Revisions[0].Spec.Name)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDiffRuleRevisions(t *testing.T) {
+	rs, rsvc, auth := setupRules()
+	defer rs.Close()
+
+	conf := sdk.Config{
+		RulesEngineURL: rs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcDiff := re.RevisionDiff{
+		RuleID:  ruleID,
+		From:    1,
+		To:      2,
+		Changes: []re.Change{{Path: "name", From: "old", To: "new"}},
+	}
+
+	cases := []struct {
+		desc            string
+		id              string
+		from            uint64
+		to              uint64
+		token           string
+		session         smqauthn.Session
+		svcRes          re.RevisionDiff
+		svcErr          error
+		authenticateErr error
+		response        sdk.RevisionDiff
+		wantErr         bool
+	}{
+		{
+			desc:   "diff rule revisions successfully",
+			id:     ruleID,
+			from:   1,
+			to:     2,
+			token:  validToken,
+			svcRes: svcDiff,
+			response: sdk.RevisionDiff{
+				RuleID:  ruleID,
+				From:    1,
+				To:      2,
+				Changes: []sdk.RevisionChange{{Path: "name", From: "old", To: "new"}},
+			},
+		},
+		{
+			desc:    "diff rule revisions with invalid revision",
+			id:      ruleID,
+			from:    0,
+			to:      2,
+			token:   validToken,
+			wantErr: true,
+		},
+		{
+			desc:    "diff rule revisions with service error",
+			id:      ruleID,
+			from:    1,
+			to:      2,
+			token:   validToken,
+			svcErr:  svcerr.ErrNotFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := rsvc.On("DiffRevisions", mock.Anything, tc.session, tc.id, tc.from, tc.to).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.DiffRuleRevisions(context.Background(), tc.id, tc.from, tc.to, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.response, result)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRollbackRule(t *testing.T) {
+	rs, rsvc, auth := setupRules()
+	defer rs.Close()
+
+	conf := sdk.Config{
+		RulesEngineURL: rs.URL,
+	}
+	mgsdk := sdk.NewSDK(conf)
+
+	svcRule := re.Rule{
+		ID:       ruleID,
+		Status:   re.EnabledStatus,
+		Revision: 3,
+	}
+
+	cases := []struct {
+		desc            string
+		id              string
+		revision        uint64
+		token           string
+		session         smqauthn.Session
+		svcRes          re.Rule
+		svcErr          error
+		authenticateErr error
+		wantErr         bool
+	}{
+		{
+			desc:     "rollback rule successfully",
+			id:       ruleID,
+			revision: 1,
+			token:    validToken,
+			svcRes:   svcRule,
+		},
+		{
+			desc:     "rollback rule with empty token",
+			id:       ruleID,
+			revision: 1,
+			token:    "",
+			wantErr:  true,
+		},
+		{
+			desc:     "rollback rule with invalid revision",
+			id:       ruleID,
+			revision: 0,
+			token:    validToken,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: domainID + "_" + validID, UserID: validID, DomainID: domainID}
+			}
+			authCall := auth.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authenticateErr)
+			svcCall := rsvc.On("RollbackRule", mock.Anything, tc.session, tc.id, tc.revision).Return(tc.svcRes, tc.svcErr)
+			result, err := mgsdk.RollbackRule(context.Background(), tc.id, tc.revision, domainID, tc.token)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, ruleID, result.ID)
+				assert.Equal(t, uint64(3), result.Revision)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
 func TestEnableRule(t *testing.T) {
 	rs, rsvc, auth := setupRules()
 	defer rs.Close()
@@ -194,6 +194,8 @@ type PageMetadata struct {
 	IPAddresses        []string  `json:"ip_addresses,omitempty"`
 	EmailAddresses     []string  `json:"email_addresses,omitempty"`
 	TTL                string    `json:"ttl,omitempty"`
+	GroupBy            []string  `json:"group_by,omitempty"`
+	Bucket             string    `json:"bucket,omitempty"`
 }
 
 type Role struct {
@@ -1670,9 +1672,31 @@ type SDK interface {
 	// ListAlarms retrieves a page of alarms.
 	ListAlarms(ctx context.Context, pm PageMetadata, domainID, token string) (AlarmsPage, smqerrors.SDKError)
 
+	// AlarmStats aggregates the alarms that match the page filters by the
+	// page GroupBy fields and Bucket.
+	AlarmStats(ctx context.Context, pm PageMetadata, domainID, token string) (AlarmStats, smqerrors.SDKError)
+
 	// DeleteAlarm deletes an alarm.
 	DeleteAlarm(ctx context.Context, id, domainID, token string) smqerrors.SDKError
 
+	// BulkAlarms applies the acknowledge, assign, resolve or delete action
+	// to the selected alarms and reports the outcome for each alarm.
+	BulkAlarms(ctx context.Context, action string, req BulkAlarmsRequest, domainID, token string) (BulkAlarmsReport, smqerrors.SDKError)
+
+	// AddAlarmComment adds the comment, or the reply to the parent comment,
+	// to the alarm.
+	AddAlarmComment(ctx context.Context, comment AlarmComment, domainID, token string) (AlarmComment, smqerrors.SDKError)
+
+	// AlarmComments retrieves a page of the alarm comments.
+	AlarmComments(ctx context.Context, alarmID string, pm PageMetadata, domainID, token string) (AlarmCommentsPage, smqerrors.SDKError)
+
+	// RemoveAlarmComment removes the alarm comment and its replies.
+	RemoveAlarmComment(ctx context.Context, alarmID, id, domainID, token string) smqerrors.SDKError
+
+	// AlarmTimeline retrieves a page of the alarm activities, from the
+	// oldest one.
+	AlarmTimeline(ctx context.Context, alarmID string, pm PageMetadata, domainID, token string) (AlarmTimeline, smqerrors.SDKError)
+
 	// AddReportConfig creates a new report configuration.
 	AddReportConfig(ctx context.Context, cfg ReportConfig, domainID, token string) (ReportConfig, smqerrors.SDKError)
 
@@ -1727,6 +1751,43 @@ type SDK interface {
 	// ListRules retrieves a page of rules.
 	ListRules(ctx context.Context, pm PageMetadata, domainID, token string) (Page, smqerrors.SDKError)
 
+	// ListRuleExecutions retrieves a page of the rule execution history.
+	//
+	// example:
+	//  pm := sdk.PageMetadata{
+	//    Offset: 0,
+	//    Limit:  10,
+	//    Status: "failed",
+	//  }
+	//  page, _ := sdk.ListRuleExecutions(context.Background(), "ruleID", pm, "domainID", "token")
+	//  fmt.Println(page)
+	ListRuleExecutions(ctx context.Context, id string, pm PageMetadata, domainID, token string) (ExecutionsPage, smqerrors.SDKError)
+
+	// ListRuleRevisions retrieves a page of the rule revisions, newest first.
+	//
+	// example:
+	//  pm := sdk.PageMetadata{
+	//    Offset: 0,
+	//    Limit:  10,
+	//  }
+	//  page, _ := sdk.ListRuleRevisions(context.Background(), "ruleID", pm, "domainID", "token")
+	//  fmt.Println(page)
+	ListRuleRevisions(ctx context.Context, id string, pm PageMetadata, domainID, token string) (RevisionsPage, smqerrors.SDKError)
+
+	// DiffRuleRevisions retrieves the rule spec changes from one revision to another.
+	//
+	// example:
+	//  diff, _ := sdk.DiffRuleRevisions(context.Background(), "ruleID", 1, 3, "domainID", "token")
+	//  fmt.Println(diff)
+	DiffRuleRevisions(ctx context.Context, id string, from, to uint64, domainID, token string) (RevisionDiff, smqerrors.SDKError)
+
+	// RollbackRule restores the rule spec of the revision as a new revision.
+	//
+	// example:
+	//  rule, _ := sdk.RollbackRule(context.Background(), "ruleID", 2, "domainID", "token")
+	//  fmt.Println(rule)
+	RollbackRule(ctx context.Context, id string, revision uint64, domainID, token string) (Rule, smqerrors.SDKError)
+
 	// RemoveRule deletes a rule.
 	RemoveRule(ctx context.Context, id, domainID, token string) smqerrors.SDKError
 
@@ -2128,6 +2189,27 @@ func (pm PageMetadata) query() (string, error) {
 	if pm.TTL != "" {
 		q.Add("ttl", pm.TTL)
 	}
+	if pm.RuleID != "" {
+		q.Add("rule_id", pm.RuleID)
+	}
+	if pm.ChannelID != "" {
+		q.Add("channel_id", pm.ChannelID)
+	}
+	if pm.ClientID != "" {
+		q.Add("client_id", pm.ClientID)
+	}
+	if pm.Subtopic != "" {
+		q.Add("subtopic", pm.Subtopic)
+	}
+	if pm.Severity != 0 {
+		q.Add("severity", strconv.FormatUint(uint64(pm.Severity), 10))
+	}
+	if len(pm.GroupBy) > 0 {
+		q.Add("group_by", strings.Join(pm.GroupBy, ","))
+	}
+	if pm.Bucket != "" {
+		q.Add("bucket", pm.Bucket)
+	}
 
 	return q.Encode(), nil
 }
@@ -9,6 +9,8 @@ type Payload map[string]any
 // Message represents a JSON messages.
 type Message struct {
 	Channel   string  `json:"channel,omitempty" db:"channel" bson:"channel"`
+	Domain    string  `json:"domain,omitempty" db:"domain" bson:"domain,omitempty"`
+	ClientID  string  `json:"client_id,omitempty" db:"client_id" bson:"client_id,omitempty"`
 	Created   int64   `json:"created,omitempty" db:"created" bson:"created"`
 	Subtopic  string  `json:"subtopic,omitempty" db:"subtopic" bson:"subtopic,omitempty"`
 	Publisher string  `json:"publisher,omitempty" db:"publisher" bson:"publisher"`
@@ -54,6 +54,8 @@ func (ts *transformerService) Transform(msg *messaging.Message) (any, error) {
 		Created:   msg.GetCreated(),
 		Protocol:  msg.GetProtocol(),
 		Channel:   msg.GetChannel(),
+		Domain:    msg.GetDomain(),
+		ClientID:  msg.GetClientId(),
 		Subtopic:  msg.GetSubtopic(),
 	}
 
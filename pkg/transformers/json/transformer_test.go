@@ -37,6 +37,8 @@ func TestTransformJSON(t *testing.T) {
 	tr := json.New(ts)
 	msg := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "subtopic-1",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -45,6 +47,8 @@ func TestTransformJSON(t *testing.T) {
 	}
 	invalid := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "subtopic-1",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -54,6 +58,8 @@ func TestTransformJSON(t *testing.T) {
 
 	listMsg := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "subtopic-1",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -63,6 +69,8 @@ func TestTransformJSON(t *testing.T) {
 
 	tsMsg := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "subtopic-1",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -72,6 +80,8 @@ func TestTransformJSON(t *testing.T) {
 
 	microsMsg := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "subtopic-1",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -81,6 +91,8 @@ func TestTransformJSON(t *testing.T) {
 
 	invalidFmt := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -90,6 +102,8 @@ func TestTransformJSON(t *testing.T) {
 
 	invalidTimeField := messaging.Message{
 		Channel:   "channel-1",
+		Domain:    "domain-1",
+		ClientId:  "client-1",
 		Subtopic:  "subtopic-1",
 		Publisher: "publisher-1",
 		Protocol:  "protocol",
@@ -101,6 +115,8 @@ func TestTransformJSON(t *testing.T) {
 		Data: []json.Message{
 			{
 				Channel:   msg.Channel,
+				Domain:    msg.Domain,
+				ClientID:  msg.ClientId,
 				Subtopic:  msg.Subtopic,
 				Publisher: msg.Publisher,
 				Protocol:  msg.Protocol,
@@ -122,6 +138,8 @@ func TestTransformJSON(t *testing.T) {
 		Data: []json.Message{
 			{
 				Channel:   msg.Channel,
+				Domain:    msg.Domain,
+				ClientID:  msg.ClientId,
 				Subtopic:  msg.Subtopic,
 				Publisher: msg.Publisher,
 				Protocol:  msg.Protocol,
@@ -144,6 +162,8 @@ func TestTransformJSON(t *testing.T) {
 		Data: []json.Message{
 			{
 				Channel:   msg.Channel,
+				Domain:    msg.Domain,
+				ClientID:  msg.ClientId,
 				Subtopic:  msg.Subtopic,
 				Publisher: msg.Publisher,
 				Protocol:  msg.Protocol,
@@ -166,6 +186,8 @@ func TestTransformJSON(t *testing.T) {
 		Data: []json.Message{
 			{
 				Channel:   msg.Channel,
+				Domain:    msg.Domain,
+				ClientID:  msg.ClientId,
 				Subtopic:  msg.Subtopic,
 				Publisher: msg.Publisher,
 				Protocol:  msg.Protocol,
@@ -181,6 +203,8 @@ func TestTransformJSON(t *testing.T) {
 			},
 			{
 				Channel:   msg.Channel,
+				Domain:    msg.Domain,
+				ClientID:  msg.ClientId,
 				Subtopic:  msg.Subtopic,
 				Publisher: msg.Publisher,
 				Protocol:  msg.Protocol,
@@ -6,6 +6,8 @@ package senml
 // Message represents a resolved (normalized) SenML record.
 type Message struct {
 	Channel     string   `json:"channel,omitempty" db:"channel" bson:"channel"`
+	Domain      string   `json:"domain,omitempty" db:"domain" bson:"domain,omitempty"`
+	ClientID    string   `json:"client_id,omitempty" db:"client_id" bson:"client_id,omitempty"`
 	Subtopic    string   `json:"subtopic,omitempty" db:"subtopic" bson:"subtopic,omitempty"`
 	Publisher   string   `json:"publisher,omitempty" db:"publisher" bson:"publisher"`
 	Protocol    string   `json:"protocol,omitempty" db:"protocol" bson:"protocol"`
@@ -75,6 +75,8 @@ func (t transformer) Transform(msg *messaging.Message) (any, error) {
 
 		msgs[i] = Message{
 			Channel:     msg.GetChannel(),
+			Domain:      msg.GetDomain(),
+			ClientID:    msg.GetClientId(),
 			Subtopic:    msg.GetSubtopic(),
 			Publisher:   msg.GetPublisher(),
 			Protocol:    msg.GetProtocol(),
@@ -25,6 +25,8 @@ func TestTransformJSON(t *testing.T) {
 	tr := senml.New(senml.JSON)
 	msg := &messaging.Message{
 		Channel:   "channel",
+		Domain:    "domain",
+		ClientId:  "client",
 		Subtopic:  "subtopic",
 		Publisher: "publisher",
 		Protocol:  "protocol",
@@ -39,6 +41,8 @@ func TestTransformJSON(t *testing.T) {
 	msgs := []senml.Message{
 		{
 			Channel:    "channel",
+			Domain:     "domain",
+			ClientID:   "client",
 			Subtopic:   "subtopic",
 			Publisher:  "publisher",
 			Protocol:   "protocol",
@@ -0,0 +1,271 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhook sends signed HTTP requests with retries. It's used for
+// the rule engine webhook outputs and the alarm webhook receivers.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/callout"
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	// SignatureHeader contains the hex encoded HMAC-SHA256 of the timestamp
+	// and the body joined with a dot, prefixed with "sha256=".
+	SignatureHeader = "X-Magistrala-Signature"
+	// TimestampHeader contains the Unix time in seconds used for the signature.
+	TimestampHeader = "X-Magistrala-Timestamp"
+
+	defTimeout  = 10 * time.Second
+	defBackoff  = time.Second
+	defIdleTime = 5 * time.Minute
+	// MaxBackoff is the longest delay between the retries.
+	MaxBackoff = time.Minute
+	// MaxRunTime limits a delivery with all its retries, so slow endpoints
+	// don't keep the callers busy.
+	MaxRunTime     = 2 * time.Minute
+	maxErrBodySize = 1024
+)
+
+var errStatus = errors.New("webhook responded with unexpected status")
+
+// Config is the webhook request and the HTTP client configuration.
+type Config struct {
+	URL    string
+	Method string
+	// Headers are set in addition to the content type and the signature.
+	Headers map[string]string
+	// Secret is the HMAC key used to sign the requests. Requests are not signed if it's empty.
+	Secret  string
+	Timeout time.Duration
+	// Retries is the number of retries for the network errors and 429 and 5xx responses.
+	Retries uint
+	// Backoff is the delay before the first retry. It doubles with each retry.
+	Backoff             time.Duration
+	SkipTLSVerification bool
+	CACert              string
+	Cert                string
+	Key                 string
+}
+
+// Send sends the body to the webhook, retrying the failed requests. The
+// client is created for the request if it's nil.
+func Send(ctx context.Context, client *http.Client, cfg Config, body []byte) error {
+	if client == nil {
+		c, err := NewClient(cfg)
+		if err != nil {
+			return err
+		}
+		// The client isn't reused, so its connections are closed with the transport.
+		c.Transport.(*http.Transport).DisableKeepAlives = true
+		client = c
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, MaxRunTime)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	backoff := cfg.Backoff
+	if backoff == 0 {
+		backoff = defBackoff
+	}
+	for i := uint(0); ; i++ {
+		retry, err := send(ctx, client, cfg, body)
+		if err == nil || !retry || i >= cfg.Retries {
+			return err
+		}
+		delay := min(backoff<<i, MaxBackoff)
+		// Don't wait for a retry that can't be made before the deadline.
+		if time.Until(deadline) < delay {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// send makes a single request and reports if it can be retried.
+func send(ctx context.Context, client *http.Client, cfg Config, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, cfg.RequestMethod(), cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	for k, v := range cfg.RequestHeaders(body, time.Now()) {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrBodySize))
+	err = errors.Wrap(errStatus, fmt.Errorf("%d: %s", resp.StatusCode, msg))
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+
+	return retry, err
+}
+
+// RequestMethod returns the HTTP method, POST by default.
+func (c Config) RequestMethod() string {
+	if c.Method == "" {
+		return http.MethodPost
+	}
+	return strings.ToUpper(c.Method)
+}
+
+// RequestHeaders returns the headers of the request with the body sent at now.
+func (c Config) RequestHeaders(body []byte, now time.Time) map[string]string {
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	for k, v := range c.Headers {
+		headers[http.CanonicalHeaderKey(k)] = v
+	}
+	if c.Secret != "" {
+		ts := strconv.FormatInt(now.Unix(), 10)
+		headers[TimestampHeader] = ts
+		headers[SignatureHeader] = "sha256=" + Sign(c.Secret, ts, body)
+	}
+
+	return headers
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the timestamp and the body.
+// Receivers use it to verify the webhook requests.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewClient returns the HTTP client with the TLS configuration and the timeout of the webhook.
+func NewClient(cfg Config) (*http.Client, error) {
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defTimeout
+	}
+
+	return callout.NewHTTPClient(callout.Config{
+		TLSVerification: !cfg.SkipTLSVerification,
+		Timeout:         timeout,
+		CACert:          cfg.CACert,
+		Cert:            cfg.Cert,
+		Key:             cfg.Key,
+	})
+}
+
+// clientKey identifies the HTTP client configuration of the webhook.
+func (c Config) clientKey() string {
+	h := sha256.New()
+	for _, s := range []string{strconv.FormatBool(c.SkipTLSVerification), c.Timeout.String(), c.CACert, c.Cert, c.Key} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type poolClient struct {
+	client   *http.Client
+	lastUsed time.Time
+}
+
+// Pool keeps the HTTP clients of the webhooks, so the connections and the
+// parsed certificates are reused between the requests. Webhooks with the
+// same TLS configuration and timeout share a client. Clients that are not
+// used for the idle time are closed.
+type Pool struct {
+	mu       sync.Mutex
+	idleTime time.Duration
+	clients  map[string]*poolClient
+}
+
+// NewPool returns a webhook HTTP client pool.
+func NewPool(idleTime time.Duration) *Pool {
+	if idleTime <= 0 {
+		idleTime = defIdleTime
+	}
+
+	return &Pool{
+		idleTime: idleTime,
+		clients:  make(map[string]*poolClient),
+	}
+}
+
+// Get returns the HTTP client of the webhook configuration, creating it if needed.
+func (p *Pool) Get(cfg Config) (*http.Client, error) {
+	key := cfg.clientKey()
+	now := time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictIdle(now)
+	if c, ok := p.clients[key]; ok {
+		c.lastUsed = now
+		return c.client, nil
+	}
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p.clients[key] = &poolClient{client: client, lastUsed: now}
+
+	return client, nil
+}
+
+// Send sends the body to the webhook with the pooled client.
+func (p *Pool) Send(ctx context.Context, cfg Config, body []byte) error {
+	client, err := p.Get(cfg)
+	if err != nil {
+		return err
+	}
+
+	return Send(ctx, client, cfg, body)
+}
+
+// Close closes the idle connections of all the clients.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	clients := p.clients
+	p.clients = make(map[string]*poolClient)
+	p.mu.Unlock()
+
+	for _, c := range clients {
+		c.client.CloseIdleConnections()
+	}
+}
+
+// evictIdle closes the idle clients. It must be called with the lock held.
+func (p *Pool) evictIdle(now time.Time) {
+	for key, c := range p.clients {
+		if now.Sub(c.lastUsed) > p.idleTime {
+			c.client.CloseIdleConnections()
+			delete(p.clients, key)
+		}
+	}
+}
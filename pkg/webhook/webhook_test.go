@@ -0,0 +1,139 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	body := []byte(`{"temperature":35}`)
+
+	cases := []struct {
+		desc     string
+		cfg      webhook.Config
+		statuses []int
+		timeout  time.Duration
+		calls    int32
+		err      bool
+	}{
+		{
+			desc:     "send signed request",
+			cfg:      webhook.Config{Method: "patch", Headers: map[string]string{"x-api-key": "key"}, Secret: "secret"},
+			statuses: []int{http.StatusOK},
+			calls:    1,
+		},
+		{
+			desc:     "retry on server error",
+			cfg:      webhook.Config{Retries: 2, Backoff: time.Millisecond},
+			statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusNoContent},
+			calls:    3,
+		},
+		{
+			desc:     "fail after exhausting retries",
+			cfg:      webhook.Config{Retries: 1, Backoff: time.Millisecond},
+			statuses: []int{http.StatusBadGateway, http.StatusBadGateway},
+			calls:    2,
+			err:      true,
+		},
+		{
+			desc:     "don't retry on client error",
+			cfg:      webhook.Config{Retries: 3, Backoff: time.Millisecond},
+			statuses: []int{http.StatusNotFound},
+			calls:    1,
+			err:      true,
+		},
+		{
+			desc:     "don't wait for retry after the deadline",
+			cfg:      webhook.Config{Retries: 5, Backoff: webhook.MaxBackoff},
+			statuses: []int{http.StatusInternalServerError},
+			timeout:  time.Second,
+			calls:    1,
+			err:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var calls atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := calls.Add(1)
+				b, _ := io.ReadAll(r.Body)
+				assert.Equal(t, tc.cfg.RequestMethod(), r.Method)
+				assert.Equal(t, string(body), string(b))
+				for k, v := range tc.cfg.Headers {
+					assert.Equal(t, v, r.Header.Get(k))
+				}
+				switch tc.cfg.Secret {
+				case "":
+					assert.Empty(t, r.Header.Get(webhook.SignatureHeader))
+				default:
+					sig := webhook.Sign(tc.cfg.Secret, r.Header.Get(webhook.TimestampHeader), b)
+					assert.Equal(t, "sha256="+sig, r.Header.Get(webhook.SignatureHeader))
+				}
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer ts.Close()
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+			tc.cfg.URL = ts.URL
+			err := webhook.Send(ctx, nil, tc.cfg, body)
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("%s: unexpected error %v", tc.desc, err))
+			assert.Equal(t, tc.calls, calls.Load(), fmt.Sprintf("%s: expected %d calls got %d", tc.desc, tc.calls, calls.Load()))
+		})
+	}
+}
+
+func TestPool(t *testing.T) {
+	cfg := webhook.Config{URL: "https://example.com/hook", Timeout: time.Second}
+	sameClient := cfg
+	sameClient.URL = "https://example.com/other"
+	sameClient.Secret = "secret"
+	other := cfg
+	other.SkipTLSVerification = true
+
+	pool := webhook.NewPool(time.Minute)
+	defer pool.Close()
+
+	c1, err := pool.Get(cfg)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	c2, err := pool.Get(sameClient)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Same(t, c1, c2, "expected the client to be shared by the same configuration")
+
+	c3, err := pool.Get(other)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.NotSame(t, c1, c3, "expected a new client for the changed TLS configuration")
+
+	_, err = pool.Get(webhook.Config{URL: "https://example.com/hook", Cert: "-----BEGIN CERTIFICATE-----"})
+	assert.NotNil(t, err, "expected error creating client with invalid certificate")
+}
+
+func TestPoolIdle(t *testing.T) {
+	cfg := webhook.Config{URL: "https://example.com/hook"}
+	pool := webhook.NewPool(10 * time.Millisecond)
+	defer pool.Close()
+
+	c1, err := pool.Get(cfg)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	time.Sleep(20 * time.Millisecond)
+	c2, err := pool.Get(cfg)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.NotSame(t, c1, c2, "expected the idle client to be replaced")
+}
@@ -143,6 +143,86 @@ func listRulesEndpoint(s re.Service) endpoint.Endpoint {
 	}
 }
 
+func listExecutionsEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(listExecutionsReq)
+		if err := req.validate(); err != nil {
+			return executionsPageRes{}, err
+		}
+		page, err := s.ListExecutions(ctx, session, req.ExecutionPageMeta)
+		if err != nil {
+			return executionsPageRes{}, err
+		}
+
+		return executionsPageRes{ExecutionPage: page}, nil
+	}
+}
+
+func listRevisionsEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(listRevisionsReq)
+		if err := req.validate(); err != nil {
+			return revisionsPageRes{}, err
+		}
+		page, err := s.ListRevisions(ctx, session, req.RevisionPageMeta)
+		if err != nil {
+			return revisionsPageRes{}, err
+		}
+
+		return revisionsPageRes{RevisionPage: page}, nil
+	}
+}
+
+func diffRevisionsEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(diffRevisionsReq)
+		if err := req.validate(); err != nil {
+			return revisionDiffRes{}, err
+		}
+		diff, err := s.DiffRevisions(ctx, session, req.id, req.from, req.to)
+		if err != nil {
+			return revisionDiffRes{}, err
+		}
+
+		return revisionDiffRes{RevisionDiff: diff}, nil
+	}
+}
+
+func rollbackRuleEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(rollbackRuleReq)
+		if err := req.validate(); err != nil {
+			return updateRuleRes{}, err
+		}
+		rule, err := s.RollbackRule(ctx, session, req.id, req.revision)
+		if err != nil {
+			return updateRuleRes{}, err
+		}
+
+		return updateRuleRes{Rule: rule}, nil
+	}
+}
+
 func deleteRuleEndpoint(s re.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request any) (any, error) {
 		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
@@ -203,3 +283,181 @@ func disableRuleEndpoint(s re.Service) endpoint.Endpoint {
 		return updateRuleStatusRes{Rule: rule}, err
 	}
 }
+
+func dryRunRuleEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(dryRunRuleReq)
+		if err := req.validate(); err != nil {
+			return dryRunRuleRes{}, err
+		}
+		res, err := s.DryRunRule(ctx, session, req.Rule, req.message())
+		if err != nil {
+			return dryRunRuleRes{}, err
+		}
+		return dryRunRuleRes{DryRunResult: res}, nil
+	}
+}
+
+func addPipelineEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(addPipelineReq)
+		if err := req.validate(); err != nil {
+			return pipelineRes{}, err
+		}
+		p, err := s.AddPipeline(ctx, session, req.Pipeline)
+		if err != nil {
+			return pipelineRes{}, err
+		}
+
+		return pipelineRes{Pipeline: p, created: true}, nil
+	}
+}
+
+func viewPipelineEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(pipelineReq)
+		if err := req.validate(); err != nil {
+			return pipelineRes{}, err
+		}
+		p, err := s.ViewPipeline(ctx, session, req.id)
+		if err != nil {
+			return pipelineRes{}, err
+		}
+
+		return pipelineRes{Pipeline: p}, nil
+	}
+}
+
+func updatePipelineEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(updatePipelineReq)
+		if err := req.validate(); err != nil {
+			return pipelineRes{}, err
+		}
+		p, err := s.UpdatePipeline(ctx, session, req.Pipeline)
+		if err != nil {
+			return pipelineRes{}, err
+		}
+
+		return pipelineRes{Pipeline: p}, nil
+	}
+}
+
+func listPipelinesEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(listPipelinesReq)
+		if err := req.validate(); err != nil {
+			return pipelinesPageRes{}, err
+		}
+		page, err := s.ListPipelines(ctx, session, req.PipelinePageMeta)
+		if err != nil {
+			return pipelinesPageRes{}, err
+		}
+
+		return pipelinesPageRes{PipelinePage: page}, nil
+	}
+}
+
+func deletePipelineEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(pipelineReq)
+		if err := req.validate(); err != nil {
+			return deletePipelineRes{}, err
+		}
+		if err := s.RemovePipeline(ctx, session, req.id); err != nil {
+			return deletePipelineRes{}, err
+		}
+
+		return deletePipelineRes{deleted: true}, nil
+	}
+}
+
+func enablePipelineEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(pipelineReq)
+		if err := req.validate(); err != nil {
+			return pipelineRes{}, err
+		}
+		p, err := s.EnablePipeline(ctx, session, req.id)
+		if err != nil {
+			return pipelineRes{}, err
+		}
+
+		return pipelineRes{Pipeline: p}, nil
+	}
+}
+
+func disablePipelineEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(pipelineReq)
+		if err := req.validate(); err != nil {
+			return pipelineRes{}, err
+		}
+		p, err := s.DisablePipeline(ctx, session, req.id)
+		if err != nil {
+			return pipelineRes{}, err
+		}
+
+		return pipelineRes{Pipeline: p}, nil
+	}
+}
+
+func listPipelineExecutionsEndpoint(s re.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		session, ok := ctx.Value(authn.SessionKey).(authn.Session)
+		if !ok {
+			return nil, svcerr.ErrAuthorization
+		}
+
+		req := request.(listPipelineExecutionsReq)
+		if err := req.validate(); err != nil {
+			return executionsPageRes{}, err
+		}
+		page, err := s.ListPipelineExecutions(ctx, session, req.ExecutionPageMeta)
+		if err != nil {
+			return executionsPageRes{}, err
+		}
+
+		return executionsPageRes{ExecutionPage: page}, nil
+	}
+}
@@ -940,6 +940,51 @@ func TestUpdateRuleScheduleEndpoint(t *testing.T) {
 			status:      http.StatusBadRequest,
 			err:         apiutil.ErrValidation,
 		},
+		{
+			desc:     "update rule schedule with cron expression",
+			token:    validToken,
+			id:       validID,
+			domainID: domainID,
+			schedule: pkgSch.Schedule{
+				StartDateTime: future,
+				Recurring:     pkgSch.Cron,
+				Cron:          "30 8 * * 1-5",
+				Timezone:      "Europe/Belgrade",
+			},
+			contentType: contentType,
+			svcResp:     ruleWithSchedule,
+			status:      http.StatusOK,
+			err:         nil,
+		},
+		{
+			desc:     "update rule schedule with invalid cron expression",
+			token:    validToken,
+			id:       validID,
+			domainID: domainID,
+			schedule: pkgSch.Schedule{
+				StartDateTime: future,
+				Recurring:     pkgSch.Cron,
+				Cron:          "every weekday",
+			},
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrValidation,
+		},
+		{
+			desc:     "update rule schedule with invalid timezone",
+			token:    validToken,
+			id:       validID,
+			domainID: domainID,
+			schedule: pkgSch.Schedule{
+				StartDateTime:   future,
+				Recurring:       pkgSch.Daily,
+				RecurringPeriod: 1,
+				Timezone:        "Mars/Olympus_Mons",
+			},
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrValidation,
+		},
 		{
 			desc:        "update rule schedule with service error",
 			token:       validToken,
@@ -1276,10 +1321,1010 @@ func TestDeleteRuleEndpoint(t *testing.T) {
 	}
 }
 
-type respBody struct {
-	Err     string    `json:"error"`
-	Message string    `json:"message"`
-	Total   uint64    `json:"total"`
-	ID      string    `json:"id"`
-	Status  re.Status `json:"status"`
+func TestAddPipelineEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	pipeline := re.Pipeline{
+		Name:         namegen.Generate(),
+		InputChannel: "channel",
+		Steps: []re.Step{
+			{Name: "check", RuleID: rule.ID, Next: []re.Branch{{Step: "notify", When: &re.Condition{Field: "temperature", Operator: re.GtOperator, Value: 30}}}},
+			{Name: "notify", RuleID: validID},
+		},
+	}
+	cyclic := pipeline
+	cyclic.Steps = []re.Step{{Name: "check", RuleID: rule.ID, Next: []re.Branch{{Step: "check"}}}}
+	unnamed := pipeline
+	unnamed.Name = ""
+	saved := pipeline
+	saved.ID = validID
+	saved.DomainID = domainID
+
+	cases := []struct {
+		desc        string
+		pipeline    re.Pipeline
+		token       string
+		contentType string
+		session     smqauthn.Session
+		svcRes      re.Pipeline
+		svcErr      error
+		status      int
+		authnErr    error
+		err         error
+	}{
+		{
+			desc:        "add pipeline successfully",
+			pipeline:    pipeline,
+			token:       validToken,
+			contentType: contentType,
+			svcRes:      saved,
+			status:      http.StatusCreated,
+		},
+		{
+			desc:        "add pipeline with invalid token",
+			pipeline:    pipeline,
+			token:       invalidToken,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			authnErr:    svcerr.ErrAuthentication,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "add pipeline with invalid content type",
+			pipeline:    pipeline,
+			token:       validToken,
+			contentType: "application/xml",
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "add pipeline without name",
+			pipeline:    unnamed,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrNameSize,
+		},
+		{
+			desc:        "add pipeline with cyclic steps",
+			pipeline:    cyclic,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrValidation,
+		},
+		{
+			desc:        "add pipeline with service error",
+			pipeline:    pipeline,
+			token:       validToken,
+			contentType: contentType,
+			svcErr:      svcerr.ErrCreateEntity,
+			status:      http.StatusUnprocessableEntity,
+			err:         svcerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/pipelines", ts.URL, domainID),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(toJSON(tc.pipeline)),
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("AddPipeline", mock.Anything, tc.session, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, tc.svcRes.ID, bodyRes.ID)
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListPipelinesEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		query    string
+		token    string
+		session  smqauthn.Session
+		pageMeta re.PipelinePageMeta
+		svcRes   re.PipelinePage
+		svcErr   error
+		status   int
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "list pipelines successfully",
+			token:    validToken,
+			pageMeta: re.PipelinePageMeta{Limit: 10, Status: re.EnabledStatus},
+			svcRes:   re.PipelinePage{Limit: 10, Total: 1, Pipelines: []re.Pipeline{{ID: validID}}},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list pipelines with filters",
+			token:    validToken,
+			query:    "offset=1&limit=5&name=pipeline&input_channel=channel&status=all",
+			pageMeta: re.PipelinePageMeta{Offset: 1, Limit: 5, Name: "pipeline", InputChannel: "channel", Status: re.AllStatus},
+			svcRes:   re.PipelinePage{Offset: 1, Limit: 5},
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "list pipelines with invalid token",
+			token:    invalidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "list pipelines with invalid status",
+			token:  validToken,
+			query:  "status=invalid",
+			status: http.StatusBadRequest,
+			err:    svcerr.ErrInvalidStatus,
+		},
+		{
+			desc:   "list pipelines with limit greater than max",
+			token:  validToken,
+			query:  "limit=1001",
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrLimitSize,
+		},
+		{
+			desc:     "list pipelines with service error",
+			token:    validToken,
+			pageMeta: re.PipelinePageMeta{Limit: 10, Status: re.EnabledStatus},
+			svcErr:   svcerr.ErrAuthorization,
+			status:   http.StatusForbidden,
+			err:      svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodGet,
+				url:         fmt.Sprintf("%s/%s/pipelines?%s", ts.URL, domainID, tc.query),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ListPipelines", mock.Anything, tc.session, tc.pageMeta).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			assert.Equal(t, tc.svcRes.Total, bodyRes.Total)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDeletePipelineEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		token    string
+		session  smqauthn.Session
+		svcErr   error
+		status   int
+		authnErr error
+	}{
+		{
+			desc:   "delete pipeline successfully",
+			token:  validToken,
+			status: http.StatusNoContent,
+		},
+		{
+			desc:     "delete pipeline with invalid token",
+			token:    invalidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "delete non-existing pipeline",
+			token:  validToken,
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodDelete,
+				url:    fmt.Sprintf("%s/%s/pipelines/%s", ts.URL, domainID, validID),
+				token:  tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RemovePipeline", mock.Anything, tc.session, validID).Return(tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListPipelineExecutionsEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	correlationID := testsutil.GenerateUUID(t)
+	cases := []struct {
+		desc     string
+		query    string
+		token    string
+		session  smqauthn.Session
+		pageMeta re.ExecutionPageMeta
+		svcRes   re.ExecutionPage
+		svcErr   error
+		status   int
+		err      error
+	}{
+		{
+			desc:  "list pipeline executions successfully",
+			token: validToken,
+			pageMeta: re.ExecutionPageMeta{
+				Limit:      10,
+				PipelineID: validID,
+				Status:     re.AllExecutions,
+				Dir:        "desc",
+			},
+			svcRes: re.ExecutionPage{Limit: 10, Total: 2},
+			status: http.StatusOK,
+		},
+		{
+			desc:  "list pipeline executions of a run",
+			token: validToken,
+			query: "correlation_id=" + correlationID + "&dir=asc",
+			pageMeta: re.ExecutionPageMeta{
+				Limit:         10,
+				PipelineID:    validID,
+				Status:        re.AllExecutions,
+				Dir:           "asc",
+				CorrelationID: correlationID,
+			},
+			svcRes: re.ExecutionPage{Limit: 10, Total: 2},
+			status: http.StatusOK,
+		},
+		{
+			desc:   "list pipeline executions with invalid direction",
+			token:  validToken,
+			query:  "dir=invalid",
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrInvalidDirection,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodGet,
+				url:         fmt.Sprintf("%s/%s/pipelines/%s/executions?%s", ts.URL, domainID, validID, tc.query),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, nil)
+			svcCall := svc.On("ListPipelineExecutions", mock.Anything, tc.session, tc.pageMeta).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			assert.Equal(t, tc.svcRes.Total, bodyRes.Total)
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+type respBody struct {
+	Err     string    `json:"error"`
+	Message string    `json:"message"`
+	Total   uint64    `json:"total"`
+	ID      string    `json:"id"`
+	Status  re.Status `json:"status"`
+}
+
+func TestListExecutionsEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	exec := re.Execution{
+		ID:        testsutil.GenerateUUID(t),
+		RuleID:    rule.ID,
+		DomainID:  domainID,
+		Status:    re.SuccessExecution,
+		Outputs:   []string{"channels"},
+		Duration:  time.Millisecond,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	cases := []struct {
+		desc     string
+		query    string
+		id       string
+		domainID string
+		token    string
+		session  smqauthn.Session
+		pageMeta re.ExecutionPageMeta
+		svcRes   re.ExecutionPage
+		svcErr   error
+		status   int
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "list executions successfully",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			pageMeta: re.ExecutionPageMeta{
+				Limit:  10,
+				RuleID: rule.ID,
+				Status: re.AllExecutions,
+				Dir:    "desc",
+			},
+			svcRes: re.ExecutionPage{
+				Limit:      10,
+				Total:      1,
+				Executions: []re.Execution{exec},
+			},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list executions with filters",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "offset=1&limit=5&status=failed&dir=asc&from=1700000000&to=1700003600",
+			pageMeta: re.ExecutionPageMeta{
+				Offset: 1,
+				Limit:  5,
+				RuleID: rule.ID,
+				Status: re.FailedExecution,
+				Dir:    "asc",
+				From:   time.Unix(1700000000, 0),
+				To:     time.Unix(1700003600, 0),
+			},
+			svcRes: re.ExecutionPage{Offset: 1, Limit: 5},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list executions with correlation ID",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "correlation_id=" + validID,
+			pageMeta: re.ExecutionPageMeta{
+				Limit:         10,
+				RuleID:        rule.ID,
+				Status:        re.AllExecutions,
+				Dir:           "desc",
+				CorrelationID: validID,
+			},
+			svcRes: re.ExecutionPage{Limit: 10},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list executions with empty token",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    "",
+			status:   http.StatusUnauthorized,
+			err:      apiutil.ErrBearerToken,
+		},
+		{
+			desc:     "list executions with invalid token",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    invalidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "list executions with invalid status",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "status=invalid",
+			status:   http.StatusBadRequest,
+			err:      svcerr.ErrInvalidStatus,
+		},
+		{
+			desc:     "list executions with limit greater than max",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "limit=1001",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrLimitSize,
+		},
+		{
+			desc:     "list executions with invalid direction",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "dir=invalid",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrInvalidDirection,
+		},
+		{
+			desc:     "list executions with invalid from",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "from=invalid",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrInvalidQueryParams,
+		},
+		{
+			desc:     "list executions with to before from",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "from=1700003600&to=1700000000",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrInvalidTimeFormat,
+		},
+		{
+			desc:     "list executions with service error",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			pageMeta: re.ExecutionPageMeta{
+				Limit:  10,
+				RuleID: rule.ID,
+				Status: re.AllExecutions,
+				Dir:    "desc",
+			},
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+			err:    svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodGet,
+				url:         fmt.Sprintf("%s/%s/rules/%s/executions?%s", ts.URL, tc.domainID, tc.id, tc.query),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ListExecutions", mock.Anything, tc.session, tc.pageMeta).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestListRevisionsEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	rev := re.Revision{
+		RuleID:    rule.ID,
+		Revision:  1,
+		Spec:      rule.Spec(),
+		CreatedAt: time.Now().UTC(),
+		CreatedBy: userID,
+	}
+
+	cases := []struct {
+		desc     string
+		query    string
+		id       string
+		domainID string
+		token    string
+		session  smqauthn.Session
+		pageMeta re.RevisionPageMeta
+		svcRes   re.RevisionPage
+		svcErr   error
+		status   int
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "list revisions successfully",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			pageMeta: re.RevisionPageMeta{
+				Limit:  10,
+				RuleID: rule.ID,
+			},
+			svcRes: re.RevisionPage{
+				Limit:     10,
+				Total:     1,
+				Revisions: []re.Revision{rev},
+			},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list revisions with offset and limit",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "offset=1&limit=5",
+			pageMeta: re.RevisionPageMeta{
+				Offset: 1,
+				Limit:  5,
+				RuleID: rule.ID,
+			},
+			svcRes: re.RevisionPage{Offset: 1, Limit: 5},
+			status: http.StatusOK,
+		},
+		{
+			desc:     "list revisions with invalid token",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    invalidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "list revisions with limit greater than max",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			query:    "limit=1001",
+			status:   http.StatusBadRequest,
+			err:      apiutil.ErrLimitSize,
+		},
+		{
+			desc:     "list revisions with service error",
+			id:       rule.ID,
+			domainID: domainID,
+			token:    validToken,
+			pageMeta: re.RevisionPageMeta{
+				Limit:  10,
+				RuleID: rule.ID,
+			},
+			svcErr: svcerr.ErrAuthorization,
+			status: http.StatusForbidden,
+			err:    svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodGet,
+				url:         fmt.Sprintf("%s/%s/rules/%s/revisions?%s", ts.URL, tc.domainID, tc.id, tc.query),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("ListRevisions", mock.Anything, tc.session, tc.pageMeta).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDiffRevisionsEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	diff := re.RevisionDiff{
+		RuleID:  rule.ID,
+		From:    1,
+		To:      2,
+		Changes: []re.Change{{Path: "name", From: "old", To: "new"}},
+	}
+
+	cases := []struct {
+		desc     string
+		query    string
+		token    string
+		session  smqauthn.Session
+		from     uint64
+		to       uint64
+		svcRes   re.RevisionDiff
+		svcErr   error
+		status   int
+		authnErr error
+		err      error
+	}{
+		{
+			desc:   "diff revisions successfully",
+			query:  "from=1&to=2",
+			token:  validToken,
+			from:   1,
+			to:     2,
+			svcRes: diff,
+			status: http.StatusOK,
+		},
+		{
+			desc:     "diff revisions with invalid token",
+			query:    "from=1&to=2",
+			token:    invalidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:   "diff revisions without to revision",
+			query:  "from=1",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    re.ErrInvalidRevision,
+		},
+		{
+			desc:   "diff revisions with invalid from revision",
+			query:  "from=invalid&to=2",
+			token:  validToken,
+			status: http.StatusBadRequest,
+			err:    apiutil.ErrInvalidQueryParams,
+		},
+		{
+			desc:   "diff revisions with non-existing revision",
+			query:  "from=1&to=5",
+			token:  validToken,
+			from:   1,
+			to:     5,
+			svcErr: svcerr.ErrNotFound,
+			status: http.StatusNotFound,
+			err:    svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodGet,
+				url:         fmt.Sprintf("%s/%s/rules/%s/revisions/diff?%s", ts.URL, domainID, rule.ID, tc.query),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("DiffRevisions", mock.Anything, tc.session, rule.ID, tc.from, tc.to).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestRollbackRuleEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	restored := rule
+	restored.Revision = 3
+
+	cases := []struct {
+		desc     string
+		revision string
+		token    string
+		session  smqauthn.Session
+		svcRes   re.Rule
+		svcErr   error
+		status   int
+		authnErr error
+		err      error
+	}{
+		{
+			desc:     "rollback rule successfully",
+			revision: "1",
+			token:    validToken,
+			svcRes:   restored,
+			status:   http.StatusOK,
+		},
+		{
+			desc:     "rollback rule with invalid token",
+			revision: "1",
+			token:    invalidToken,
+			status:   http.StatusUnauthorized,
+			authnErr: svcerr.ErrAuthentication,
+			err:      svcerr.ErrAuthentication,
+		},
+		{
+			desc:     "rollback rule with invalid revision",
+			revision: "invalid",
+			token:    validToken,
+			status:   http.StatusBadRequest,
+			err:      re.ErrInvalidRevision,
+		},
+		{
+			desc:     "rollback rule with zero revision",
+			revision: "0",
+			token:    validToken,
+			status:   http.StatusBadRequest,
+			err:      re.ErrInvalidRevision,
+		},
+		{
+			desc:     "rollback rule with service error",
+			revision: "1",
+			token:    validToken,
+			svcErr:   svcerr.ErrAuthorization,
+			status:   http.StatusForbidden,
+			err:      svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/rules/%s/revisions/%s/rollback", ts.URL, domainID, rule.ID, tc.revision),
+				contentType: contentType,
+				token:       tc.token,
+			}
+			if tc.token == validToken {
+				tc.session = smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID}
+			}
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.session, tc.authnErr)
+			svcCall := svc.On("RollbackRule", mock.Anything, tc.session, rule.ID, uint64(1)).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var bodyRes respBody
+			err = json.NewDecoder(res.Body).Decode(&bodyRes)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if bodyRes.Err != "" || bodyRes.Message != "" {
+				err = errors.Wrap(errors.New(bodyRes.Err), errors.New(bodyRes.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
+}
+
+func TestDryRunRuleEndpoint(t *testing.T) {
+	ts, svc, authn := newRuleEngineServer()
+	defer ts.Close()
+
+	validReq := map[string]any{
+		"rule": map[string]any{
+			"name":          namegen.Generate(),
+			"input_channel": validID,
+			"logic":         map[string]any{"type": 0, "value": "return message.payload"},
+		},
+		"message": map[string]any{
+			"subtopic": "temperature",
+			"payload":  map[string]any{"v": 25},
+		},
+	}
+	svcRes := re.DryRunResult{Result: map[string]any{"v": float64(25)}}
+
+	cases := []struct {
+		desc        string
+		req         any
+		domainID    string
+		token       string
+		contentType string
+		status      int
+		authnRes    smqauthn.Session
+		authnErr    error
+		svcRes      re.DryRunResult
+		svcErr      error
+		err         error
+	}{
+		{
+			desc:        "dry run rule successfully",
+			req:         validReq,
+			token:       validToken,
+			contentType: contentType,
+			domainID:    domainID,
+			authnRes:    smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID},
+			status:      http.StatusOK,
+			svcRes:      svcRes,
+		},
+		{
+			desc:        "dry run rule with invalid token",
+			req:         validReq,
+			token:       invalidToken,
+			domainID:    domainID,
+			contentType: contentType,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+			err:         svcerr.ErrAuthentication,
+		},
+		{
+			desc:        "dry run rule with empty token",
+			req:         validReq,
+			domainID:    domainID,
+			contentType: contentType,
+			status:      http.StatusUnauthorized,
+			err:         apiutil.ErrBearerToken,
+		},
+		{
+			desc:        "dry run rule with empty domainID",
+			req:         validReq,
+			token:       validToken,
+			contentType: contentType,
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMissingDomainID,
+		},
+		{
+			desc:        "dry run rule with invalid content type",
+			req:         validReq,
+			token:       validToken,
+			domainID:    domainID,
+			contentType: "application/xml",
+			authnRes:    smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID},
+			status:      http.StatusUnsupportedMediaType,
+			err:         apiutil.ErrUnsupportedContentType,
+		},
+		{
+			desc:        "dry run rule with malformed request body",
+			req:         "invalid",
+			token:       validToken,
+			domainID:    domainID,
+			contentType: contentType,
+			authnRes:    smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrMalformedRequestBody,
+		},
+		{
+			desc: "dry run rule with empty payload",
+			req: map[string]any{
+				"rule": validReq["rule"],
+			},
+			token:       validToken,
+			domainID:    domainID,
+			contentType: contentType,
+			authnRes:    smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrEmptyMessage,
+		},
+		{
+			desc: "dry run rule with invalid window",
+			req: map[string]any{
+				"rule": map[string]any{
+					"name":   namegen.Generate(),
+					"logic":  map[string]any{"type": 0, "value": "return window.value"},
+					"window": map[string]any{"size": "1m"},
+				},
+				"message": validReq["message"],
+			},
+			token:       validToken,
+			domainID:    domainID,
+			contentType: contentType,
+			authnRes:    smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID},
+			status:      http.StatusBadRequest,
+			err:         apiutil.ErrValidation,
+		},
+		{
+			desc:        "dry run rule with service error",
+			req:         validReq,
+			token:       validToken,
+			domainID:    domainID,
+			contentType: contentType,
+			authnRes:    smqauthn.Session{DomainUserID: auth.EncodeDomainUserID(domainID, userID), UserID: userID, DomainID: domainID},
+			svcErr:      svcerr.ErrAuthorization,
+			status:      http.StatusForbidden,
+			err:         svcerr.ErrAuthorization,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/rules/dry-run", ts.URL, tc.domainID),
+				contentType: tc.contentType,
+				token:       tc.token,
+				body:        strings.NewReader(toJSON(tc.req)),
+			}
+
+			authCall := authn.On("Authenticate", mock.Anything, tc.token).Return(tc.authnRes, tc.authnErr)
+			svcCall := svc.On("DryRunRule", mock.Anything, tc.authnRes, mock.Anything, mock.Anything).Return(tc.svcRes, tc.svcErr)
+			res, err := req.make()
+
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			var resBody struct {
+				respBody
+				Result any `json:"result"`
+			}
+			err = json.NewDecoder(res.Body).Decode(&resBody)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+			if resBody.Err != "" || resBody.Message != "" {
+				err = errors.Wrap(errors.New(resBody.Err), errors.New(resBody.Message))
+			}
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.err == nil {
+				assert.Equal(t, tc.svcRes.Result, resBody.Result, fmt.Sprintf("%s: expected result %v got %v", tc.desc, tc.svcRes.Result, resBody.Result))
+			}
+			svcCall.Unset()
+			authCall.Unset()
+		})
+	}
 }
@@ -4,9 +4,12 @@
 package api
 
 import (
+	"encoding/json"
+
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
 	"github.com/absmach/magistrala/pkg/schedule"
 	"github.com/absmach/magistrala/re"
 )
@@ -28,6 +31,11 @@ func (req addRuleReq) validate() error {
 	if err := req.Rule.Schedule.Validate(); err != nil {
 		return errors.Wrap(err, apiutil.ErrValidation)
 	}
+	if req.Rule.Window != nil {
+		if err := req.Rule.Window.Validate(); err != nil {
+			return errors.Wrap(err, apiutil.ErrValidation)
+		}
+	}
 
 	return nil
 }
@@ -67,6 +75,75 @@ func (req listRulesReq) validate() error {
 	return nil
 }
 
+type listExecutionsReq struct {
+	re.ExecutionPageMeta
+}
+
+func (req listExecutionsReq) validate() error {
+	if req.RuleID == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+	if req.Dir != api.AscDir && req.Dir != api.DescDir {
+		return apiutil.ErrInvalidDirection
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && req.To.Before(req.From) {
+		return errors.Wrap(apiutil.ErrValidation, apiutil.ErrInvalidTimeFormat)
+	}
+
+	return nil
+}
+
+type listRevisionsReq struct {
+	re.RevisionPageMeta
+}
+
+func (req listRevisionsReq) validate() error {
+	if req.RuleID == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type diffRevisionsReq struct {
+	id   string
+	from uint64
+	to   uint64
+}
+
+func (req diffRevisionsReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.from == 0 || req.to == 0 {
+		return errors.Wrap(apiutil.ErrValidation, re.ErrInvalidRevision)
+	}
+
+	return nil
+}
+
+type rollbackRuleReq struct {
+	id       string
+	revision uint64
+}
+
+func (req rollbackRuleReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.revision == 0 {
+		return errors.Wrap(apiutil.ErrValidation, re.ErrInvalidRevision)
+	}
+
+	return nil
+}
+
 type updateRuleReq struct {
 	Rule re.Rule
 }
@@ -78,6 +155,11 @@ func (req updateRuleReq) validate() error {
 	if len(req.Rule.Name) > api.MaxNameSize {
 		return apiutil.ErrNameSize
 	}
+	if req.Rule.Window != nil {
+		if err := req.Rule.Window.Validate(); err != nil {
+			return errors.Wrap(err, apiutil.ErrValidation)
+		}
+	}
 
 	return nil
 }
@@ -135,3 +217,122 @@ func (req deleteRuleReq) validate() error {
 
 	return nil
 }
+
+type dryRunRuleReq struct {
+	Rule    re.Rule       `json:"rule"`
+	Message dryRunMessage `json:"message"`
+}
+
+// dryRunMessage is the sample message. Payload is passed to the rule as is.
+type dryRunMessage struct {
+	Channel   string          `json:"channel,omitempty"`
+	Subtopic  string          `json:"subtopic,omitempty"`
+	Publisher string          `json:"publisher,omitempty"`
+	ClientID  string          `json:"client_id,omitempty"`
+	Protocol  string          `json:"protocol,omitempty"`
+	Created   int64           `json:"created,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+func (req dryRunRuleReq) validate() error {
+	if len(req.Message.Payload) == 0 {
+		return apiutil.ErrEmptyMessage
+	}
+	if req.Rule.Window != nil {
+		if err := req.Rule.Window.Validate(); err != nil {
+			return errors.Wrap(err, apiutil.ErrValidation)
+		}
+	}
+
+	return nil
+}
+
+func (req dryRunRuleReq) message() *messaging.Message {
+	return &messaging.Message{
+		Channel:   req.Message.Channel,
+		Subtopic:  req.Message.Subtopic,
+		Publisher: req.Message.Publisher,
+		ClientId:  req.Message.ClientID,
+		Protocol:  req.Message.Protocol,
+		Created:   req.Message.Created,
+		Payload:   req.Message.Payload,
+	}
+}
+
+type addPipelineReq struct {
+	re.Pipeline
+}
+
+func (req addPipelineReq) validate() error {
+	if len(req.Name) > api.MaxNameSize || req.Name == "" {
+		return apiutil.ErrNameSize
+	}
+	if err := req.Pipeline.Validate(); err != nil {
+		return errors.Wrap(err, apiutil.ErrValidation)
+	}
+
+	return nil
+}
+
+type updatePipelineReq struct {
+	Pipeline re.Pipeline
+}
+
+func (req updatePipelineReq) validate() error {
+	if req.Pipeline.ID == "" {
+		return apiutil.ErrMissingID
+	}
+	if len(req.Pipeline.Name) > api.MaxNameSize || req.Pipeline.Name == "" {
+		return apiutil.ErrNameSize
+	}
+	if err := req.Pipeline.Validate(); err != nil {
+		return errors.Wrap(err, apiutil.ErrValidation)
+	}
+
+	return nil
+}
+
+type pipelineReq struct {
+	id string
+}
+
+func (req pipelineReq) validate() error {
+	if req.id == "" {
+		return apiutil.ErrMissingID
+	}
+
+	return nil
+}
+
+type listPipelinesReq struct {
+	re.PipelinePageMeta
+}
+
+func (req listPipelinesReq) validate() error {
+	if req.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+
+	return nil
+}
+
+type listPipelineExecutionsReq struct {
+	re.ExecutionPageMeta
+}
+
+func (req listPipelineExecutionsReq) validate() error {
+	if req.PipelineID == "" {
+		return apiutil.ErrMissingID
+	}
+	if req.Limit > maxLimitSize {
+		return apiutil.ErrLimitSize
+	}
+	if req.Dir != api.AscDir && req.Dir != api.DescDir {
+		return apiutil.ErrInvalidDirection
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && req.To.Before(req.From) {
+		return errors.Wrap(apiutil.ErrValidation, apiutil.ErrInvalidTimeFormat)
+	}
+
+	return nil
+}
@@ -18,6 +18,10 @@ var (
 	_ magistrala.Response = (*rulesPageRes)(nil)
 	_ magistrala.Response = (*updateRuleRes)(nil)
 	_ magistrala.Response = (*deleteRuleRes)(nil)
+	_ magistrala.Response = (*dryRunRuleRes)(nil)
+	_ magistrala.Response = (*pipelineRes)(nil)
+	_ magistrala.Response = (*pipelinesPageRes)(nil)
+	_ magistrala.Response = (*deletePipelineRes)(nil)
 )
 
 type pageRes struct {
@@ -101,6 +105,54 @@ func (res rulesPageRes) Empty() bool {
 	return false
 }
 
+type executionsPageRes struct {
+	re.ExecutionPage `json:",inline"`
+}
+
+func (res executionsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res executionsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res executionsPageRes) Empty() bool {
+	return false
+}
+
+type revisionsPageRes struct {
+	re.RevisionPage `json:",inline"`
+}
+
+func (res revisionsPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res revisionsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res revisionsPageRes) Empty() bool {
+	return false
+}
+
+type revisionDiffRes struct {
+	re.RevisionDiff `json:",inline"`
+}
+
+func (res revisionDiffRes) Code() int {
+	return http.StatusOK
+}
+
+func (res revisionDiffRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res revisionDiffRes) Empty() bool {
+	return false
+}
+
 type updateRuleStatusRes struct {
 	re.Rule `json:",inline"`
 }
@@ -136,3 +188,82 @@ func (res deleteRuleRes) Headers() map[string]string {
 func (res deleteRuleRes) Empty() bool {
 	return true
 }
+
+type dryRunRuleRes struct {
+	re.DryRunResult
+}
+
+func (res dryRunRuleRes) Code() int {
+	return http.StatusOK
+}
+
+func (res dryRunRuleRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res dryRunRuleRes) Empty() bool {
+	return false
+}
+
+type pipelineRes struct {
+	re.Pipeline `json:",inline"`
+	created     bool
+}
+
+func (res pipelineRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res pipelineRes) Headers() map[string]string {
+	if res.created {
+		return map[string]string{
+			"Location": fmt.Sprintf("/pipelines/%s", res.ID),
+		}
+	}
+
+	return map[string]string{}
+}
+
+func (res pipelineRes) Empty() bool {
+	return false
+}
+
+type pipelinesPageRes struct {
+	re.PipelinePage `json:",inline"`
+}
+
+func (res pipelinesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res pipelinesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res pipelinesPageRes) Empty() bool {
+	return false
+}
+
+type deletePipelineRes struct {
+	deleted bool
+}
+
+func (res deletePipelineRes) Code() int {
+	if res.deleted {
+		return http.StatusNoContent
+	}
+
+	return http.StatusOK
+}
+
+func (res deletePipelineRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res deletePipelineRes) Empty() bool {
+	return true
+}
@@ -7,8 +7,11 @@ import (
 	"context"
 	"encoding/json"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/absmach/magistrala"
 	api "github.com/absmach/magistrala/api/http"
@@ -24,8 +27,13 @@ import (
 )
 
 const (
-	ruleIdKey       = "ruleID"
-	inputChannelKey = "input_channel"
+	ruleIdKey        = "ruleID"
+	pipelineIdKey    = "pipelineID"
+	inputChannelKey  = "input_channel"
+	correlationIDKey = "correlation_id"
+	fromKey          = "from"
+	toKey            = "to"
+	revisionKey      = "revision"
 )
 
 // MakeHandler creates an HTTP handler for the service endpoints.
@@ -53,6 +61,13 @@ func MakeHandler(svc re.Service, authn smqauthn.AuthNMiddleware, mux *chi.Mux, l
 					opts...,
 				), "list_rules").ServeHTTP)
 
+				r.Post("/dry-run", otelhttp.NewHandler(kithttp.NewServer(
+					dryRunRuleEndpoint(svc),
+					decodeDryRunRuleRequest,
+					api.EncodeResponse,
+					opts...,
+				), "dry_run_rule").ServeHTTP)
+
 				r = roleManagerHttp.EntityAvailableActionsRouter(svc, d, r, opts)
 
 				r.Route("/{ruleID}", func(r chi.Router) {
@@ -105,9 +120,99 @@ func MakeHandler(svc re.Service, authn smqauthn.AuthNMiddleware, mux *chi.Mux, l
 						opts...,
 					), "disable_rule").ServeHTTP)
 
+					r.Get("/executions", otelhttp.NewHandler(kithttp.NewServer(
+						listExecutionsEndpoint(svc),
+						decodeListExecutionsRequest,
+						api.EncodeResponse,
+						opts...,
+					), "list_rule_executions").ServeHTTP)
+
+					r.Route("/revisions", func(r chi.Router) {
+						r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+							listRevisionsEndpoint(svc),
+							decodeListRevisionsRequest,
+							api.EncodeResponse,
+							opts...,
+						), "list_rule_revisions").ServeHTTP)
+
+						r.Get("/diff", otelhttp.NewHandler(kithttp.NewServer(
+							diffRevisionsEndpoint(svc),
+							decodeDiffRevisionsRequest,
+							api.EncodeResponse,
+							opts...,
+						), "diff_rule_revisions").ServeHTTP)
+
+						r.Post("/{revision}/rollback", otelhttp.NewHandler(kithttp.NewServer(
+							rollbackRuleEndpoint(svc),
+							decodeRollbackRuleRequest,
+							api.EncodeResponse,
+							opts...,
+						), "rollback_rule").ServeHTTP)
+					})
+
 					roleManagerHttp.EntityRoleMangerRouter(svc, d, r, opts)
 				})
 			})
+
+			r.Route("/pipelines", func(r chi.Router) {
+				r.Post("/", otelhttp.NewHandler(kithttp.NewServer(
+					addPipelineEndpoint(svc),
+					decodeAddPipelineRequest,
+					api.EncodeResponse,
+					opts...,
+				), "add_pipeline").ServeHTTP)
+
+				r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+					listPipelinesEndpoint(svc),
+					decodeListPipelinesRequest,
+					api.EncodeResponse,
+					opts...,
+				), "list_pipelines").ServeHTTP)
+
+				r.Route("/{pipelineID}", func(r chi.Router) {
+					r.Get("/", otelhttp.NewHandler(kithttp.NewServer(
+						viewPipelineEndpoint(svc),
+						decodePipelineRequest,
+						api.EncodeResponse,
+						opts...,
+					), "view_pipeline").ServeHTTP)
+
+					r.Patch("/", otelhttp.NewHandler(kithttp.NewServer(
+						updatePipelineEndpoint(svc),
+						decodeUpdatePipelineRequest,
+						api.EncodeResponse,
+						opts...,
+					), "update_pipeline").ServeHTTP)
+
+					r.Delete("/", otelhttp.NewHandler(kithttp.NewServer(
+						deletePipelineEndpoint(svc),
+						decodePipelineRequest,
+						api.EncodeResponse,
+						opts...,
+					), "delete_pipeline").ServeHTTP)
+
+					r.Post("/enable", otelhttp.NewHandler(kithttp.NewServer(
+						enablePipelineEndpoint(svc),
+						decodePipelineRequest,
+						api.EncodeResponse,
+						opts...,
+					), "enable_pipeline").ServeHTTP)
+
+					r.Post("/disable", otelhttp.NewHandler(kithttp.NewServer(
+						disablePipelineEndpoint(svc),
+						decodePipelineRequest,
+						api.EncodeResponse,
+						opts...,
+					), "disable_pipeline").ServeHTTP)
+
+					r.Get("/executions", otelhttp.NewHandler(kithttp.NewServer(
+						listPipelineExecutionsEndpoint(svc),
+						decodeListPipelineExecutionsRequest,
+						api.EncodeResponse,
+						opts...,
+					), "list_pipeline_executions").ServeHTTP)
+				})
+			})
 		})
 	})
 
@@ -128,6 +233,17 @@ func decodeAddRuleRequest(_ context.Context, r *http.Request) (any, error) {
 	return addRuleReq{Rule: rule}, nil
 }
 
+func decodeDryRunRuleRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+	var req dryRunRuleReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	return req, nil
+}
+
 func decodeViewRuleRequest(_ context.Context, r *http.Request) (any, error) {
 	id := chi.URLParam(r, ruleIdKey)
 	withRoles, err := apiutil.ReadBoolQuery(r, api.RolesKey, false)
@@ -240,8 +356,202 @@ func decodeListRulesRequest(_ context.Context, r *http.Request) (any, error) {
 	}, nil
 }
 
+func decodeListExecutionsRequest(_ context.Context, r *http.Request) (any, error) {
+	pm, err := readExecutionPageMeta(r)
+	if err != nil {
+		return nil, err
+	}
+	pm.RuleID = chi.URLParam(r, ruleIdKey)
+
+	return listExecutionsReq{ExecutionPageMeta: pm}, nil
+}
+
+func decodeListPipelineExecutionsRequest(_ context.Context, r *http.Request) (any, error) {
+	pm, err := readExecutionPageMeta(r)
+	if err != nil {
+		return nil, err
+	}
+	pm.PipelineID = chi.URLParam(r, pipelineIdKey)
+
+	return listPipelineExecutionsReq{ExecutionPageMeta: pm}, nil
+}
+
+func readExecutionPageMeta(r *http.Request) (re.ExecutionPageMeta, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	s, err := apiutil.ReadStringQuery(r, api.StatusKey, re.All)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	st, err := re.ToExecutionStatus(s)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	dir, err := apiutil.ReadStringQuery(r, api.DirKey, api.DescDir)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	from, err := apiutil.ReadNumQuery[int64](r, fromKey, 0)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	if from > math.MaxInt32 {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, apiutil.ErrInvalidTimeFormat)
+	}
+	var fromTime time.Time
+	if from != 0 {
+		fromTime = time.Unix(from, 0)
+	}
+	to, err := apiutil.ReadNumQuery[int64](r, toKey, 0)
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	if to > math.MaxInt32 {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, apiutil.ErrInvalidTimeFormat)
+	}
+	var toTime time.Time
+	if to != 0 {
+		toTime = time.Unix(to, 0)
+	}
+
+	cid, err := apiutil.ReadStringQuery(r, correlationIDKey, "")
+	if err != nil {
+		return re.ExecutionPageMeta{}, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return re.ExecutionPageMeta{
+		Offset:        offset,
+		Limit:         limit,
+		Status:        st,
+		Dir:           dir,
+		From:          fromTime,
+		To:            toTime,
+		CorrelationID: cid,
+	}, nil
+}
+
+func decodeListRevisionsRequest(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listRevisionsReq{
+		RevisionPageMeta: re.RevisionPageMeta{
+			Offset: offset,
+			Limit:  limit,
+			RuleID: chi.URLParam(r, ruleIdKey),
+		},
+	}, nil
+}
+
+func decodeDiffRevisionsRequest(_ context.Context, r *http.Request) (any, error) {
+	from, err := apiutil.ReadNumQuery[uint64](r, fromKey, 0)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	to, err := apiutil.ReadNumQuery[uint64](r, toKey, 0)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return diffRevisionsReq{
+		id:   chi.URLParam(r, ruleIdKey),
+		from: from,
+		to:   to,
+	}, nil
+}
+
+func decodeRollbackRuleRequest(_ context.Context, r *http.Request) (any, error) {
+	revision, err := strconv.ParseUint(chi.URLParam(r, revisionKey), 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, re.ErrInvalidRevision)
+	}
+
+	return rollbackRuleReq{
+		id:       chi.URLParam(r, ruleIdKey),
+		revision: revision,
+	}, nil
+}
+
 func decodeDeleteRuleRequest(_ context.Context, r *http.Request) (any, error) {
 	id := chi.URLParam(r, ruleIdKey)
 
 	return deleteRuleReq{id: id}, nil
 }
+
+func decodeAddPipelineRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+	var p re.Pipeline
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+
+	return addPipelineReq{Pipeline: p}, nil
+}
+
+func decodeUpdatePipelineRequest(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), api.ContentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+	var p re.Pipeline
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	p.ID = chi.URLParam(r, pipelineIdKey)
+
+	return updatePipelineReq{Pipeline: p}, nil
+}
+
+func decodePipelineRequest(_ context.Context, r *http.Request) (any, error) {
+	return pipelineReq{id: chi.URLParam(r, pipelineIdKey)}, nil
+}
+
+func decodeListPipelinesRequest(_ context.Context, r *http.Request) (any, error) {
+	offset, err := apiutil.ReadNumQuery[uint64](r, api.OffsetKey, api.DefOffset)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	limit, err := apiutil.ReadNumQuery[uint64](r, api.LimitKey, api.DefLimit)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	name, err := apiutil.ReadStringQuery(r, api.NameKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	ic, err := apiutil.ReadStringQuery(r, inputChannelKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	s, err := apiutil.ReadStringQuery(r, api.StatusKey, api.DefStatus)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+	st, err := re.ToStatus(s)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	return listPipelinesReq{
+		PipelinePageMeta: re.PipelinePageMeta{
+			Offset:       offset,
+			Limit:        limit,
+			Name:         name,
+			InputChannel: ic,
+			Status:       st,
+		},
+	}, nil
+}
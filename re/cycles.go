@@ -0,0 +1,329 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/re/outputs"
+)
+
+// channelStore reads the rules and the pipelines of the channel graph.
+type channelStore interface {
+	ViewRule(ctx context.Context, id string) (Rule, error)
+	ListAllRules(ctx context.Context, pm PageMeta) (Page, error)
+	ListPipelines(ctx context.Context, pm PipelinePageMeta) (PipelinePage, error)
+}
+
+// channelGraph connects the rules and the pipelines of a domain that publish
+// to the channels with the rules and the pipelines that consume them. Rules
+// are consumers unless they're scheduled, and pipelines publish with the
+// outputs of all their step rules. The consumers of a channel are read when
+// the traversal reaches the channel, so only the part of the domain reachable
+// from the checked rule or pipeline is loaded.
+type channelGraph struct {
+	store     channelStore
+	domainID  string
+	rules     map[string]Rule
+	pipelines map[string]Pipeline
+	// changed maps the nodes of the checked rule or pipeline to their input
+	// channels. They replace the saved versions read from the store.
+	changed   map[string]string
+	consumers map[string][]string
+	nodes     map[string]channelNode
+}
+
+// channelNode is a consumer of the channel topic that publishes to other topics.
+type channelNode struct {
+	id    string
+	topic string
+	// consumer is false for the scheduled rules, which don't run on messages.
+	consumer bool
+	pubs     []*outputs.ChannelPublisher
+}
+
+func newChannelGraph(store channelStore, domainID string) *channelGraph {
+	return &channelGraph{
+		store:     store,
+		domainID:  domainID,
+		rules:     make(map[string]Rule),
+		pipelines: make(map[string]Pipeline),
+		changed:   make(map[string]string),
+		consumers: make(map[string][]string),
+		nodes:     make(map[string]channelNode),
+	}
+}
+
+// changeRule replaces the saved rule with the checked one.
+func (g *channelGraph) changeRule(r Rule) {
+	g.rules[r.ID] = r
+	g.changed[ruleNodeID(r.ID)] = r.InputChannel
+}
+
+// changePipeline replaces the saved pipeline with the checked one.
+func (g *channelGraph) changePipeline(p Pipeline) {
+	g.pipelines[p.ID] = p
+	g.changed[pipelineNodeID(p.ID)] = p.InputChannel
+}
+
+// validateRuleChannels rejects the rule if its outputs would publish back
+// to the rule, or to a pipeline using the rule, through the channels.
+func (re *re) validateRuleChannels(ctx context.Context, r Rule) error {
+	err := re.checkRuleCycles(ctx, r)
+	switch {
+	case errors.Contains(err, ErrChannelCycle):
+		return errors.Wrap(svcerr.ErrMalformedEntity, err)
+	case err != nil:
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return nil
+}
+
+// checkRuleCycles checks the rule with its changed input and outputs. A new
+// cycle has to go through the rule outputs, also when it passes a pipeline
+// using the rule, so the traversal starts from the rule.
+func (re *re) checkRuleCycles(ctx context.Context, r Rule) error {
+	if len(channelPublishers(r.Outputs)) == 0 {
+		return nil
+	}
+	g := newChannelGraph(re.repo, r.DomainID)
+	g.changeRule(r)
+	cycle, err := g.hasCycle(ctx, ruleNodeID(r.ID))
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return ErrChannelCycle
+	}
+
+	return nil
+}
+
+// channelsChanged reports if the update changes the input or the channel
+// outputs of the saved rule. Nil outputs are not changed by the update.
+func channelsChanged(r, saved Rule) bool {
+	if r.InputChannel != saved.InputChannel || r.InputTopic != saved.InputTopic {
+		return true
+	}
+	if r.Outputs == nil {
+		return false
+	}
+
+	return !slices.EqualFunc(channelPublishers(r.Outputs), channelPublishers(saved.Outputs), func(a, b *outputs.ChannelPublisher) bool {
+		return a.Channel == b.Channel && a.Topic == b.Topic
+	})
+}
+
+// checkPipelineCycles reports if the step rule outputs would publish back to
+// the pipeline through the channels.
+func (re *re) checkPipelineCycles(ctx context.Context, p Pipeline, steps map[string]Rule) error {
+	publishes := false
+	for _, r := range steps {
+		if len(channelPublishers(r.Outputs)) > 0 {
+			publishes = true
+			break
+		}
+	}
+	if !publishes {
+		return nil
+	}
+	g := newChannelGraph(re.repo, p.DomainID)
+	for id, r := range steps {
+		g.rules[id] = r
+	}
+	g.changePipeline(p)
+	cycle, err := g.hasCycle(ctx, pipelineNodeID(p.ID))
+	if err != nil {
+		return err
+	}
+	if cycle {
+		return ErrChannelCycle
+	}
+
+	return nil
+}
+
+// channelConsumers returns the nodes consuming the channel. The rules and
+// the pipelines are read whatever their status, since the disabled ones may
+// be enabled later.
+func (g *channelGraph) channelConsumers(ctx context.Context, channel string) ([]string, error) {
+	if ids, ok := g.consumers[channel]; ok {
+		return ids, nil
+	}
+	page, err := g.store.ListAllRules(ctx, PageMeta{Domain: g.domainID, Status: AllStatus, InputChannel: channel})
+	if err != nil {
+		return nil, err
+	}
+	ppage, err := g.store.ListPipelines(ctx, PipelinePageMeta{DomainID: g.domainID, Status: AllStatus, InputChannel: channel})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, r := range page.Rules {
+		id := ruleNodeID(r.ID)
+		if _, ok := g.changed[id]; ok {
+			continue
+		}
+		g.rules[r.ID] = r
+		ids = append(ids, id)
+	}
+	for _, p := range ppage.Pipelines {
+		id := pipelineNodeID(p.ID)
+		if _, ok := g.changed[id]; ok {
+			continue
+		}
+		g.pipelines[p.ID] = p
+		ids = append(ids, id)
+	}
+	for id, ch := range g.changed {
+		if ch == channel {
+			ids = append(ids, id)
+		}
+	}
+	g.consumers[channel] = ids
+
+	return ids, nil
+}
+
+// node returns the loaded rule or pipeline node. The pipeline step rules
+// are read if they're not loaded yet.
+func (g *channelGraph) node(ctx context.Context, id string) (channelNode, error) {
+	if n, ok := g.nodes[id]; ok {
+		return n, nil
+	}
+	var n channelNode
+	if rid, ok := strings.CutPrefix(id, ruleNodeID("")); ok {
+		r := g.rules[rid]
+		n = channelNode{
+			id:       id,
+			topic:    r.InputTopic,
+			consumer: r.Schedule.Time.IsZero(),
+			pubs:     channelPublishers(r.Outputs),
+		}
+	}
+	if pid, ok := strings.CutPrefix(id, pipelineNodeID("")); ok {
+		p := g.pipelines[pid]
+		n = channelNode{
+			id:       id,
+			topic:    p.InputTopic,
+			consumer: true,
+		}
+		for _, rid := range p.RuleIDs() {
+			r, err := g.rule(ctx, rid)
+			if err != nil {
+				return channelNode{}, err
+			}
+			n.pubs = append(n.pubs, channelPublishers(r.Outputs)...)
+		}
+	}
+	g.nodes[id] = n
+
+	return n, nil
+}
+
+// rule returns the pipeline step rule. Removed rules don't publish.
+func (g *channelGraph) rule(ctx context.Context, id string) (Rule, error) {
+	if r, ok := g.rules[id]; ok {
+		return r, nil
+	}
+	r, err := g.store.ViewRule(ctx, id)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+	case err != nil:
+		return Rule{}, err
+	}
+	g.rules[id] = r
+
+	return r, nil
+}
+
+// hasCycle reports if there is a cycle reachable from the start node. Each
+// node and each channel is visited once.
+func (g *channelGraph) hasCycle(ctx context.Context, start string) (bool, error) {
+	const (
+		inProgress = iota + 1
+		done
+	)
+	visited := make(map[string]int)
+	var visit func(id string) (bool, error)
+	visit = func(id string) (bool, error) {
+		switch visited[id] {
+		case inProgress:
+			return true, nil
+		case done:
+			return false, nil
+		}
+		visited[id] = inProgress
+		n, err := g.node(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		for _, pub := range n.pubs {
+			ids, err := g.channelConsumers(ctx, pub.Channel)
+			if err != nil {
+				return false, err
+			}
+			for _, mid := range ids {
+				m, err := g.node(ctx, mid)
+				if err != nil {
+					return false, err
+				}
+				if !m.consumer || !topicMatches(m.topic, pub.Topic) {
+					continue
+				}
+				cycle, err := visit(mid)
+				if cycle || err != nil {
+					return cycle, err
+				}
+			}
+		}
+		visited[id] = done
+
+		return false, nil
+	}
+
+	return visit(start)
+}
+
+func channelPublishers(outs Outputs) []*outputs.ChannelPublisher {
+	var pubs []*outputs.ChannelPublisher
+	for _, o := range outs {
+		if p, ok := o.(*outputs.ChannelPublisher); ok {
+			pubs = append(pubs, p)
+		}
+	}
+
+	return pubs
+}
+
+// topicMatches reports if the topic matches the input topic, which may have
+// the single-level (+) and the multi-level (#) wildcards.
+func topicMatches(filter, topic string) bool {
+	fl := strings.Split(filter, "/")
+	tl := strings.Split(topic, "/")
+	for i, f := range fl {
+		if f == "#" {
+			return true
+		}
+		if i >= len(tl) || (f != "+" && f != tl[i]) {
+			return false
+		}
+	}
+
+	return len(fl) == len(tl)
+}
+
+func ruleNodeID(id string) string {
+	return "rule:" + id
+}
+
+func pipelineNodeID(id string) string {
+	return "pipeline:" + id
+}
@@ -0,0 +1,241 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/authn"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/re/outputs"
+)
+
+var errRenderNotSupported = errors.New("output does not support dry run")
+
+// Renderer is implemented by the outputs that can render the data
+// they would send without sending it. It is used for the dry runs.
+type Renderer interface {
+	Render(msg *messaging.Message, val any) (any, error)
+}
+
+// DryRunResult is the outcome of running a rule against a sample message.
+// Script and output errors are reported in the result instead of failing the run.
+type DryRunResult struct {
+	Result  any            `json:"result"`
+	Window  *WindowResult  `json:"window,omitempty"`
+	Outputs []DryRunOutput `json:"outputs,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// DryRunOutput is the rendered output that would fire for the sample message.
+type DryRunOutput struct {
+	Type     string `json:"type"`
+	Rendered any    `json:"rendered,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (re *re) DryRunRule(ctx context.Context, session authn.Session, r Rule, msg *messaging.Message) (DryRunResult, error) {
+	if err := re.validateRule(r); err != nil {
+		return DryRunResult{}, err
+	}
+	if n := len(msg.Payload); n > maxPayload {
+		return DryRunResult{}, errors.Wrap(svcerr.ErrMalformedEntity, errors.New(pldExceededFmt+strconv.Itoa(n)))
+	}
+	r.DomainID = session.DomainID
+	msg.Domain = session.DomainID
+	if msg.Channel == "" {
+		msg.Channel = r.InputChannel
+	}
+	if msg.Created == 0 {
+		msg.Created = time.Now().UnixNano()
+	}
+
+	// The state is kept in memory, so the dry run starts with an empty state
+	// and never modifies the state of the persisted rules.
+	state := newMemoryState()
+	var ret DryRunResult
+	var win *WindowResult
+	if r.Window != nil {
+		w, err := evalWindow(ctx, state, r, msg)
+		if err != nil {
+			ret.Error = fmt.Sprintf("failed to evaluate rule window: %s", err)
+			return ret, nil
+		}
+		win = &w
+		ret.Window = win
+	}
+
+	var res any
+	sctx, stop := re.sandbox.start(ctx)
+	switch r.Logic.Type {
+	case GoType:
+		val, err := re.sandbox.runGo(sctx, state, r, msg, win)
+		if lerr := stop(); lerr != nil {
+			err = lerr
+		}
+		if err != nil {
+			ret.Error = fmt.Sprintf("failed to run rule logic: %s", err)
+			return ret, nil
+		}
+		res = val
+	case JSType:
+		val, err := re.sandbox.runJS(sctx, state, r, msg, win)
+		if lerr := stop(); lerr != nil {
+			err = lerr
+		}
+		if err != nil {
+			ret.Error = fmt.Sprintf("failed to run rule logic: %s", err)
+			return ret, nil
+		}
+		res = val
+	default:
+		l := re.sandbox.newLuaState(sctx, state, r, msg, win)
+		defer l.Close()
+		val, err := runLua(l, r.Logic.Value)
+		if lerr := stop(); lerr != nil {
+			err = lerr
+		}
+		if err != nil {
+			ret.Error = fmt.Sprintf("failed to run rule logic: %s", err)
+			return ret, nil
+		}
+		res = convertLua(val)
+	}
+	ret.Result = res
+
+	// Outputs don't fire for nil or false results.
+	if b, ok := res.(bool); res == nil || (ok && !b) {
+		return ret, nil
+	}
+	for _, o := range r.Outputs {
+		out := DryRunOutput{Type: outputType(o)}
+		rnd, ok := o.(Renderer)
+		if !ok {
+			out.Error = errRenderNotSupported.Error()
+			ret.Outputs = append(ret.Outputs, out)
+			continue
+		}
+		// Alarms take the rule ID from the output itself.
+		if a, ok := o.(*outputs.Alarm); ok {
+			a.RuleID = r.ID
+		}
+		val, err := rnd.Render(msg, res)
+		if err != nil {
+			out.Error = err.Error()
+		}
+		out.Rendered = val
+		ret.Outputs = append(ret.Outputs, out)
+	}
+
+	return ret, nil
+}
+
+func outputType(o Runnable) string {
+	switch o.(type) {
+	case *outputs.Alarm:
+		return outputs.AlarmsType.String()
+	case *outputs.Email:
+		return outputs.EmailType.String()
+	case *outputs.ChannelPublisher:
+		return outputs.ChannelsType.String()
+	case *outputs.SenML:
+		return outputs.SaveSenMLType.String()
+	case *outputs.Postgres:
+		return outputs.SaveRemotePgType.String()
+	case *outputs.Slack:
+		return outputs.SlackType.String()
+	case *outputs.Webhook:
+		return outputs.WebhookType.String()
+	case *outputs.Bridge:
+		return outputs.BridgeType.String()
+	default:
+		return fmt.Sprintf("%T", o)
+	}
+}
+
+// memoryState is the StateRepository used by the dry runs.
+type memoryState struct {
+	entries map[string]StateEntry
+	samples []WindowSample
+}
+
+func newMemoryState() *memoryState {
+	return &memoryState{entries: make(map[string]StateEntry)}
+}
+
+func (s *memoryState) SaveState(ctx context.Context, e StateEntry) error {
+	// Encode the value the same way the persisted state does,
+	// so the scripts read back the same types.
+	data, err := json.Marshal(e.Value)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var val any
+	if err := json.Unmarshal(data, &val); err != nil {
+		return errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	e.Value = val
+	s.entries[e.Key] = e
+	return nil
+}
+
+func (s *memoryState) RetrieveState(ctx context.Context, ruleID, key string) (StateEntry, error) {
+	e, ok := s.entries[key]
+	if !ok || (!e.ExpiresAt.IsZero() && !e.ExpiresAt.After(time.Now().UTC())) {
+		return StateEntry{}, repoerr.ErrNotFound
+	}
+	return e, nil
+}
+
+func (s *memoryState) IncrementState(ctx context.Context, ruleID, key string, delta float64, expiresAt time.Time) (float64, error) {
+	var val float64
+	if e, err := s.RetrieveState(ctx, ruleID, key); err == nil {
+		v, ok := e.Value.(float64)
+		if !ok {
+			return 0, errors.Wrap(repoerr.ErrUpdateEntity, errors.New("state value is not numeric"))
+		}
+		val = v
+	}
+	val += delta
+	s.entries[key] = StateEntry{
+		RuleID:    ruleID,
+		Key:       key,
+		Value:     val,
+		ExpiresAt: expiresAt,
+		UpdatedAt: time.Now().UTC(),
+	}
+	return val, nil
+}
+
+func (s *memoryState) RemoveState(ctx context.Context, ruleID, key string) error {
+	delete(s.entries, key)
+	return nil
+}
+
+func (s *memoryState) RemoveExpiredState(ctx context.Context, before time.Time) error {
+	for k, e := range s.entries {
+		if !e.ExpiresAt.IsZero() && !e.ExpiresAt.After(before) {
+			delete(s.entries, k)
+		}
+	}
+	return nil
+}
+
+func (s *memoryState) AppendWindowSample(ctx context.Context, sample WindowSample, start time.Time) ([]WindowSample, error) {
+	s.samples = append(s.samples, sample)
+	var ret []WindowSample
+	for _, smp := range s.samples {
+		if smp.Key == sample.Key && !smp.CreatedAt.Before(start) {
+			ret = append(ret, smp)
+		}
+	}
+	return ret, nil
+}
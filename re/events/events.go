@@ -23,6 +23,16 @@ const (
 	ruleEnable         = rulePrefix + "enable"
 	ruleDisable        = rulePrefix + "disable"
 	ruleRemove         = rulePrefix + "remove"
+	ruleRollback       = rulePrefix + "rollback"
+
+	pipelinePrefix  = rulePrefix + "pipeline_"
+	pipelineCreate  = pipelinePrefix + "create"
+	pipelineList    = pipelinePrefix + "list"
+	pipelineView    = pipelinePrefix + "view"
+	pipelineUpdate  = pipelinePrefix + "update"
+	pipelineEnable  = pipelinePrefix + "enable"
+	pipelineDisable = pipelinePrefix + "disable"
+	pipelineRemove  = pipelinePrefix + "remove"
 )
 
 var (
@@ -35,6 +45,10 @@ var (
 	_ events.Event = (*enableRuleEvent)(nil)
 	_ events.Event = (*disableRuleEvent)(nil)
 	_ events.Event = (*removeRuleEvent)(nil)
+	_ events.Event = (*rollbackRuleEvent)(nil)
+	_ events.Event = (*pipelineEvent)(nil)
+	_ events.Event = (*listPipelineEvent)(nil)
+	_ events.Event = (*removePipelineEvent)(nil)
 )
 
 type baseRuleEvent struct {
@@ -190,3 +204,58 @@ func (rre removeRuleEvent) Encode() (map[string]any, error) {
 	val["operation"] = ruleRemove
 	return val, nil
 }
+
+type rollbackRuleEvent struct {
+	rule         re.Rule
+	restoredFrom uint64
+	baseRuleEvent
+}
+
+func (rre rollbackRuleEvent) Encode() (map[string]any, error) {
+	val, err := rre.rule.EventEncode()
+	if err != nil {
+		return map[string]any{}, err
+	}
+	maps.Copy(val, rre.baseRuleEvent.Encode())
+	val["operation"] = ruleRollback
+	val["restored_from"] = rre.restoredFrom
+	return val, nil
+}
+
+// pipelineEvent is the event of the operations that return the pipeline.
+type pipelineEvent struct {
+	pipeline  re.Pipeline
+	operation string
+	baseRuleEvent
+}
+
+func (pe pipelineEvent) Encode() (map[string]any, error) {
+	val := pe.pipeline.EventEncode()
+	maps.Copy(val, pe.baseRuleEvent.Encode())
+	val["operation"] = pe.operation
+	return val, nil
+}
+
+type listPipelineEvent struct {
+	re.PipelinePageMeta
+	baseRuleEvent
+}
+
+func (lpe listPipelineEvent) Encode() (map[string]any, error) {
+	val := lpe.EventEncode()
+	maps.Copy(val, lpe.baseRuleEvent.Encode())
+	val["operation"] = pipelineList
+	return val, nil
+}
+
+type removePipelineEvent struct {
+	id string
+	baseRuleEvent
+}
+
+func (rpe removePipelineEvent) Encode() (map[string]any, error) {
+	val := rpe.baseRuleEvent.Encode()
+	val["id"] = rpe.id
+	val["operation"] = pipelineRemove
+	return val, nil
+}
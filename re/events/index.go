@@ -0,0 +1,93 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/events"
+	"github.com/absmach/magistrala/pkg/events/store"
+	"github.com/absmach/magistrala/re"
+)
+
+const (
+	ruleStream = "events.magistrala.rule.*"
+
+	// indexInactiveThreshold removes the index consumers of the stopped
+	// instances, since the instance IDs are generated on each start.
+	indexInactiveThreshold = 10 * time.Minute
+)
+
+var (
+	errNoRuleID       = errors.New("rule ID is not found in event message")
+	errNoPipelineID   = errors.New("pipeline ID or domain is not found in event message")
+	errRefreshIndex   = errors.New("failed to refresh rule index")
+	errIndexOperation = errors.New("operation key is not found in event message")
+)
+
+type indexHandler struct {
+	index *re.RuleIndex
+}
+
+// IndexEventsSubscribe keeps the rule index fresh with the rule events.
+// Each service instance has its own index, so the consumer name must be
+// unique per instance.
+func IndexEventsSubscribe(ctx context.Context, index *re.RuleIndex, esURL, esConsumerName string, logger *slog.Logger) error {
+	subscriber, err := store.NewSubscriber(ctx, esURL, "re-index-es-sub", logger)
+	if err != nil {
+		return err
+	}
+
+	subConfig := events.SubscriberConfig{
+		Stream:            ruleStream,
+		Consumer:          esConsumerName,
+		Handler:           NewIndexHandler(index),
+		Ordered:           true,
+		InactiveThreshold: indexInactiveThreshold,
+	}
+
+	return subscriber.Subscribe(ctx, subConfig)
+}
+
+// NewIndexHandler returns the event handler that refreshes the rule index.
+func NewIndexHandler(index *re.RuleIndex) events.EventHandler {
+	return &indexHandler{index: index}
+}
+
+func (h *indexHandler) Handle(ctx context.Context, event events.Event) error {
+	msg, err := event.Encode()
+	if err != nil {
+		return err
+	}
+
+	op, ok := msg["operation"]
+	if !ok {
+		return errIndexOperation
+	}
+
+	switch op {
+	case ruleCreate, ruleUpdate, ruleUpdateTags, ruleUpdateSchedule, ruleEnable, ruleDisable, ruleRemove, ruleRollback:
+		id, ok := msg["id"].(string)
+		if !ok || id == "" {
+			return errors.Wrap(errRefreshIndex, errNoRuleID)
+		}
+		if err := h.index.Refresh(ctx, id); err != nil {
+			return errors.Wrap(errRefreshIndex, err)
+		}
+	case pipelineCreate, pipelineUpdate, pipelineEnable, pipelineDisable, pipelineRemove:
+		id, ok := msg["id"].(string)
+		domainID, dok := msg["domain"].(string)
+		if !ok || !dok || id == "" || domainID == "" {
+			return errors.Wrap(errRefreshIndex, errNoPipelineID)
+		}
+		if err := h.index.RefreshPipeline(ctx, domainID, id); err != nil {
+			return errors.Wrap(errRefreshIndex, err)
+		}
+	}
+
+	return nil
+}
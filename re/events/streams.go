@@ -27,6 +27,15 @@ const (
 	EnableStream         = magistralaPrefix + ruleEnable
 	DisableStream        = magistralaPrefix + ruleDisable
 	RemoveStream         = magistralaPrefix + ruleRemove
+	RollbackStream       = magistralaPrefix + ruleRollback
+
+	CreatePipelineStream  = magistralaPrefix + pipelineCreate
+	ListPipelineStream    = magistralaPrefix + pipelineList
+	ViewPipelineStream    = magistralaPrefix + pipelineView
+	UpdatePipelineStream  = magistralaPrefix + pipelineUpdate
+	EnablePipelineStream  = magistralaPrefix + pipelineEnable
+	DisablePipelineStream = magistralaPrefix + pipelineDisable
+	RemovePipelineStream  = magistralaPrefix + pipelineRemove
 )
 
 var _ re.Service = (*eventStore)(nil)
@@ -190,6 +199,151 @@ func (es *eventStore) DisableRule(ctx context.Context, session authn.Session, id
 	return rule, nil
 }
 
+func (es *eventStore) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error) {
+	return es.svc.DryRunRule(ctx, session, r, msg)
+}
+
+func (es *eventStore) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	return es.svc.ListExecutions(ctx, session, pm)
+}
+
+func (es *eventStore) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	return es.svc.ListRevisions(ctx, session, pm)
+}
+
+func (es *eventStore) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (re.RevisionDiff, error) {
+	return es.svc.DiffRevisions(ctx, session, id, from, to)
+}
+
+func (es *eventStore) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error) {
+	rule, err := es.svc.RollbackRule(ctx, session, id, revision)
+	if err != nil {
+		return rule, err
+	}
+	event := rollbackRuleEvent{
+		rule:          rule,
+		restoredFrom:  revision,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, RollbackStream, event); err != nil {
+		return rule, err
+	}
+	return rule, nil
+}
+
+func (es *eventStore) AddPipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	pipeline, err := es.svc.AddPipeline(ctx, session, p)
+	if err != nil {
+		return pipeline, err
+	}
+	event := pipelineEvent{
+		pipeline:      pipeline,
+		operation:     pipelineCreate,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, CreatePipelineStream, event); err != nil {
+		return pipeline, err
+	}
+	return pipeline, nil
+}
+
+func (es *eventStore) ViewPipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	pipeline, err := es.svc.ViewPipeline(ctx, session, id)
+	if err != nil {
+		return pipeline, err
+	}
+	event := pipelineEvent{
+		pipeline:      pipeline,
+		operation:     pipelineView,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, ViewPipelineStream, event); err != nil {
+		return pipeline, err
+	}
+	return pipeline, nil
+}
+
+func (es *eventStore) UpdatePipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	pipeline, err := es.svc.UpdatePipeline(ctx, session, p)
+	if err != nil {
+		return pipeline, err
+	}
+	event := pipelineEvent{
+		pipeline:      pipeline,
+		operation:     pipelineUpdate,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, UpdatePipelineStream, event); err != nil {
+		return pipeline, err
+	}
+	return pipeline, nil
+}
+
+func (es *eventStore) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	page, err := es.svc.ListPipelines(ctx, session, pm)
+	if err != nil {
+		return page, err
+	}
+	event := listPipelineEvent{
+		PipelinePageMeta: pm,
+		baseRuleEvent:    newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, ListPipelineStream, event); err != nil {
+		return page, err
+	}
+	return page, nil
+}
+
+func (es *eventStore) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	if err := es.svc.RemovePipeline(ctx, session, id); err != nil {
+		return err
+	}
+	event := removePipelineEvent{
+		id:            id,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, RemovePipelineStream, event); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (es *eventStore) EnablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	pipeline, err := es.svc.EnablePipeline(ctx, session, id)
+	if err != nil {
+		return pipeline, err
+	}
+	event := pipelineEvent{
+		pipeline:      pipeline,
+		operation:     pipelineEnable,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, EnablePipelineStream, event); err != nil {
+		return pipeline, err
+	}
+	return pipeline, nil
+}
+
+func (es *eventStore) DisablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	pipeline, err := es.svc.DisablePipeline(ctx, session, id)
+	if err != nil {
+		return pipeline, err
+	}
+	event := pipelineEvent{
+		pipeline:      pipeline,
+		operation:     pipelineDisable,
+		baseRuleEvent: newBaseRuleEvent(session, middleware.GetReqID(ctx)),
+	}
+	if err := es.Publish(ctx, DisablePipelineStream, event); err != nil {
+		return pipeline, err
+	}
+	return pipeline, nil
+}
+
+func (es *eventStore) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	return es.svc.ListPipelineExecutions(ctx, session, pm)
+}
+
 func (es *eventStore) StartScheduler(ctx context.Context) error {
 	return es.svc.StartScheduler(ctx)
 }
@@ -0,0 +1,144 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
+	"github.com/go-kit/kit/metrics"
+)
+
+// ExecutionStatus represents the outcome of a rule execution.
+type ExecutionStatus uint8
+
+const (
+	// SuccessExecution represents a rule execution that completed without errors.
+	SuccessExecution ExecutionStatus = iota
+	// SkippedExecution represents a rule execution where no outputs ran,
+	// because the logic returned nil or false or the rule has no outputs.
+	SkippedExecution
+	// FailedExecution represents a rule execution that failed.
+	FailedExecution
+
+	// AllExecutions is used for querying purposes to list executions
+	// irrespective of their status. It is never stored in the database.
+	AllExecutions
+)
+
+// String representation of the possible execution status values.
+const (
+	Success = "success"
+	Skipped = "skipped"
+	Failed  = "failed"
+)
+
+func (s ExecutionStatus) String() string {
+	switch s {
+	case SuccessExecution:
+		return Success
+	case SkippedExecution:
+		return Skipped
+	case FailedExecution:
+		return Failed
+	case AllExecutions:
+		return All
+	default:
+		return Unknown
+	}
+}
+
+// ToExecutionStatus converts string value to a valid execution status.
+func ToExecutionStatus(status string) (ExecutionStatus, error) {
+	switch status {
+	case "", All:
+		return AllExecutions, nil
+	case Success:
+		return SuccessExecution, nil
+	case Skipped:
+		return SkippedExecution, nil
+	case Failed:
+		return FailedExecution, nil
+	}
+	return ExecutionStatus(0), svcerr.ErrInvalidStatus
+}
+
+func (s ExecutionStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (s *ExecutionStatus) UnmarshalJSON(data []byte) error {
+	str := strings.Trim(string(data), "\"")
+	val, err := ToExecutionStatus(str)
+	*s = val
+	return err
+}
+
+// Execution is a single run of a rule for a message or a scheduled due time.
+// Rules run as the pipeline steps have the pipeline and the step set, and the
+// steps of the same pipeline run share the correlation ID.
+type Execution struct {
+	ID             string          `json:"id"`
+	RuleID         string          `json:"rule_id"`
+	DomainID       string          `json:"domain_id"`
+	PipelineID     string          `json:"pipeline_id,omitempty"`
+	Step           string          `json:"step,omitempty"`
+	CorrelationID  string          `json:"correlation_id,omitempty"`
+	Channel        string          `json:"channel,omitempty"`
+	Subtopic       string          `json:"subtopic,omitempty"`
+	ClientID       string          `json:"client_id,omitempty"`
+	MessageCreated int64           `json:"message_created,omitempty"` // Creation time of the message in nanoseconds.
+	Status         ExecutionStatus `json:"status"`
+	Error          string          `json:"error,omitempty"`
+	Outputs        []string        `json:"outputs,omitempty"` // Types of the outputs that ran.
+	Duration       time.Duration   `json:"duration"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// ExecutionPageMeta contains page metadata that helps navigation.
+type ExecutionPageMeta struct {
+	Total         uint64          `json:"total"`
+	Offset        uint64          `json:"offset"`
+	Limit         uint64          `json:"limit"`
+	Dir           string          `json:"dir"`
+	RuleID        string          `json:"rule_id"`
+	DomainID      string          `json:"domain_id"`
+	PipelineID    string          `json:"pipeline_id,omitempty"`
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	Status        ExecutionStatus `json:"status"`
+	From          time.Time       `json:"from,omitempty"`
+	To            time.Time       `json:"to,omitempty"`
+}
+
+type ExecutionPage struct {
+	Offset     uint64      `json:"offset"`
+	Limit      uint64      `json:"limit"`
+	Total      uint64      `json:"total"`
+	Executions []Execution `json:"executions"`
+}
+
+// ExecutionRepository persists the rule execution history.
+type ExecutionRepository interface {
+	// AddExecution saves the rule execution.
+	AddExecution(ctx context.Context, e Execution) error
+
+	// ListExecutions retrieves the executions of a rule or a pipeline, newest first by default.
+	ListExecutions(ctx context.Context, pm ExecutionPageMeta) (ExecutionPage, error)
+
+	// RemoveExecutions removes all the executions created before the given time.
+	RemoveExecutions(ctx context.Context, before time.Time) error
+}
+
+// HistoryConfig configures the rule execution history.
+type HistoryConfig struct {
+	// Retention is how long the executions are kept. Zero keeps them forever.
+	Retention time.Duration
+	// Counter counts the executions by rule_id and status.
+	Counter metrics.Counter
+	// Latency observes the execution duration in seconds by rule_id.
+	Latency metrics.Histogram
+}
@@ -15,7 +15,6 @@ import (
 	pkglog "github.com/absmach/magistrala/pkg/logger"
 	"github.com/absmach/magistrala/pkg/messaging"
 	golang "github.com/traefik/yaegi/interp"
-	"github.com/traefik/yaegi/stdlib"
 )
 
 const logicFunction = "main.logicFunction"
@@ -23,6 +22,8 @@ const logicFunction = "main.logicFunction"
 var (
 	goKeywordRegex = regexp.MustCompile(`\bgo\s+func\s*\(|^\s*go\s+\w+\(|[;\s{]go\s+func\s*\(|[;\s{]go\s+\w+\(`)
 	panicRegex     = regexp.MustCompile(`\bpanic\s*\(`)
+
+	errInvalidLogicFunction = errors.New("invalid logic function signature")
 )
 
 // Type message is a magistrala message with payload replaces by JSON deserialized payload.
@@ -37,21 +38,9 @@ type message struct {
 	Payload   any    `json:"payload,omitempty"`
 }
 
-func (re *re) processGo(ctx context.Context, details []slog.Attr, r Rule, msg *messaging.Message) (ret pkglog.RunInfo) {
-	defer func() {
-		if r := recover(); r != nil {
-			ret = pkglog.RunInfo{
-				Level:   slog.LevelError,
-				Details: details,
-				Message: fmt.Sprintf("panic in Go script: %v", r),
-			}
-		}
-	}()
-
-	i := golang.New(golang.Options{})
-	if err := i.Use(stdlib.Symbols); err != nil {
-		return pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: err.Error()}
-	}
+// newMessage returns the message with the JSON payload deserialized.
+// Payloads that are not JSON are kept as bytes.
+func newMessage(msg *messaging.Message) message {
 	m := message{
 		Created:   msg.Created,
 		ClientID:  msg.ClientIdentity(),
@@ -67,38 +56,150 @@ func (re *re) processGo(ctx context.Context, details []slog.Attr, r Rule, msg *m
 	}
 	m.Payload = pld
 
-	err := i.Use(golang.Exports{
-		"messaging/m": {
-			"message": reflect.ValueOf(m),
-		},
-	})
-	if err != nil {
-		return pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: err.Error()}
-	}
-	if _, err = i.Eval(r.Logic.Value); err != nil {
-		return pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: err.Error()}
+	return m
+}
+
+func (re *re) processGo(ctx context.Context, details []slog.Attr, r Rule, msg *messaging.Message, win *WindowResult, exec *Execution) (any, pkglog.RunInfo) {
+	sctx, stop := re.sandbox.start(ctx)
+	res, err := re.programs.runGo(sctx, re.repo, r, msg, win)
+	if lerr := stop(); lerr != nil {
+		err = lerr
 	}
-	ifc, err := i.Eval(logicFunction)
 	if err != nil {
-		return pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: err.Error()}
-	}
-	f, ok := ifc.Interface().(func() any)
-	if !ok {
-		return pkglog.RunInfo{Level: slog.LevelError, Message: "invalid logic function signature", Details: details}
+		return nil, pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: err.Error()}
 	}
-	res := f()
 	if b, ok := res.(bool); ok && !b {
-		return pkglog.RunInfo{Level: slog.LevelInfo, Message: "logic returned false", Details: details}
+		exec.Status = SkippedExecution
+		return res, pkglog.RunInfo{Level: slog.LevelInfo, Message: "logic returned false", Details: details}
+	}
+	if len(r.Outputs) == 0 {
+		exec.Status = SkippedExecution
 	}
 	for _, o := range r.Outputs {
 		if e := re.handleOutput(ctx, o, r, msg, res); e != nil {
 			err = errors.Wrap(e, err)
+			continue
 		}
+		exec.Outputs = append(exec.Outputs, outputType(o))
 	}
-	ret = pkglog.RunInfo{Level: slog.LevelInfo, Details: details, Message: "rule processed successfully"}
+	ret := pkglog.RunInfo{Level: slog.LevelInfo, Details: details, Message: "rule processed successfully"}
 	if err != nil {
 		ret.Level = slog.LevelError
 		ret.Message = fmt.Sprintf("failed to handle rule output: %s", err)
 	}
-	return ret
+	return res, ret
+}
+
+// runGo evaluates the Go logic and returns the result of the logic function.
+func (s *sandbox) runGo(ctx context.Context, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) (any, error) {
+	p, err := newGoProgram(ctx, r.Logic.Value, s.goSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.run(ctx, state, r, msg, win)
+}
+
+// goProgram is the interpreted Go logic, prepared before the message arrives.
+// The message, the state and the window are exported as variables and set
+// by the run. The program runs once, since the interpreter keeps the package
+// variables of the logic.
+type goProgram struct {
+	interp *golang.Interpreter
+	env    goEnv
+}
+
+type goEnv struct {
+	message message
+	state   ruleState
+	window  WindowResult
+}
+
+// The state functions read the state of the current run, since the
+// ruleState methods would be bound to the state of the first run.
+func (e *goEnv) get(key string) (any, error) {
+	return e.state.Get(key)
+}
+
+func (e *goEnv) set(key string, value any, ttl int) error {
+	return e.state.Set(key, value, ttl)
+}
+
+func (e *goEnv) increment(key string, delta float64, ttl int) (float64, error) {
+	return e.state.Increment(key, delta, ttl)
+}
+
+func (e *goEnv) delete(key string) error {
+	return e.state.Delete(key)
+}
+
+// newGoProgram builds the program in the context of the sandbox run, since
+// building it runs the package variable initializers and the init functions
+// of the logic.
+func newGoProgram(ctx context.Context, code string, symbols golang.Exports) (p *goProgram, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			p = nil
+			err = fmt.Errorf("panic in Go script: %v", r)
+		}
+	}()
+
+	i := golang.New(golang.Options{})
+	p = &goProgram{interp: i}
+	if err := i.Use(symbols); err != nil {
+		return nil, err
+	}
+	err = i.Use(golang.Exports{
+		"messaging/m": {
+			"message": reflect.ValueOf(&p.env.message).Elem(),
+		},
+		"state/state": {
+			"Get":       reflect.ValueOf(p.env.get),
+			"Set":       reflect.ValueOf(p.env.set),
+			"Increment": reflect.ValueOf(p.env.increment),
+			"Delete":    reflect.ValueOf(p.env.delete),
+			"Window":    reflect.ValueOf(&p.env.window).Elem(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = i.EvalWithContext(ctx, code); err != nil {
+		if pnc, ok := err.(golang.Panic); ok {
+			return nil, fmt.Errorf("panic in Go script: %v", pnc.Value)
+		}
+		return nil, err
+	}
+	ifc, err := i.Eval(logicFunction)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ifc.Interface().(func() any); !ok {
+		return nil, errInvalidLogicFunction
+	}
+
+	return p, nil
+}
+
+// run calls the logic function with the message. The logic is stopped
+// when the context is canceled.
+func (p *goProgram) run(ctx context.Context, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) (any, error) {
+	p.env.message = newMessage(msg)
+	p.env.state = ruleState{ctx: ctx, repo: state, ruleID: r.ID}
+	if win != nil {
+		p.env.window = *win
+	}
+
+	res, err := p.interp.EvalWithContext(ctx, logicFunction+"()")
+	if err != nil {
+		if pnc, ok := err.(golang.Panic); ok {
+			return nil, fmt.Errorf("panic in Go script: %v", pnc.Value)
+		}
+		return nil, err
+	}
+	if !res.IsValid() {
+		return nil, nil
+	}
+
+	return res.Interface(), nil
 }
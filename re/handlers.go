@@ -5,10 +5,10 @@ package re
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/absmach/magistrala/pkg/errors"
@@ -23,9 +23,15 @@ var (
 )
 
 const (
+	// maxStepRuns limits the step runs of a single pipeline run.
+	maxStepRuns    = 4 * maxPipelineSteps
 	maxPayload     = 100 * 1024
 	pldExceededFmt = "max payload size of 100kB exceeded: "
-	protocol       = "nats"
+	// maxHops limits the channel outputs republishing a message, so the
+	// channel cycles created by the concurrent updates don't run forever.
+	maxHops         = 16
+	hopsExceededFmt = "max hops exceeded, dropping message republished by the rules engine: "
+	protocol        = "nats"
 )
 
 func (re *re) Handle(msg *messaging.Message) error {
@@ -33,68 +39,243 @@ func (re *re) Handle(msg *messaging.Message) error {
 	if n := len(msg.Payload); n > maxPayload {
 		return errors.New(pldExceededFmt + strconv.Itoa(n))
 	}
-	// Skip filtering by message topic and fetch all non-scheduled rules instead.
-	// It's cleaner and more efficient to match wildcards in Go, but we can
-	// revisit this if it ever becomes a performance bottleneck.
-	pm := PageMeta{
-		Domain:       msg.Domain,
-		InputChannel: msg.Channel,
-		Status:       EnabledStatus,
-		Scheduled:    &scheduledFalse,
+	if n := msg.GetHops(); n > maxHops {
+		return messaging.NewError(errors.New(hopsExceededFmt+strconv.Itoa(int(n))), messaging.Term)
 	}
 	ctx := context.Background()
-	page, err := re.repo.ListAllRules(ctx, pm)
+	rules, err := re.index.Match(ctx, msg.Domain, msg.Channel, msg.Subtopic)
 	if err != nil {
 		return err
 	}
-	for _, r := range page.Rules {
-		if matchTopic(msg.Subtopic, r.InputTopic) {
-			go func(ctx context.Context) {
-				re.runInfo <- re.process(ctx, r, msg)
-			}(ctx)
-		}
+	pipelines, err := re.index.MatchPipelines(ctx, msg.Domain, msg.Channel, msg.Subtopic)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 && len(pipelines) == 0 {
+		return nil
+	}
+	jobs := make([]func(), 0, len(rules)+len(pipelines))
+	for _, r := range rules {
+		jobs = append(jobs, func() {
+			re.runInfo <- re.process(ctx, r, msg)
+		})
+	}
+	for _, p := range pipelines {
+		jobs = append(jobs, func() {
+			re.processPipeline(ctx, p, msg)
+		})
+	}
+	// The message is redelivered when the workers are saturated, so it's
+	// either run by all the matching rules or by none of them.
+	if err := re.workers.submit(msg.Domain, jobs...); err != nil {
+		return messaging.NewError(err, messaging.Nack)
 	}
 
 	return nil
 }
 
-// matchTopic matches a published subtopic against a subscription pattern
-// using MQTT-style wildcards: + (single level) and # (multi-level).
-func matchTopic(published, subscribed string) bool {
-	p := strings.Split(published, "/")
-	s := strings.Split(subscribed, "/")
-	n := len(p)
+// process runs the rule and records the execution in the rule history.
+func (re *re) process(ctx context.Context, r Rule, msg *messaging.Message) pkglog.RunInfo {
+	exec := newExecution(r, msg)
+	_, info := re.execute(ctx, r, msg, &exec)
 
-	for i := range s {
-		if s[i] == "#" {
-			return true
-		}
-		if i >= n {
-			return false
-		}
-		if s[i] != "+" && p[i] != s[i] {
-			return false
-		}
+	return info
+}
+
+func newExecution(r Rule, msg *messaging.Message) Execution {
+	return Execution{
+		RuleID:         r.ID,
+		DomainID:       r.DomainID,
+		Channel:        msg.Channel,
+		Subtopic:       msg.Subtopic,
+		ClientID:       msg.ClientIdentity(),
+		MessageCreated: msg.Created,
+		Status:         SuccessExecution,
+		CreatedAt:      time.Now().UTC(),
 	}
-	return len(s) == n
 }
 
-func (re *re) process(ctx context.Context, r Rule, msg *messaging.Message) pkglog.RunInfo {
+// execute runs the rule, records the execution and returns the logic result.
+// The result is nil if the logic failed.
+func (re *re) execute(ctx context.Context, r Rule, msg *messaging.Message, exec *Execution) (any, pkglog.RunInfo) {
+	res, info := re.run(ctx, r, msg, exec)
+	exec.Duration = time.Since(exec.CreatedAt)
+	if info.Level == slog.LevelError {
+		exec.Status = FailedExecution
+		exec.Error = info.Message
+	}
+	if err := re.saveExecution(ctx, *exec); err != nil {
+		info.Details = append(info.Details, slog.String("history_error", err.Error()))
+	}
+
+	return res, info
+}
+
+func (re *re) saveExecution(ctx context.Context, exec Execution) error {
+	re.history.Counter.With("rule_id", exec.RuleID, "status", exec.Status.String()).Add(1)
+	re.history.Latency.With("rule_id", exec.RuleID).Observe(exec.Duration.Seconds())
+
+	id, err := re.idp.ID()
+	if err != nil {
+		return err
+	}
+	exec.ID = id
+
+	return re.repo.AddExecution(ctx, exec)
+}
+
+func (re *re) run(ctx context.Context, r Rule, msg *messaging.Message, exec *Execution) (any, pkglog.RunInfo) {
 	details := []slog.Attr{
 		slog.String("domain_id", r.DomainID),
 		slog.String("rule_id", r.ID),
 		slog.String("rule_name", r.Name),
 		slog.Time("exec_time", time.Now().UTC()),
 	}
+	var win *WindowResult
+	if r.Window != nil {
+		w, err := evalWindow(ctx, re.repo, r, msg)
+		if err != nil {
+			return nil, pkglog.RunInfo{Level: slog.LevelError, Message: fmt.Sprintf("failed to evaluate rule window: %s", err), Details: details}
+		}
+		win = &w
+	}
 	switch r.Logic.Type {
 	case GoType:
-		return re.processGo(ctx, details, r, msg)
+		return re.processGo(ctx, details, r, msg, win, exec)
+	case JSType:
+		return re.processJS(ctx, details, r, msg, win, exec)
 	default:
-		return re.processLua(ctx, details, r, msg)
+		return re.processLua(ctx, details, r, msg, win, exec)
+	}
+}
+
+// pipelineRun is a single run of the pipeline for a message.
+type pipelineRun struct {
+	pipeline      Pipeline
+	steps         map[string]Step
+	correlationID string
+	// runs counts the step runs, since the branches that join run the
+	// following steps once per branch.
+	runs int
+}
+
+// processPipeline runs the pipeline steps from the first one. Each step runs
+// on the result of the previous one, and the steps with the matching
+// branches run in turn, so the whole run happens on the same worker.
+func (re *re) processPipeline(ctx context.Context, p Pipeline, msg *messaging.Message) {
+	id, err := re.idp.ID()
+	if err != nil {
+		re.runInfo <- pkglog.RunInfo{
+			Level:   slog.LevelError,
+			Message: fmt.Sprintf("failed to start pipeline: %s", err),
+			Details: []slog.Attr{slog.String("domain_id", p.DomainID), slog.String("pipeline_id", p.ID)},
+		}
+		return
+	}
+	run := &pipelineRun{
+		pipeline:      p,
+		steps:         make(map[string]Step, len(p.Steps)),
+		correlationID: id,
+	}
+	for _, s := range p.Steps {
+		run.steps[s.Name] = s
+	}
+	re.runStep(ctx, run, p.Steps[0], msg)
+}
+
+func (re *re) runStep(ctx context.Context, run *pipelineRun, s Step, msg *messaging.Message) {
+	details := []slog.Attr{
+		slog.String("domain_id", run.pipeline.DomainID),
+		slog.String("pipeline_id", run.pipeline.ID),
+		slog.String("step", s.Name),
+		slog.String("correlation_id", run.correlationID),
+	}
+	if run.runs++; run.runs > maxStepRuns {
+		re.runInfo <- pkglog.RunInfo{Level: slog.LevelWarn, Message: "pipeline step runs limit exceeded", Details: details}
+		return
+	}
+	r, ok := re.index.StepRule(s.RuleID)
+	if !ok {
+		exec := Execution{
+			RuleID:         s.RuleID,
+			DomainID:       run.pipeline.DomainID,
+			PipelineID:     run.pipeline.ID,
+			Step:           s.Name,
+			CorrelationID:  run.correlationID,
+			Channel:        msg.Channel,
+			Subtopic:       msg.Subtopic,
+			ClientID:       msg.ClientIdentity(),
+			MessageCreated: msg.Created,
+			Status:         FailedExecution,
+			Error:          errStepRuleNotFound.Error(),
+			CreatedAt:      time.Now().UTC(),
+		}
+		info := pkglog.RunInfo{Level: slog.LevelError, Message: errStepRuleNotFound.Error(), Details: details}
+		if err := re.saveExecution(ctx, exec); err != nil {
+			info.Details = append(info.Details, slog.String("history_error", err.Error()))
+		}
+		re.runInfo <- info
+		return
+	}
+	if r.Status != EnabledStatus {
+		re.runInfo <- pkglog.RunInfo{Level: slog.LevelInfo, Message: "pipeline step rule is disabled", Details: details}
+		return
+	}
+
+	exec := newExecution(r, msg)
+	exec.PipelineID = run.pipeline.ID
+	exec.Step = s.Name
+	exec.CorrelationID = run.correlationID
+	res, info := re.execute(ctx, r, msg, &exec)
+	info.Details = append(info.Details, details[1:]...)
+	re.runInfo <- info
+	// Like the outputs, the following steps don't run for nil or false results.
+	if b, ok := res.(bool); res == nil || (ok && !b) {
+		return
+	}
+
+	var next *messaging.Message
+	for _, b := range s.Next {
+		if b.When != nil && !b.When.Match(res) {
+			continue
+		}
+		if next == nil {
+			m, err := stepMessage(msg, res)
+			if err != nil {
+				re.runInfo <- pkglog.RunInfo{Level: slog.LevelError, Message: fmt.Sprintf("failed to encode pipeline step result: %s", err), Details: details}
+				return
+			}
+			next = m
+		}
+		re.runStep(ctx, run, run.steps[b.Step], next)
+	}
+}
+
+// stepMessage returns the message with the step result as the payload, so
+// the next step reads the result as the message payload.
+func stepMessage(msg *messaging.Message, res any) (*messaging.Message, error) {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
 	}
+
+	return &messaging.Message{
+		Domain:    msg.Domain,
+		Channel:   msg.Channel,
+		Subtopic:  msg.Subtopic,
+		Publisher: msg.Publisher,
+		ClientId:  msg.ClientIdentity(),
+		Protocol:  msg.Protocol,
+		Created:   msg.Created,
+		Payload:   data,
+		Hops:      msg.GetHops(),
+	}, nil
 }
 
 func (re *re) handleOutput(ctx context.Context, o Runnable, r Rule, msg *messaging.Message, val any) error {
+	if err := re.secrets.open(o); err != nil {
+		return err
+	}
 	switch o := o.(type) {
 	case *outputs.Alarm:
 		o.AlarmsPub = re.alarmsPub
@@ -109,7 +290,18 @@ func (re *re) handleOutput(ctx context.Context, o Runnable, r Rule, msg *messagi
 	case *outputs.SenML:
 		o.WritersPub = re.writersPub
 		return o.Run(ctx, msg, val)
-	case *outputs.Postgres, *outputs.Slack:
+	case *outputs.Bridge:
+		o.Pool = re.bridges
+		o.RuleID = r.ID
+		return o.Run(ctx, msg, val)
+	case *outputs.Postgres:
+		o.Pool = re.pgPool
+		o.RuleID = r.ID
+		return o.Run(ctx, msg, val)
+	case *outputs.Webhook:
+		o.Pool = re.webhooks
+		return o.Run(ctx, msg, val)
+	case *outputs.Slack:
 		return o.Run(ctx, msg, val)
 	default:
 		return fmt.Errorf("unknown output type: %T", o)
@@ -130,6 +322,24 @@ func (re *re) StartScheduler(ctx context.Context) error {
 				ScheduledBefore: &due,
 			}
 
+			if err := re.repo.RemoveExpiredState(ctx, due); err != nil {
+				re.runInfo <- pkglog.RunInfo{
+					Level:   slog.LevelError,
+					Message: fmt.Sprintf("failed to remove expired rule state: %s", err),
+					Details: []slog.Attr{slog.Time("due", due)},
+				}
+			}
+
+			if re.history.Retention > 0 {
+				if err := re.repo.RemoveExecutions(ctx, due.Add(-re.history.Retention)); err != nil {
+					re.runInfo <- pkglog.RunInfo{
+						Level:   slog.LevelError,
+						Message: fmt.Sprintf("failed to remove old rule executions: %s", err),
+						Details: []slog.Attr{slog.Time("due", due)},
+					}
+				}
+			}
+
 			page, err := re.repo.ListAllRules(ctx, pm)
 			if err != nil {
 				re.runInfo <- pkglog.RunInfo{
@@ -142,24 +352,43 @@ func (re *re) StartScheduler(ctx context.Context) error {
 			}
 
 			for _, r := range page.Rules {
-				go func(rule Rule, dueTime time.Time) {
-					if _, err := re.repo.UpdateRuleDue(ctx, rule.ID, rule.Schedule.NextDue()); err != nil {
-						re.runInfo <- pkglog.RunInfo{Level: slog.LevelError, Message: fmt.Sprintf("failed to update rule: %s", err), Details: []slog.Attr{slog.Time("time", time.Now().UTC())}}
-						return
-					}
-
-					msg := &messaging.Message{
-						Domain:   rule.DomainID,
-						Channel:  rule.InputChannel,
-						Subtopic: rule.InputTopic,
-						Protocol: protocol,
-						Created:  dueTime.Unix(),
-					}
-					re.runInfo <- re.process(ctx, rule, msg)
-				}(r, due)
+				re.schedule(ctx, r, due)
 			}
 			// Reset due, it will reset in the page meta as well.
 			due = time.Now().UTC()
 		}
 	}
 }
+
+// schedule queues the due run of the rule with the workers, unless its
+// previous run is still queued. The rules that don't fit in the queue are
+// left due, so they run on one of the next ticks.
+func (re *re) schedule(ctx context.Context, rule Rule, due time.Time) {
+	if _, queued := re.scheduled.LoadOrStore(rule.ID, struct{}{}); queued {
+		return
+	}
+	job := func() {
+		defer re.scheduled.Delete(rule.ID)
+		if _, err := re.repo.UpdateRuleDue(ctx, rule.ID, rule.Schedule.NextDue()); err != nil {
+			re.runInfo <- pkglog.RunInfo{Level: slog.LevelError, Message: fmt.Sprintf("failed to update rule: %s", err), Details: []slog.Attr{slog.Time("time", time.Now().UTC())}}
+			return
+		}
+
+		msg := &messaging.Message{
+			Domain:   rule.DomainID,
+			Channel:  rule.InputChannel,
+			Subtopic: rule.InputTopic,
+			Protocol: protocol,
+			Created:  due.Unix(),
+		}
+		re.runInfo <- re.process(ctx, rule, msg)
+	}
+	if err := re.workers.submit(rule.DomainID, job); err != nil {
+		re.scheduled.Delete(rule.ID)
+		re.runInfo <- pkglog.RunInfo{
+			Level:   slog.LevelWarn,
+			Message: fmt.Sprintf("scheduled rule run postponed: %s", err),
+			Details: []slog.Attr{slog.String("domain_id", rule.DomainID), slog.String("rule_id", rule.ID), slog.Time("due", due)},
+		}
+	}
+}
@@ -0,0 +1,443 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+)
+
+// RuleIndex keeps the rules and the pipelines that are run on the incoming
+// messages in memory, so the messages are matched without querying the
+// database. They are indexed by the domain, the input channel and the input
+// topic levels. The rules used by the pipeline steps are kept as well.
+//
+// The index is loaded on the first match and kept fresh with Refresh and
+// RefreshPipeline, which are called on the rule events, and Sync, which
+// reloads it periodically in case some events are missed.
+type RuleIndex struct {
+	mu     sync.RWMutex
+	repo   Repository
+	loaded bool
+	// rules maps the rule ID to the indexed rule, so the rules are
+	// removed without walking the topics.
+	rules            map[string]Rule
+	channels         map[channelKey]*topicNode[Rule]
+	pipelines        map[string]Pipeline
+	pipelineChannels map[channelKey]*topicNode[Pipeline]
+	// steps maps the rule ID to the rule used by the indexed pipelines.
+	// Step rules are kept whatever their status and schedule.
+	steps map[string]Rule
+}
+
+type channelKey struct {
+	domain  string
+	channel string
+}
+
+// topicNode is a topic level. Entries with the input topics ending at the level
+// are in entries, and the entries with the multi-level wildcard at the level are in multi.
+type topicNode[T any] struct {
+	children map[string]*topicNode[T]
+	entries  map[string]T
+	multi    map[string]T
+}
+
+func newTopicNode[T any]() *topicNode[T] {
+	return &topicNode[T]{
+		children: make(map[string]*topicNode[T]),
+		entries:  make(map[string]T),
+		multi:    make(map[string]T),
+	}
+}
+
+// NewRuleIndex returns an empty rule index backed by the repository.
+func NewRuleIndex(repo Repository) *RuleIndex {
+	return &RuleIndex{
+		repo:             repo,
+		rules:            make(map[string]Rule),
+		channels:         make(map[channelKey]*topicNode[Rule]),
+		pipelines:        make(map[string]Pipeline),
+		pipelineChannels: make(map[channelKey]*topicNode[Pipeline]),
+		steps:            make(map[string]Rule),
+	}
+}
+
+// Match returns the rules to run for the message published to the channel
+// subtopic. Rules are copied, so the callers can change their outputs.
+func (idx *RuleIndex) Match(ctx context.Context, domain, channel, subtopic string) ([]Rule, error) {
+	if err := idx.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	root, ok := idx.channels[channelKey{domain: domain, channel: channel}]
+	if !ok {
+		return nil, nil
+	}
+	var ret []Rule
+	root.match(strings.Split(subtopic, "/"), func(r Rule) {
+		ret = append(ret, cloneRule(r))
+	})
+
+	return ret, nil
+}
+
+// MatchPipelines returns the pipelines to run for the message published to
+// the channel subtopic.
+func (idx *RuleIndex) MatchPipelines(ctx context.Context, domain, channel, subtopic string) ([]Pipeline, error) {
+	if err := idx.ensureLoaded(ctx); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	root, ok := idx.pipelineChannels[channelKey{domain: domain, channel: channel}]
+	if !ok {
+		return nil, nil
+	}
+	var ret []Pipeline
+	root.match(strings.Split(subtopic, "/"), func(p Pipeline) {
+		ret = append(ret, p)
+	})
+
+	return ret, nil
+}
+
+// StepRule returns the copy of the rule used by the pipeline step.
+func (idx *RuleIndex) StepRule(id string) (Rule, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	r, ok := idx.steps[id]
+	if !ok {
+		return Rule{}, false
+	}
+
+	return cloneRule(r), true
+}
+
+// Refresh reloads the rule from the repository, and indexes or removes it.
+func (idx *RuleIndex) Refresh(ctx context.Context, id string) error {
+	idx.mu.RLock()
+	loaded := idx.loaded
+	idx.mu.RUnlock()
+	// The rule will be read with the others on the first match.
+	if !loaded {
+		return nil
+	}
+
+	r, err := idx.repo.ViewRule(ctx, id)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+		idx.remove(id)
+		return nil
+	case err != nil:
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(id)
+	if indexed(r) {
+		idx.addLocked(r)
+	}
+	if _, ok := idx.steps[id]; ok {
+		idx.steps[id] = r
+	}
+
+	return nil
+}
+
+// RefreshPipeline reloads the pipeline and its step rules from the
+// repository, and indexes or removes the pipeline.
+func (idx *RuleIndex) RefreshPipeline(ctx context.Context, domainID, id string) error {
+	idx.mu.RLock()
+	loaded := idx.loaded
+	idx.mu.RUnlock()
+	if !loaded {
+		return nil
+	}
+
+	p, err := idx.repo.ViewPipeline(ctx, domainID, id)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+		idx.mu.Lock()
+		defer idx.mu.Unlock()
+		idx.removePipelineLocked(id)
+		idx.pruneStepsLocked()
+		return nil
+	case err != nil:
+		return err
+	}
+	var steps map[string]Rule
+	if p.Status == EnabledStatus {
+		if steps, err = idx.stepRules(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removePipelineLocked(id)
+	if p.Status == EnabledStatus {
+		idx.addPipelineLocked(p)
+		maps.Copy(idx.steps, steps)
+	}
+	idx.pruneStepsLocked()
+
+	return nil
+}
+
+// Load replaces the indexed rules with the rules from the repository.
+func (idx *RuleIndex) Load(ctx context.Context) error {
+	pm := PageMeta{
+		Status:    EnabledStatus,
+		Scheduled: &scheduledFalse,
+	}
+	page, err := idx.repo.ListAllRules(ctx, pm)
+	if err != nil {
+		return err
+	}
+	ppage, err := idx.repo.ListPipelines(ctx, PipelinePageMeta{Status: EnabledStatus})
+	if err != nil {
+		return err
+	}
+	steps := make(map[string]Rule)
+	for _, p := range ppage.Pipelines {
+		s, err := idx.stepRules(ctx, p)
+		if err != nil {
+			return err
+		}
+		maps.Copy(steps, s)
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.rules = make(map[string]Rule, len(page.Rules))
+	idx.channels = make(map[channelKey]*topicNode[Rule])
+	for _, r := range page.Rules {
+		idx.addLocked(r)
+	}
+	idx.pipelines = make(map[string]Pipeline, len(ppage.Pipelines))
+	idx.pipelineChannels = make(map[channelKey]*topicNode[Pipeline])
+	for _, p := range ppage.Pipelines {
+		idx.addPipelineLocked(p)
+	}
+	idx.steps = steps
+	idx.loaded = true
+
+	return nil
+}
+
+// Sync reloads the index on each interval until the context is canceled.
+func (idx *RuleIndex) Sync(ctx context.Context, interval time.Duration) error {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick.C:
+			// Errors are retried on the next tick.
+			_ = idx.Load(ctx)
+		}
+	}
+}
+
+// Len returns the number of the indexed rules.
+func (idx *RuleIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.rules)
+}
+
+func (idx *RuleIndex) ensureLoaded(ctx context.Context) error {
+	idx.mu.RLock()
+	loaded := idx.loaded
+	idx.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	return idx.Load(ctx)
+}
+
+// remove removes the rule, and the step rule, so the steps using it fail.
+func (idx *RuleIndex) remove(id string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.removeLocked(id)
+	delete(idx.steps, id)
+}
+
+func (idx *RuleIndex) addLocked(r Rule) {
+	key := channelKey{domain: r.DomainID, channel: r.InputChannel}
+	node, ok := idx.channels[key]
+	if !ok {
+		node = newTopicNode[Rule]()
+		idx.channels[key] = node
+	}
+	node.add(strings.Split(r.InputTopic, "/"), r.ID, r)
+	idx.rules[r.ID] = r
+}
+
+func (idx *RuleIndex) removeLocked(id string) {
+	r, ok := idx.rules[id]
+	if !ok {
+		return
+	}
+	delete(idx.rules, id)
+
+	key := channelKey{domain: r.DomainID, channel: r.InputChannel}
+	root := idx.channels[key]
+	if root.remove(strings.Split(r.InputTopic, "/"), id) {
+		delete(idx.channels, key)
+	}
+}
+
+func (idx *RuleIndex) addPipelineLocked(p Pipeline) {
+	key := channelKey{domain: p.DomainID, channel: p.InputChannel}
+	node, ok := idx.pipelineChannels[key]
+	if !ok {
+		node = newTopicNode[Pipeline]()
+		idx.pipelineChannels[key] = node
+	}
+	node.add(strings.Split(p.InputTopic, "/"), p.ID, p)
+	idx.pipelines[p.ID] = p
+}
+
+func (idx *RuleIndex) removePipelineLocked(id string) {
+	p, ok := idx.pipelines[id]
+	if !ok {
+		return
+	}
+	delete(idx.pipelines, id)
+
+	key := channelKey{domain: p.DomainID, channel: p.InputChannel}
+	root := idx.pipelineChannels[key]
+	if root.remove(strings.Split(p.InputTopic, "/"), id) {
+		delete(idx.pipelineChannels, key)
+	}
+}
+
+// pruneStepsLocked removes the step rules that are no longer used by the pipelines.
+func (idx *RuleIndex) pruneStepsLocked() {
+	used := make(map[string]bool, len(idx.steps))
+	for _, p := range idx.pipelines {
+		for _, id := range p.RuleIDs() {
+			used[id] = true
+		}
+	}
+	for id := range idx.steps {
+		if !used[id] {
+			delete(idx.steps, id)
+		}
+	}
+}
+
+// stepRules reads the rules of the pipeline steps. Removed rules are left
+// out, and their steps fail when the pipeline runs.
+func (idx *RuleIndex) stepRules(ctx context.Context, p Pipeline) (map[string]Rule, error) {
+	steps := make(map[string]Rule, len(p.Steps))
+	for _, id := range p.RuleIDs() {
+		r, err := idx.repo.ViewRule(ctx, id)
+		switch {
+		case errors.Contains(err, repoerr.ErrNotFound):
+			continue
+		case err != nil:
+			return nil, err
+		}
+		steps[id] = r
+	}
+
+	return steps, nil
+}
+
+// add adds the entry at the topic levels below the node.
+func (n *topicNode[T]) add(levels []string, id string, v T) {
+	node := n
+	for _, level := range levels {
+		if level == "#" {
+			node.multi[id] = v
+			return
+		}
+		child, ok := node.children[level]
+		if !ok {
+			child = newTopicNode[T]()
+			node.children[level] = child
+		}
+		node = child
+	}
+	node.entries[id] = v
+}
+
+// match calls fn for each entry of the node subtree that matches the topic levels.
+func (n *topicNode[T]) match(levels []string, fn func(T)) {
+	for _, v := range n.multi {
+		fn(v)
+	}
+	if len(levels) == 0 {
+		for _, v := range n.entries {
+			fn(v)
+		}
+		return
+	}
+	if child, ok := n.children[levels[0]]; ok {
+		child.match(levels[1:], fn)
+	}
+	if child, ok := n.children["+"]; ok && levels[0] != "+" {
+		child.match(levels[1:], fn)
+	}
+}
+
+// remove removes the entry and reports if the node became empty.
+func (n *topicNode[T]) remove(levels []string, id string) bool {
+	switch {
+	case len(levels) > 0 && levels[0] == "#":
+		delete(n.multi, id)
+	case len(levels) == 0:
+		delete(n.entries, id)
+	default:
+		if child, ok := n.children[levels[0]]; ok && child.remove(levels[1:], id) {
+			delete(n.children, levels[0])
+		}
+	}
+
+	return len(n.children) == 0 && len(n.entries) == 0 && len(n.multi) == 0
+}
+
+// indexed reports if the rule is run on the incoming messages.
+func indexed(r Rule) bool {
+	return r.Status == EnabledStatus && r.Schedule.Time.IsZero()
+}
+
+// cloneRule copies the rule outputs, since they are changed when the rule runs.
+func cloneRule(r Rule) Rule {
+	outs := make(Outputs, len(r.Outputs))
+	for i, o := range r.Outputs {
+		v := reflect.ValueOf(o)
+		if v.Kind() != reflect.Pointer || v.IsNil() {
+			outs[i] = o
+			continue
+		}
+		c := reflect.New(v.Elem().Type())
+		c.Elem().Set(v.Elem())
+		outs[i] = c.Interface().(Runnable)
+	}
+	r.Outputs = outs
+
+	return r
+}
@@ -0,0 +1,275 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	pkgSch "github.com/absmach/magistrala/pkg/schedule"
+	"github.com/absmach/magistrala/re"
+	"github.com/absmach/magistrala/re/mocks"
+	"github.com/absmach/magistrala/re/outputs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func indexRule(id, domain, channel, topic string) re.Rule {
+	return re.Rule{
+		ID:           id,
+		DomainID:     domain,
+		InputChannel: channel,
+		InputTopic:   topic,
+		Status:       re.EnabledStatus,
+	}
+}
+
+func ruleIDs(rules []re.Rule) []string {
+	ids := []string{}
+	for _, r := range rules {
+		ids = append(ids, r.ID)
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func TestRuleIndexMatch(t *testing.T) {
+	repo := new(mocks.Repository)
+	rules := []re.Rule{
+		indexRule("exact", domainID, inputChannel, "a/b"),
+		indexRule("single", domainID, inputChannel, "a/+"),
+		indexRule("multi", domainID, inputChannel, "a/#"),
+		indexRule("all", domainID, inputChannel, "#"),
+		indexRule("empty", domainID, inputChannel, ""),
+		indexRule("nested", domainID, inputChannel, "+/b/+"),
+		indexRule("other-channel", domainID, "other.channel", "#"),
+		indexRule("other-domain", "other-domain", inputChannel, "#"),
+	}
+	repo.On("ListAllRules", mock.Anything, mock.Anything).Return(re.Page{Rules: rules}, nil).Once()
+	repo.On("ListPipelines", mock.Anything, mock.Anything).Return(re.PipelinePage{}, nil)
+	idx := re.NewRuleIndex(repo)
+
+	cases := []struct {
+		desc     string
+		domain   string
+		channel  string
+		subtopic string
+		expected []string
+	}{
+		{
+			desc:     "match exact and wildcard topics",
+			domain:   domainID,
+			channel:  inputChannel,
+			subtopic: "a/b",
+			expected: []string{"all", "exact", "multi", "single"},
+		},
+		{
+			desc:     "match parent level with multi-level wildcard",
+			domain:   domainID,
+			channel:  inputChannel,
+			subtopic: "a",
+			expected: []string{"all", "multi"},
+		},
+		{
+			desc:     "match deep topic",
+			domain:   domainID,
+			channel:  inputChannel,
+			subtopic: "a/b/c",
+			expected: []string{"all", "multi", "nested"},
+		},
+		{
+			desc:     "match empty topic",
+			domain:   domainID,
+			channel:  inputChannel,
+			subtopic: "",
+			expected: []string{"all", "empty"},
+		},
+		{
+			desc:     "match other channel",
+			domain:   domainID,
+			channel:  "other.channel",
+			subtopic: "a/b",
+			expected: []string{"other-channel"},
+		},
+		{
+			desc:     "match unknown channel",
+			domain:   domainID,
+			channel:  "unknown",
+			subtopic: "a/b",
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := idx.Match(context.Background(), tc.domain, tc.channel, tc.subtopic)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.expected, ruleIDs(res))
+		})
+	}
+	repo.AssertNumberOfCalls(t, "ListAllRules", 1)
+}
+
+func TestRuleIndexMatchCopiesOutputs(t *testing.T) {
+	repo := new(mocks.Repository)
+	rule := indexRule("rule", domainID, inputChannel, "")
+	rule.Outputs = re.Outputs{&outputs.Postgres{Password: "secret"}}
+	repo.On("ListAllRules", mock.Anything, mock.Anything).Return(re.Page{Rules: []re.Rule{rule}}, nil)
+	repo.On("ListPipelines", mock.Anything, mock.Anything).Return(re.PipelinePage{}, nil)
+	idx := re.NewRuleIndex(repo)
+
+	res, err := idx.Match(context.Background(), domainID, inputChannel, "")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	res[0].Outputs[0].(*outputs.Postgres).Password = "changed"
+
+	res, err = idx.Match(context.Background(), domainID, inputChannel, "")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, "secret", res[0].Outputs[0].(*outputs.Postgres).Password)
+}
+
+func TestRuleIndexLoadError(t *testing.T) {
+	repo := new(mocks.Repository)
+	repo.On("ListAllRules", mock.Anything, mock.Anything).Return(re.Page{}, repoerr.ErrViewEntity).Once()
+	repo.On("ListAllRules", mock.Anything, mock.Anything).Return(re.Page{Rules: []re.Rule{indexRule("rule", domainID, inputChannel, "")}}, nil).Once()
+	repo.On("ListPipelines", mock.Anything, mock.Anything).Return(re.PipelinePage{}, nil)
+	idx := re.NewRuleIndex(repo)
+
+	_, err := idx.Match(context.Background(), domainID, inputChannel, "")
+	assert.True(t, errors.Contains(err, repoerr.ErrViewEntity), fmt.Sprintf("expected %s got %s", repoerr.ErrViewEntity, err))
+
+	res, err := idx.Match(context.Background(), domainID, inputChannel, "")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, []string{"rule"}, ruleIDs(res))
+}
+
+func TestRuleIndexRefresh(t *testing.T) {
+	repo := new(mocks.Repository)
+	repo.On("ListAllRules", mock.Anything, mock.Anything).Return(re.Page{Rules: []re.Rule{indexRule("rule", domainID, inputChannel, "a")}}, nil).Once()
+	repo.On("ListPipelines", mock.Anything, mock.Anything).Return(re.PipelinePage{}, nil)
+	idx := re.NewRuleIndex(repo)
+	_, err := idx.Match(context.Background(), domainID, inputChannel, "a")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	disabled := indexRule("rule", domainID, inputChannel, "b")
+	disabled.Status = re.DisabledStatus
+	scheduled := indexRule("rule", domainID, inputChannel, "b")
+	scheduled.Schedule = pkgSch.Schedule{Time: time.Now()}
+
+	cases := []struct {
+		desc    string
+		rule    re.Rule
+		viewErr error
+		err     error
+		topics  map[string][]string
+	}{
+		{
+			desc:   "refresh rule with changed topic",
+			rule:   indexRule("rule", domainID, inputChannel, "b"),
+			topics: map[string][]string{"a": {}, "b": {"rule"}},
+		},
+		{
+			desc:   "refresh new rule",
+			rule:   indexRule("new", domainID, inputChannel, "+"),
+			topics: map[string][]string{"a": {"new"}, "b": {"new", "rule"}},
+		},
+		{
+			desc:   "refresh disabled rule",
+			rule:   disabled,
+			topics: map[string][]string{"a": {"new"}, "b": {"new"}},
+		},
+		{
+			desc:   "refresh enabled rule",
+			rule:   indexRule("rule", domainID, inputChannel, "b"),
+			topics: map[string][]string{"a": {"new"}, "b": {"new", "rule"}},
+		},
+		{
+			desc:   "refresh scheduled rule",
+			rule:   scheduled,
+			topics: map[string][]string{"a": {"new"}, "b": {"new"}},
+		},
+		{
+			desc:    "refresh removed rule",
+			rule:    indexRule("new", "", "", ""),
+			viewErr: repoerr.ErrNotFound,
+			topics:  map[string][]string{"a": {}, "b": {}},
+		},
+		{
+			desc:    "refresh rule with failed repository",
+			rule:    indexRule("rule", "", "", ""),
+			viewErr: repoerr.ErrViewEntity,
+			err:     repoerr.ErrViewEntity,
+			topics:  map[string][]string{"a": {}, "b": {}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			repoCall := repo.On("ViewRule", mock.Anything, tc.rule.ID).Return(tc.rule, tc.viewErr)
+			err := idx.Refresh(context.Background(), tc.rule.ID)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			for topic, expected := range tc.topics {
+				res, err := idx.Match(context.Background(), domainID, inputChannel, topic)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				assert.Equal(t, expected, ruleIDs(res), fmt.Sprintf("%s: unexpected rules for topic %s", tc.desc, topic))
+			}
+			repoCall.Unset()
+		})
+	}
+}
+
+// BenchmarkRuleIndexMatch matches the messages against 10k rules spread
+// over 100 domains with 10 channels each, with 10 rules per channel.
+func BenchmarkRuleIndexMatch(b *testing.B) {
+	const (
+		domains  = 100
+		channels = 10
+	)
+	topics := []string{"", "#", "sensors/+", "sensors/temperature", "sensors/+/celsius", "sensors/#", "+/humidity", "alarms", "alarms/+", "+"}
+
+	var rules []re.Rule
+	var domainIDs, channelIDs []string
+	for i := range domains {
+		domainIDs = append(domainIDs, fmt.Sprintf("domain-%d", i))
+	}
+	for i := range channels {
+		channelIDs = append(channelIDs, fmt.Sprintf("channel-%d", i))
+	}
+	for _, d := range domainIDs {
+		for _, c := range channelIDs {
+			for i, topic := range topics {
+				rules = append(rules, indexRule(fmt.Sprintf("%s-%s-rule-%d", d, c, i), d, c, topic))
+			}
+		}
+	}
+
+	repo := new(mocks.Repository)
+	repo.On("ListAllRules", mock.Anything, mock.Anything).Return(re.Page{Rules: rules}, nil)
+	repo.On("ListPipelines", mock.Anything, mock.Anything).Return(re.PipelinePage{}, nil)
+	idx := re.NewRuleIndex(repo)
+	if err := idx.Load(context.Background()); err != nil {
+		b.Fatal(err)
+	}
+	if idx.Len() != 10000 {
+		b.Fatalf("expected 10000 indexed rules got %d", idx.Len())
+	}
+	subtopics := []string{"sensors/temperature", "sensors/temperature/celsius", "alarms", "devices/humidity", ""}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if _, err := idx.Match(context.Background(), domainIDs[i%domains], channelIDs[i%channels], subtopics[i%len(subtopics)]); err != nil {
+				b.Fatal(err)
+			}
+			i++
+		}
+	})
+}
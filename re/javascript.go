@@ -0,0 +1,161 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/dop251/goja"
+)
+
+const (
+	jsLogicFunction = "logicFunction"
+	// jsScriptName is the script name used in the error stack traces.
+	jsScriptName = "<rule>"
+)
+
+func (re *re) processJS(ctx context.Context, details []slog.Attr, r Rule, msg *messaging.Message, win *WindowResult, exec *Execution) (any, pkglog.RunInfo) {
+	sctx, stop := re.sandbox.start(ctx)
+	res, err := re.programs.runJS(sctx, re.repo, r, msg, win)
+	if lerr := stop(); lerr != nil {
+		err = lerr
+	}
+	if err != nil {
+		return nil, pkglog.RunInfo{Level: slog.LevelError, Details: details, Message: fmt.Sprintf("failed to run rule logic: %s", err)}
+	}
+	if res == nil {
+		exec.Status = SkippedExecution
+		return res, pkglog.RunInfo{Level: slog.LevelWarn, Message: "rule with nil script result", Details: details}
+	}
+	if b, ok := res.(bool); ok && !b {
+		exec.Status = SkippedExecution
+		return res, pkglog.RunInfo{Level: slog.LevelInfo, Message: "logic returned false", Details: details}
+	}
+	if len(r.Outputs) == 0 {
+		exec.Status = SkippedExecution
+	}
+	for _, o := range r.Outputs {
+		if e := re.handleOutput(ctx, o, r, msg, res); e != nil {
+			err = errors.Wrap(e, err)
+			continue
+		}
+		exec.Outputs = append(exec.Outputs, outputType(o))
+	}
+	ret := pkglog.RunInfo{Level: slog.LevelInfo, Details: details, Message: "rule processed successfully"}
+	if err != nil {
+		ret.Level = slog.LevelError
+		ret.Message = fmt.Sprintf("failed to handle rule output: %s", err)
+	}
+	return res, ret
+}
+
+// runJS compiles and runs the JavaScript logic. It's used for the dry runs,
+// while the rules use the compiled programs from the cache.
+func (s *sandbox) runJS(ctx context.Context, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) (any, error) {
+	prog, err := compileJS(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return runJSProgram(ctx, prog, state, r, msg, win)
+}
+
+func compileJS(r Rule) (*goja.Program, error) {
+	return goja.Compile(jsScriptName, r.Logic.Value, false)
+}
+
+// runJSProgram runs the program in a new runtime and returns the result
+// of the logic function. The runtime only has the ECMAScript built-ins, the
+// message, the state and the window, and it's interrupted when the context
+// is canceled.
+func runJSProgram(ctx context.Context, prog *goja.Program, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) (any, error) {
+	vm := goja.New()
+	// The message and the window have the same fields as the JSON documents.
+	vm.SetFieldNameMapper(goja.TagFieldNameMapper("json", true))
+	stop := context.AfterFunc(ctx, func() {
+		vm.Interrupt(context.Cause(ctx))
+	})
+	defer stop()
+
+	m := newMessage(msg)
+	if err := vm.Set("message", &m); err != nil {
+		return nil, err
+	}
+	if err := vm.Set("state", jsState(vm, ruleState{ctx: ctx, repo: state, ruleID: r.ID})); err != nil {
+		return nil, err
+	}
+	if win != nil {
+		w := *win
+		if err := vm.Set("window", &w); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := vm.RunProgram(prog); err != nil {
+		return nil, err
+	}
+	fn, ok := goja.AssertFunction(vm.Get(jsLogicFunction))
+	if !ok {
+		return nil, errInvalidLogicFunction
+	}
+	res, err := fn(goja.Undefined())
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Export(), nil
+}
+
+// jsState exposes the rule state store to JavaScript as
+// state.get(key), state.set(key, value[, ttl]), state.increment(key[, delta[, ttl]])
+// and state.delete(key). TTL is in seconds.
+func jsState(vm *goja.Runtime, s ruleState) *goja.Object {
+	state := vm.NewObject()
+	ttl := func(v goja.Value) int {
+		if goja.IsUndefined(v) {
+			return 0
+		}
+		return int(v.ToInteger())
+	}
+	_ = state.Set("get", func(call goja.FunctionCall) goja.Value {
+		val, err := s.Get(call.Argument(0).String())
+		if err != nil {
+			panic(vm.NewGoError(fmt.Errorf("failed to get state: %w", err)))
+		}
+		if val == nil {
+			return goja.Null()
+		}
+		return vm.ToValue(val)
+	})
+	_ = state.Set("set", func(call goja.FunctionCall) goja.Value {
+		if err := s.Set(call.Argument(0).String(), call.Argument(1).Export(), ttl(call.Argument(2))); err != nil {
+			panic(vm.NewGoError(fmt.Errorf("failed to set state: %w", err)))
+		}
+		return goja.Undefined()
+	})
+	_ = state.Set("increment", func(call goja.FunctionCall) goja.Value {
+		delta := 1.0
+		if d := call.Argument(1); !goja.IsUndefined(d) {
+			delta = d.ToFloat()
+		}
+		val, err := s.Increment(call.Argument(0).String(), delta, ttl(call.Argument(2)))
+		if err != nil {
+			panic(vm.NewGoError(fmt.Errorf("failed to increment state: %w", err)))
+		}
+		return vm.ToValue(val)
+	})
+	_ = state.Set("delete", func(call goja.FunctionCall) goja.Value {
+		if err := s.Delete(call.Argument(0).String()); err != nil {
+			panic(vm.NewGoError(fmt.Errorf("failed to delete state: %w", err)))
+		}
+		return goja.Undefined()
+	})
+
+	return state
+}
@@ -12,81 +12,59 @@ import (
 	"github.com/absmach/magistrala/pkg/errors"
 	pkglog "github.com/absmach/magistrala/pkg/logger"
 	"github.com/absmach/magistrala/pkg/messaging"
-	"github.com/vadv/gopher-lua-libs/argparse"
-	"github.com/vadv/gopher-lua-libs/base64"
-	bit "github.com/vadv/gopher-lua-libs/bit"
-	"github.com/vadv/gopher-lua-libs/crypto"
-	"github.com/vadv/gopher-lua-libs/db"
-	"github.com/vadv/gopher-lua-libs/filepath"
-	client "github.com/vadv/gopher-lua-libs/http/client"
-	"github.com/vadv/gopher-lua-libs/ioutil"
-	luajson "github.com/vadv/gopher-lua-libs/json"
-	"github.com/vadv/gopher-lua-libs/regexp"
-	"github.com/vadv/gopher-lua-libs/storage"
-	"github.com/vadv/gopher-lua-libs/strings"
-	luatime "github.com/vadv/gopher-lua-libs/time"
-	"github.com/vadv/gopher-lua-libs/yaml"
 	lua "github.com/yuin/gopher-lua"
 )
 
 const payloadKey = "payload"
 
-func (re *re) processLua(ctx context.Context, details []slog.Attr, r Rule, msg *messaging.Message) pkglog.RunInfo {
-	l := lua.NewState()
+func (re *re) processLua(ctx context.Context, details []slog.Attr, r Rule, msg *messaging.Message, win *WindowResult, exec *Execution) (any, pkglog.RunInfo) {
+	sctx, stop := re.sandbox.start(ctx)
+	l := re.sandbox.newLuaState(sctx, re.repo, r, msg, win)
 	defer l.Close()
-	preload(l)
-	message := prepareMsg(l, msg)
-
-	// Set the message object as a Lua global variable.
-	l.SetGlobal("message", message)
-	if err := l.DoString(r.Logic.Value); err != nil {
-		return pkglog.RunInfo{Level: slog.LevelError, Message: fmt.Sprintf("failed to run rule logic: %s", err), Details: details}
+	result, err := re.programs.runLua(l, r)
+	if lerr := stop(); lerr != nil {
+		err = lerr
+	}
+	if err != nil {
+		return nil, pkglog.RunInfo{Level: slog.LevelError, Message: fmt.Sprintf("failed to run rule logic: %s", err), Details: details}
 	}
-	// Get the last result.
-	result := l.Get(-1)
 	if result == lua.LNil {
-		return pkglog.RunInfo{Level: slog.LevelWarn, Message: "rule with nil script result", Details: details}
+		exec.Status = SkippedExecution
+		return nil, pkglog.RunInfo{Level: slog.LevelWarn, Message: "rule with nil script result", Details: details}
 	}
-	// Converting Lua is an expensive operation, so
-	// don't do it if there are no outputs.
+	res := convertLua(result)
 	if len(r.Outputs) == 0 {
-		return pkglog.RunInfo{Level: slog.LevelWarn, Message: "rule with no outputs", Details: details}
+		exec.Status = SkippedExecution
+		return res, pkglog.RunInfo{Level: slog.LevelWarn, Message: "rule with no outputs", Details: details}
 	}
-	var err error
-	res := convertLua(result)
 
 	for _, o := range r.Outputs {
 		// If value is false, don't run the follow-up.
 		if v, ok := res.(bool); ok && !v {
-			return pkglog.RunInfo{Level: slog.LevelInfo, Message: "logic returned false", Details: details}
+			exec.Status = SkippedExecution
+			return res, pkglog.RunInfo{Level: slog.LevelInfo, Message: "logic returned false", Details: details}
 		}
 		if e := re.handleOutput(ctx, o, r, msg, res); e != nil {
 			err = errors.Wrap(e, err)
+			continue
 		}
+		exec.Outputs = append(exec.Outputs, outputType(o))
 	}
 	ret := pkglog.RunInfo{Level: slog.LevelInfo, Message: "rule processed successfully", Details: details}
 	if err != nil {
 		ret.Level = slog.LevelError
 		ret.Message = fmt.Sprintf("failed to handle rule output: %s", err)
 	}
-	return ret
+	return res, ret
 }
 
-func preload(l *lua.LState) {
-	db.Preload(l)
-	ioutil.Preload(l)
-	luajson.Preload(l)
-	yaml.Preload(l)
-	crypto.Preload(l)
-	regexp.Preload(l)
-	luatime.Preload(l)
-	storage.Preload(l)
-	base64.Preload(l)
-	argparse.Preload(l)
-	strings.Preload(l)
-	filepath.Preload(l)
-	client.Preload(l)
-	bit.Preload(l)
+// runLua runs the script and returns the last result.
+func runLua(l *lua.LState, script string) (lua.LValue, error) {
+	if err := l.DoString(script); err != nil {
+		return lua.LNil, err
+	}
+
+	return l.Get(-1), nil
 }
 
 func prepareMsg(l *lua.LState, msg *messaging.Message) lua.LValue {
@@ -116,6 +94,55 @@ func prepareMsg(l *lua.LState, msg *messaging.Message) lua.LValue {
 	return message
 }
 
+// prepareState exposes the rule state store to Lua as
+// state.get(key), state.set(key, value[, ttl]), state.increment(key[, delta[, ttl]])
+// and state.delete(key). TTL is in seconds.
+func prepareState(l *lua.LState, s ruleState) lua.LValue {
+	state := l.NewTable()
+	state.RawSetString("get", l.NewFunction(func(l *lua.LState) int {
+		val, err := s.Get(l.CheckString(1))
+		if err != nil {
+			l.RaiseError("failed to get state: %s", err)
+			return 0
+		}
+		l.Push(traverseJson(l, val))
+		return 1
+	}))
+	state.RawSetString("set", l.NewFunction(func(l *lua.LState) int {
+		if err := s.Set(l.CheckString(1), convertLua(l.CheckAny(2)), l.OptInt(3, 0)); err != nil {
+			l.RaiseError("failed to set state: %s", err)
+		}
+		return 0
+	}))
+	state.RawSetString("increment", l.NewFunction(func(l *lua.LState) int {
+		val, err := s.Increment(l.CheckString(1), float64(l.OptNumber(2, 1)), l.OptInt(3, 0))
+		if err != nil {
+			l.RaiseError("failed to increment state: %s", err)
+			return 0
+		}
+		l.Push(lua.LNumber(val))
+		return 1
+	}))
+	state.RawSetString("delete", l.NewFunction(func(l *lua.LState) int {
+		if err := s.Delete(l.CheckString(1)); err != nil {
+			l.RaiseError("failed to delete state: %s", err)
+		}
+		return 0
+	}))
+
+	return state
+}
+
+func prepareWindow(l *lua.LState, w WindowResult) lua.LValue {
+	window := l.NewTable()
+	window.RawSetString("value", lua.LNumber(w.Value))
+	window.RawSetString("count", lua.LNumber(w.Count))
+	window.RawSetString("start", lua.LNumber(w.Start))
+	window.RawSetString("end", lua.LNumber(w.End))
+
+	return window
+}
+
 func traverseJson(l *lua.LState, value any) lua.LValue {
 	switch val := value.(type) {
 	case string:
@@ -139,6 +139,128 @@ func (am *authorizationMiddleware) Cancel() error {
 	return am.svc.Cancel()
 }
 
+func (am *authorizationMiddleware) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error) {
+	if err := am.authorize(ctx, operations.OpAddRule, session, policies.DomainType, session.DomainID); err != nil {
+		return re.DryRunResult{}, errors.Wrap(errDomainCreateRules, err)
+	}
+
+	return am.svc.DryRunRule(ctx, session, r, msg)
+}
+
+func (am *authorizationMiddleware) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	if err := am.authorize(ctx, operations.OpViewRule, session, operations.EntityType, pm.RuleID); err != nil {
+		return re.ExecutionPage{}, errors.Wrap(errDomainViewRules, err)
+	}
+
+	return am.svc.ListExecutions(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	if err := am.authorize(ctx, operations.OpViewRule, session, operations.EntityType, pm.RuleID); err != nil {
+		return re.RevisionPage{}, errors.Wrap(errDomainViewRules, err)
+	}
+
+	return am.svc.ListRevisions(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (re.RevisionDiff, error) {
+	if err := am.authorize(ctx, operations.OpViewRule, session, operations.EntityType, id); err != nil {
+		return re.RevisionDiff{}, errors.Wrap(errDomainViewRules, err)
+	}
+
+	return am.svc.DiffRevisions(ctx, session, id, from, to)
+}
+
+func (am *authorizationMiddleware) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error) {
+	if err := am.authorize(ctx, operations.OpUpdateRule, session, operations.EntityType, id); err != nil {
+		return re.Rule{}, errors.Wrap(errDomainUpdateRules, err)
+	}
+
+	return am.svc.RollbackRule(ctx, session, id, revision)
+}
+
+func (am *authorizationMiddleware) AddPipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	if err := am.authorize(ctx, operations.OpAddPipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.Pipeline{}, errors.Wrap(errDomainCreateRules, err)
+	}
+	if err := am.authorizeSteps(ctx, session, p); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return am.svc.AddPipeline(ctx, session, p)
+}
+
+func (am *authorizationMiddleware) ViewPipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	if err := am.authorize(ctx, operations.OpViewPipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.Pipeline{}, errors.Wrap(errDomainViewRules, err)
+	}
+
+	return am.svc.ViewPipeline(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) UpdatePipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	if err := am.authorize(ctx, operations.OpUpdatePipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.Pipeline{}, errors.Wrap(errDomainUpdateRules, err)
+	}
+	if err := am.authorizeSteps(ctx, session, p); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return am.svc.UpdatePipeline(ctx, session, p)
+}
+
+func (am *authorizationMiddleware) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	if err := am.authorize(ctx, operations.OpViewPipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.PipelinePage{}, errors.Wrap(errDomainViewRules, err)
+	}
+
+	return am.svc.ListPipelines(ctx, session, pm)
+}
+
+func (am *authorizationMiddleware) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	if err := am.authorize(ctx, operations.OpRemovePipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return errors.Wrap(errDomainDeleteRules, err)
+	}
+
+	return am.svc.RemovePipeline(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) EnablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	if err := am.authorize(ctx, operations.OpUpdatePipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.Pipeline{}, errors.Wrap(errDomainUpdateRules, err)
+	}
+
+	return am.svc.EnablePipeline(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) DisablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	if err := am.authorize(ctx, operations.OpUpdatePipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.Pipeline{}, errors.Wrap(errDomainUpdateRules, err)
+	}
+
+	return am.svc.DisablePipeline(ctx, session, id)
+}
+
+func (am *authorizationMiddleware) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	if err := am.authorize(ctx, operations.OpViewPipeline, session, policies.DomainType, session.DomainID); err != nil {
+		return re.ExecutionPage{}, errors.Wrap(errDomainViewRules, err)
+	}
+
+	return am.svc.ListPipelineExecutions(ctx, session, pm)
+}
+
+// authorizeSteps checks that the user can view the step rules, so pipelines
+// can't run the rules the user has no access to.
+func (am *authorizationMiddleware) authorizeSteps(ctx context.Context, session authn.Session, p re.Pipeline) error {
+	for _, id := range p.RuleIDs() {
+		if err := am.authorize(ctx, operations.OpViewRule, session, operations.EntityType, id); err != nil {
+			return errors.Wrap(errDomainViewRules, err)
+		}
+	}
+
+	return nil
+}
+
 func (am *authorizationMiddleware) authorize(ctx context.Context, op permissions.Operation, session authn.Session, objType, obj string) error {
 	perm, err := am.entitiesOps.GetPermission(operations.EntityType, op)
 	if err != nil {
@@ -157,6 +157,161 @@ func (cm *calloutMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return cm.svc.DisableRule(ctx, session, id)
 }
 
+func (cm *calloutMiddleware) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error) {
+	return cm.svc.DryRunRule(ctx, session, r, msg)
+}
+
+func (cm *calloutMiddleware) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	params := map[string]any{
+		"entity_id": pm.RuleID,
+		"pagemeta":  pm,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpViewRule, params); err != nil {
+		return re.ExecutionPage{}, err
+	}
+
+	return cm.svc.ListExecutions(ctx, session, pm)
+}
+
+func (cm *calloutMiddleware) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	params := map[string]any{
+		"entity_id": pm.RuleID,
+		"pagemeta":  pm,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpViewRule, params); err != nil {
+		return re.RevisionPage{}, err
+	}
+
+	return cm.svc.ListRevisions(ctx, session, pm)
+}
+
+func (cm *calloutMiddleware) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (re.RevisionDiff, error) {
+	params := map[string]any{
+		"entity_id": id,
+		"from":      from,
+		"to":        to,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpViewRule, params); err != nil {
+		return re.RevisionDiff{}, err
+	}
+
+	return cm.svc.DiffRevisions(ctx, session, id, from, to)
+}
+
+func (cm *calloutMiddleware) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error) {
+	params := map[string]any{
+		"entity_id": id,
+		"revision":  revision,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpUpdateRule, params); err != nil {
+		return re.Rule{}, err
+	}
+
+	return cm.svc.RollbackRule(ctx, session, id, revision)
+}
+
+func (cm *calloutMiddleware) AddPipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	params := map[string]any{
+		"entities": p,
+		"count":    1,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpAddPipeline, params); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return cm.svc.AddPipeline(ctx, session, p)
+}
+
+func (cm *calloutMiddleware) ViewPipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpViewPipeline, params); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return cm.svc.ViewPipeline(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) UpdatePipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	params := map[string]any{
+		"entity_id": p.ID,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpUpdatePipeline, params); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return cm.svc.UpdatePipeline(ctx, session, p)
+}
+
+func (cm *calloutMiddleware) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	params := map[string]any{
+		"pagemeta": pm,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpViewPipeline, params); err != nil {
+		return re.PipelinePage{}, err
+	}
+
+	return cm.svc.ListPipelines(ctx, session, pm)
+}
+
+func (cm *calloutMiddleware) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpRemovePipeline, params); err != nil {
+		return err
+	}
+
+	return cm.svc.RemovePipeline(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) EnablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpUpdatePipeline, params); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return cm.svc.EnablePipeline(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) DisablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	params := map[string]any{
+		"entity_id": id,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpUpdatePipeline, params); err != nil {
+		return re.Pipeline{}, err
+	}
+
+	return cm.svc.DisablePipeline(ctx, session, id)
+}
+
+func (cm *calloutMiddleware) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	params := map[string]any{
+		"entity_id": pm.PipelineID,
+		"pagemeta":  pm,
+	}
+
+	if err := cm.callOut(ctx, session, operations.OpViewPipeline, params); err != nil {
+		return re.ExecutionPage{}, err
+	}
+
+	return cm.svc.ListPipelineExecutions(ctx, session, pm)
+}
+
 func (cm *calloutMiddleware) StartScheduler(ctx context.Context) error {
 	return cm.svc.StartScheduler(ctx)
 }
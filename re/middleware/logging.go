@@ -208,6 +208,268 @@ func (lm *loggingMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return lm.svc.DisableRule(ctx, session, id)
 }
 
+func (lm *loggingMiddleware) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (res re.DryRunResult, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("rule",
+				slog.String("name", r.Name),
+				slog.String("input_channel", r.InputChannel),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Dry run rule failed", args...)
+			return
+		}
+		if res.Error != "" {
+			args = append(args, slog.String("script_error", res.Error))
+		}
+		lm.logger.Info("Dry run rule completed successfully", args...)
+	}(time.Now())
+	return lm.svc.DryRunRule(ctx, session, r, msg)
+}
+
+func (lm *loggingMiddleware) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (page re.ExecutionPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", pm.RuleID),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List rule executions failed", args...)
+			return
+		}
+		lm.logger.Info("List rule executions completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListExecutions(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (page re.RevisionPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", pm.RuleID),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List rule revisions failed", args...)
+			return
+		}
+		lm.logger.Info("List rule revisions completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListRevisions(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (diff re.RevisionDiff, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("rule_id", id),
+			slog.Uint64("from", from),
+			slog.Uint64("to", to),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Diff rule revisions failed", args...)
+			return
+		}
+		lm.logger.Info("Diff rule revisions completed successfully", args...)
+	}(time.Now())
+	return lm.svc.DiffRevisions(ctx, session, id, from, to)
+}
+
+func (lm *loggingMiddleware) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (r re.Rule, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("rule",
+				slog.String("id", id),
+				slog.Uint64("restored_from", revision),
+				slog.Uint64("revision", r.Revision),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Rollback rule failed", args...)
+			return
+		}
+		lm.logger.Info("Rollback rule completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RollbackRule(ctx, session, id, revision)
+}
+
+func (lm *loggingMiddleware) AddPipeline(ctx context.Context, session authn.Session, pipeline re.Pipeline) (p re.Pipeline, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("pipeline",
+				slog.String("id", p.ID),
+				slog.String("name", p.Name),
+				slog.String("input_channel", p.InputChannel),
+				slog.Int("steps", len(p.Steps)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Add pipeline failed", args...)
+			return
+		}
+		lm.logger.Info("Add pipeline completed successfully", args...)
+	}(time.Now())
+	return lm.svc.AddPipeline(ctx, session, pipeline)
+}
+
+func (lm *loggingMiddleware) ViewPipeline(ctx context.Context, session authn.Session, id string) (p re.Pipeline, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("pipeline_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("View pipeline failed", args...)
+			return
+		}
+		lm.logger.Info("View pipeline completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ViewPipeline(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) UpdatePipeline(ctx context.Context, session authn.Session, pipeline re.Pipeline) (p re.Pipeline, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("pipeline",
+				slog.String("id", p.ID),
+				slog.String("name", p.Name),
+				slog.String("input_channel", p.InputChannel),
+				slog.Int("steps", len(p.Steps)),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Update pipeline failed", args...)
+			return
+		}
+		lm.logger.Info("Update pipeline completed successfully", args...)
+	}(time.Now())
+	return lm.svc.UpdatePipeline(ctx, session, pipeline)
+}
+
+func (lm *loggingMiddleware) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (page re.PipelinePage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List pipelines failed", args...)
+			return
+		}
+		lm.logger.Info("List pipelines completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListPipelines(ctx, session, pm)
+}
+
+func (lm *loggingMiddleware) RemovePipeline(ctx context.Context, session authn.Session, id string) (err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("pipeline_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Remove pipeline failed", args...)
+			return
+		}
+		lm.logger.Info("Remove pipeline completed successfully", args...)
+	}(time.Now())
+	return lm.svc.RemovePipeline(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) EnablePipeline(ctx context.Context, session authn.Session, id string) (p re.Pipeline, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("pipeline_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Enable pipeline failed", args...)
+			return
+		}
+		lm.logger.Info("Enable pipeline completed successfully", args...)
+	}(time.Now())
+	return lm.svc.EnablePipeline(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) DisablePipeline(ctx context.Context, session authn.Session, id string) (p re.Pipeline, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("pipeline_id", id),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("Disable pipeline failed", args...)
+			return
+		}
+		lm.logger.Info("Disable pipeline completed successfully", args...)
+	}(time.Now())
+	return lm.svc.DisablePipeline(ctx, session, id)
+}
+
+func (lm *loggingMiddleware) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (page re.ExecutionPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("domain_id", session.DomainID),
+			slog.String("pipeline_id", pm.PipelineID),
+			slog.Group("page",
+				slog.Uint64("offset", pm.Offset),
+				slog.Uint64("limit", pm.Limit),
+				slog.Uint64("total", page.Total),
+			),
+		}
+		if err != nil {
+			args = append(args, slog.String("error", err.Error()))
+			lm.logger.Warn("List pipeline executions failed", args...)
+			return
+		}
+		lm.logger.Info("List pipeline executions completed successfully", args...)
+	}(time.Now())
+	return lm.svc.ListPipelineExecutions(ctx, session, pm)
+}
+
 func (lm *loggingMiddleware) StartScheduler(ctx context.Context) (err error) {
 	defer func(begin time.Time) {
 		args := []any{
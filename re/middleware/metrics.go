@@ -114,6 +114,123 @@ func (mm *metricsMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return mm.service.DisableRule(ctx, session, id)
 }
 
+func (mm *metricsMiddleware) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "dry_run_rule").Add(1)
+		mm.latency.With("method", "dry_run_rule").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.DryRunRule(ctx, session, r, msg)
+}
+
+func (mm *metricsMiddleware) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_executions").Add(1)
+		mm.latency.With("method", "list_executions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListExecutions(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_revisions").Add(1)
+		mm.latency.With("method", "list_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListRevisions(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (re.RevisionDiff, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "diff_revisions").Add(1)
+		mm.latency.With("method", "diff_revisions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.DiffRevisions(ctx, session, id, from, to)
+}
+
+func (mm *metricsMiddleware) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "rollback_rule").Add(1)
+		mm.latency.With("method", "rollback_rule").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RollbackRule(ctx, session, id, revision)
+}
+
+func (mm *metricsMiddleware) AddPipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "add_pipeline").Add(1)
+		mm.latency.With("method", "add_pipeline").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.AddPipeline(ctx, session, p)
+}
+
+func (mm *metricsMiddleware) ViewPipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "view_pipeline").Add(1)
+		mm.latency.With("method", "view_pipeline").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ViewPipeline(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) UpdatePipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "update_pipeline").Add(1)
+		mm.latency.With("method", "update_pipeline").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.UpdatePipeline(ctx, session, p)
+}
+
+func (mm *metricsMiddleware) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_pipelines").Add(1)
+		mm.latency.With("method", "list_pipelines").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListPipelines(ctx, session, pm)
+}
+
+func (mm *metricsMiddleware) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "remove_pipeline").Add(1)
+		mm.latency.With("method", "remove_pipeline").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.RemovePipeline(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) EnablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "enable_pipeline").Add(1)
+		mm.latency.With("method", "enable_pipeline").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.EnablePipeline(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) DisablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "disable_pipeline").Add(1)
+		mm.latency.With("method", "disable_pipeline").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.DisablePipeline(ctx, session, id)
+}
+
+func (mm *metricsMiddleware) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "list_pipeline_executions").Add(1)
+		mm.latency.With("method", "list_pipeline_executions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.service.ListPipelineExecutions(ctx, session, pm)
+}
+
 func (mm *metricsMiddleware) Handle(msg *messaging.Message) error {
 	defer func(begin time.Time) {
 		mm.counter.With("method", "handle").Add(1)
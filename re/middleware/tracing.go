@@ -115,6 +115,139 @@ func (tm *tracingMiddleware) DisableRule(ctx context.Context, session authn.Sess
 	return tm.svc.DisableRule(ctx, session, id)
 }
 
+func (tm *tracingMiddleware) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "dry_run_rule", trace.WithAttributes(
+		attribute.String("name", r.Name),
+		attribute.String("channel", msg.Channel),
+		attribute.String("subtopic", msg.Subtopic),
+	))
+	defer span.End()
+
+	return tm.svc.DryRunRule(ctx, session, r, msg)
+}
+
+func (tm *tracingMiddleware) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_executions", trace.WithAttributes(
+		attribute.String("rule_id", pm.RuleID),
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListExecutions(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_revisions", trace.WithAttributes(
+		attribute.String("rule_id", pm.RuleID),
+		attribute.Int("offset", int(pm.Offset)),
+		attribute.Int("limit", int(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListRevisions(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (re.RevisionDiff, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "diff_revisions", trace.WithAttributes(
+		attribute.String("rule_id", id),
+		attribute.Int64("from", int64(from)),
+		attribute.Int64("to", int64(to)),
+	))
+	defer span.End()
+
+	return tm.svc.DiffRevisions(ctx, session, id, from, to)
+}
+
+func (tm *tracingMiddleware) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "rollback_rule", trace.WithAttributes(
+		attribute.String("id", id),
+		attribute.Int64("revision", int64(revision)),
+	))
+	defer span.End()
+
+	return tm.svc.RollbackRule(ctx, session, id, revision)
+}
+
+func (tm *tracingMiddleware) AddPipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "add_pipeline", trace.WithAttributes(
+		attribute.String("name", p.Name),
+		attribute.String("input_channel", p.InputChannel),
+	))
+	defer span.End()
+
+	return tm.svc.AddPipeline(ctx, session, p)
+}
+
+func (tm *tracingMiddleware) ViewPipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "view_pipeline", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.ViewPipeline(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) UpdatePipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "update_pipeline", trace.WithAttributes(
+		attribute.String("id", p.ID),
+		attribute.String("name", p.Name),
+		attribute.String("input_channel", p.InputChannel),
+	))
+	defer span.End()
+
+	return tm.svc.UpdatePipeline(ctx, session, p)
+}
+
+func (tm *tracingMiddleware) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_pipelines", trace.WithAttributes(
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListPipelines(ctx, session, pm)
+}
+
+func (tm *tracingMiddleware) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "remove_pipeline", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.RemovePipeline(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) EnablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "enable_pipeline", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.EnablePipeline(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) DisablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "disable_pipeline", trace.WithAttributes(
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	return tm.svc.DisablePipeline(ctx, session, id)
+}
+
+func (tm *tracingMiddleware) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	ctx, span := smqTracing.StartSpan(ctx, tm.tracer, "list_pipeline_executions", trace.WithAttributes(
+		attribute.String("pipeline_id", pm.PipelineID),
+		attribute.String("correlation_id", pm.CorrelationID),
+		attribute.Int64("offset", int64(pm.Offset)),
+		attribute.Int64("limit", int64(pm.Limit)),
+	))
+	defer span.End()
+
+	return tm.svc.ListPipelineExecutions(ctx, session, pm)
+}
+
 func (tm *tracingMiddleware) Handle(msg *messaging.Message) error {
 	_, span := smqTracing.StartSpan(context.Background(), tm.tracer, "handle", trace.WithAttributes(
 		attribute.String("channel", msg.Channel),
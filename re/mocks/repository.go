@@ -44,6 +44,129 @@ func (_m *Repository) EXPECT() *Repository_Expecter {
 	return &Repository_Expecter{mock: &_m.Mock}
 }
 
+// AddExecution provides a mock function for the type Repository
+func (_mock *Repository) AddExecution(ctx context.Context, e re.Execution) error {
+	ret := _mock.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddExecution")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Execution) error); ok {
+		r0 = returnFunc(ctx, e)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_AddExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddExecution'
+type Repository_AddExecution_Call struct {
+	*mock.Call
+}
+
+// AddExecution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - e re.Execution
+func (_e *Repository_Expecter) AddExecution(ctx interface{}, e interface{}) *Repository_AddExecution_Call {
+	return &Repository_AddExecution_Call{Call: _e.mock.On("AddExecution", ctx, e)}
+}
+
+func (_c *Repository_AddExecution_Call) Run(run func(ctx context.Context, e re.Execution)) *Repository_AddExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 re.Execution
+		if args[1] != nil {
+			arg1 = args[1].(re.Execution)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddExecution_Call) Return(err error) *Repository_AddExecution_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_AddExecution_Call) RunAndReturn(run func(ctx context.Context, e re.Execution) error) *Repository_AddExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPipeline provides a mock function for the type Repository
+func (_mock *Repository) AddPipeline(ctx context.Context, p re.Pipeline) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Pipeline) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Pipeline) re.Pipeline); ok {
+		r0 = returnFunc(ctx, p)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.Pipeline) error); ok {
+		r1 = returnFunc(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AddPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPipeline'
+type Repository_AddPipeline_Call struct {
+	*mock.Call
+}
+
+// AddPipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p re.Pipeline
+func (_e *Repository_Expecter) AddPipeline(ctx interface{}, p interface{}) *Repository_AddPipeline_Call {
+	return &Repository_AddPipeline_Call{Call: _e.mock.On("AddPipeline", ctx, p)}
+}
+
+func (_c *Repository_AddPipeline_Call) Run(run func(ctx context.Context, p re.Pipeline)) *Repository_AddPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 re.Pipeline
+		if args[1] != nil {
+			arg1 = args[1].(re.Pipeline)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AddPipeline_Call) Return(pipeline re.Pipeline, err error) *Repository_AddPipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Repository_AddPipeline_Call) RunAndReturn(run func(ctx context.Context, p re.Pipeline) (re.Pipeline, error)) *Repository_AddPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddRoles provides a mock function for the type Repository
 func (_mock *Repository) AddRoles(ctx context.Context, rps []roles.RoleProvision) ([]roles.RoleProvision, error) {
 	ret := _mock.Called(ctx, rps)
@@ -178,6 +301,164 @@ func (_c *Repository_AddRule_Call) RunAndReturn(run func(ctx context.Context, r
 	return _c
 }
 
+// AppendWindowSample provides a mock function for the type Repository
+func (_mock *Repository) AppendWindowSample(ctx context.Context, s re.WindowSample, start time.Time) ([]re.WindowSample, error) {
+	ret := _mock.Called(ctx, s, start)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendWindowSample")
+	}
+
+	var r0 []re.WindowSample
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.WindowSample, time.Time) ([]re.WindowSample, error)); ok {
+		return returnFunc(ctx, s, start)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.WindowSample, time.Time) []re.WindowSample); ok {
+		r0 = returnFunc(ctx, s, start)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]re.WindowSample)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.WindowSample, time.Time) error); ok {
+		r1 = returnFunc(ctx, s, start)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_AppendWindowSample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendWindowSample'
+type Repository_AppendWindowSample_Call struct {
+	*mock.Call
+}
+
+// AppendWindowSample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s re.WindowSample
+//   - start time.Time
+func (_e *Repository_Expecter) AppendWindowSample(ctx interface{}, s interface{}, start interface{}) *Repository_AppendWindowSample_Call {
+	return &Repository_AppendWindowSample_Call{Call: _e.mock.On("AppendWindowSample", ctx, s, start)}
+}
+
+func (_c *Repository_AppendWindowSample_Call) Run(run func(ctx context.Context, s re.WindowSample, start time.Time)) *Repository_AppendWindowSample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 re.WindowSample
+		if args[1] != nil {
+			arg1 = args[1].(re.WindowSample)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_AppendWindowSample_Call) Return(windowSamples []re.WindowSample, err error) *Repository_AppendWindowSample_Call {
+	_c.Call.Return(windowSamples, err)
+	return _c
+}
+
+func (_c *Repository_AppendWindowSample_Call) RunAndReturn(run func(ctx context.Context, s re.WindowSample, start time.Time) ([]re.WindowSample, error)) *Repository_AppendWindowSample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementState provides a mock function for the type Repository
+func (_mock *Repository) IncrementState(ctx context.Context, ruleID string, key string, delta float64, expiresAt time.Time) (float64, error) {
+	ret := _mock.Called(ctx, ruleID, key, delta, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementState")
+	}
+
+	var r0 float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, float64, time.Time) (float64, error)); ok {
+		return returnFunc(ctx, ruleID, key, delta, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, float64, time.Time) float64); ok {
+		r0 = returnFunc(ctx, ruleID, key, delta, expiresAt)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, float64, time.Time) error); ok {
+		r1 = returnFunc(ctx, ruleID, key, delta, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_IncrementState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementState'
+type Repository_IncrementState_Call struct {
+	*mock.Call
+}
+
+// IncrementState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID string
+//   - key string
+//   - delta float64
+//   - expiresAt time.Time
+func (_e *Repository_Expecter) IncrementState(ctx interface{}, ruleID interface{}, key interface{}, delta interface{}, expiresAt interface{}) *Repository_IncrementState_Call {
+	return &Repository_IncrementState_Call{Call: _e.mock.On("IncrementState", ctx, ruleID, key, delta, expiresAt)}
+}
+
+func (_c *Repository_IncrementState_Call) Run(run func(ctx context.Context, ruleID string, key string, delta float64, expiresAt time.Time)) *Repository_IncrementState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 float64
+		if args[3] != nil {
+			arg3 = args[3].(float64)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_IncrementState_Call) Return(f float64, err error) *Repository_IncrementState_Call {
+	_c.Call.Return(f, err)
+	return _c
+}
+
+func (_c *Repository_IncrementState_Call) RunAndReturn(run func(ctx context.Context, ruleID string, key string, delta float64, expiresAt time.Time) (float64, error)) *Repository_IncrementState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAllRules provides a mock function for the type Repository
 func (_mock *Repository) ListAllRules(ctx context.Context, pm re.PageMeta) (re.Page, error) {
 	ret := _mock.Called(ctx, pm)
@@ -244,74 +525,272 @@ func (_c *Repository_ListAllRules_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
-// ListEntityMembers provides a mock function for the type Repository
-func (_mock *Repository) ListEntityMembers(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery) (roles.MembersRolePage, error) {
-	ret := _mock.Called(ctx, entityID, pageQuery)
+// ListEntityMembers provides a mock function for the type Repository
+func (_mock *Repository) ListEntityMembers(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery) (roles.MembersRolePage, error) {
+	ret := _mock.Called(ctx, entityID, pageQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.MembersRolePage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, roles.MembersRolePageQuery) (roles.MembersRolePage, error)); ok {
+		return returnFunc(ctx, entityID, pageQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, roles.MembersRolePageQuery) roles.MembersRolePage); ok {
+		r0 = returnFunc(ctx, entityID, pageQuery)
+	} else {
+		r0 = ret.Get(0).(roles.MembersRolePage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, roles.MembersRolePageQuery) error); ok {
+		r1 = returnFunc(ctx, entityID, pageQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListEntityMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntityMembers'
+type Repository_ListEntityMembers_Call struct {
+	*mock.Call
+}
+
+// ListEntityMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityID string
+//   - pageQuery roles.MembersRolePageQuery
+func (_e *Repository_Expecter) ListEntityMembers(ctx interface{}, entityID interface{}, pageQuery interface{}) *Repository_ListEntityMembers_Call {
+	return &Repository_ListEntityMembers_Call{Call: _e.mock.On("ListEntityMembers", ctx, entityID, pageQuery)}
+}
+
+func (_c *Repository_ListEntityMembers_Call) Run(run func(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery)) *Repository_ListEntityMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 roles.MembersRolePageQuery
+		if args[2] != nil {
+			arg2 = args[2].(roles.MembersRolePageQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListEntityMembers_Call) Return(membersRolePage roles.MembersRolePage, err error) *Repository_ListEntityMembers_Call {
+	_c.Call.Return(membersRolePage, err)
+	return _c
+}
+
+func (_c *Repository_ListEntityMembers_Call) RunAndReturn(run func(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery) (roles.MembersRolePage, error)) *Repository_ListEntityMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExecutions provides a mock function for the type Repository
+func (_mock *Repository) ListExecutions(ctx context.Context, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExecutions")
+	}
+
+	var r0 re.ExecutionPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.ExecutionPageMeta) (re.ExecutionPage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.ExecutionPageMeta) re.ExecutionPage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(re.ExecutionPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.ExecutionPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExecutions'
+type Repository_ListExecutions_Call struct {
+	*mock.Call
+}
+
+// ListExecutions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm re.ExecutionPageMeta
+func (_e *Repository_Expecter) ListExecutions(ctx interface{}, pm interface{}) *Repository_ListExecutions_Call {
+	return &Repository_ListExecutions_Call{Call: _e.mock.On("ListExecutions", ctx, pm)}
+}
+
+func (_c *Repository_ListExecutions_Call) Run(run func(ctx context.Context, pm re.ExecutionPageMeta)) *Repository_ListExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 re.ExecutionPageMeta
+		if args[1] != nil {
+			arg1 = args[1].(re.ExecutionPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListExecutions_Call) Return(executionPage re.ExecutionPage, err error) *Repository_ListExecutions_Call {
+	_c.Call.Return(executionPage, err)
+	return _c
+}
+
+func (_c *Repository_ListExecutions_Call) RunAndReturn(run func(ctx context.Context, pm re.ExecutionPageMeta) (re.ExecutionPage, error)) *Repository_ListExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPipelines provides a mock function for the type Repository
+func (_mock *Repository) ListPipelines(ctx context.Context, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	ret := _mock.Called(ctx, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPipelines")
+	}
+
+	var r0 re.PipelinePage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.PipelinePageMeta) (re.PipelinePage, error)); ok {
+		return returnFunc(ctx, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.PipelinePageMeta) re.PipelinePage); ok {
+		r0 = returnFunc(ctx, pm)
+	} else {
+		r0 = ret.Get(0).(re.PipelinePage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.PipelinePageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ListPipelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPipelines'
+type Repository_ListPipelines_Call struct {
+	*mock.Call
+}
+
+// ListPipelines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pm re.PipelinePageMeta
+func (_e *Repository_Expecter) ListPipelines(ctx interface{}, pm interface{}) *Repository_ListPipelines_Call {
+	return &Repository_ListPipelines_Call{Call: _e.mock.On("ListPipelines", ctx, pm)}
+}
+
+func (_c *Repository_ListPipelines_Call) Run(run func(ctx context.Context, pm re.PipelinePageMeta)) *Repository_ListPipelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 re.PipelinePageMeta
+		if args[1] != nil {
+			arg1 = args[1].(re.PipelinePageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ListPipelines_Call) Return(pipelinePage re.PipelinePage, err error) *Repository_ListPipelines_Call {
+	_c.Call.Return(pipelinePage, err)
+	return _c
+}
+
+func (_c *Repository_ListPipelines_Call) RunAndReturn(run func(ctx context.Context, pm re.PipelinePageMeta) (re.PipelinePage, error)) *Repository_ListPipelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRevisions provides a mock function for the type Repository
+func (_mock *Repository) ListRevisions(ctx context.Context, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	ret := _mock.Called(ctx, pm)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEntityMembers")
+		panic("no return value specified for ListRevisions")
 	}
 
-	var r0 roles.MembersRolePage
+	var r0 re.RevisionPage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, roles.MembersRolePageQuery) (roles.MembersRolePage, error)); ok {
-		return returnFunc(ctx, entityID, pageQuery)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.RevisionPageMeta) (re.RevisionPage, error)); ok {
+		return returnFunc(ctx, pm)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, roles.MembersRolePageQuery) roles.MembersRolePage); ok {
-		r0 = returnFunc(ctx, entityID, pageQuery)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.RevisionPageMeta) re.RevisionPage); ok {
+		r0 = returnFunc(ctx, pm)
 	} else {
-		r0 = ret.Get(0).(roles.MembersRolePage)
+		r0 = ret.Get(0).(re.RevisionPage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, roles.MembersRolePageQuery) error); ok {
-		r1 = returnFunc(ctx, entityID, pageQuery)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.RevisionPageMeta) error); ok {
+		r1 = returnFunc(ctx, pm)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Repository_ListEntityMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntityMembers'
-type Repository_ListEntityMembers_Call struct {
+// Repository_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type Repository_ListRevisions_Call struct {
 	*mock.Call
 }
 
-// ListEntityMembers is a helper method to define mock.On call
+// ListRevisions is a helper method to define mock.On call
 //   - ctx context.Context
-//   - entityID string
-//   - pageQuery roles.MembersRolePageQuery
-func (_e *Repository_Expecter) ListEntityMembers(ctx interface{}, entityID interface{}, pageQuery interface{}) *Repository_ListEntityMembers_Call {
-	return &Repository_ListEntityMembers_Call{Call: _e.mock.On("ListEntityMembers", ctx, entityID, pageQuery)}
+//   - pm re.RevisionPageMeta
+func (_e *Repository_Expecter) ListRevisions(ctx interface{}, pm interface{}) *Repository_ListRevisions_Call {
+	return &Repository_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, pm)}
 }
 
-func (_c *Repository_ListEntityMembers_Call) Run(run func(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery)) *Repository_ListEntityMembers_Call {
+func (_c *Repository_ListRevisions_Call) Run(run func(ctx context.Context, pm re.RevisionPageMeta)) *Repository_ListRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 re.RevisionPageMeta
 		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 roles.MembersRolePageQuery
-		if args[2] != nil {
-			arg2 = args[2].(roles.MembersRolePageQuery)
+			arg1 = args[1].(re.RevisionPageMeta)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_ListEntityMembers_Call) Return(membersRolePage roles.MembersRolePage, err error) *Repository_ListEntityMembers_Call {
-	_c.Call.Return(membersRolePage, err)
+func (_c *Repository_ListRevisions_Call) Return(revisionPage re.RevisionPage, err error) *Repository_ListRevisions_Call {
+	_c.Call.Return(revisionPage, err)
 	return _c
 }
 
-func (_c *Repository_ListEntityMembers_Call) RunAndReturn(run func(ctx context.Context, entityID string, pageQuery roles.MembersRolePageQuery) (roles.MembersRolePage, error)) *Repository_ListEntityMembers_Call {
+func (_c *Repository_ListRevisions_Call) RunAndReturn(run func(ctx context.Context, pm re.RevisionPageMeta) (re.RevisionPage, error)) *Repository_ListRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -451,6 +930,120 @@ func (_c *Repository_RemoveEntityMembers_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// RemoveExecutions provides a mock function for the type Repository
+func (_mock *Repository) RemoveExecutions(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveExecutions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExecutions'
+type Repository_RemoveExecutions_Call struct {
+	*mock.Call
+}
+
+// RemoveExecutions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *Repository_Expecter) RemoveExecutions(ctx interface{}, before interface{}) *Repository_RemoveExecutions_Call {
+	return &Repository_RemoveExecutions_Call{Call: _e.mock.On("RemoveExecutions", ctx, before)}
+}
+
+func (_c *Repository_RemoveExecutions_Call) Run(run func(ctx context.Context, before time.Time)) *Repository_RemoveExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveExecutions_Call) Return(err error) *Repository_RemoveExecutions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveExecutions_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *Repository_RemoveExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveExpiredState provides a mock function for the type Repository
+func (_mock *Repository) RemoveExpiredState(ctx context.Context, before time.Time) error {
+	ret := _mock.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveExpiredState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveExpiredState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExpiredState'
+type Repository_RemoveExpiredState_Call struct {
+	*mock.Call
+}
+
+// RemoveExpiredState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *Repository_Expecter) RemoveExpiredState(ctx interface{}, before interface{}) *Repository_RemoveExpiredState_Call {
+	return &Repository_RemoveExpiredState_Call{Call: _e.mock.On("RemoveExpiredState", ctx, before)}
+}
+
+func (_c *Repository_RemoveExpiredState_Call) Run(run func(ctx context.Context, before time.Time)) *Repository_RemoveExpiredState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveExpiredState_Call) Return(err error) *Repository_RemoveExpiredState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveExpiredState_Call) RunAndReturn(run func(ctx context.Context, before time.Time) error) *Repository_RemoveExpiredState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveMemberFromAllRoles provides a mock function for the type Repository
 func (_mock *Repository) RemoveMemberFromAllRoles(ctx context.Context, memberID string) error {
 	ret := _mock.Called(ctx, memberID)
@@ -508,6 +1101,69 @@ func (_c *Repository_RemoveMemberFromAllRoles_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// RemovePipeline provides a mock function for the type Repository
+func (_mock *Repository) RemovePipeline(ctx context.Context, domainID string, id string) error {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePipeline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemovePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePipeline'
+type Repository_RemovePipeline_Call struct {
+	*mock.Call
+}
+
+// RemovePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) RemovePipeline(ctx interface{}, domainID interface{}, id interface{}) *Repository_RemovePipeline_Call {
+	return &Repository_RemovePipeline_Call{Call: _e.mock.On("RemovePipeline", ctx, domainID, id)}
+}
+
+func (_c *Repository_RemovePipeline_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_RemovePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemovePipeline_Call) Return(err error) *Repository_RemovePipeline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemovePipeline_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) error) *Repository_RemovePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveRoles provides a mock function for the type Repository
 func (_mock *Repository) RemoveRoles(ctx context.Context, roleIDs []string) error {
 	ret := _mock.Called(ctx, roleIDs)
@@ -622,6 +1278,69 @@ func (_c *Repository_RemoveRule_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// RemoveState provides a mock function for the type Repository
+func (_mock *Repository) RemoveState(ctx context.Context, ruleID string, key string) error {
+	ret := _mock.Called(ctx, ruleID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, ruleID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RemoveState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveState'
+type Repository_RemoveState_Call struct {
+	*mock.Call
+}
+
+// RemoveState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID string
+//   - key string
+func (_e *Repository_Expecter) RemoveState(ctx interface{}, ruleID interface{}, key interface{}) *Repository_RemoveState_Call {
+	return &Repository_RemoveState_Call{Call: _e.mock.On("RemoveState", ctx, ruleID, key)}
+}
+
+func (_c *Repository_RemoveState_Call) Run(run func(ctx context.Context, ruleID string, key string)) *Repository_RemoveState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RemoveState_Call) Return(err error) *Repository_RemoveState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RemoveState_Call) RunAndReturn(run func(ctx context.Context, ruleID string, key string) error) *Repository_RemoveState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RetrieveAllRoles provides a mock function for the type Repository
 func (_mock *Repository) RetrieveAllRoles(ctx context.Context, entityID string, limit uint64, offset uint64) (roles.RolePage, error) {
 	ret := _mock.Called(ctx, entityID, limit, offset)
@@ -958,7 +1677,74 @@ func (_e *Repository_Expecter) RetrieveRole(ctx interface{}, roleID interface{})
 	return &Repository_RetrieveRole_Call{Call: _e.mock.On("RetrieveRole", ctx, roleID)}
 }
 
-func (_c *Repository_RetrieveRole_Call) Run(run func(ctx context.Context, roleID string)) *Repository_RetrieveRole_Call {
+func (_c *Repository_RetrieveRole_Call) Run(run func(ctx context.Context, roleID string)) *Repository_RetrieveRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RetrieveRole_Call) Return(role roles.Role, err error) *Repository_RetrieveRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *Repository_RetrieveRole_Call) RunAndReturn(run func(ctx context.Context, roleID string) (roles.Role, error)) *Repository_RetrieveRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrieveState provides a mock function for the type Repository
+func (_mock *Repository) RetrieveState(ctx context.Context, ruleID string, key string) (re.StateEntry, error) {
+	ret := _mock.Called(ctx, ruleID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrieveState")
+	}
+
+	var r0 re.StateEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (re.StateEntry, error)); ok {
+		return returnFunc(ctx, ruleID, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) re.StateEntry); ok {
+		r0 = returnFunc(ctx, ruleID, key)
+	} else {
+		r0 = ret.Get(0).(re.StateEntry)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, ruleID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RetrieveState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrieveState'
+type Repository_RetrieveState_Call struct {
+	*mock.Call
+}
+
+// RetrieveState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID string
+//   - key string
+func (_e *Repository_Expecter) RetrieveState(ctx interface{}, ruleID interface{}, key interface{}) *Repository_RetrieveState_Call {
+	return &Repository_RetrieveState_Call{Call: _e.mock.On("RetrieveState", ctx, ruleID, key)}
+}
+
+func (_c *Repository_RetrieveState_Call) Run(run func(ctx context.Context, ruleID string, key string)) *Repository_RetrieveState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -968,20 +1754,25 @@ func (_c *Repository_RetrieveRole_Call) Run(run func(ctx context.Context, roleID
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_RetrieveRole_Call) Return(role roles.Role, err error) *Repository_RetrieveRole_Call {
-	_c.Call.Return(role, err)
+func (_c *Repository_RetrieveState_Call) Return(stateEntry re.StateEntry, err error) *Repository_RetrieveState_Call {
+	_c.Call.Return(stateEntry, err)
 	return _c
 }
 
-func (_c *Repository_RetrieveRole_Call) RunAndReturn(run func(ctx context.Context, roleID string) (roles.Role, error)) *Repository_RetrieveRole_Call {
+func (_c *Repository_RetrieveState_Call) RunAndReturn(run func(ctx context.Context, ruleID string, key string) (re.StateEntry, error)) *Repository_RetrieveState_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1367,106 +2158,355 @@ func (_mock *Repository) RoleListMembers(ctx context.Context, roleID string, lim
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64, uint64) error); ok {
 		r1 = returnFunc(ctx, roleID, limit, offset)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_RoleListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleListMembers'
+type Repository_RoleListMembers_Call struct {
+	*mock.Call
+}
+
+// RoleListMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - roleID string
+//   - limit uint64
+//   - offset uint64
+func (_e *Repository_Expecter) RoleListMembers(ctx interface{}, roleID interface{}, limit interface{}, offset interface{}) *Repository_RoleListMembers_Call {
+	return &Repository_RoleListMembers_Call{Call: _e.mock.On("RoleListMembers", ctx, roleID, limit, offset)}
+}
+
+func (_c *Repository_RoleListMembers_Call) Run(run func(ctx context.Context, roleID string, limit uint64, offset uint64)) *Repository_RoleListMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uint64
+		if args[2] != nil {
+			arg2 = args[2].(uint64)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RoleListMembers_Call) Return(membersPage roles.MembersPage, err error) *Repository_RoleListMembers_Call {
+	_c.Call.Return(membersPage, err)
+	return _c
+}
+
+func (_c *Repository_RoleListMembers_Call) RunAndReturn(run func(ctx context.Context, roleID string, limit uint64, offset uint64) (roles.MembersPage, error)) *Repository_RoleListMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RoleRemoveActions provides a mock function for the type Repository
+func (_mock *Repository) RoleRemoveActions(ctx context.Context, role roles.Role, actions []string) error {
+	ret := _mock.Called(ctx, role, actions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoleRemoveActions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role, []string) error); ok {
+		r0 = returnFunc(ctx, role, actions)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RoleRemoveActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveActions'
+type Repository_RoleRemoveActions_Call struct {
+	*mock.Call
+}
+
+// RoleRemoveActions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role roles.Role
+//   - actions []string
+func (_e *Repository_Expecter) RoleRemoveActions(ctx interface{}, role interface{}, actions interface{}) *Repository_RoleRemoveActions_Call {
+	return &Repository_RoleRemoveActions_Call{Call: _e.mock.On("RoleRemoveActions", ctx, role, actions)}
+}
+
+func (_c *Repository_RoleRemoveActions_Call) Run(run func(ctx context.Context, role roles.Role, actions []string)) *Repository_RoleRemoveActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 roles.Role
+		if args[1] != nil {
+			arg1 = args[1].(roles.Role)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RoleRemoveActions_Call) Return(err error) *Repository_RoleRemoveActions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RoleRemoveActions_Call) RunAndReturn(run func(ctx context.Context, role roles.Role, actions []string) error) *Repository_RoleRemoveActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RoleRemoveAllActions provides a mock function for the type Repository
+func (_mock *Repository) RoleRemoveAllActions(ctx context.Context, role roles.Role) error {
+	ret := _mock.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoleRemoveAllActions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role) error); ok {
+		r0 = returnFunc(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RoleRemoveAllActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveAllActions'
+type Repository_RoleRemoveAllActions_Call struct {
+	*mock.Call
+}
+
+// RoleRemoveAllActions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role roles.Role
+func (_e *Repository_Expecter) RoleRemoveAllActions(ctx interface{}, role interface{}) *Repository_RoleRemoveAllActions_Call {
+	return &Repository_RoleRemoveAllActions_Call{Call: _e.mock.On("RoleRemoveAllActions", ctx, role)}
+}
+
+func (_c *Repository_RoleRemoveAllActions_Call) Run(run func(ctx context.Context, role roles.Role)) *Repository_RoleRemoveAllActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 roles.Role
+		if args[1] != nil {
+			arg1 = args[1].(roles.Role)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RoleRemoveAllActions_Call) Return(err error) *Repository_RoleRemoveAllActions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RoleRemoveAllActions_Call) RunAndReturn(run func(ctx context.Context, role roles.Role) error) *Repository_RoleRemoveAllActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RoleRemoveAllMembers provides a mock function for the type Repository
+func (_mock *Repository) RoleRemoveAllMembers(ctx context.Context, role roles.Role) error {
+	ret := _mock.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoleRemoveAllMembers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role) error); ok {
+		r0 = returnFunc(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Repository_RoleRemoveAllMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveAllMembers'
+type Repository_RoleRemoveAllMembers_Call struct {
+	*mock.Call
+}
+
+// RoleRemoveAllMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role roles.Role
+func (_e *Repository_Expecter) RoleRemoveAllMembers(ctx interface{}, role interface{}) *Repository_RoleRemoveAllMembers_Call {
+	return &Repository_RoleRemoveAllMembers_Call{Call: _e.mock.On("RoleRemoveAllMembers", ctx, role)}
+}
+
+func (_c *Repository_RoleRemoveAllMembers_Call) Run(run func(ctx context.Context, role roles.Role)) *Repository_RoleRemoveAllMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 roles.Role
+		if args[1] != nil {
+			arg1 = args[1].(roles.Role)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_RoleRemoveAllMembers_Call) Return(err error) *Repository_RoleRemoveAllMembers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Repository_RoleRemoveAllMembers_Call) RunAndReturn(run func(ctx context.Context, role roles.Role) error) *Repository_RoleRemoveAllMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RoleRemoveMembers provides a mock function for the type Repository
+func (_mock *Repository) RoleRemoveMembers(ctx context.Context, role roles.Role, members []string) error {
+	ret := _mock.Called(ctx, role, members)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoleRemoveMembers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role, []string) error); ok {
+		r0 = returnFunc(ctx, role, members)
+	} else {
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// Repository_RoleListMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleListMembers'
-type Repository_RoleListMembers_Call struct {
+// Repository_RoleRemoveMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveMembers'
+type Repository_RoleRemoveMembers_Call struct {
 	*mock.Call
 }
 
-// RoleListMembers is a helper method to define mock.On call
+// RoleRemoveMembers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - roleID string
-//   - limit uint64
-//   - offset uint64
-func (_e *Repository_Expecter) RoleListMembers(ctx interface{}, roleID interface{}, limit interface{}, offset interface{}) *Repository_RoleListMembers_Call {
-	return &Repository_RoleListMembers_Call{Call: _e.mock.On("RoleListMembers", ctx, roleID, limit, offset)}
+//   - role roles.Role
+//   - members []string
+func (_e *Repository_Expecter) RoleRemoveMembers(ctx interface{}, role interface{}, members interface{}) *Repository_RoleRemoveMembers_Call {
+	return &Repository_RoleRemoveMembers_Call{Call: _e.mock.On("RoleRemoveMembers", ctx, role, members)}
 }
 
-func (_c *Repository_RoleListMembers_Call) Run(run func(ctx context.Context, roleID string, limit uint64, offset uint64)) *Repository_RoleListMembers_Call {
+func (_c *Repository_RoleRemoveMembers_Call) Run(run func(ctx context.Context, role roles.Role, members []string)) *Repository_RoleRemoveMembers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 roles.Role
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(roles.Role)
 		}
-		var arg2 uint64
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(uint64)
-		}
-		var arg3 uint64
-		if args[3] != nil {
-			arg3 = args[3].(uint64)
+			arg2 = args[2].([]string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_RoleListMembers_Call) Return(membersPage roles.MembersPage, err error) *Repository_RoleListMembers_Call {
-	_c.Call.Return(membersPage, err)
+func (_c *Repository_RoleRemoveMembers_Call) Return(err error) *Repository_RoleRemoveMembers_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Repository_RoleListMembers_Call) RunAndReturn(run func(ctx context.Context, roleID string, limit uint64, offset uint64) (roles.MembersPage, error)) *Repository_RoleListMembers_Call {
+func (_c *Repository_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.Context, role roles.Role, members []string) error) *Repository_RoleRemoveMembers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RoleRemoveActions provides a mock function for the type Repository
-func (_mock *Repository) RoleRemoveActions(ctx context.Context, role roles.Role, actions []string) error {
-	ret := _mock.Called(ctx, role, actions)
+// RollbackRule provides a mock function for the type Repository
+func (_mock *Repository) RollbackRule(ctx context.Context, r re.Rule, revision uint64) (re.Rule, error) {
+	ret := _mock.Called(ctx, r, revision)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RoleRemoveActions")
+		panic("no return value specified for RollbackRule")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role, []string) error); ok {
-		r0 = returnFunc(ctx, role, actions)
+	var r0 re.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Rule, uint64) (re.Rule, error)); ok {
+		return returnFunc(ctx, r, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Rule, uint64) re.Rule); ok {
+		r0 = returnFunc(ctx, r, revision)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(re.Rule)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.Rule, uint64) error); ok {
+		r1 = returnFunc(ctx, r, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Repository_RoleRemoveActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveActions'
-type Repository_RoleRemoveActions_Call struct {
+// Repository_RollbackRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackRule'
+type Repository_RollbackRule_Call struct {
 	*mock.Call
 }
 
-// RoleRemoveActions is a helper method to define mock.On call
+// RollbackRule is a helper method to define mock.On call
 //   - ctx context.Context
-//   - role roles.Role
-//   - actions []string
-func (_e *Repository_Expecter) RoleRemoveActions(ctx interface{}, role interface{}, actions interface{}) *Repository_RoleRemoveActions_Call {
-	return &Repository_RoleRemoveActions_Call{Call: _e.mock.On("RoleRemoveActions", ctx, role, actions)}
+//   - r re.Rule
+//   - revision uint64
+func (_e *Repository_Expecter) RollbackRule(ctx interface{}, r interface{}, revision interface{}) *Repository_RollbackRule_Call {
+	return &Repository_RollbackRule_Call{Call: _e.mock.On("RollbackRule", ctx, r, revision)}
 }
 
-func (_c *Repository_RoleRemoveActions_Call) Run(run func(ctx context.Context, role roles.Role, actions []string)) *Repository_RoleRemoveActions_Call {
+func (_c *Repository_RollbackRule_Call) Run(run func(ctx context.Context, r re.Rule, revision uint64)) *Repository_RollbackRule_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 roles.Role
+		var arg1 re.Rule
 		if args[1] != nil {
-			arg1 = args[1].(roles.Role)
+			arg1 = args[1].(re.Rule)
 		}
-		var arg2 []string
+		var arg2 uint64
 		if args[2] != nil {
-			arg2 = args[2].([]string)
+			arg2 = args[2].(uint64)
 		}
 		run(
 			arg0,
@@ -1477,54 +2517,54 @@ func (_c *Repository_RoleRemoveActions_Call) Run(run func(ctx context.Context, r
 	return _c
 }
 
-func (_c *Repository_RoleRemoveActions_Call) Return(err error) *Repository_RoleRemoveActions_Call {
-	_c.Call.Return(err)
+func (_c *Repository_RollbackRule_Call) Return(rule re.Rule, err error) *Repository_RollbackRule_Call {
+	_c.Call.Return(rule, err)
 	return _c
 }
 
-func (_c *Repository_RoleRemoveActions_Call) RunAndReturn(run func(ctx context.Context, role roles.Role, actions []string) error) *Repository_RoleRemoveActions_Call {
+func (_c *Repository_RollbackRule_Call) RunAndReturn(run func(ctx context.Context, r re.Rule, revision uint64) (re.Rule, error)) *Repository_RollbackRule_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RoleRemoveAllActions provides a mock function for the type Repository
-func (_mock *Repository) RoleRemoveAllActions(ctx context.Context, role roles.Role) error {
-	ret := _mock.Called(ctx, role)
+// SaveState provides a mock function for the type Repository
+func (_mock *Repository) SaveState(ctx context.Context, e re.StateEntry) error {
+	ret := _mock.Called(ctx, e)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RoleRemoveAllActions")
+		panic("no return value specified for SaveState")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role) error); ok {
-		r0 = returnFunc(ctx, role)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.StateEntry) error); ok {
+		r0 = returnFunc(ctx, e)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// Repository_RoleRemoveAllActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveAllActions'
-type Repository_RoleRemoveAllActions_Call struct {
+// Repository_SaveState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveState'
+type Repository_SaveState_Call struct {
 	*mock.Call
 }
 
-// RoleRemoveAllActions is a helper method to define mock.On call
+// SaveState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - role roles.Role
-func (_e *Repository_Expecter) RoleRemoveAllActions(ctx interface{}, role interface{}) *Repository_RoleRemoveAllActions_Call {
-	return &Repository_RoleRemoveAllActions_Call{Call: _e.mock.On("RoleRemoveAllActions", ctx, role)}
+//   - e re.StateEntry
+func (_e *Repository_Expecter) SaveState(ctx interface{}, e interface{}) *Repository_SaveState_Call {
+	return &Repository_SaveState_Call{Call: _e.mock.On("SaveState", ctx, e)}
 }
 
-func (_c *Repository_RoleRemoveAllActions_Call) Run(run func(ctx context.Context, role roles.Role)) *Repository_RoleRemoveAllActions_Call {
+func (_c *Repository_SaveState_Call) Run(run func(ctx context.Context, e re.StateEntry)) *Repository_SaveState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 roles.Role
+		var arg1 re.StateEntry
 		if args[1] != nil {
-			arg1 = args[1].(roles.Role)
+			arg1 = args[1].(re.StateEntry)
 		}
 		run(
 			arg0,
@@ -1534,54 +2574,63 @@ func (_c *Repository_RoleRemoveAllActions_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *Repository_RoleRemoveAllActions_Call) Return(err error) *Repository_RoleRemoveAllActions_Call {
+func (_c *Repository_SaveState_Call) Return(err error) *Repository_SaveState_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *Repository_RoleRemoveAllActions_Call) RunAndReturn(run func(ctx context.Context, role roles.Role) error) *Repository_RoleRemoveAllActions_Call {
+func (_c *Repository_SaveState_Call) RunAndReturn(run func(ctx context.Context, e re.StateEntry) error) *Repository_SaveState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RoleRemoveAllMembers provides a mock function for the type Repository
-func (_mock *Repository) RoleRemoveAllMembers(ctx context.Context, role roles.Role) error {
-	ret := _mock.Called(ctx, role)
+// UpdatePipeline provides a mock function for the type Repository
+func (_mock *Repository) UpdatePipeline(ctx context.Context, p re.Pipeline) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RoleRemoveAllMembers")
+		panic("no return value specified for UpdatePipeline")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role) error); ok {
-		r0 = returnFunc(ctx, role)
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Pipeline) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Pipeline) re.Pipeline); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(re.Pipeline)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.Pipeline) error); ok {
+		r1 = returnFunc(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Repository_RoleRemoveAllMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveAllMembers'
-type Repository_RoleRemoveAllMembers_Call struct {
+// Repository_UpdatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipeline'
+type Repository_UpdatePipeline_Call struct {
 	*mock.Call
 }
 
-// RoleRemoveAllMembers is a helper method to define mock.On call
+// UpdatePipeline is a helper method to define mock.On call
 //   - ctx context.Context
-//   - role roles.Role
-func (_e *Repository_Expecter) RoleRemoveAllMembers(ctx interface{}, role interface{}) *Repository_RoleRemoveAllMembers_Call {
-	return &Repository_RoleRemoveAllMembers_Call{Call: _e.mock.On("RoleRemoveAllMembers", ctx, role)}
+//   - p re.Pipeline
+func (_e *Repository_Expecter) UpdatePipeline(ctx interface{}, p interface{}) *Repository_UpdatePipeline_Call {
+	return &Repository_UpdatePipeline_Call{Call: _e.mock.On("UpdatePipeline", ctx, p)}
 }
 
-func (_c *Repository_RoleRemoveAllMembers_Call) Run(run func(ctx context.Context, role roles.Role)) *Repository_RoleRemoveAllMembers_Call {
+func (_c *Repository_UpdatePipeline_Call) Run(run func(ctx context.Context, p re.Pipeline)) *Repository_UpdatePipeline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 roles.Role
+		var arg1 re.Pipeline
 		if args[1] != nil {
-			arg1 = args[1].(roles.Role)
+			arg1 = args[1].(re.Pipeline)
 		}
 		run(
 			arg0,
@@ -1591,75 +2640,78 @@ func (_c *Repository_RoleRemoveAllMembers_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *Repository_RoleRemoveAllMembers_Call) Return(err error) *Repository_RoleRemoveAllMembers_Call {
-	_c.Call.Return(err)
+func (_c *Repository_UpdatePipeline_Call) Return(pipeline re.Pipeline, err error) *Repository_UpdatePipeline_Call {
+	_c.Call.Return(pipeline, err)
 	return _c
 }
 
-func (_c *Repository_RoleRemoveAllMembers_Call) RunAndReturn(run func(ctx context.Context, role roles.Role) error) *Repository_RoleRemoveAllMembers_Call {
+func (_c *Repository_UpdatePipeline_Call) RunAndReturn(run func(ctx context.Context, p re.Pipeline) (re.Pipeline, error)) *Repository_UpdatePipeline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RoleRemoveMembers provides a mock function for the type Repository
-func (_mock *Repository) RoleRemoveMembers(ctx context.Context, role roles.Role, members []string) error {
-	ret := _mock.Called(ctx, role, members)
+// UpdatePipelineStatus provides a mock function for the type Repository
+func (_mock *Repository) UpdatePipelineStatus(ctx context.Context, p re.Pipeline) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RoleRemoveMembers")
+		panic("no return value specified for UpdatePipelineStatus")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, roles.Role, []string) error); ok {
-		r0 = returnFunc(ctx, role, members)
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Pipeline) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, re.Pipeline) re.Pipeline); ok {
+		r0 = returnFunc(ctx, p)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(re.Pipeline)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, re.Pipeline) error); ok {
+		r1 = returnFunc(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// Repository_RoleRemoveMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleRemoveMembers'
-type Repository_RoleRemoveMembers_Call struct {
+// Repository_UpdatePipelineStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipelineStatus'
+type Repository_UpdatePipelineStatus_Call struct {
 	*mock.Call
 }
 
-// RoleRemoveMembers is a helper method to define mock.On call
+// UpdatePipelineStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - role roles.Role
-//   - members []string
-func (_e *Repository_Expecter) RoleRemoveMembers(ctx interface{}, role interface{}, members interface{}) *Repository_RoleRemoveMembers_Call {
-	return &Repository_RoleRemoveMembers_Call{Call: _e.mock.On("RoleRemoveMembers", ctx, role, members)}
+//   - p re.Pipeline
+func (_e *Repository_Expecter) UpdatePipelineStatus(ctx interface{}, p interface{}) *Repository_UpdatePipelineStatus_Call {
+	return &Repository_UpdatePipelineStatus_Call{Call: _e.mock.On("UpdatePipelineStatus", ctx, p)}
 }
 
-func (_c *Repository_RoleRemoveMembers_Call) Run(run func(ctx context.Context, role roles.Role, members []string)) *Repository_RoleRemoveMembers_Call {
+func (_c *Repository_UpdatePipelineStatus_Call) Run(run func(ctx context.Context, p re.Pipeline)) *Repository_UpdatePipelineStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 roles.Role
+		var arg1 re.Pipeline
 		if args[1] != nil {
-			arg1 = args[1].(roles.Role)
-		}
-		var arg2 []string
-		if args[2] != nil {
-			arg2 = args[2].([]string)
+			arg1 = args[1].(re.Pipeline)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Repository_RoleRemoveMembers_Call) Return(err error) *Repository_RoleRemoveMembers_Call {
-	_c.Call.Return(err)
+func (_c *Repository_UpdatePipelineStatus_Call) Return(pipeline re.Pipeline, err error) *Repository_UpdatePipelineStatus_Call {
+	_c.Call.Return(pipeline, err)
 	return _c
 }
 
-func (_c *Repository_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.Context, role roles.Role, members []string) error) *Repository_RoleRemoveMembers_Call {
+func (_c *Repository_UpdatePipelineStatus_Call) RunAndReturn(run func(ctx context.Context, p re.Pipeline) (re.Pipeline, error)) *Repository_UpdatePipelineStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2066,6 +3118,150 @@ func (_c *Repository_UpdateRuleTags_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// ViewPipeline provides a mock function for the type Repository
+func (_mock *Repository) ViewPipeline(ctx context.Context, domainID string, id string) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, domainID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewPipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, domainID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) re.Pipeline); ok {
+		r0 = returnFunc(ctx, domainID, id)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, domainID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ViewPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewPipeline'
+type Repository_ViewPipeline_Call struct {
+	*mock.Call
+}
+
+// ViewPipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - domainID string
+//   - id string
+func (_e *Repository_Expecter) ViewPipeline(ctx interface{}, domainID interface{}, id interface{}) *Repository_ViewPipeline_Call {
+	return &Repository_ViewPipeline_Call{Call: _e.mock.On("ViewPipeline", ctx, domainID, id)}
+}
+
+func (_c *Repository_ViewPipeline_Call) Run(run func(ctx context.Context, domainID string, id string)) *Repository_ViewPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ViewPipeline_Call) Return(pipeline re.Pipeline, err error) *Repository_ViewPipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Repository_ViewPipeline_Call) RunAndReturn(run func(ctx context.Context, domainID string, id string) (re.Pipeline, error)) *Repository_ViewPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ViewRevision provides a mock function for the type Repository
+func (_mock *Repository) ViewRevision(ctx context.Context, ruleID string, revision uint64) (re.Revision, error) {
+	ret := _mock.Called(ctx, ruleID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewRevision")
+	}
+
+	var r0 re.Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64) (re.Revision, error)); ok {
+		return returnFunc(ctx, ruleID, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint64) re.Revision); ok {
+		r0 = returnFunc(ctx, ruleID, revision)
+	} else {
+		r0 = ret.Get(0).(re.Revision)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = returnFunc(ctx, ruleID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repository_ViewRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewRevision'
+type Repository_ViewRevision_Call struct {
+	*mock.Call
+}
+
+// ViewRevision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ruleID string
+//   - revision uint64
+func (_e *Repository_Expecter) ViewRevision(ctx interface{}, ruleID interface{}, revision interface{}) *Repository_ViewRevision_Call {
+	return &Repository_ViewRevision_Call{Call: _e.mock.On("ViewRevision", ctx, ruleID, revision)}
+}
+
+func (_c *Repository_ViewRevision_Call) Run(run func(ctx context.Context, ruleID string, revision uint64)) *Repository_ViewRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uint64
+		if args[2] != nil {
+			arg2 = args[2].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Repository_ViewRevision_Call) Return(revision1 re.Revision, err error) *Repository_ViewRevision_Call {
+	_c.Call.Return(revision1, err)
+	return _c
+}
+
+func (_c *Repository_ViewRevision_Call) RunAndReturn(run func(ctx context.Context, ruleID string, revision uint64) (re.Revision, error)) *Repository_ViewRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ViewRule provides a mock function for the type Repository
 func (_mock *Repository) ViewRule(ctx context.Context, id string) (re.Rule, error) {
 	ret := _mock.Called(ctx, id)
@@ -45,6 +45,78 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// AddPipeline provides a mock function for the type Service
+func (_mock *Service) AddPipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, session, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.Pipeline) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, session, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.Pipeline) re.Pipeline); ok {
+		r0 = returnFunc(ctx, session, p)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.Pipeline) error); ok {
+		r1 = returnFunc(ctx, session, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_AddPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPipeline'
+type Service_AddPipeline_Call struct {
+	*mock.Call
+}
+
+// AddPipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - p re.Pipeline
+func (_e *Service_Expecter) AddPipeline(ctx interface{}, session interface{}, p interface{}) *Service_AddPipeline_Call {
+	return &Service_AddPipeline_Call{Call: _e.mock.On("AddPipeline", ctx, session, p)}
+}
+
+func (_c *Service_AddPipeline_Call) Run(run func(ctx context.Context, session authn.Session, p re.Pipeline)) *Service_AddPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 re.Pipeline
+		if args[2] != nil {
+			arg2 = args[2].(re.Pipeline)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_AddPipeline_Call) Return(pipeline re.Pipeline, err error) *Service_AddPipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Service_AddPipeline_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error)) *Service_AddPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddRole provides a mock function for the type Service
 func (_mock *Service) AddRole(ctx context.Context, session authn.Session, entityID string, roleName string, optionalActions []string, optionalMembers []string) (roles.RoleProvision, error) {
 	ret := _mock.Called(ctx, session, entityID, roleName, optionalActions, optionalMembers)
@@ -259,6 +331,162 @@ func (_c *Service_Cancel_Call) RunAndReturn(run func() error) *Service_Cancel_Ca
 	return _c
 }
 
+// DiffRevisions provides a mock function for the type Service
+func (_mock *Service) DiffRevisions(ctx context.Context, session authn.Session, id string, from uint64, to uint64) (re.RevisionDiff, error) {
+	ret := _mock.Called(ctx, session, id, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRevisions")
+	}
+
+	var r0 re.RevisionDiff
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) (re.RevisionDiff, error)); ok {
+		return returnFunc(ctx, session, id, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64, uint64) re.RevisionDiff); ok {
+		r0 = returnFunc(ctx, session, id, from, to)
+	} else {
+		r0 = ret.Get(0).(re.RevisionDiff)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64, uint64) error); ok {
+		r1 = returnFunc(ctx, session, id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type Service_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - from uint64
+//   - to uint64
+func (_e *Service_Expecter) DiffRevisions(ctx interface{}, session interface{}, id interface{}, from interface{}, to interface{}) *Service_DiffRevisions_Call {
+	return &Service_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", ctx, session, id, from, to)}
+}
+
+func (_c *Service_DiffRevisions_Call) Run(run func(ctx context.Context, session authn.Session, id string, from uint64, to uint64)) *Service_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		var arg4 uint64
+		if args[4] != nil {
+			arg4 = args[4].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DiffRevisions_Call) Return(revisionDiff re.RevisionDiff, err error) *Service_DiffRevisions_Call {
+	_c.Call.Return(revisionDiff, err)
+	return _c
+}
+
+func (_c *Service_DiffRevisions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, from uint64, to uint64) (re.RevisionDiff, error)) *Service_DiffRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisablePipeline provides a mock function for the type Service
+func (_mock *Service) DisablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisablePipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) re.Pipeline); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_DisablePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisablePipeline'
+type Service_DisablePipeline_Call struct {
+	*mock.Call
+}
+
+// DisablePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) DisablePipeline(ctx interface{}, session interface{}, id interface{}) *Service_DisablePipeline_Call {
+	return &Service_DisablePipeline_Call{Call: _e.mock.On("DisablePipeline", ctx, session, id)}
+}
+
+func (_c *Service_DisablePipeline_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_DisablePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DisablePipeline_Call) Return(pipeline re.Pipeline, err error) *Service_DisablePipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Service_DisablePipeline_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (re.Pipeline, error)) *Service_DisablePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DisableRule provides a mock function for the type Service
 func (_mock *Service) DisableRule(ctx context.Context, session authn.Session, id string) (re.Rule, error) {
 	ret := _mock.Called(ctx, session, id)
@@ -331,6 +559,156 @@ func (_c *Service_DisableRule_Call) RunAndReturn(run func(ctx context.Context, s
 	return _c
 }
 
+// DryRunRule provides a mock function for the type Service
+func (_mock *Service) DryRunRule(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error) {
+	ret := _mock.Called(ctx, session, r, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunRule")
+	}
+
+	var r0 re.DryRunResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.Rule, *messaging.Message) (re.DryRunResult, error)); ok {
+		return returnFunc(ctx, session, r, msg)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.Rule, *messaging.Message) re.DryRunResult); ok {
+		r0 = returnFunc(ctx, session, r, msg)
+	} else {
+		r0 = ret.Get(0).(re.DryRunResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.Rule, *messaging.Message) error); ok {
+		r1 = returnFunc(ctx, session, r, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_DryRunRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DryRunRule'
+type Service_DryRunRule_Call struct {
+	*mock.Call
+}
+
+// DryRunRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - r re.Rule
+//   - msg *messaging.Message
+func (_e *Service_Expecter) DryRunRule(ctx interface{}, session interface{}, r interface{}, msg interface{}) *Service_DryRunRule_Call {
+	return &Service_DryRunRule_Call{Call: _e.mock.On("DryRunRule", ctx, session, r, msg)}
+}
+
+func (_c *Service_DryRunRule_Call) Run(run func(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message)) *Service_DryRunRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 re.Rule
+		if args[2] != nil {
+			arg2 = args[2].(re.Rule)
+		}
+		var arg3 *messaging.Message
+		if args[3] != nil {
+			arg3 = args[3].(*messaging.Message)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_DryRunRule_Call) Return(dryRunResult re.DryRunResult, err error) *Service_DryRunRule_Call {
+	_c.Call.Return(dryRunResult, err)
+	return _c
+}
+
+func (_c *Service_DryRunRule_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, r re.Rule, msg *messaging.Message) (re.DryRunResult, error)) *Service_DryRunRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnablePipeline provides a mock function for the type Service
+func (_mock *Service) EnablePipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnablePipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) re.Pipeline); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_EnablePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnablePipeline'
+type Service_EnablePipeline_Call struct {
+	*mock.Call
+}
+
+// EnablePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) EnablePipeline(ctx interface{}, session interface{}, id interface{}) *Service_EnablePipeline_Call {
+	return &Service_EnablePipeline_Call{Call: _e.mock.On("EnablePipeline", ctx, session, id)}
+}
+
+func (_c *Service_EnablePipeline_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_EnablePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_EnablePipeline_Call) Return(pipeline re.Pipeline, err error) *Service_EnablePipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Service_EnablePipeline_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (re.Pipeline, error)) *Service_EnablePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EnableRule provides a mock function for the type Service
 func (_mock *Service) EnableRule(ctx context.Context, session authn.Session, id string) (re.Rule, error) {
 	ret := _mock.Called(ctx, session, id)
@@ -494,7 +872,296 @@ func (_e *Service_Expecter) ListAvailableActions(ctx interface{}, session interf
 	return &Service_ListAvailableActions_Call{Call: _e.mock.On("ListAvailableActions", ctx, session)}
 }
 
-func (_c *Service_ListAvailableActions_Call) Run(run func(ctx context.Context, session authn.Session)) *Service_ListAvailableActions_Call {
+func (_c *Service_ListAvailableActions_Call) Run(run func(ctx context.Context, session authn.Session)) *Service_ListAvailableActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListAvailableActions_Call) Return(strings []string, err error) *Service_ListAvailableActions_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Service_ListAvailableActions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session) ([]string, error)) *Service_ListAvailableActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEntityMembers provides a mock function for the type Service
+func (_mock *Service) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, pq roles.MembersRolePageQuery) (roles.MembersRolePage, error) {
+	ret := _mock.Called(ctx, session, entityID, pq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEntityMembers")
+	}
+
+	var r0 roles.MembersRolePage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, roles.MembersRolePageQuery) (roles.MembersRolePage, error)); ok {
+		return returnFunc(ctx, session, entityID, pq)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, roles.MembersRolePageQuery) roles.MembersRolePage); ok {
+		r0 = returnFunc(ctx, session, entityID, pq)
+	} else {
+		r0 = ret.Get(0).(roles.MembersRolePage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, roles.MembersRolePageQuery) error); ok {
+		r1 = returnFunc(ctx, session, entityID, pq)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListEntityMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntityMembers'
+type Service_ListEntityMembers_Call struct {
+	*mock.Call
+}
+
+// ListEntityMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - entityID string
+//   - pq roles.MembersRolePageQuery
+func (_e *Service_Expecter) ListEntityMembers(ctx interface{}, session interface{}, entityID interface{}, pq interface{}) *Service_ListEntityMembers_Call {
+	return &Service_ListEntityMembers_Call{Call: _e.mock.On("ListEntityMembers", ctx, session, entityID, pq)}
+}
+
+func (_c *Service_ListEntityMembers_Call) Run(run func(ctx context.Context, session authn.Session, entityID string, pq roles.MembersRolePageQuery)) *Service_ListEntityMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 roles.MembersRolePageQuery
+		if args[3] != nil {
+			arg3 = args[3].(roles.MembersRolePageQuery)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListEntityMembers_Call) Return(membersRolePage roles.MembersRolePage, err error) *Service_ListEntityMembers_Call {
+	_c.Call.Return(membersRolePage, err)
+	return _c
+}
+
+func (_c *Service_ListEntityMembers_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, entityID string, pq roles.MembersRolePageQuery) (roles.MembersRolePage, error)) *Service_ListEntityMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExecutions provides a mock function for the type Service
+func (_mock *Service) ListExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExecutions")
+	}
+
+	var r0 re.ExecutionPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.ExecutionPageMeta) (re.ExecutionPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.ExecutionPageMeta) re.ExecutionPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(re.ExecutionPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.ExecutionPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExecutions'
+type Service_ListExecutions_Call struct {
+	*mock.Call
+}
+
+// ListExecutions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm re.ExecutionPageMeta
+func (_e *Service_Expecter) ListExecutions(ctx interface{}, session interface{}, pm interface{}) *Service_ListExecutions_Call {
+	return &Service_ListExecutions_Call{Call: _e.mock.On("ListExecutions", ctx, session, pm)}
+}
+
+func (_c *Service_ListExecutions_Call) Run(run func(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta)) *Service_ListExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 re.ExecutionPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(re.ExecutionPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListExecutions_Call) Return(executionPage re.ExecutionPage, err error) *Service_ListExecutions_Call {
+	_c.Call.Return(executionPage, err)
+	return _c
+}
+
+func (_c *Service_ListExecutions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error)) *Service_ListExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPipelineExecutions provides a mock function for the type Service
+func (_mock *Service) ListPipelineExecutions(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPipelineExecutions")
+	}
+
+	var r0 re.ExecutionPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.ExecutionPageMeta) (re.ExecutionPage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.ExecutionPageMeta) re.ExecutionPage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(re.ExecutionPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.ExecutionPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListPipelineExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPipelineExecutions'
+type Service_ListPipelineExecutions_Call struct {
+	*mock.Call
+}
+
+// ListPipelineExecutions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm re.ExecutionPageMeta
+func (_e *Service_Expecter) ListPipelineExecutions(ctx interface{}, session interface{}, pm interface{}) *Service_ListPipelineExecutions_Call {
+	return &Service_ListPipelineExecutions_Call{Call: _e.mock.On("ListPipelineExecutions", ctx, session, pm)}
+}
+
+func (_c *Service_ListPipelineExecutions_Call) Run(run func(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta)) *Service_ListPipelineExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 re.ExecutionPageMeta
+		if args[2] != nil {
+			arg2 = args[2].(re.ExecutionPageMeta)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ListPipelineExecutions_Call) Return(executionPage re.ExecutionPage, err error) *Service_ListPipelineExecutions_Call {
+	_c.Call.Return(executionPage, err)
+	return _c
+}
+
+func (_c *Service_ListPipelineExecutions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm re.ExecutionPageMeta) (re.ExecutionPage, error)) *Service_ListPipelineExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPipelines provides a mock function for the type Service
+func (_mock *Service) ListPipelines(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	ret := _mock.Called(ctx, session, pm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPipelines")
+	}
+
+	var r0 re.PipelinePage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.PipelinePageMeta) (re.PipelinePage, error)); ok {
+		return returnFunc(ctx, session, pm)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.PipelinePageMeta) re.PipelinePage); ok {
+		r0 = returnFunc(ctx, session, pm)
+	} else {
+		r0 = ret.Get(0).(re.PipelinePage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.PipelinePageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ListPipelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPipelines'
+type Service_ListPipelines_Call struct {
+	*mock.Call
+}
+
+// ListPipelines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - pm re.PipelinePageMeta
+func (_e *Service_Expecter) ListPipelines(ctx interface{}, session interface{}, pm interface{}) *Service_ListPipelines_Call {
+	return &Service_ListPipelines_Call{Call: _e.mock.On("ListPipelines", ctx, session, pm)}
+}
+
+func (_c *Service_ListPipelines_Call) Run(run func(ctx context.Context, session authn.Session, pm re.PipelinePageMeta)) *Service_ListPipelines_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -504,65 +1171,69 @@ func (_c *Service_ListAvailableActions_Call) Run(run func(ctx context.Context, s
 		if args[1] != nil {
 			arg1 = args[1].(authn.Session)
 		}
+		var arg2 re.PipelinePageMeta
+		if args[2] != nil {
+			arg2 = args[2].(re.PipelinePageMeta)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *Service_ListAvailableActions_Call) Return(strings []string, err error) *Service_ListAvailableActions_Call {
-	_c.Call.Return(strings, err)
+func (_c *Service_ListPipelines_Call) Return(pipelinePage re.PipelinePage, err error) *Service_ListPipelines_Call {
+	_c.Call.Return(pipelinePage, err)
 	return _c
 }
 
-func (_c *Service_ListAvailableActions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session) ([]string, error)) *Service_ListAvailableActions_Call {
+func (_c *Service_ListPipelines_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm re.PipelinePageMeta) (re.PipelinePage, error)) *Service_ListPipelines_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEntityMembers provides a mock function for the type Service
-func (_mock *Service) ListEntityMembers(ctx context.Context, session authn.Session, entityID string, pq roles.MembersRolePageQuery) (roles.MembersRolePage, error) {
-	ret := _mock.Called(ctx, session, entityID, pq)
+// ListRevisions provides a mock function for the type Service
+func (_mock *Service) ListRevisions(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	ret := _mock.Called(ctx, session, pm)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListEntityMembers")
+		panic("no return value specified for ListRevisions")
 	}
 
-	var r0 roles.MembersRolePage
+	var r0 re.RevisionPage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, roles.MembersRolePageQuery) (roles.MembersRolePage, error)); ok {
-		return returnFunc(ctx, session, entityID, pq)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.RevisionPageMeta) (re.RevisionPage, error)); ok {
+		return returnFunc(ctx, session, pm)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, roles.MembersRolePageQuery) roles.MembersRolePage); ok {
-		r0 = returnFunc(ctx, session, entityID, pq)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.RevisionPageMeta) re.RevisionPage); ok {
+		r0 = returnFunc(ctx, session, pm)
 	} else {
-		r0 = ret.Get(0).(roles.MembersRolePage)
+		r0 = ret.Get(0).(re.RevisionPage)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, roles.MembersRolePageQuery) error); ok {
-		r1 = returnFunc(ctx, session, entityID, pq)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.RevisionPageMeta) error); ok {
+		r1 = returnFunc(ctx, session, pm)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// Service_ListEntityMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEntityMembers'
-type Service_ListEntityMembers_Call struct {
+// Service_ListRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRevisions'
+type Service_ListRevisions_Call struct {
 	*mock.Call
 }
 
-// ListEntityMembers is a helper method to define mock.On call
+// ListRevisions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - session authn.Session
-//   - entityID string
-//   - pq roles.MembersRolePageQuery
-func (_e *Service_Expecter) ListEntityMembers(ctx interface{}, session interface{}, entityID interface{}, pq interface{}) *Service_ListEntityMembers_Call {
-	return &Service_ListEntityMembers_Call{Call: _e.mock.On("ListEntityMembers", ctx, session, entityID, pq)}
+//   - pm re.RevisionPageMeta
+func (_e *Service_Expecter) ListRevisions(ctx interface{}, session interface{}, pm interface{}) *Service_ListRevisions_Call {
+	return &Service_ListRevisions_Call{Call: _e.mock.On("ListRevisions", ctx, session, pm)}
 }
 
-func (_c *Service_ListEntityMembers_Call) Run(run func(ctx context.Context, session authn.Session, entityID string, pq roles.MembersRolePageQuery)) *Service_ListEntityMembers_Call {
+func (_c *Service_ListRevisions_Call) Run(run func(ctx context.Context, session authn.Session, pm re.RevisionPageMeta)) *Service_ListRevisions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -572,30 +1243,25 @@ func (_c *Service_ListEntityMembers_Call) Run(run func(ctx context.Context, sess
 		if args[1] != nil {
 			arg1 = args[1].(authn.Session)
 		}
-		var arg2 string
+		var arg2 re.RevisionPageMeta
 		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 roles.MembersRolePageQuery
-		if args[3] != nil {
-			arg3 = args[3].(roles.MembersRolePageQuery)
+			arg2 = args[2].(re.RevisionPageMeta)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *Service_ListEntityMembers_Call) Return(membersRolePage roles.MembersRolePage, err error) *Service_ListEntityMembers_Call {
-	_c.Call.Return(membersRolePage, err)
+func (_c *Service_ListRevisions_Call) Return(revisionPage re.RevisionPage, err error) *Service_ListRevisions_Call {
+	_c.Call.Return(revisionPage, err)
 	return _c
 }
 
-func (_c *Service_ListEntityMembers_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, entityID string, pq roles.MembersRolePageQuery) (roles.MembersRolePage, error)) *Service_ListEntityMembers_Call {
+func (_c *Service_ListRevisions_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, pm re.RevisionPageMeta) (re.RevisionPage, error)) *Service_ListRevisions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -804,6 +1470,69 @@ func (_c *Service_RemoveMemberFromAllRoles_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// RemovePipeline provides a mock function for the type Service
+func (_mock *Service) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePipeline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) error); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// Service_RemovePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePipeline'
+type Service_RemovePipeline_Call struct {
+	*mock.Call
+}
+
+// RemovePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) RemovePipeline(ctx interface{}, session interface{}, id interface{}) *Service_RemovePipeline_Call {
+	return &Service_RemovePipeline_Call{Call: _e.mock.On("RemovePipeline", ctx, session, id)}
+}
+
+func (_c *Service_RemovePipeline_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_RemovePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RemovePipeline_Call) Return(err error) *Service_RemovePipeline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *Service_RemovePipeline_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) error) *Service_RemovePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveRole provides a mock function for the type Service
 func (_mock *Service) RemoveRole(ctx context.Context, session authn.Session, entityID string, roleID string) error {
 	ret := _mock.Called(ctx, session, entityID, roleID)
@@ -1896,6 +2625,84 @@ func (_c *Service_RoleRemoveMembers_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// RollbackRule provides a mock function for the type Service
+func (_mock *Service) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error) {
+	ret := _mock.Called(ctx, session, id, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackRule")
+	}
+
+	var r0 re.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64) (re.Rule, error)); ok {
+		return returnFunc(ctx, session, id, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string, uint64) re.Rule); ok {
+		r0 = returnFunc(ctx, session, id, revision)
+	} else {
+		r0 = ret.Get(0).(re.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string, uint64) error); ok {
+		r1 = returnFunc(ctx, session, id, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_RollbackRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackRule'
+type Service_RollbackRule_Call struct {
+	*mock.Call
+}
+
+// RollbackRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+//   - revision uint64
+func (_e *Service_Expecter) RollbackRule(ctx interface{}, session interface{}, id interface{}, revision interface{}) *Service_RollbackRule_Call {
+	return &Service_RollbackRule_Call{Call: _e.mock.On("RollbackRule", ctx, session, id, revision)}
+}
+
+func (_c *Service_RollbackRule_Call) Run(run func(ctx context.Context, session authn.Session, id string, revision uint64)) *Service_RollbackRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 uint64
+		if args[3] != nil {
+			arg3 = args[3].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_RollbackRule_Call) Return(rule re.Rule, err error) *Service_RollbackRule_Call {
+	_c.Call.Return(rule, err)
+	return _c
+}
+
+func (_c *Service_RollbackRule_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string, revision uint64) (re.Rule, error)) *Service_RollbackRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartScheduler provides a mock function for the type Service
 func (_mock *Service) StartScheduler(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -1947,6 +2754,78 @@ func (_c *Service_StartScheduler_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// UpdatePipeline provides a mock function for the type Service
+func (_mock *Service) UpdatePipeline(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, session, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.Pipeline) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, session, p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, re.Pipeline) re.Pipeline); ok {
+		r0 = returnFunc(ctx, session, p)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, re.Pipeline) error); ok {
+		r1 = returnFunc(ctx, session, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_UpdatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipeline'
+type Service_UpdatePipeline_Call struct {
+	*mock.Call
+}
+
+// UpdatePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - p re.Pipeline
+func (_e *Service_Expecter) UpdatePipeline(ctx interface{}, session interface{}, p interface{}) *Service_UpdatePipeline_Call {
+	return &Service_UpdatePipeline_Call{Call: _e.mock.On("UpdatePipeline", ctx, session, p)}
+}
+
+func (_c *Service_UpdatePipeline_Call) Run(run func(ctx context.Context, session authn.Session, p re.Pipeline)) *Service_UpdatePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 re.Pipeline
+		if args[2] != nil {
+			arg2 = args[2].(re.Pipeline)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_UpdatePipeline_Call) Return(pipeline re.Pipeline, err error) *Service_UpdatePipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Service_UpdatePipeline_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, p re.Pipeline) (re.Pipeline, error)) *Service_UpdatePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRoleName provides a mock function for the type Service
 func (_mock *Service) UpdateRoleName(ctx context.Context, session authn.Session, entityID string, roleID string, newRoleName string) (roles.Role, error) {
 	ret := _mock.Called(ctx, session, entityID, roleID, newRoleName)
@@ -2247,6 +3126,78 @@ func (_c *Service_UpdateRuleTags_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// ViewPipeline provides a mock function for the type Service
+func (_mock *Service) ViewPipeline(ctx context.Context, session authn.Session, id string) (re.Pipeline, error) {
+	ret := _mock.Called(ctx, session, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewPipeline")
+	}
+
+	var r0 re.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) (re.Pipeline, error)); ok {
+		return returnFunc(ctx, session, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, authn.Session, string) re.Pipeline); ok {
+		r0 = returnFunc(ctx, session, id)
+	} else {
+		r0 = ret.Get(0).(re.Pipeline)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, authn.Session, string) error); ok {
+		r1 = returnFunc(ctx, session, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_ViewPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ViewPipeline'
+type Service_ViewPipeline_Call struct {
+	*mock.Call
+}
+
+// ViewPipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session authn.Session
+//   - id string
+func (_e *Service_Expecter) ViewPipeline(ctx interface{}, session interface{}, id interface{}) *Service_ViewPipeline_Call {
+	return &Service_ViewPipeline_Call{Call: _e.mock.On("ViewPipeline", ctx, session, id)}
+}
+
+func (_c *Service_ViewPipeline_Call) Run(run func(ctx context.Context, session authn.Session, id string)) *Service_ViewPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 authn.Session
+		if args[1] != nil {
+			arg1 = args[1].(authn.Session)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_ViewPipeline_Call) Return(pipeline re.Pipeline, err error) *Service_ViewPipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *Service_ViewPipeline_Call) RunAndReturn(run func(ctx context.Context, session authn.Session, id string) (re.Pipeline, error)) *Service_ViewPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ViewRule provides a mock function for the type Service
 func (_mock *Service) ViewRule(ctx context.Context, session authn.Session, id string, withRoles bool) (re.Rule, error) {
 	ret := _mock.Called(ctx, session, id, withRoles)
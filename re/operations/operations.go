@@ -18,6 +18,10 @@ const (
 	OpListRules
 	OpEnableRule
 	OpDisableRule
+	OpAddPipeline
+	OpViewPipeline
+	OpUpdatePipeline
+	OpRemovePipeline
 )
 
 func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
@@ -58,5 +62,21 @@ func OperationDetails() map[permissions.Operation]permissions.OperationDetails {
 			Name:               "disable",
 			PermissionRequired: true,
 		},
+		OpAddPipeline: {
+			Name:               "pipeline_create",
+			PermissionRequired: true,
+		},
+		OpViewPipeline: {
+			Name:               "pipeline_view",
+			PermissionRequired: true,
+		},
+		OpUpdatePipeline: {
+			Name:               "pipeline_update",
+			PermissionRequired: true,
+		},
+		OpRemovePipeline: {
+			Name:               "pipeline_delete",
+			PermissionRequired: true,
+		},
 	}
 }
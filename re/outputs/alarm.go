@@ -16,39 +16,66 @@ import (
 type Alarm struct {
 	AlarmsPub messaging.Publisher `json:"-"`
 	RuleID    string              `json:"rule_id"`
+	// DedupKey lists the alarm fields the repeated alarms are matched on.
+	// The default fields are used if it's empty, unless the logic sets
+	// the alarm dedup key.
+	DedupKey []string `json:"dedup_key,omitempty"`
+}
+
+func (a *Alarm) Validate() error {
+	return alarms.ValidateDedupFields(a.DedupKey)
 }
 
 func (a *Alarm) Run(ctx context.Context, msg *messaging.Message, val any) error {
-	data, err := json.Marshal(val)
+	alarmsList, err := a.alarms(msg, val)
 	if err != nil {
 		return err
 	}
 
+	for _, alarm := range alarmsList {
+		if err := a.processAlarm(ctx, msg, alarm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Render returns the alarms that would be published.
+func (a *Alarm) Render(msg *messaging.Message, val any) (any, error) {
+	return a.alarms(msg, val)
+}
+
+func (a *Alarm) alarms(msg *messaging.Message, val any) ([]alarms.Alarm, error) {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+
 	var alarmsList []alarms.Alarm
 	if err := json.Unmarshal(data, &alarmsList); err != nil {
 		var single alarms.Alarm
 		if err := json.Unmarshal(data, &single); err != nil {
-			return err
+			return nil, err
 		}
 		alarmsList = []alarms.Alarm{single}
 	}
 
-	for _, alarm := range alarmsList {
-		if err := a.processAlarm(ctx, msg, alarm); err != nil {
-			return err
+	for i := range alarmsList {
+		alarmsList[i].RuleID = a.RuleID
+		alarmsList[i].DomainID = msg.Domain
+		alarmsList[i].ClientID = msg.ClientIdentity()
+		alarmsList[i].ChannelID = msg.Channel
+		alarmsList[i].Subtopic = msg.Subtopic
+		if alarmsList[i].DedupKey == "" {
+			alarmsList[i].DedupKey = alarms.DedupKey(alarmsList[i], a.DedupKey)
 		}
 	}
 
-	return nil
+	return alarmsList, nil
 }
 
 func (a *Alarm) processAlarm(ctx context.Context, msg *messaging.Message, alarm alarms.Alarm) error {
-	alarm.RuleID = a.RuleID
-	alarm.DomainID = msg.Domain
-	alarm.ClientID = msg.ClientIdentity()
-	alarm.ChannelID = msg.Channel
-	alarm.Subtopic = msg.Subtopic
-
 	var buf bytes.Buffer
 	if err := gob.NewEncoder(&buf).Encode(alarm); err != nil {
 		return err
@@ -73,7 +100,12 @@ func (a *Alarm) processAlarm(ctx context.Context, msg *messaging.Message, alarm
 }
 
 func (a *Alarm) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]any{
+	m := map[string]any{
 		"type": AlarmsType.String(),
-	})
+	}
+	if len(a.DedupKey) > 0 {
+		m["dedup_key"] = a.DedupKey
+	}
+
+	return json.Marshal(m)
 }
@@ -0,0 +1,380 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/messaging/mqtt"
+	"github.com/absmach/magistrala/pkg/messaging/nats"
+	broker "github.com/nats-io/nats.go"
+)
+
+const (
+	MQTTProtocol = "mqtt"
+	NATSProtocol = "nats"
+
+	bridgeTimeout     = 10 * time.Second
+	defBridgeIdleTime = 10 * time.Minute
+	maxBridgeQoS      = 2
+)
+
+var (
+	ErrInvalidBridgeProtocol = errors.New("invalid bridge protocol, only mqtt and nats are supported")
+	ErrInvalidBridgeURL      = errors.New("invalid bridge URL")
+	ErrInvalidBridgeQoS      = errors.New("invalid bridge QoS, must be 0, 1 or 2")
+	ErrInvalidBridgeTopic    = errors.New("invalid bridge topic")
+	ErrInvalidBridgePayload  = errors.New("invalid bridge payload template")
+	errBridgePoolClosed      = errors.New("bridge connection pool is closed")
+
+	mqttSchemes = map[string]bool{"tcp": true, "mqtt": true, "ssl": true, "tls": true, "mqtts": true, "ws": true, "wss": true}
+	natsSchemes = map[string]bool{"nats": true, "tls": true}
+)
+
+// Bridge publishes the rule result to an external MQTT or NATS broker.
+// Connections are shared between the runs of the same rule through the Pool.
+type Bridge struct {
+	Protocol string `json:"protocol"`
+	URL      string `json:"url"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// QoS is the MQTT quality of service. It's ignored for NATS.
+	QoS uint8 `json:"qos,omitempty"`
+	// Topic is the topic or subject template.
+	Topic string `json:"topic"`
+	// Payload is the message payload template. The JSON encoded result is sent if it's empty.
+	Payload string `json:"payload,omitempty"`
+
+	RuleID string      `json:"-"`
+	Pool   *BridgePool `json:"-"`
+}
+
+func (b *Bridge) Run(ctx context.Context, msg *messaging.Message, val any) error {
+	topic, payload, err := b.render(msg, val)
+	if err != nil {
+		return err
+	}
+	if b.Pool == nil {
+		return errBridgePoolClosed
+	}
+
+	pub, err := b.Pool.Get(ctx, b.RuleID, *b)
+	if err != nil {
+		return err
+	}
+
+	return pub.Publish(ctx, topic, &messaging.Message{Payload: payload})
+}
+
+// Render returns the message that would be published.
+func (b *Bridge) Render(msg *messaging.Message, val any) (any, error) {
+	topic, payload, err := b.render(msg, val)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := map[string]any{
+		"protocol": b.protocol(),
+		"url":      b.URL,
+		"topic":    topic,
+		"payload":  string(payload),
+	}
+	if b.protocol() == MQTTProtocol {
+		ret["qos"] = b.QoS
+	}
+
+	return ret, nil
+}
+
+// Validate checks the bridge configuration.
+func (b *Bridge) Validate() error {
+	var schemes map[string]bool
+	switch b.protocol() {
+	case MQTTProtocol:
+		schemes = mqttSchemes
+	case NATSProtocol:
+		schemes = natsSchemes
+	default:
+		return ErrInvalidBridgeProtocol
+	}
+	u, err := url.Parse(b.URL)
+	if err != nil || !schemes[u.Scheme] || u.Host == "" {
+		return ErrInvalidBridgeURL
+	}
+	if b.QoS > maxBridgeQoS {
+		return ErrInvalidBridgeQoS
+	}
+	if strings.TrimSpace(b.Topic) == "" {
+		return ErrInvalidBridgeTopic
+	}
+	if _, err := template.New("topic").Parse(b.Topic); err != nil {
+		return errors.Wrap(ErrInvalidBridgeTopic, err)
+	}
+	if _, err := template.New("payload").Parse(b.Payload); err != nil {
+		return errors.Wrap(ErrInvalidBridgePayload, err)
+	}
+
+	return nil
+}
+
+// Secrets returns the credentials that are stored encrypted.
+func (b *Bridge) Secrets() []*string {
+	return []*string{&b.Password}
+}
+
+func (b *Bridge) protocol() string {
+	return strings.ToLower(b.Protocol)
+}
+
+// key identifies the connection settings, so the rule updates
+// that change the endpoint or credentials open a new connection.
+func (b *Bridge) key() string {
+	h := sha256.New()
+	for _, s := range []string{b.protocol(), b.URL, b.Username, b.Password, fmt.Sprint(b.QoS)} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (b *Bridge) render(msg *messaging.Message, val any) (string, []byte, error) {
+	templData := templateVal{
+		Message: msg,
+		Result:  val,
+	}
+
+	topic, err := execTemplate("topic", b.Topic, templData)
+	if err != nil {
+		return "", nil, errors.Wrap(ErrInvalidBridgeTopic, err)
+	}
+	if strings.TrimSpace(string(topic)) == "" {
+		return "", nil, ErrInvalidBridgeTopic
+	}
+
+	if b.Payload == "" {
+		payload, err := json.Marshal(val)
+		if err != nil {
+			return "", nil, err
+		}
+		return string(topic), payload, nil
+	}
+
+	payload, err := execTemplate("payload", b.Payload, templData)
+	if err != nil {
+		return "", nil, errors.Wrap(ErrInvalidBridgePayload, err)
+	}
+
+	return string(topic), payload, nil
+}
+
+func execTemplate(name, text string, data templateVal) ([]byte, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	if err := tmpl.Execute(&output, data); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+func (b *Bridge) MarshalJSON() ([]byte, error) {
+	m := map[string]any{
+		"type":     BridgeType.String(),
+		"protocol": b.protocol(),
+		"url":      b.URL,
+		"topic":    b.Topic,
+	}
+	if b.Username != "" {
+		m["username"] = b.Username
+	}
+	if b.Password != "" {
+		m["password"] = b.Password
+	}
+	if b.QoS != 0 {
+		m["qos"] = b.QoS
+	}
+	if b.Payload != "" {
+		m["payload"] = b.Payload
+	}
+
+	return json.Marshal(m)
+}
+
+// BridgeFactory creates a publisher connected to the bridge endpoint.
+type BridgeFactory func(ctx context.Context, b Bridge, clientID string) (messaging.Publisher, error)
+
+type bridgeConn struct {
+	pub      messaging.Publisher
+	lastUsed time.Time
+}
+
+// BridgePool keeps the bridge connections of the rules, so the messages
+// are not published over a new connection each time. Connections that
+// are not used for the idle time are closed.
+type BridgePool struct {
+	mu       sync.Mutex
+	factory  BridgeFactory
+	idleTime time.Duration
+	closed   bool
+	// conns maps the rule ID to the connections of its bridges.
+	conns map[string]map[string]*bridgeConn
+}
+
+// NewBridgePool returns a bridge connection pool. The MQTT and NATS
+// publishers are used if the factory is nil.
+func NewBridgePool(factory BridgeFactory, idleTime time.Duration) *BridgePool {
+	if factory == nil {
+		factory = newBridgePublisher
+	}
+	if idleTime <= 0 {
+		idleTime = defBridgeIdleTime
+	}
+
+	return &BridgePool{
+		factory:  factory,
+		idleTime: idleTime,
+		conns:    make(map[string]map[string]*bridgeConn),
+	}
+}
+
+// Get returns the publisher of the rule bridge, connecting if needed.
+func (p *BridgePool) Get(ctx context.Context, ruleID string, b Bridge) (messaging.Publisher, error) {
+	key := b.key()
+	now := time.Now()
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, errBridgePoolClosed
+	}
+	p.evictIdle(now)
+	if c, ok := p.conns[ruleID][key]; ok {
+		c.lastUsed = now
+		p.mu.Unlock()
+		return c.pub, nil
+	}
+	p.mu.Unlock()
+
+	// Connect without holding the lock, so slow brokers don't block other rules.
+	pub, err := p.factory(ctx, b, clientID(ruleID))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		pub.Close()
+		return nil, errBridgePoolClosed
+	}
+	// Another run of the rule may have connected in the meantime.
+	if c, ok := p.conns[ruleID][key]; ok {
+		pub.Close()
+		c.lastUsed = now
+		return c.pub, nil
+	}
+	if p.conns[ruleID] == nil {
+		p.conns[ruleID] = make(map[string]*bridgeConn)
+	}
+	p.conns[ruleID][key] = &bridgeConn{pub: pub, lastUsed: now}
+
+	return pub, nil
+}
+
+// Remove closes the connections of the rule.
+func (p *BridgePool) Remove(ruleID string) error {
+	p.mu.Lock()
+	conns := p.conns[ruleID]
+	delete(p.conns, ruleID)
+	p.mu.Unlock()
+
+	var err error
+	for _, c := range conns {
+		if e := c.pub.Close(); e != nil {
+			err = errors.Wrap(e, err)
+		}
+	}
+
+	return err
+}
+
+// Close closes all the connections. The pool can't be used after it's closed.
+func (p *BridgePool) Close() error {
+	p.mu.Lock()
+	conns := p.conns
+	p.conns = make(map[string]map[string]*bridgeConn)
+	p.closed = true
+	p.mu.Unlock()
+
+	var err error
+	for _, rc := range conns {
+		for _, c := range rc {
+			if e := c.pub.Close(); e != nil {
+				err = errors.Wrap(e, err)
+			}
+		}
+	}
+
+	return err
+}
+
+// evictIdle closes the idle connections. It must be called with the lock held.
+func (p *BridgePool) evictIdle(now time.Time) {
+	for ruleID, rc := range p.conns {
+		for key, c := range rc {
+			if now.Sub(c.lastUsed) > p.idleTime {
+				c.pub.Close()
+				delete(rc, key)
+			}
+		}
+		if len(rc) == 0 {
+			delete(p.conns, ruleID)
+		}
+	}
+}
+
+// clientID returns a unique client ID, so the connections of the
+// rules engine replicas don't disconnect each other.
+func clientID(ruleID string) string {
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+
+	return fmt.Sprintf("mg-re-%s-%s", ruleID, hex.EncodeToString(suffix))
+}
+
+func newBridgePublisher(ctx context.Context, b Bridge, clientID string) (messaging.Publisher, error) {
+	switch b.protocol() {
+	case MQTTProtocol:
+		return mqtt.NewPublisher(b.URL, b.Username, b.Password, b.QoS, bridgeTimeout, mqtt.ClientID(clientID))
+	case NATSProtocol:
+		opts := []broker.Option{broker.Name(clientID), broker.Timeout(bridgeTimeout)}
+		switch {
+		case b.Username != "":
+			opts = append(opts, broker.UserInfo(b.Username, b.Password))
+		case b.Password != "":
+			opts = append(opts, broker.Token(b.Password))
+		}
+		return nats.NewPublisher(ctx, b.URL, nats.Raw(), nats.ConnOptions(opts...))
+	default:
+		return nil, ErrInvalidBridgeProtocol
+	}
+}
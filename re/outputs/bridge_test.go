@@ -0,0 +1,252 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/messaging/mocks"
+	"github.com/absmach/magistrala/re/outputs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errConnect = errors.New("failed to connect")
+
+type bridgeFactory struct {
+	pubs    []*mocks.PubSub
+	clients []string
+	err     error
+}
+
+func (f *bridgeFactory) new(ctx context.Context, b outputs.Bridge, clientID string) (messaging.Publisher, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	pub := new(mocks.PubSub)
+	pub.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	pub.On("Close").Return(nil)
+	f.pubs = append(f.pubs, pub)
+	f.clients = append(f.clients, clientID)
+
+	return pub, nil
+}
+
+func TestBridgeRun(t *testing.T) {
+	msg := &messaging.Message{
+		Domain:    "domain",
+		Channel:   "channel",
+		Subtopic:  "temperature",
+		Publisher: "publisher",
+		Payload:   []byte(`{"temperature": 35}`),
+	}
+	val := map[string]any{"temperature": 35}
+
+	cases := []struct {
+		desc    string
+		bridge  outputs.Bridge
+		topic   string
+		payload string
+		err     error
+	}{
+		{
+			desc: "publish result as JSON",
+			bridge: outputs.Bridge{
+				Protocol: outputs.MQTTProtocol,
+				URL:      "tcp://broker:1883",
+				Topic:    "devices/{{.Message.Channel}}/{{.Message.Subtopic}}",
+			},
+			topic:   "devices/channel/temperature",
+			payload: `{"temperature":35}`,
+		},
+		{
+			desc: "publish templated payload",
+			bridge: outputs.Bridge{
+				Protocol: outputs.NATSProtocol,
+				URL:      "nats://broker:4222",
+				Topic:    "devices.{{.Message.Publisher}}",
+				Payload:  `{"t": {{index .Result "temperature"}}}`,
+			},
+			topic:   "devices.publisher",
+			payload: `{"t": 35}`,
+		},
+		{
+			desc: "publish with empty topic",
+			bridge: outputs.Bridge{
+				Protocol: outputs.MQTTProtocol,
+				URL:      "tcp://broker:1883",
+				Topic:    "{{.Message.Protocol}}",
+			},
+			err: outputs.ErrInvalidBridgeTopic,
+		},
+		{
+			desc: "publish with invalid payload template",
+			bridge: outputs.Bridge{
+				Protocol: outputs.MQTTProtocol,
+				URL:      "tcp://broker:1883",
+				Topic:    "devices",
+				Payload:  "{{.Result",
+			},
+			err: outputs.ErrInvalidBridgePayload,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := &bridgeFactory{}
+			pool := outputs.NewBridgePool(f.new, time.Minute)
+			defer pool.Close()
+			tc.bridge.Pool = pool
+			tc.bridge.RuleID = "rule"
+
+			err := tc.bridge.Run(context.Background(), msg, val)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if tc.err != nil {
+				assert.Empty(t, f.pubs)
+				return
+			}
+			assert.Len(t, f.pubs, 1)
+			f.pubs[0].AssertCalled(t, "Publish", mock.Anything, tc.topic, &messaging.Message{Payload: []byte(tc.payload)})
+		})
+	}
+}
+
+func TestBridgePool(t *testing.T) {
+	b := outputs.Bridge{Protocol: outputs.MQTTProtocol, URL: "tcp://broker:1883", Username: "user", Password: "pass", Topic: "devices"}
+	other := b
+	other.Password = "new-pass"
+
+	f := &bridgeFactory{}
+	pool := outputs.NewBridgePool(f.new, time.Minute)
+
+	pub1, err := pool.Get(context.Background(), "rule1", b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	pub2, err := pool.Get(context.Background(), "rule1", b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Same(t, pub1, pub2, "expected the connection to be reused")
+
+	pub3, err := pool.Get(context.Background(), "rule2", b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.NotSame(t, pub1, pub3, "expected a connection per rule")
+	assert.NotEqual(t, f.clients[0], f.clients[1], "expected unique client IDs")
+
+	pub4, err := pool.Get(context.Background(), "rule1", other)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.NotSame(t, pub1, pub4, "expected a new connection for the changed credentials")
+	assert.Len(t, f.pubs, 3)
+
+	err = pool.Remove("rule1")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	f.pubs[0].AssertCalled(t, "Close")
+	f.pubs[2].AssertCalled(t, "Close")
+	f.pubs[1].AssertNotCalled(t, "Close")
+
+	_, err = pool.Get(context.Background(), "rule1", b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Len(t, f.pubs, 4)
+
+	err = pool.Close()
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	f.pubs[1].AssertCalled(t, "Close")
+	f.pubs[3].AssertCalled(t, "Close")
+
+	_, err = pool.Get(context.Background(), "rule1", b)
+	assert.NotNil(t, err, "expected error getting connection from closed pool")
+
+	failing := outputs.NewBridgePool((&bridgeFactory{err: errConnect}).new, time.Minute)
+	_, err = failing.Get(context.Background(), "rule1", b)
+	assert.True(t, errors.Contains(err, errConnect), fmt.Sprintf("expected %s got %s", errConnect, err))
+}
+
+func TestBridgePoolIdle(t *testing.T) {
+	b := outputs.Bridge{Protocol: outputs.NATSProtocol, URL: "nats://broker:4222", Topic: "devices"}
+	f := &bridgeFactory{}
+	pool := outputs.NewBridgePool(f.new, 10*time.Millisecond)
+	defer pool.Close()
+
+	_, err := pool.Get(context.Background(), "rule1", b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	time.Sleep(20 * time.Millisecond)
+	_, err = pool.Get(context.Background(), "rule2", b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	f.pubs[0].AssertCalled(t, "Close")
+}
+
+func TestBridgeValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		bridge outputs.Bridge
+		err    error
+	}{
+		{
+			desc:   "valid MQTT bridge",
+			bridge: outputs.Bridge{Protocol: "MQTT", URL: "ssl://broker:8883", QoS: 1, Topic: "devices/{{.Message.Channel}}"},
+		},
+		{
+			desc:   "valid NATS bridge",
+			bridge: outputs.Bridge{Protocol: outputs.NATSProtocol, URL: "nats://broker:4222", Topic: "devices"},
+		},
+		{
+			desc:   "bridge with invalid protocol",
+			bridge: outputs.Bridge{Protocol: "amqp", URL: "amqp://broker:5672", Topic: "devices"},
+			err:    outputs.ErrInvalidBridgeProtocol,
+		},
+		{
+			desc:   "bridge with URL scheme of another protocol",
+			bridge: outputs.Bridge{Protocol: outputs.NATSProtocol, URL: "tcp://broker:1883", Topic: "devices"},
+			err:    outputs.ErrInvalidBridgeURL,
+		},
+		{
+			desc:   "bridge without host",
+			bridge: outputs.Bridge{Protocol: outputs.MQTTProtocol, URL: "tcp://", Topic: "devices"},
+			err:    outputs.ErrInvalidBridgeURL,
+		},
+		{
+			desc:   "bridge with invalid QoS",
+			bridge: outputs.Bridge{Protocol: outputs.MQTTProtocol, URL: "tcp://broker:1883", QoS: 3, Topic: "devices"},
+			err:    outputs.ErrInvalidBridgeQoS,
+		},
+		{
+			desc:   "bridge without topic",
+			bridge: outputs.Bridge{Protocol: outputs.MQTTProtocol, URL: "tcp://broker:1883"},
+			err:    outputs.ErrInvalidBridgeTopic,
+		},
+		{
+			desc:   "bridge with invalid topic template",
+			bridge: outputs.Bridge{Protocol: outputs.MQTTProtocol, URL: "tcp://broker:1883", Topic: "{{.Message"},
+			err:    outputs.ErrInvalidBridgeTopic,
+		},
+		{
+			desc:   "bridge with invalid payload template",
+			bridge: outputs.Bridge{Protocol: outputs.MQTTProtocol, URL: "tcp://broker:1883", Topic: "devices", Payload: "{{.Result"},
+			err:    outputs.ErrInvalidBridgePayload,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.bridge.Validate()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestBridgeJSON(t *testing.T) {
+	data := []byte(`{"type":"bridge","protocol":"mqtt","url":"tcp://broker:1883","username":"user","password":"pass","qos":1,"topic":"devices/{{.Message.Channel}}"}`)
+
+	var b outputs.Bridge
+	err := json.Unmarshal(data, &b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, uint8(1), b.QoS)
+
+	out, err := json.Marshal(&b)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.JSONEq(t, string(data), string(out))
+}
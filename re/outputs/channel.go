@@ -33,6 +33,7 @@ func (p *ChannelPublisher) Run(ctx context.Context, msg *messaging.Message, val
 		Subtopic:  p.Topic,
 		Protocol:  protocol,
 		Payload:   data,
+		Hops:      msg.GetHops() + 1,
 	}
 
 	topic := messaging.EncodeTopicSuffix(msg.Domain, p.Channel, p.Topic)
@@ -43,6 +44,19 @@ func (p *ChannelPublisher) Run(ctx context.Context, msg *messaging.Message, val
 	return nil
 }
 
+// Render returns the topic and the payload that would be published.
+func (p *ChannelPublisher) Render(msg *messaging.Message, val any) (any, error) {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"topic":   messaging.EncodeTopicSuffix(msg.Domain, p.Channel, p.Topic),
+		"payload": json.RawMessage(data),
+	}, nil
+}
+
 func (cp *ChannelPublisher) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]string{
 		"type":    ChannelsType.String(),
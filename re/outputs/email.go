@@ -21,6 +21,32 @@ type Email struct {
 }
 
 func (e *Email) Run(ctx context.Context, msg *messaging.Message, val any) error {
+	content, err := e.content(msg, val)
+	if err != nil {
+		return err
+	}
+
+	if err := e.Emailer.SendEmailNotification(e.To, "", e.Subject, "", "", content, "", make(map[string][]byte)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Render returns the email that would be sent.
+func (e *Email) Render(msg *messaging.Message, val any) (any, error) {
+	content, err := e.content(msg, val)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"to":      e.To,
+		"subject": e.Subject,
+		"content": content,
+	}, nil
+}
+
+func (e *Email) content(msg *messaging.Message, val any) (string, error) {
 	templData := templateVal{
 		Message: msg,
 		Result:  val,
@@ -28,20 +54,15 @@ func (e *Email) Run(ctx context.Context, msg *messaging.Message, val any) error
 
 	tmpl, err := template.New("email").Parse(e.Content)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	var output bytes.Buffer
 	if err := tmpl.Execute(&output, templData); err != nil {
-		return err
+		return "", err
 	}
 
-	content := output.String()
-
-	if err := e.Emailer.SendEmailNotification(e.To, "", e.Subject, "", "", content, "", make(map[string][]byte)); err != nil {
-		return err
-	}
-	return nil
+	return output.String(), nil
 }
 
 func (e *Email) MarshalJSON() ([]byte, error) {
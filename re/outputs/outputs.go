@@ -27,10 +27,12 @@ const (
 	EmailType
 	SaveRemotePgType
 	SlackType
+	WebhookType
+	BridgeType
 )
 
 var (
-	scriptKindToString = [...]string{"channels", "alarms", "save_senml", "email", "save_remote_pg", "slack"}
+	scriptKindToString = [...]string{"channels", "alarms", "save_senml", "email", "save_remote_pg", "slack", "webhook", "bridge"}
 	stringToScriptKind = map[string]OutputType{
 		"channels":       ChannelsType,
 		"alarms":         AlarmsType,
@@ -38,6 +40,8 @@ var (
 		"email":          EmailType,
 		"save_remote_pg": SaveRemotePgType,
 		"slack":          SlackType,
+		"webhook":        WebhookType,
+		"bridge":         BridgeType,
 	}
 )
 
@@ -6,17 +6,53 @@ package outputs
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/messaging"
-	_ "github.com/jackc/pgx/v5/stdlib" // required for SQL access
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/jmoiron/sqlx"
 )
 
+const (
+	defPgPort          = 5432
+	defPgSSLMode       = "disable"
+	defPgFlushInterval = time.Second
+	maxPgFlushInterval = time.Minute
+	maxPgBatchSize     = 1000
+	// maxPgParams is the maximum number of the query parameters supported by PostgreSQL.
+	maxPgParams = 65535
+)
+
+var (
+	ErrInvalidPgTable         = errors.New("invalid table name")
+	ErrInvalidPgColumn        = errors.New("invalid column name")
+	ErrInvalidPgSSLMode       = errors.New("invalid sslmode, must be one of disable, allow, prefer, require, verify-ca or verify-full")
+	ErrInvalidPgCert          = errors.New("invalid PostgreSQL certificate or key, only PEM data is supported")
+	ErrInvalidPgBatchSize     = errors.New("invalid batch size, must be at most 1000")
+	ErrInvalidPgFlushInterval = errors.New("invalid flush interval, must be between 0 and 1m")
+	errPgConnect              = errors.New("failed to connect to DB")
+	errPgInsert               = errors.New("failed to insert data")
+
+	// Identifiers are not quoted, so only the plain ones are accepted to prevent SQL injection.
+	pgIdentRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	pgSSLModes   = map[string]bool{"disable": true, "allow": true, "prefer": true, "require": true, "verify-ca": true, "verify-full": true}
+)
+
 type Postgres struct {
 	Host     string `json:"host"`
 	Port     int    `json:"port"`
@@ -25,9 +61,89 @@ type Postgres struct {
 	Database string `json:"database"`
 	Table    string `json:"table"`
 	Mapping  string `json:"mapping"`
+	// SSLMode is the libpq sslmode, "disable" by default.
+	SSLMode string `json:"ssl_mode,omitempty"`
+	// SSLRootCert is the PEM encoded CA used with the verify-ca and verify-full modes.
+	SSLRootCert string `json:"ssl_root_cert,omitempty"`
+	SSLCert     string `json:"ssl_cert,omitempty"`
+	SSLKey      string `json:"ssl_key,omitempty"`
+	// BatchSize is the number of rows inserted at once. Rows are inserted
+	// immediately if it's 0 or 1, otherwise they are inserted when the batch
+	// is full or when the flush interval passes, whichever comes first.
+	BatchSize     uint          `json:"batch_size,omitempty"`
+	FlushInterval time.Duration `json:"flush_interval,omitempty"`
+
+	RuleID string        `json:"-"`
+	Pool   *PostgresPool `json:"-"`
 }
 
 func (p *Postgres) Run(ctx context.Context, msg *messaging.Message, val any) error {
+	columns, err := p.columns(msg, val)
+	if err != nil {
+		return err
+	}
+	for c := range columns {
+		if !pgIdentRegex.MatchString(c) {
+			return errors.Wrap(ErrInvalidPgColumn, errors.New(c))
+		}
+	}
+	if p.Pool == nil {
+		return errPgPoolClosed
+	}
+	if p.BatchSize > 1 {
+		return p.Pool.Enqueue(ctx, p.RuleID, *p, columns)
+	}
+
+	return p.Pool.Insert(ctx, *p, []map[string]any{columns})
+}
+
+// Render returns the table and the columns that would be inserted.
+func (p *Postgres) Render(msg *messaging.Message, val any) (any, error) {
+	columns, err := p.columns(msg, val)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"table":   p.Table,
+		"columns": columns,
+	}, nil
+}
+
+// Validate checks the PostgreSQL output configuration.
+func (p *Postgres) Validate() error {
+	if !validPgTable(p.Table) {
+		return ErrInvalidPgTable
+	}
+	if !pgSSLModes[p.sslMode()] {
+		return ErrInvalidPgSSLMode
+	}
+	// Only the PEM data is accepted, so the rules can't read the files of the service.
+	for _, c := range []string{p.SSLRootCert, p.SSLCert, p.SSLKey} {
+		if c != "" && !strings.HasPrefix(strings.TrimSpace(c), pemPrefix) {
+			return ErrInvalidPgCert
+		}
+	}
+	// The key may be omitted in the updates that keep the stored one.
+	if p.SSLKey != "" && p.SSLCert == "" {
+		return ErrInvalidPgCert
+	}
+	if p.BatchSize > maxPgBatchSize {
+		return ErrInvalidPgBatchSize
+	}
+	if p.FlushInterval < 0 || p.FlushInterval > maxPgFlushInterval {
+		return ErrInvalidPgFlushInterval
+	}
+
+	return nil
+}
+
+// Secrets returns the credentials that are stored encrypted.
+func (p *Postgres) Secrets() []*string {
+	return []*string{&p.Password, &p.SSLKey}
+}
+
+func (p *Postgres) columns(msg *messaging.Message, val any) (map[string]any, error) {
 	templData := templateVal{
 		Message: msg,
 		Result:  val,
@@ -35,73 +151,233 @@ func (p *Postgres) Run(ctx context.Context, msg *messaging.Message, val any) err
 
 	tmpl, err := template.New("postgres").Parse(p.Mapping)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var output bytes.Buffer
 	if err := tmpl.Execute(&output, templData); err != nil {
-		return err
+		return nil, err
 	}
 
-	mapping := output.String()
 	var columns map[string]any
-	if err = json.Unmarshal([]byte(mapping), &columns); err != nil {
-		return err
+	if err = json.Unmarshal(output.Bytes(), &columns); err != nil {
+		return nil, err
 	}
 
-	connStr := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
-		p.Host, p.Port, p.User, p.Password, p.Database,
-	)
+	return columns, nil
+}
+
+func (p *Postgres) sslMode() string {
+	if p.SSLMode == "" {
+		return defPgSSLMode
+	}
+	return strings.ToLower(p.SSLMode)
+}
+
+func (p *Postgres) flushInterval() time.Duration {
+	if p.FlushInterval == 0 {
+		return defPgFlushInterval
+	}
+	return p.FlushInterval
+}
 
-	db, err := sqlx.Open("pgx", connStr)
+// key identifies the database connection settings.
+func (p *Postgres) key() string {
+	h := sha256.New()
+	for _, s := range []string{p.Host, strconv.Itoa(p.Port), p.User, p.Password, p.Database, p.sslMode(), p.SSLRootCert, p.SSLCert, p.SSLKey} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (p *Postgres) connConfig() (*pgx.ConnConfig, error) {
+	port := p.Port
+	if port == 0 {
+		port = defPgPort
+	}
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(p.User, p.Password),
+		Host:     net.JoinHostPort(p.Host, strconv.Itoa(port)),
+		Path:     "/" + p.Database,
+		RawQuery: url.Values{"sslmode": {p.sslMode()}}.Encode(),
+	}
+	cfg, err := pgx.ParseConfig(u.String())
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if p.SSLRootCert == "" && p.SSLCert == "" {
+		return cfg, nil
 	}
-	defer db.Close()
 
-	if err := db.Ping(); err != nil {
-		return errors.Wrap(errors.New("failed to connect to DB"), err)
+	// pgx reads the certificates from files, so the PEM data is set on the TLS configs directly.
+	var roots *x509.CertPool
+	if p.SSLRootCert != "" {
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM([]byte(p.SSLRootCert)) {
+			return nil, ErrInvalidPgCert
+		}
+	}
+	var certs []tls.Certificate
+	if p.SSLCert != "" {
+		cert, err := tls.X509KeyPair([]byte(p.SSLCert), []byte(p.SSLKey))
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidPgCert, err)
+		}
+		certs = append(certs, cert)
+	}
+	tlsConfigs := []*tls.Config{cfg.TLSConfig}
+	for _, fb := range cfg.Fallbacks {
+		tlsConfigs = append(tlsConfigs, fb.TLSConfig)
+	}
+	for _, tc := range tlsConfigs {
+		if tc == nil {
+			continue
+		}
+		if roots != nil {
+			tc.RootCAs = roots
+		}
+		tc.Certificates = certs
 	}
 
-	var (
-		cols         []string
-		values       []any
-		placeholders []string
-	)
+	return cfg, nil
+}
+
+func validPgTable(table string) bool {
+	parts := strings.Split(table, ".")
+	if len(parts) > 2 {
+		return false
+	}
+	for _, part := range parts {
+		if !pgIdentRegex.MatchString(part) {
+			return false
+		}
+	}
+
+	return true
+}
 
-	i := 1
-	for k, v := range columns {
-		cols = append(cols, k)
-		values = append(values, v)
-		placeholders = append(placeholders, fmt.Sprintf("$%d", i))
-		i++
+// insertQueries returns the multi-row inserts of the rows. Rows with the same
+// columns are inserted together, split so they don't exceed the parameters limit.
+func insertQueries(table string, rows []map[string]any) ([]string, [][]any) {
+	groups := make(map[string][]map[string]any)
+	var keys []string
+	for _, row := range rows {
+		cols := make([]string, 0, len(row))
+		for c := range row {
+			cols = append(cols, c)
+		}
+		sort.Strings(cols)
+		key := strings.Join(cols, ", ")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], row)
 	}
 
-	q := fmt.Sprintf(
-		`INSERT INTO %s (%s) VALUES (%s)`,
-		p.Table,
-		strings.Join(cols, ", "),
-		strings.Join(placeholders, ", "),
+	var (
+		queries []string
+		args    [][]any
 	)
+	for _, key := range keys {
+		cols := strings.Split(key, ", ")
+		group := groups[key]
+		chunk := max(maxPgParams/len(cols), 1)
+		for start := 0; start < len(group); start += chunk {
+			end := min(start+chunk, len(group))
+			var (
+				values       []any
+				placeholders []string
+			)
+			for _, row := range group[start:end] {
+				ph := make([]string, len(cols))
+				for i, c := range cols {
+					values = append(values, row[c])
+					ph[i] = fmt.Sprintf("$%d", len(values))
+				}
+				placeholders = append(placeholders, "("+strings.Join(ph, ", ")+")")
+			}
+			queries = append(queries, fmt.Sprintf(`INSERT INTO %s (%s) VALUES %s`, table, key, strings.Join(placeholders, ", ")))
+			args = append(args, values)
+		}
+	}
 
-	_, err = db.Exec(q, values...)
+	return queries, args
+}
+
+// OpenPostgres connects to the database of the output.
+func OpenPostgres(ctx context.Context, p Postgres) (PostgresDB, error) {
+	cfg, err := p.connConfig()
 	if err != nil {
-		return errors.Wrap(errors.New("failed to insert data"), err)
+		return nil, errors.Wrap(errPgConnect, err)
+	}
+	db := sqlx.NewDb(stdlib.OpenDB(*cfg), "pgx")
+	db.SetMaxOpenConns(pgMaxOpenConns)
+	db.SetMaxIdleConns(pgMaxIdleConns)
+	db.SetConnMaxIdleTime(pgConnMaxIdleTime)
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, errors.Wrap(errPgConnect, err)
 	}
 
-	return nil
+	return db, nil
 }
 
 func (p *Postgres) MarshalJSON() ([]byte, error) {
-	return json.Marshal(map[string]any{
+	m := map[string]any{
 		"type":     SaveRemotePgType.String(),
 		"host":     p.Host,
 		"port":     p.Port,
 		"user":     p.User,
-		"password": p.Password,
 		"database": p.Database,
 		"table":    p.Table,
 		"mapping":  p.Mapping,
-	})
+	}
+	if p.Password != "" {
+		m["password"] = p.Password
+	}
+	if p.SSLMode != "" {
+		m["ssl_mode"] = p.sslMode()
+	}
+	if p.SSLRootCert != "" {
+		m["ssl_root_cert"] = p.SSLRootCert
+	}
+	if p.SSLCert != "" {
+		m["ssl_cert"] = p.SSLCert
+	}
+	if p.SSLKey != "" {
+		m["ssl_key"] = p.SSLKey
+	}
+	if p.BatchSize != 0 {
+		m["batch_size"] = p.BatchSize
+	}
+	if p.FlushInterval != 0 {
+		m["flush_interval"] = p.FlushInterval.String()
+	}
+
+	return json.Marshal(m)
+}
+
+func (p *Postgres) UnmarshalJSON(data []byte) error {
+	type Alias Postgres
+	temp := struct {
+		FlushInterval string `json:"flush_interval,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	if temp.FlushInterval != "" {
+		interval, err := time.ParseDuration(temp.FlushInterval)
+		if err != nil {
+			return errors.Wrap(ErrInvalidPgFlushInterval, err)
+		}
+		p.FlushInterval = interval
+	}
+
+	return nil
 }
@@ -0,0 +1,260 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	defPgIdleTime     = 10 * time.Minute
+	pgMaxOpenConns    = 5
+	pgMaxIdleConns    = 2
+	pgConnMaxIdleTime = 5 * time.Minute
+)
+
+var errPgPoolClosed = errors.New("PostgreSQL connection pool is closed")
+
+// PostgresDB is the remote database the rows are inserted into.
+type PostgresDB interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	Close() error
+}
+
+// PostgresOpener connects to the database of the output.
+type PostgresOpener func(ctx context.Context, p Postgres) (PostgresDB, error)
+
+type pgConn struct {
+	db       PostgresDB
+	lastUsed time.Time
+}
+
+type pgBatch struct {
+	target Postgres
+	rows   []map[string]any
+	timer  *time.Timer
+}
+
+// PostgresPool shares the database connections between the rules that write
+// to the same database, and collects the rows of the batched outputs.
+// Connections that are not used for the idle time are closed.
+type PostgresPool struct {
+	mu       sync.Mutex
+	open     PostgresOpener
+	idleTime time.Duration
+	onError  func(ruleID string, err error)
+	closed   bool
+	flushes  sync.WaitGroup
+	// dbs maps the connection settings to the connection.
+	dbs map[string]*pgConn
+	// batches maps the rule ID to its pending batches.
+	batches map[string]map[string]*pgBatch
+}
+
+// NewPostgresPool returns a PostgreSQL connection pool. OpenPostgres is used
+// if the opener is nil. The errors of the batches flushed in the background
+// are reported to onError.
+func NewPostgresPool(open PostgresOpener, idleTime time.Duration, onError func(ruleID string, err error)) *PostgresPool {
+	if open == nil {
+		open = OpenPostgres
+	}
+	if idleTime <= 0 {
+		idleTime = defPgIdleTime
+	}
+	if onError == nil {
+		onError = func(string, error) {}
+	}
+
+	return &PostgresPool{
+		open:     open,
+		idleTime: idleTime,
+		onError:  onError,
+		dbs:      make(map[string]*pgConn),
+		batches:  make(map[string]map[string]*pgBatch),
+	}
+}
+
+// Insert inserts the rows into the output table.
+func (pp *PostgresPool) Insert(ctx context.Context, p Postgres, rows []map[string]any) error {
+	return pp.insert(ctx, p, rows, false)
+}
+
+// Enqueue adds the row to the rule batch. The batch is inserted in place
+// once it's full, or in the background when the flush interval passes.
+func (pp *PostgresPool) Enqueue(ctx context.Context, ruleID string, p Postgres, row map[string]any) error {
+	key := p.key() + "/" + p.Table
+
+	pp.mu.Lock()
+	if pp.closed {
+		pp.mu.Unlock()
+		return errPgPoolClosed
+	}
+	b, ok := pp.batches[ruleID][key]
+	if !ok {
+		b = &pgBatch{target: p}
+		b.timer = time.AfterFunc(p.flushInterval(), func() {
+			if pp.take(ruleID, key, b) {
+				defer pp.flushes.Done()
+				if err := pp.insert(context.Background(), b.target, b.rows, true); err != nil {
+					pp.onError(ruleID, err)
+				}
+			}
+		})
+		if pp.batches[ruleID] == nil {
+			pp.batches[ruleID] = make(map[string]*pgBatch)
+		}
+		pp.batches[ruleID][key] = b
+	}
+	b.rows = append(b.rows, row)
+	full := uint(len(b.rows)) >= p.BatchSize
+	pp.mu.Unlock()
+
+	if full && pp.take(ruleID, key, b) {
+		defer pp.flushes.Done()
+		b.timer.Stop()
+		return pp.insert(ctx, b.target, b.rows, true)
+	}
+
+	return nil
+}
+
+// Remove inserts the pending rows of the rule. It's called when the rule
+// changes, so the next rows are batched with the new settings.
+func (pp *PostgresPool) Remove(ctx context.Context, ruleID string) error {
+	pp.mu.Lock()
+	batches := pp.batches[ruleID]
+	delete(pp.batches, ruleID)
+	pp.mu.Unlock()
+
+	return pp.flush(ctx, batches)
+}
+
+// Close inserts the pending rows and closes the connections.
+// The pool can't be used after it's closed.
+func (pp *PostgresPool) Close() error {
+	pp.mu.Lock()
+	pp.closed = true
+	batches := make(map[string]*pgBatch)
+	for ruleID, rb := range pp.batches {
+		for key, b := range rb {
+			batches[ruleID+"/"+key] = b
+		}
+	}
+	pp.batches = make(map[string]map[string]*pgBatch)
+	pp.mu.Unlock()
+
+	err := pp.flush(context.Background(), batches)
+	pp.flushes.Wait()
+
+	pp.mu.Lock()
+	dbs := pp.dbs
+	pp.dbs = make(map[string]*pgConn)
+	pp.mu.Unlock()
+	for _, c := range dbs {
+		if e := c.db.Close(); e != nil {
+			err = errors.Wrap(e, err)
+		}
+	}
+
+	return err
+}
+
+func (pp *PostgresPool) flush(ctx context.Context, batches map[string]*pgBatch) error {
+	var err error
+	for _, b := range batches {
+		b.timer.Stop()
+		if e := pp.insert(ctx, b.target, b.rows, true); e != nil {
+			err = errors.Wrap(e, err)
+		}
+	}
+
+	return err
+}
+
+// insert inserts the rows. Draining inserts the pending batches while the pool is being closed.
+func (pp *PostgresPool) insert(ctx context.Context, p Postgres, rows []map[string]any, draining bool) error {
+	db, err := pp.conn(ctx, p, draining)
+	if err != nil {
+		return err
+	}
+
+	queries, args := insertQueries(p.Table, rows)
+	for i, q := range queries {
+		if _, err := db.ExecContext(ctx, q, args[i]...); err != nil {
+			return errors.Wrap(errPgInsert, err)
+		}
+	}
+
+	return nil
+}
+
+// take removes the batch from the pending ones and reports if the caller
+// owns it. The caller that owns the batch must mark the flush as done.
+func (pp *PostgresPool) take(ruleID, key string, b *pgBatch) bool {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+
+	if pp.batches[ruleID][key] != b {
+		return false
+	}
+	delete(pp.batches[ruleID], key)
+	if len(pp.batches[ruleID]) == 0 {
+		delete(pp.batches, ruleID)
+	}
+	pp.flushes.Add(1)
+
+	return true
+}
+
+// conn returns the connection of the output, connecting if needed.
+func (pp *PostgresPool) conn(ctx context.Context, p Postgres, draining bool) (PostgresDB, error) {
+	key := p.key()
+	now := time.Now()
+
+	pp.mu.Lock()
+	if pp.closed && !draining {
+		pp.mu.Unlock()
+		return nil, errPgPoolClosed
+	}
+	pp.evictIdle(now)
+	if c, ok := pp.dbs[key]; ok {
+		c.lastUsed = now
+		pp.mu.Unlock()
+		return c.db, nil
+	}
+	pp.mu.Unlock()
+
+	// Connect without holding the lock, so slow databases don't block other rules.
+	db, err := pp.open(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	// Another rule may have connected in the meantime.
+	if c, ok := pp.dbs[key]; ok {
+		db.Close()
+		c.lastUsed = now
+		return c.db, nil
+	}
+	pp.dbs[key] = &pgConn{db: db, lastUsed: now}
+
+	return db, nil
+}
+
+// evictIdle closes the idle connections. It must be called with the lock held.
+func (pp *PostgresPool) evictIdle(now time.Time) {
+	for key, c := range pp.dbs {
+		if now.Sub(c.lastUsed) > pp.idleTime {
+			c.db.Close()
+			delete(pp.dbs, key)
+		}
+	}
+}
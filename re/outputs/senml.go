@@ -16,17 +16,10 @@ type SenML struct {
 }
 
 func (s *SenML) Run(ctx context.Context, msg *messaging.Message, val any) error {
-	// In case there is a single SenML value, convert to slice so we can decode.
-	if _, ok := val.([]any); !ok {
-		val = []any{val}
-	}
-	data, err := json.Marshal(val)
+	data, err := s.payload(val)
 	if err != nil {
 		return err
 	}
-	if _, err := senml.Decode(data, senml.JSON); err != nil {
-		return err
-	}
 
 	m := &messaging.Message{
 		Domain:    msg.Domain,
@@ -46,6 +39,32 @@ func (s *SenML) Run(ctx context.Context, msg *messaging.Message, val any) error
 	return nil
 }
 
+// Render returns the SenML payload that would be saved.
+func (s *SenML) Render(msg *messaging.Message, val any) (any, error) {
+	data, err := s.payload(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(data), nil
+}
+
+func (s *SenML) payload(val any) ([]byte, error) {
+	// In case there is a single SenML value, convert to slice so we can decode.
+	if _, ok := val.([]any); !ok {
+		val = []any{val}
+	}
+	data, err := json.Marshal(val)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := senml.Decode(data, senml.JSON); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
 func (senml *SenML) MarshalJSON() ([]byte, error) {
 	return json.Marshal(map[string]string{
 		"type": SaveSenMLType.String(),
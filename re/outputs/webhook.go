@@ -0,0 +1,233 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/webhook"
+)
+
+const (
+	maxWebhookTimeout = time.Minute
+	maxWebhookRetries = 5
+	pemPrefix         = "-----BEGIN"
+)
+
+var (
+	ErrInvalidWebhookURL     = errors.New("invalid webhook URL, only http and https are supported")
+	ErrInvalidWebhookMethod  = errors.New("invalid webhook method, only POST, PUT and PATCH are supported")
+	ErrInvalidWebhookTimeout = errors.New("invalid webhook timeout, must be between 0 and 1m")
+	ErrInvalidWebhookBackoff = errors.New("invalid webhook backoff, must be between 0 and 1m")
+	ErrInvalidWebhookRetries = errors.New("invalid webhook retries, must be at most 5")
+	ErrInvalidWebhookCert    = errors.New("webhook certificates and keys must be PEM encoded")
+)
+
+// Webhook sends the rule result to a generic HTTP endpoint.
+type Webhook struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Body is the request body template. The JSON encoded result is sent if it's empty.
+	Body string `json:"body,omitempty"`
+	// Secret is the HMAC key used to sign the requests. Requests are not signed if it's empty.
+	Secret  string        `json:"secret,omitempty"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// Retries is the number of retries for the network errors and 429 and 5xx responses.
+	Retries uint `json:"retries,omitempty"`
+	// Backoff is the delay before the first retry. It doubles with each retry.
+	Backoff             time.Duration `json:"backoff,omitempty"`
+	SkipTLSVerification bool          `json:"skip_tls_verification,omitempty"`
+	CACert              string        `json:"ca_cert,omitempty"`
+	Cert                string        `json:"cert,omitempty"`
+	Key                 string        `json:"key,omitempty"`
+
+	// Pool shares the HTTP clients between the runs. A new client is used
+	// for each run if it's nil.
+	Pool *webhook.Pool `json:"-"`
+}
+
+func (w *Webhook) Run(ctx context.Context, msg *messaging.Message, val any) error {
+	body, err := w.body(msg, val)
+	if err != nil {
+		return err
+	}
+	if w.Pool == nil {
+		return webhook.Send(ctx, nil, w.config(), body)
+	}
+
+	return w.Pool.Send(ctx, w.config(), body)
+}
+
+// Render returns the request that would be sent.
+func (w *Webhook) Render(msg *messaging.Message, val any) (any, error) {
+	body, err := w.body(msg, val)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"method":  w.method(),
+		"url":     w.URL,
+		"headers": w.config().RequestHeaders(body, time.Now()),
+		"body":    string(body),
+	}, nil
+}
+
+// Validate checks the webhook configuration.
+func (w *Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	switch w.method() {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return ErrInvalidWebhookMethod
+	}
+	if w.Timeout < 0 || w.Timeout > maxWebhookTimeout {
+		return ErrInvalidWebhookTimeout
+	}
+	if w.Backoff < 0 || w.Backoff > webhook.MaxBackoff {
+		return ErrInvalidWebhookBackoff
+	}
+	if w.Retries > maxWebhookRetries {
+		return ErrInvalidWebhookRetries
+	}
+	// Only the PEM data is accepted, so the rules can't read the files of the service.
+	for _, c := range []string{w.CACert, w.Cert, w.Key} {
+		if c != "" && !strings.HasPrefix(strings.TrimSpace(c), pemPrefix) {
+			return ErrInvalidWebhookCert
+		}
+	}
+
+	return nil
+}
+
+// Secrets returns the credentials that are stored encrypted.
+func (w *Webhook) Secrets() []*string {
+	return []*string{&w.Secret, &w.Key}
+}
+
+func (w *Webhook) config() webhook.Config {
+	return webhook.Config{
+		URL:                 w.URL,
+		Method:              w.Method,
+		Headers:             w.Headers,
+		Secret:              w.Secret,
+		Timeout:             w.Timeout,
+		Retries:             w.Retries,
+		Backoff:             w.Backoff,
+		SkipTLSVerification: w.SkipTLSVerification,
+		CACert:              w.CACert,
+		Cert:                w.Cert,
+		Key:                 w.Key,
+	}
+}
+
+func (w *Webhook) method() string {
+	return w.config().RequestMethod()
+}
+
+func (w *Webhook) body(msg *messaging.Message, val any) ([]byte, error) {
+	if w.Body == "" {
+		return json.Marshal(val)
+	}
+
+	templData := templateVal{
+		Message: msg,
+		Result:  val,
+	}
+
+	tmpl, err := template.New("webhook").Parse(w.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	if err := tmpl.Execute(&output, templData); err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+func (w *Webhook) MarshalJSON() ([]byte, error) {
+	m := map[string]any{
+		"type":   WebhookType.String(),
+		"url":    w.URL,
+		"method": w.method(),
+	}
+	if len(w.Headers) > 0 {
+		m["headers"] = w.Headers
+	}
+	if w.Body != "" {
+		m["body"] = w.Body
+	}
+	if w.Secret != "" {
+		m["secret"] = w.Secret
+	}
+	if w.Timeout != 0 {
+		m["timeout"] = w.Timeout.String()
+	}
+	if w.Retries != 0 {
+		m["retries"] = w.Retries
+	}
+	if w.Backoff != 0 {
+		m["backoff"] = w.Backoff.String()
+	}
+	if w.SkipTLSVerification {
+		m["skip_tls_verification"] = true
+	}
+	if w.CACert != "" {
+		m["ca_cert"] = w.CACert
+	}
+	if w.Cert != "" {
+		m["cert"] = w.Cert
+	}
+	if w.Key != "" {
+		m["key"] = w.Key
+	}
+
+	return json.Marshal(m)
+}
+
+func (w *Webhook) UnmarshalJSON(data []byte) error {
+	type Alias Webhook
+	temp := struct {
+		Timeout string `json:"timeout,omitempty"`
+		Backoff string `json:"backoff,omitempty"`
+		*Alias
+	}{
+		Alias: (*Alias)(w),
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	if temp.Timeout != "" {
+		timeout, err := time.ParseDuration(temp.Timeout)
+		if err != nil {
+			return errors.Wrap(ErrInvalidWebhookTimeout, err)
+		}
+		w.Timeout = timeout
+	}
+	if temp.Backoff != "" {
+		backoff, err := time.ParseDuration(temp.Backoff)
+		if err != nil {
+			return errors.Wrap(ErrInvalidWebhookBackoff, err)
+		}
+		w.Backoff = backoff
+	}
+
+	return nil
+}
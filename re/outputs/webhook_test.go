@@ -0,0 +1,236 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package outputs_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/absmach/magistrala/pkg/webhook"
+	"github.com/absmach/magistrala/re/outputs"
+	"github.com/stretchr/testify/assert"
+)
+
+type webhookReq struct {
+	method    string
+	header    http.Header
+	body      string
+	signature string
+}
+
+func TestWebhookRun(t *testing.T) {
+	msg := &messaging.Message{
+		Domain:    "domain",
+		Channel:   "channel",
+		Publisher: "publisher",
+		Payload:   []byte(`{"temperature": 35}`),
+	}
+	val := map[string]any{"temperature": 35}
+
+	cases := []struct {
+		desc     string
+		webhook  outputs.Webhook
+		statuses []int
+		calls    int32
+		body     string
+		signed   bool
+		err      bool
+	}{
+		{
+			desc:     "send result as JSON",
+			webhook:  outputs.Webhook{},
+			statuses: []int{http.StatusOK},
+			calls:    1,
+			body:     `{"temperature":35}`,
+		},
+		{
+			desc: "send templated body with headers and signature",
+			webhook: outputs.Webhook{
+				Method:  "put",
+				Headers: map[string]string{"x-api-key": "key"},
+				Body:    `{"channel": "{{.Message.Channel}}", "t": {{index .Result "temperature"}}}`,
+				Secret:  "secret",
+			},
+			statuses: []int{http.StatusAccepted},
+			calls:    1,
+			body:     `{"channel": "channel", "t": 35}`,
+			signed:   true,
+		},
+		{
+			desc: "retry on server error",
+			webhook: outputs.Webhook{
+				Retries: 2,
+				Backoff: time.Millisecond,
+			},
+			statuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK},
+			calls:    3,
+			body:     `{"temperature":35}`,
+		},
+		{
+			desc: "fail after exhausting retries",
+			webhook: outputs.Webhook{
+				Retries: 1,
+				Backoff: time.Millisecond,
+			},
+			statuses: []int{http.StatusBadGateway, http.StatusBadGateway},
+			calls:    2,
+			body:     `{"temperature":35}`,
+			err:      true,
+		},
+		{
+			desc: "don't retry on client error",
+			webhook: outputs.Webhook{
+				Retries: 3,
+				Backoff: time.Millisecond,
+			},
+			statuses: []int{http.StatusBadRequest},
+			calls:    1,
+			body:     `{"temperature":35}`,
+			err:      true,
+		},
+		{
+			desc: "send with pooled client",
+			webhook: outputs.Webhook{
+				Pool: webhook.NewPool(time.Minute),
+			},
+			statuses: []int{http.StatusOK},
+			calls:    1,
+			body:     `{"temperature":35}`,
+		},
+		{
+			desc: "fail with invalid body template",
+			webhook: outputs.Webhook{
+				Body: `{{.Result`,
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var calls atomic.Int32
+			reqs := make(chan webhookReq, len(tc.statuses))
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := calls.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				reqs <- webhookReq{
+					method:    r.Method,
+					header:    r.Header,
+					body:      string(body),
+					signature: webhook.Sign(tc.webhook.Secret, r.Header.Get(webhook.TimestampHeader), body),
+				}
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer ts.Close()
+
+			tc.webhook.URL = ts.URL
+			err := tc.webhook.Run(context.Background(), msg, val)
+			assert.Equal(t, tc.err, err != nil, fmt.Sprintf("%s: unexpected error %v", tc.desc, err))
+			assert.Equal(t, tc.calls, calls.Load(), fmt.Sprintf("%s: expected %d calls got %d", tc.desc, tc.calls, calls.Load()))
+			if tc.calls == 0 {
+				return
+			}
+
+			req := <-reqs
+			method := http.MethodPost
+			if tc.webhook.Method != "" {
+				method = http.MethodPut
+			}
+			assert.Equal(t, method, req.method)
+			assert.Equal(t, tc.body, req.body)
+			assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+			for k, v := range tc.webhook.Headers {
+				assert.Equal(t, v, req.header.Get(k))
+			}
+			switch tc.signed {
+			case true:
+				assert.Equal(t, "sha256="+req.signature, req.header.Get(webhook.SignatureHeader))
+			default:
+				assert.Empty(t, req.header.Get(webhook.SignatureHeader))
+			}
+		})
+	}
+}
+
+func TestWebhookValidate(t *testing.T) {
+	cases := []struct {
+		desc    string
+		webhook outputs.Webhook
+		err     error
+	}{
+		{
+			desc:    "valid webhook",
+			webhook: outputs.Webhook{URL: "https://example.com/hook", Method: "PATCH", Retries: 3, Timeout: time.Second},
+		},
+		{
+			desc:    "webhook with invalid scheme",
+			webhook: outputs.Webhook{URL: "ftp://example.com/hook"},
+			err:     outputs.ErrInvalidWebhookURL,
+		},
+		{
+			desc:    "webhook without host",
+			webhook: outputs.Webhook{URL: "http://"},
+			err:     outputs.ErrInvalidWebhookURL,
+		},
+		{
+			desc:    "webhook with invalid method",
+			webhook: outputs.Webhook{URL: "https://example.com/hook", Method: "GET"},
+			err:     outputs.ErrInvalidWebhookMethod,
+		},
+		{
+			desc:    "webhook with too long timeout",
+			webhook: outputs.Webhook{URL: "https://example.com/hook", Timeout: time.Hour},
+			err:     outputs.ErrInvalidWebhookTimeout,
+		},
+		{
+			desc:    "webhook with too long backoff",
+			webhook: outputs.Webhook{URL: "https://example.com/hook", Backoff: time.Hour},
+			err:     outputs.ErrInvalidWebhookBackoff,
+		},
+		{
+			desc:    "webhook with too many retries",
+			webhook: outputs.Webhook{URL: "https://example.com/hook", Retries: 10},
+			err:     outputs.ErrInvalidWebhookRetries,
+		},
+		{
+			desc:    "webhook with certificate file path",
+			webhook: outputs.Webhook{URL: "https://example.com/hook", Cert: "/etc/ssl/cert.pem"},
+			err:     outputs.ErrInvalidWebhookCert,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.webhook.Validate()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestWebhookJSON(t *testing.T) {
+	data := []byte(`{"type":"webhook","url":"https://example.com/hook","method":"PUT","timeout":"5s","backoff":"500ms","retries":2,"secret":"secret"}`)
+
+	var w outputs.Webhook
+	err := json.Unmarshal(data, &w)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 5*time.Second, w.Timeout)
+	assert.Equal(t, 500*time.Millisecond, w.Backoff)
+	assert.Equal(t, uint(2), w.Retries)
+
+	out, err := json.Marshal(&w)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.JSONEq(t, string(data), string(out))
+
+	err = json.Unmarshal([]byte(`{"url":"https://example.com/hook","timeout":"invalid"}`), &w)
+	assert.True(t, errors.Contains(err, outputs.ErrInvalidWebhookTimeout), fmt.Sprintf("expected %s got %s", outputs.ErrInvalidWebhookTimeout, err))
+}
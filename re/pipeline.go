@@ -0,0 +1,387 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+// maxPipelineSteps limits the number of the pipeline steps.
+const maxPipelineSteps = 32
+
+var (
+	// ErrPipelineCycle indicates that the pipeline steps branch back to an earlier step.
+	ErrPipelineCycle = errors.NewRequestError("pipeline steps form a cycle")
+	// ErrChannelCycle indicates that the rule outputs publish back to the rule input
+	// through the channels, so the messages would be processed forever.
+	ErrChannelCycle = errors.NewRequestError("rule outputs publish to the rule input channel")
+
+	errNoSteps          = errors.NewRequestError("pipeline must have at least one step")
+	errTooManySteps     = errors.NewRequestError("pipeline has too many steps")
+	errNoInputChannel   = errors.NewRequestError("pipeline input channel is required")
+	errStepName         = errors.NewRequestError("pipeline step name must be unique and non-empty")
+	errStepRule         = errors.NewRequestError("pipeline step rule is required")
+	errUnknownStep      = errors.NewRequestError("pipeline branch refers to unknown step")
+	errUnreachableStep  = errors.NewRequestError("pipeline step is not reachable from the first step")
+	errInvalidOperator  = errors.NewRequestError("invalid pipeline condition operator")
+	errStepRuleNotFound = errors.NewRequestError("pipeline step rule not found")
+)
+
+// Operator compares the step result field with the condition value.
+type Operator string
+
+const (
+	EqOperator     Operator = "eq"
+	NeOperator     Operator = "ne"
+	GtOperator     Operator = "gt"
+	GteOperator    Operator = "gte"
+	LtOperator     Operator = "lt"
+	LteOperator    Operator = "lte"
+	ExistsOperator Operator = "exists"
+)
+
+// Pipeline chains the rules in-process. The first step runs on the messages
+// published to the pipeline input, and each step runs on the result of the
+// step that branched to it. The rule outputs of each step fire as usual.
+type Pipeline struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	DomainID     string    `json:"domain"`
+	Metadata     Metadata  `json:"metadata,omitempty"`
+	InputChannel string    `json:"input_channel"`
+	InputTopic   string    `json:"input_topic"`
+	Steps        []Step    `json:"steps"`
+	Status       Status    `json:"status"`
+	CreatedAt    time.Time `json:"created_at"`
+	CreatedBy    string    `json:"created_by"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	UpdatedBy    string    `json:"updated_by"`
+}
+
+// Step runs the logic and the outputs of the rule. The rule input channel
+// and schedule are not used by the pipeline.
+type Step struct {
+	Name   string `json:"name"`
+	RuleID string `json:"rule_id"`
+	// Next lists the steps that run on the step result. All the branches
+	// with the matching conditions run, so the result fans out.
+	Next []Branch `json:"next,omitempty"`
+}
+
+// Branch continues the pipeline with the step if the condition matches
+// the result, or always if there is no condition.
+type Branch struct {
+	Step string     `json:"step"`
+	When *Condition `json:"when,omitempty"`
+}
+
+// Condition compares the field of the step result with the value. The field
+// is a dot-separated path, for example "payload.temperature" or "values.0",
+// and the empty field is the whole result.
+type Condition struct {
+	Field    string   `json:"field,omitempty"`
+	Operator Operator `json:"operator"`
+	Value    any      `json:"value,omitempty"`
+}
+
+// EventEncode converts a Pipeline struct to map[string]any at event producer.
+func (p Pipeline) EventEncode() map[string]any {
+	m := map[string]any{
+		"id":            p.ID,
+		"name":          p.Name,
+		"domain":        p.DomainID,
+		"input_channel": p.InputChannel,
+		"input_topic":   p.InputTopic,
+		"status":        p.Status.String(),
+		"created_at":    p.CreatedAt.Format(TimeLayout),
+		"created_by":    p.CreatedBy,
+	}
+	if len(p.Metadata) > 0 {
+		m["metadata"] = p.Metadata
+	}
+	if len(p.Steps) > 0 {
+		steps := make([]string, len(p.Steps))
+		for i, s := range p.Steps {
+			steps[i] = s.RuleID
+		}
+		m["steps"] = steps
+	}
+	if !p.UpdatedAt.IsZero() {
+		m["updated_at"] = p.UpdatedAt.Format(TimeLayout)
+	}
+	if p.UpdatedBy != "" {
+		m["updated_by"] = p.UpdatedBy
+	}
+
+	return m
+}
+
+// Validate checks that the first step reaches all the other steps and
+// that the steps don't branch back, so each run ends.
+func (p Pipeline) Validate() error {
+	if p.InputChannel == "" {
+		return errNoInputChannel
+	}
+	switch {
+	case len(p.Steps) == 0:
+		return errNoSteps
+	case len(p.Steps) > maxPipelineSteps:
+		return errTooManySteps
+	}
+	steps := make(map[string]Step, len(p.Steps))
+	for _, s := range p.Steps {
+		if _, ok := steps[s.Name]; ok || s.Name == "" {
+			return errStepName
+		}
+		if s.RuleID == "" {
+			return errStepRule
+		}
+		steps[s.Name] = s
+	}
+	for _, s := range p.Steps {
+		for _, b := range s.Next {
+			if _, ok := steps[b.Step]; !ok {
+				return errUnknownStep
+			}
+			if b.When != nil {
+				if err := b.When.validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Depth-first search, where the steps on the current path are in progress.
+	const (
+		inProgress = iota + 1
+		done
+	)
+	visited := make(map[string]int, len(steps))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch visited[name] {
+		case inProgress:
+			return ErrPipelineCycle
+		case done:
+			return nil
+		}
+		visited[name] = inProgress
+		for _, b := range steps[name].Next {
+			if err := visit(b.Step); err != nil {
+				return err
+			}
+		}
+		visited[name] = done
+
+		return nil
+	}
+	if err := visit(p.Steps[0].Name); err != nil {
+		return err
+	}
+	if len(visited) != len(steps) {
+		return errUnreachableStep
+	}
+
+	return nil
+}
+
+// RuleIDs returns the IDs of the step rules.
+func (p Pipeline) RuleIDs() []string {
+	var ids []string
+	seen := make(map[string]bool, len(p.Steps))
+	for _, s := range p.Steps {
+		if !seen[s.RuleID] {
+			seen[s.RuleID] = true
+			ids = append(ids, s.RuleID)
+		}
+	}
+
+	return ids
+}
+
+func (c Condition) validate() error {
+	switch c.Operator {
+	case EqOperator, NeOperator, GtOperator, GteOperator, LtOperator, LteOperator, ExistsOperator:
+		return nil
+	default:
+		return errInvalidOperator
+	}
+}
+
+// Match reports if the result matches the condition. Numbers are compared
+// by value and strings lexically, other values only match eq and ne.
+func (c Condition) Match(result any) bool {
+	val, ok := lookup(result, c.Field)
+	if c.Operator == ExistsOperator {
+		return ok
+	}
+	if !ok {
+		return c.Operator == NeOperator
+	}
+	switch c.Operator {
+	case EqOperator:
+		return equalValues(val, c.Value)
+	case NeOperator:
+		return !equalValues(val, c.Value)
+	}
+	cmp, ok := compare(val, c.Value)
+	if !ok {
+		return false
+	}
+	switch c.Operator {
+	case GtOperator:
+		return cmp > 0
+	case GteOperator:
+		return cmp >= 0
+	case LtOperator:
+		return cmp < 0
+	case LteOperator:
+		return cmp <= 0
+	default:
+		return false
+	}
+}
+
+// lookup returns the value at the path of the JSON document.
+func lookup(doc any, path string) (any, bool) {
+	if path == "" {
+		return doc, doc != nil
+	}
+	// Results of the Go logic may be structs, so they're read as JSON.
+	switch doc.(type) {
+	case map[string]any, []any:
+	default:
+		b, err := json.Marshal(doc)
+		if err != nil {
+			return nil, false
+		}
+		if err := json.Unmarshal(b, &doc); err != nil {
+			return nil, false
+		}
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch v := doc.(type) {
+		case map[string]any:
+			val, ok := v[key]
+			if !ok {
+				return nil, false
+			}
+			doc = val
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			doc = v[i]
+		default:
+			return nil, false
+		}
+	}
+
+	return doc, doc != nil
+}
+
+func compare(a, b any) (int, bool) {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	x, aok := a.(string)
+	y, bok := b.(string)
+	if !aok || !bok {
+		return 0, false
+	}
+
+	return strings.Compare(x, y), true
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// PipelinePageMeta contains page metadata that helps navigation.
+type PipelinePageMeta struct {
+	Total        uint64 `json:"total"`
+	Offset       uint64 `json:"offset"`
+	Limit        uint64 `json:"limit"`
+	Name         string `json:"name,omitempty"`
+	InputChannel string `json:"input_channel,omitempty"`
+	Status       Status `json:"status,omitempty"`
+	DomainID     string `json:"domain_id,omitempty"`
+}
+
+// EventEncode converts a PipelinePageMeta struct to map[string]any.
+func (pm PipelinePageMeta) EventEncode() map[string]any {
+	m := map[string]any{
+		"total":     pm.Total,
+		"offset":    pm.Offset,
+		"limit":     pm.Limit,
+		"status":    pm.Status.String(),
+		"domain_id": pm.DomainID,
+	}
+	if pm.Name != "" {
+		m["name"] = pm.Name
+	}
+	if pm.InputChannel != "" {
+		m["input_channel"] = pm.InputChannel
+	}
+
+	return m
+}
+
+type PipelinePage struct {
+	Offset    uint64     `json:"offset"`
+	Limit     uint64     `json:"limit"`
+	Total     uint64     `json:"total"`
+	Pipelines []Pipeline `json:"pipelines"`
+}
+
+// PipelineRepository persists the pipelines. Pipelines are looked up within
+// the domain, since they're authorized on the domain level.
+type PipelineRepository interface {
+	AddPipeline(ctx context.Context, p Pipeline) (Pipeline, error)
+	ViewPipeline(ctx context.Context, domainID, id string) (Pipeline, error)
+	UpdatePipeline(ctx context.Context, p Pipeline) (Pipeline, error)
+	UpdatePipelineStatus(ctx context.Context, p Pipeline) (Pipeline, error)
+	RemovePipeline(ctx context.Context, domainID, id string) error
+	// ListPipelines retrieves the pipelines. The domain filter is optional,
+	// so the index loads the pipelines of all the domains.
+	ListPipelines(ctx context.Context, pm PipelinePageMeta) (PipelinePage, error)
+}
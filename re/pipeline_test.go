@@ -0,0 +1,376 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/re/outputs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineValidate(t *testing.T) {
+	step := func(name string, next ...string) Step {
+		s := Step{Name: name, RuleID: name + "-rule"}
+		for _, n := range next {
+			s.Next = append(s.Next, Branch{Step: n})
+		}
+		return s
+	}
+	tooMany := make([]Step, maxPipelineSteps+1)
+	for i := range tooMany {
+		tooMany[i] = step(fmt.Sprintf("step-%d", i))
+	}
+
+	cases := []struct {
+		desc     string
+		pipeline Pipeline
+		err      error
+	}{
+		{
+			desc:     "validate linear pipeline",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a", "b"), step("b", "c"), step("c")}},
+		},
+		{
+			desc:     "validate fan-out pipeline joining on the same step",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a", "b", "c"), step("b", "d"), step("c", "d"), step("d")}},
+		},
+		{
+			desc:     "validate pipeline without input channel",
+			pipeline: Pipeline{Steps: []Step{step("a")}},
+			err:      errNoInputChannel,
+		},
+		{
+			desc:     "validate pipeline without steps",
+			pipeline: Pipeline{InputChannel: "channel"},
+			err:      errNoSteps,
+		},
+		{
+			desc:     "validate pipeline with too many steps",
+			pipeline: Pipeline{InputChannel: "channel", Steps: tooMany},
+			err:      errTooManySteps,
+		},
+		{
+			desc:     "validate pipeline with duplicate step names",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a", "a"), step("a")}},
+			err:      errStepName,
+		},
+		{
+			desc:     "validate pipeline with empty step name",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("")}},
+			err:      errStepName,
+		},
+		{
+			desc:     "validate pipeline without step rule",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{{Name: "a"}}},
+			err:      errStepRule,
+		},
+		{
+			desc:     "validate pipeline branching to unknown step",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a", "b")}},
+			err:      errUnknownStep,
+		},
+		{
+			desc: "validate pipeline with invalid condition operator",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{
+				{Name: "a", RuleID: "a-rule", Next: []Branch{{Step: "b", When: &Condition{Operator: "like"}}}},
+				step("b"),
+			}},
+			err: errInvalidOperator,
+		},
+		{
+			desc:     "validate pipeline with unreachable step",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a"), step("b")}},
+			err:      errUnreachableStep,
+		},
+		{
+			desc:     "validate pipeline with cycle",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a", "b"), step("b", "c"), step("c", "b")}},
+			err:      ErrPipelineCycle,
+		},
+		{
+			desc:     "validate pipeline with self loop",
+			pipeline: Pipeline{InputChannel: "channel", Steps: []Step{step("a", "a")}},
+			err:      ErrPipelineCycle,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.pipeline.Validate()
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestConditionMatch(t *testing.T) {
+	result := map[string]any{
+		"temperature": 25.5,
+		"unit":        "C",
+		"alarm":       true,
+		"values":      []any{1.0, 2.0},
+		"sensor":      map[string]any{"name": "s1"},
+	}
+
+	cases := []struct {
+		desc      string
+		condition Condition
+		result    any
+		match     bool
+	}{
+		{
+			desc:      "match greater number",
+			condition: Condition{Field: "temperature", Operator: GtOperator, Value: 20},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "match lower or equal number",
+			condition: Condition{Field: "temperature", Operator: LteOperator, Value: 25.5},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "don't match lower number",
+			condition: Condition{Field: "temperature", Operator: LtOperator, Value: 20},
+			result:    result,
+			match:     false,
+		},
+		{
+			desc:      "match equal string",
+			condition: Condition{Field: "unit", Operator: EqOperator, Value: "C"},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "match not equal string",
+			condition: Condition{Field: "unit", Operator: NeOperator, Value: "F"},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "match equal boolean",
+			condition: Condition{Field: "alarm", Operator: EqOperator, Value: true},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "match nested field",
+			condition: Condition{Field: "sensor.name", Operator: EqOperator, Value: "s1"},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "match array element",
+			condition: Condition{Field: "values.1", Operator: GteOperator, Value: 2},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "don't match array element out of range",
+			condition: Condition{Field: "values.2", Operator: ExistsOperator},
+			result:    result,
+			match:     false,
+		},
+		{
+			desc:      "match existing field",
+			condition: Condition{Field: "unit", Operator: ExistsOperator},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "match missing field with ne",
+			condition: Condition{Field: "missing", Operator: NeOperator, Value: 1},
+			result:    result,
+			match:     true,
+		},
+		{
+			desc:      "don't match missing field with eq",
+			condition: Condition{Field: "missing", Operator: EqOperator, Value: 1},
+			result:    result,
+			match:     false,
+		},
+		{
+			desc:      "don't compare string with number",
+			condition: Condition{Field: "unit", Operator: GtOperator, Value: 1},
+			result:    result,
+			match:     false,
+		},
+		{
+			desc:      "match whole result",
+			condition: Condition{Operator: GtOperator, Value: 10},
+			result:    42,
+			match:     true,
+		},
+		{
+			desc:      "match struct result field",
+			condition: Condition{Field: "value", Operator: EqOperator, Value: 3},
+			result: struct {
+				Value int `json:"value"`
+			}{Value: 3},
+			match: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.match, tc.condition.Match(tc.result), tc.desc)
+		})
+	}
+}
+
+// channelStoreStub serves the channel graph from memory and records the
+// channels that were read.
+type channelStoreStub struct {
+	rules     []Rule
+	pipelines []Pipeline
+	channels  []string
+}
+
+func (s *channelStoreStub) ViewRule(_ context.Context, id string) (Rule, error) {
+	for _, r := range s.rules {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+
+	return Rule{}, repoerr.ErrNotFound
+}
+
+func (s *channelStoreStub) ListAllRules(_ context.Context, pm PageMeta) (Page, error) {
+	s.channels = append(s.channels, pm.InputChannel)
+	var page Page
+	for _, r := range s.rules {
+		if r.InputChannel == pm.InputChannel {
+			page.Rules = append(page.Rules, r)
+		}
+	}
+
+	return page, nil
+}
+
+func (s *channelStoreStub) ListPipelines(_ context.Context, pm PipelinePageMeta) (PipelinePage, error) {
+	var page PipelinePage
+	for _, p := range s.pipelines {
+		if p.InputChannel == pm.InputChannel {
+			page.Pipelines = append(page.Pipelines, p)
+		}
+	}
+
+	return page, nil
+}
+
+func TestChannelGraphCycle(t *testing.T) {
+	rule := func(id, channel, topic string, pubs ...*outputs.ChannelPublisher) Rule {
+		r := Rule{ID: id, InputChannel: channel, InputTopic: topic}
+		for _, p := range pubs {
+			r.Outputs = append(r.Outputs, p)
+		}
+		return r
+	}
+
+	cases := []struct {
+		desc      string
+		rules     []Rule
+		pipelines []Pipeline
+		start     string
+		cycle     bool
+		channels  []string
+	}{
+		{
+			desc:     "rule publishing to its own input",
+			rules:    []Rule{rule("a", "c1", "t", &outputs.ChannelPublisher{Channel: "c1", Topic: "t"})},
+			start:    ruleNodeID("a"),
+			cycle:    true,
+			channels: []string{"c1"},
+		},
+		{
+			desc:     "rule publishing to matching wildcard input",
+			rules:    []Rule{rule("a", "c1", "sensors/#", &outputs.ChannelPublisher{Channel: "c1", Topic: "sensors/out"})},
+			start:    ruleNodeID("a"),
+			cycle:    true,
+			channels: []string{"c1"},
+		},
+		{
+			desc: "rules publishing to each other",
+			rules: []Rule{
+				rule("a", "c1", "", &outputs.ChannelPublisher{Channel: "c2"}),
+				rule("b", "c2", "", &outputs.ChannelPublisher{Channel: "c1"}),
+			},
+			start:    ruleNodeID("a"),
+			cycle:    true,
+			channels: []string{"c2", "c1"},
+		},
+		{
+			desc: "rules publishing in a chain",
+			rules: []Rule{
+				rule("a", "c1", "", &outputs.ChannelPublisher{Channel: "c2"}),
+				rule("b", "c2", "", &outputs.ChannelPublisher{Channel: "c3"}),
+			},
+			start:    ruleNodeID("a"),
+			cycle:    false,
+			channels: []string{"c2", "c3"},
+		},
+		{
+			desc:     "rule publishing to other topic",
+			rules:    []Rule{rule("a", "c1", "in", &outputs.ChannelPublisher{Channel: "c1", Topic: "out"})},
+			start:    ruleNodeID("a"),
+			cycle:    false,
+			channels: []string{"c1"},
+		},
+		{
+			desc:      "pipeline step publishing to the pipeline input",
+			rules:     []Rule{rule("a", "other", "", &outputs.ChannelPublisher{Channel: "c1"})},
+			pipelines: []Pipeline{{ID: "p", InputChannel: "c1", Steps: []Step{{Name: "s", RuleID: "a"}}}},
+			start:     pipelineNodeID("p"),
+			cycle:     true,
+			channels:  []string{"c1"},
+		},
+		{
+			desc: "rule publishing back through pipeline using the rule",
+			rules: []Rule{
+				rule("a", "c1", "", &outputs.ChannelPublisher{Channel: "c2"}),
+			},
+			pipelines: []Pipeline{{ID: "p", InputChannel: "c2", Steps: []Step{{Name: "s", RuleID: "a"}}}},
+			start:     ruleNodeID("a"),
+			cycle:     true,
+			channels:  []string{"c2"},
+		},
+		{
+			desc: "rule not reading unreachable channels",
+			rules: []Rule{
+				rule("a", "c1", "", &outputs.ChannelPublisher{Channel: "c2"}),
+				rule("b", "c3", "", &outputs.ChannelPublisher{Channel: "c3"}),
+			},
+			start:    ruleNodeID("a"),
+			cycle:    false,
+			channels: []string{"c2"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			store := &channelStoreStub{rules: tc.rules, pipelines: tc.pipelines}
+			g := newChannelGraph(store, "")
+			for _, r := range tc.rules {
+				if ruleNodeID(r.ID) == tc.start {
+					g.changeRule(r)
+				}
+			}
+			for _, p := range tc.pipelines {
+				if pipelineNodeID(p.ID) == tc.start {
+					g.changePipeline(p)
+				}
+			}
+			cycle, err := g.hasCycle(context.Background(), tc.start)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.cycle, cycle, tc.desc)
+			assert.Equal(t, tc.channels, store.channels, fmt.Sprintf("%s: unexpected channels read", tc.desc))
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/absmach/magistrala/api/http"
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/re"
+	"github.com/jackc/pgtype"
+)
+
+type dbExecution struct {
+	ID             string             `db:"id"`
+	RuleID         string             `db:"rule_id"`
+	DomainID       string             `db:"domain_id"`
+	PipelineID     sql.NullString     `db:"pipeline_id"`
+	Step           sql.NullString     `db:"step"`
+	CorrelationID  sql.NullString     `db:"correlation_id"`
+	Channel        sql.NullString     `db:"channel"`
+	Subtopic       sql.NullString     `db:"subtopic"`
+	ClientID       sql.NullString     `db:"client_id"`
+	MessageCreated int64              `db:"message_created"`
+	Status         re.ExecutionStatus `db:"status"`
+	Error          sql.NullString     `db:"error"`
+	Outputs        pgtype.TextArray   `db:"outputs"`
+	Duration       int64              `db:"duration"`
+	CreatedAt      time.Time          `db:"created_at"`
+}
+
+type dbExecutionPageMeta struct {
+	Offset        uint64             `db:"offset"`
+	Limit         uint64             `db:"limit"`
+	RuleID        string             `db:"rule_id"`
+	DomainID      string             `db:"domain_id"`
+	PipelineID    string             `db:"pipeline_id"`
+	CorrelationID string             `db:"correlation_id"`
+	Status        re.ExecutionStatus `db:"status"`
+	From          time.Time          `db:"from"`
+	To            time.Time          `db:"to"`
+}
+
+func (repo *PostgresRepository) AddExecution(ctx context.Context, e re.Execution) error {
+	q := `
+		INSERT INTO rules_executions (id, rule_id, domain_id, pipeline_id, step, correlation_id, channel, subtopic,
+			client_id, message_created, status, error, outputs, duration, created_at)
+		VALUES (:id, :rule_id, :domain_id, :pipeline_id, :step, :correlation_id, :channel, :subtopic,
+			:client_id, :message_created, :status, :error, :outputs, :duration, :created_at);
+	`
+	dbe, err := executionToDb(e)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrCreateEntity, err)
+	}
+	if _, err := repo.DB.NamedExecContext(ctx, q, dbe); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *PostgresRepository) ListExecutions(ctx context.Context, pm re.ExecutionPageMeta) (re.ExecutionPage, error) {
+	dir := api.DescDir
+	if pm.Dir == api.AscDir {
+		dir = api.AscDir
+	}
+	wq := executionsQuery(pm)
+	pgData := ""
+	if pm.Limit != 0 {
+		pgData = "LIMIT :limit"
+	}
+	if pm.Offset != 0 {
+		pgData += " OFFSET :offset"
+	}
+
+	q := fmt.Sprintf(`
+		SELECT id, rule_id, domain_id, pipeline_id, step, correlation_id, channel, subtopic, client_id, message_created,
+			status, error, outputs, duration, created_at
+		FROM rules_executions %s ORDER BY created_at %s, id %s %s;
+	`, wq, dir, dir, pgData)
+
+	dbpm := dbExecutionPageMeta{
+		Offset:        pm.Offset,
+		Limit:         pm.Limit,
+		RuleID:        pm.RuleID,
+		DomainID:      pm.DomainID,
+		PipelineID:    pm.PipelineID,
+		CorrelationID: pm.CorrelationID,
+		Status:        pm.Status,
+		From:          pm.From,
+		To:            pm.To,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbpm)
+	if err != nil {
+		return re.ExecutionPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	execs := []re.Execution{}
+	for rows.Next() {
+		var dbe dbExecution
+		if err := rows.StructScan(&dbe); err != nil {
+			return re.ExecutionPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		execs = append(execs, dbToExecution(dbe))
+	}
+	if err := rows.Err(); err != nil {
+		return re.ExecutionPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM rules_executions %s;`, wq)
+	total, err := postgres.Total(ctx, repo.DB, cq, dbpm)
+	if err != nil {
+		return re.ExecutionPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return re.ExecutionPage{
+		Total:      total,
+		Offset:     pm.Offset,
+		Limit:      pm.Limit,
+		Executions: execs,
+	}, nil
+}
+
+func (repo *PostgresRepository) RemoveExecutions(ctx context.Context, before time.Time) error {
+	q := `DELETE FROM rules_executions WHERE created_at < $1;`
+	if _, err := repo.DB.ExecContext(ctx, q, before); err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func executionsQuery(pm re.ExecutionPageMeta) string {
+	var query []string
+	if pm.RuleID != "" {
+		query = append(query, "rule_id = :rule_id")
+	}
+	if pm.DomainID != "" {
+		query = append(query, "domain_id = :domain_id")
+	}
+	if pm.PipelineID != "" {
+		query = append(query, "pipeline_id = :pipeline_id")
+	}
+	if pm.CorrelationID != "" {
+		query = append(query, "correlation_id = :correlation_id")
+	}
+	if pm.Status != re.AllExecutions {
+		query = append(query, "status = :status")
+	}
+	if !pm.From.IsZero() {
+		query = append(query, "created_at >= :from")
+	}
+	if !pm.To.IsZero() {
+		query = append(query, "created_at <= :to")
+	}
+	if len(query) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+}
+
+func executionToDb(e re.Execution) (dbExecution, error) {
+	var outputs pgtype.TextArray
+	if err := outputs.Set(e.Outputs); err != nil {
+		return dbExecution{}, err
+	}
+
+	return dbExecution{
+		ID:             e.ID,
+		RuleID:         e.RuleID,
+		DomainID:       e.DomainID,
+		PipelineID:     toNullString(e.PipelineID),
+		Step:           toNullString(e.Step),
+		CorrelationID:  toNullString(e.CorrelationID),
+		Channel:        toNullString(e.Channel),
+		Subtopic:       toNullString(e.Subtopic),
+		ClientID:       toNullString(e.ClientID),
+		MessageCreated: e.MessageCreated,
+		Status:         e.Status,
+		Error:          toNullString(e.Error),
+		Outputs:        outputs,
+		Duration:       int64(e.Duration),
+		CreatedAt:      e.CreatedAt,
+	}, nil
+}
+
+func dbToExecution(dbe dbExecution) re.Execution {
+	var outputs []string
+	for _, o := range dbe.Outputs.Elements {
+		outputs = append(outputs, o.String)
+	}
+
+	return re.Execution{
+		ID:             dbe.ID,
+		RuleID:         dbe.RuleID,
+		DomainID:       dbe.DomainID,
+		PipelineID:     dbe.PipelineID.String,
+		Step:           dbe.Step.String,
+		CorrelationID:  dbe.CorrelationID.String,
+		Channel:        dbe.Channel.String,
+		Subtopic:       dbe.Subtopic.String,
+		ClientID:       dbe.ClientID.String,
+		MessageCreated: dbe.MessageCreated,
+		Status:         dbe.Status,
+		Error:          dbe.Error.String,
+		Outputs:        outputs,
+		Duration:       time.Duration(dbe.Duration),
+		CreatedAt:      dbe.CreatedAt,
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/re"
+	"github.com/absmach/magistrala/re/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddExecution(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc string
+		exec re.Execution
+		err  error
+	}{
+		{
+			desc: "add successful execution",
+			exec: re.Execution{
+				ID:             generateUUID(t),
+				RuleID:         rule.ID,
+				DomainID:       rule.DomainID,
+				Channel:        rule.InputChannel,
+				Subtopic:       "temperature",
+				ClientID:       generateUUID(t),
+				MessageCreated: now.UnixNano(),
+				Status:         re.SuccessExecution,
+				Outputs:        []string{"channels", "email"},
+				Duration:       time.Millisecond,
+				CreatedAt:      now,
+			},
+		},
+		{
+			desc: "add failed execution",
+			exec: re.Execution{
+				ID:        generateUUID(t),
+				RuleID:    rule.ID,
+				DomainID:  rule.DomainID,
+				Status:    re.FailedExecution,
+				Error:     "failed to run rule logic",
+				Duration:  time.Millisecond,
+				CreatedAt: now,
+			},
+		},
+		{
+			desc: "add execution of non-existing rule",
+			exec: re.Execution{
+				ID:        generateUUID(t),
+				RuleID:    generateUUID(t),
+				DomainID:  rule.DomainID,
+				Status:    re.SuccessExecution,
+				CreatedAt: now,
+			},
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.AddExecution(context.Background(), tc.exec)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestListExecutions(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	var execs []re.Execution
+	for i := range 10 {
+		status := re.SuccessExecution
+		if i%2 == 1 {
+			status = re.FailedExecution
+		}
+		exec := re.Execution{
+			ID:        generateUUID(t),
+			RuleID:    rule.ID,
+			DomainID:  rule.DomainID,
+			Channel:   rule.InputChannel,
+			Status:    status,
+			Outputs:   []string{"channels"},
+			Duration:  time.Duration(i) * time.Millisecond,
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+		}
+		if status == re.FailedExecution {
+			exec.Outputs = nil
+			exec.Error = "failed to run rule logic"
+		}
+		err := repo.AddExecution(context.Background(), exec)
+		assert.Nil(t, err, fmt.Sprintf("add execution unexpected error: %s", err))
+		execs = append(execs, exec)
+	}
+
+	cases := []struct {
+		desc     string
+		pm       re.ExecutionPageMeta
+		total    uint64
+		expected []re.Execution
+	}{
+		{
+			desc: "list all executions ascending",
+			pm: re.ExecutionPageMeta{
+				RuleID:   rule.ID,
+				DomainID: rule.DomainID,
+				Status:   re.AllExecutions,
+				Dir:      "asc",
+				Limit:    10,
+			},
+			total:    10,
+			expected: execs,
+		},
+		{
+			desc: "list executions with offset and limit",
+			pm: re.ExecutionPageMeta{
+				RuleID:   rule.ID,
+				DomainID: rule.DomainID,
+				Status:   re.AllExecutions,
+				Dir:      "desc",
+				Offset:   1,
+				Limit:    2,
+			},
+			total:    10,
+			expected: []re.Execution{execs[8], execs[7]},
+		},
+		{
+			desc: "list failed executions",
+			pm: re.ExecutionPageMeta{
+				RuleID:   rule.ID,
+				DomainID: rule.DomainID,
+				Status:   re.FailedExecution,
+				Dir:      "asc",
+				Limit:    10,
+			},
+			total:    5,
+			expected: []re.Execution{execs[1], execs[3], execs[5], execs[7], execs[9]},
+		},
+		{
+			desc: "list executions in time range",
+			pm: re.ExecutionPageMeta{
+				RuleID:   rule.ID,
+				DomainID: rule.DomainID,
+				Status:   re.AllExecutions,
+				Dir:      "asc",
+				Limit:    10,
+				From:     now.Add(2 * time.Minute),
+				To:       now.Add(4 * time.Minute),
+			},
+			total:    3,
+			expected: execs[2:5],
+		},
+		{
+			desc: "list executions of another domain",
+			pm: re.ExecutionPageMeta{
+				RuleID:   rule.ID,
+				DomainID: generateUUID(t),
+				Status:   re.AllExecutions,
+				Limit:    10,
+			},
+			total:    0,
+			expected: []re.Execution{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.ListExecutions(context.Background(), tc.pm)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, page.Total))
+			assert.Equal(t, len(tc.expected), len(page.Executions))
+			for i := range page.Executions {
+				assert.Equal(t, tc.expected[i].ID, page.Executions[i].ID)
+				assert.Equal(t, tc.expected[i].Status, page.Executions[i].Status)
+				assert.Equal(t, tc.expected[i].Outputs, page.Executions[i].Outputs)
+				assert.Equal(t, tc.expected[i].Error, page.Executions[i].Error)
+				assert.Equal(t, tc.expected[i].Duration, page.Executions[i].Duration)
+			}
+		})
+	}
+}
+
+func TestRemoveExecutions(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	for _, createdAt := range []time.Time{now.Add(-2 * time.Hour), now} {
+		err := repo.AddExecution(context.Background(), re.Execution{
+			ID:        generateUUID(t),
+			RuleID:    rule.ID,
+			DomainID:  rule.DomainID,
+			Status:    re.SuccessExecution,
+			CreatedAt: createdAt,
+		})
+		assert.Nil(t, err, fmt.Sprintf("add execution unexpected error: %s", err))
+	}
+
+	err := repo.RemoveExecutions(context.Background(), now.Add(-time.Hour))
+	assert.Nil(t, err, fmt.Sprintf("remove executions unexpected error: %s", err))
+
+	page, err := repo.ListExecutions(context.Background(), re.ExecutionPageMeta{
+		RuleID:   rule.ID,
+		DomainID: rule.DomainID,
+		Status:   re.AllExecutions,
+		Limit:    10,
+	})
+	assert.Nil(t, err, fmt.Sprintf("list executions unexpected error: %s", err))
+	assert.Equal(t, uint64(1), page.Total)
+}
@@ -135,6 +135,162 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 						WHERE jsonb_typeof(r.outputs) = 'array'`,
 				},
 			},
+			{
+				Id: "rules_06",
+				Up: []string{
+					`ALTER TABLE rules ADD COLUMN time_window JSONB;`,
+					`CREATE TABLE IF NOT EXISTS rules_state (
+						rule_id     VARCHAR(36) NOT NULL REFERENCES rules (id) ON DELETE CASCADE,
+						key         TEXT NOT NULL,
+						value       JSONB,
+						expires_at  TIMESTAMP,
+						updated_at  TIMESTAMP NOT NULL,
+						PRIMARY KEY (rule_id, key)
+					)`,
+					`CREATE TABLE IF NOT EXISTS rules_window_samples (
+						rule_id     VARCHAR(36) NOT NULL REFERENCES rules (id) ON DELETE CASCADE,
+						key         TEXT NOT NULL,
+						value       DOUBLE PRECISION NOT NULL,
+						created_at  TIMESTAMP NOT NULL
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_rules_window_samples ON rules_window_samples (rule_id, key, created_at)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS rules_window_samples`,
+					`DROP TABLE IF EXISTS rules_state`,
+					`ALTER TABLE rules DROP COLUMN time_window;`,
+				},
+			},
+			{
+				Id: "rules_07",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS rules_executions (
+						id               VARCHAR(36) PRIMARY KEY,
+						rule_id          VARCHAR(36) NOT NULL REFERENCES rules (id) ON DELETE CASCADE,
+						domain_id        VARCHAR(36) NOT NULL,
+						channel          TEXT,
+						subtopic         TEXT,
+						client_id        TEXT,
+						message_created  BIGINT,
+						status           SMALLINT NOT NULL DEFAULT 0 CHECK (status >= 0),
+						error            TEXT,
+						outputs          TEXT[],
+						duration         BIGINT NOT NULL DEFAULT 0,
+						created_at       TIMESTAMP NOT NULL
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_rules_executions_rule ON rules_executions (rule_id, created_at)`,
+					`CREATE INDEX IF NOT EXISTS idx_rules_executions_created_at ON rules_executions (created_at)`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS rules_executions`,
+				},
+			},
+			{
+				Id: "rules_08",
+				Up: []string{
+					`ALTER TABLE rules
+						ADD COLUMN cron            TEXT NOT NULL DEFAULT '',
+						ADD COLUMN timezone        VARCHAR(64) NOT NULL DEFAULT '',
+						ADD COLUMN end_datetime    TIMESTAMP,
+						ADD COLUMN max_occurrences INTEGER NOT NULL DEFAULT 0 CHECK (max_occurrences >= 0),
+						ADD COLUMN occurrences     INTEGER NOT NULL DEFAULT 0 CHECK (occurrences >= 0),
+						ADD COLUMN exclusions      JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE rules
+						DROP COLUMN cron,
+						DROP COLUMN timezone,
+						DROP COLUMN end_datetime,
+						DROP COLUMN max_occurrences,
+						DROP COLUMN occurrences,
+						DROP COLUMN exclusions`,
+				},
+			},
+			{
+				Id: "rules_09",
+				Up: []string{
+					`ALTER TABLE rules ADD COLUMN revision BIGINT NOT NULL DEFAULT 1 CHECK (revision > 0)`,
+					`CREATE TABLE IF NOT EXISTS rules_revisions (
+						rule_id        VARCHAR(36) NOT NULL REFERENCES rules (id) ON DELETE CASCADE,
+						revision       BIGINT NOT NULL CHECK (revision > 0),
+						spec           JSONB NOT NULL,
+						restored_from  BIGINT NOT NULL DEFAULT 0 CHECK (restored_from >= 0),
+						created_at     TIMESTAMP NOT NULL,
+						created_by     VARCHAR(254) NOT NULL DEFAULT '',
+						PRIMARY KEY (rule_id, revision)
+					)`,
+					// Save the existing rules as the first revision, so they can be rolled back to.
+					`INSERT INTO rules_revisions (rule_id, revision, spec, created_at, created_by)
+					SELECT id, 1, jsonb_strip_nulls(jsonb_build_object(
+						'name', name,
+						'metadata', metadata,
+						'tags', to_jsonb(tags),
+						'input_channel', input_channel,
+						'input_topic', COALESCE(input_topic, ''),
+						'logic', jsonb_build_object('type', logic_type, 'value', convert_from(COALESCE(logic_value, ''::bytea), 'UTF8')),
+						'outputs', outputs,
+						'window', time_window,
+						'schedule', jsonb_build_object(
+							'start_datetime', to_char(start_datetime, 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+							'recurring', CASE recurring
+								WHEN 1 THEN 'hourly'
+								WHEN 2 THEN 'daily'
+								WHEN 3 THEN 'weekly'
+								WHEN 4 THEN 'monthly'
+								WHEN 5 THEN 'cron'
+								ELSE 'none'
+							END,
+							'recurring_period', COALESCE(recurring_period, 0),
+							'cron', cron,
+							'timezone', timezone,
+							'end_datetime', to_char(end_datetime, 'YYYY-MM-DD"T"HH24:MI:SS"Z"'),
+							'max_occurrences', max_occurrences,
+							'exclusions', exclusions
+						)
+					)),
+					CASE WHEN updated_at > created_at THEN updated_at ELSE created_at END,
+					COALESCE(CASE WHEN updated_at > created_at THEN updated_by ELSE created_by END, '')
+					FROM rules
+					ON CONFLICT DO NOTHING`,
+				},
+				Down: []string{
+					`DROP TABLE IF EXISTS rules_revisions`,
+					`ALTER TABLE rules DROP COLUMN revision`,
+				},
+			},
+			{
+				Id: "rules_10",
+				Up: []string{
+					`CREATE TABLE IF NOT EXISTS pipelines (
+						id             VARCHAR(36) PRIMARY KEY,
+						name           VARCHAR(1024),
+						domain_id      VARCHAR(36) NOT NULL,
+						metadata       JSONB,
+						input_channel  VARCHAR(36) NOT NULL,
+						input_topic    TEXT NOT NULL DEFAULT '',
+						steps          JSONB NOT NULL,
+						status         SMALLINT NOT NULL DEFAULT 0 CHECK (status >= 0),
+						created_at     TIMESTAMP NOT NULL,
+						created_by     VARCHAR(254),
+						updated_at     TIMESTAMP,
+						updated_by     VARCHAR(254)
+					)`,
+					`CREATE INDEX IF NOT EXISTS idx_pipelines_domain ON pipelines (domain_id)`,
+					`ALTER TABLE rules_executions
+						ADD COLUMN pipeline_id     VARCHAR(36),
+						ADD COLUMN step            VARCHAR(1024),
+						ADD COLUMN correlation_id  VARCHAR(36)`,
+					`CREATE INDEX IF NOT EXISTS idx_rules_executions_pipeline ON rules_executions (pipeline_id, created_at) WHERE pipeline_id IS NOT NULL`,
+				},
+				Down: []string{
+					`DROP INDEX IF EXISTS idx_rules_executions_pipeline`,
+					`ALTER TABLE rules_executions
+						DROP COLUMN pipeline_id,
+						DROP COLUMN step,
+						DROP COLUMN correlation_id`,
+					`DROP TABLE IF EXISTS pipelines`,
+				},
+			},
 		},
 	}
 
@@ -0,0 +1,279 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/re"
+)
+
+const pipelineColumns = `id, name, domain_id, metadata, input_channel, input_topic, steps, status,
+	created_at, created_by, updated_at, updated_by`
+
+type dbPipeline struct {
+	ID           string         `db:"id"`
+	Name         sql.NullString `db:"name"`
+	DomainID     string         `db:"domain_id"`
+	Metadata     []byte         `db:"metadata"`
+	InputChannel string         `db:"input_channel"`
+	InputTopic   string         `db:"input_topic"`
+	Steps        []byte         `db:"steps"`
+	Status       re.Status      `db:"status"`
+	CreatedAt    time.Time      `db:"created_at"`
+	CreatedBy    sql.NullString `db:"created_by"`
+	UpdatedAt    sql.NullTime   `db:"updated_at"`
+	UpdatedBy    sql.NullString `db:"updated_by"`
+}
+
+type dbPipelinePageMeta struct {
+	Offset       uint64    `db:"offset"`
+	Limit        uint64    `db:"limit"`
+	Name         string    `db:"name"`
+	InputChannel string    `db:"input_channel"`
+	Status       re.Status `db:"status"`
+	DomainID     string    `db:"domain_id"`
+}
+
+func (repo *PostgresRepository) AddPipeline(ctx context.Context, p re.Pipeline) (re.Pipeline, error) {
+	q := fmt.Sprintf(`
+		INSERT INTO pipelines (%s)
+		VALUES (:id, :name, :domain_id, :metadata, :input_channel, :input_topic, :steps, :status,
+			:created_at, :created_by, :updated_at, :updated_by)
+		RETURNING %s;
+	`, pipelineColumns, pipelineColumns)
+
+	return repo.savePipeline(ctx, q, p, repoerr.ErrCreateEntity)
+}
+
+func (repo *PostgresRepository) ViewPipeline(ctx context.Context, domainID, id string) (re.Pipeline, error) {
+	q := fmt.Sprintf(`SELECT %s FROM pipelines WHERE domain_id = $1 AND id = $2;`, pipelineColumns)
+	row := repo.DB.QueryRowxContext(ctx, q, domainID, id)
+	if err := row.Err(); err != nil {
+		return re.Pipeline{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	var dbp dbPipeline
+	if err := row.StructScan(&dbp); err != nil {
+		if errors.Contains(err, sql.ErrNoRows) {
+			return re.Pipeline{}, repoerr.ErrNotFound
+		}
+		return re.Pipeline{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return dbToPipeline(dbp)
+}
+
+func (repo *PostgresRepository) UpdatePipeline(ctx context.Context, p re.Pipeline) (re.Pipeline, error) {
+	q := fmt.Sprintf(`
+		UPDATE pipelines
+		SET name = :name, metadata = :metadata, input_channel = :input_channel, input_topic = :input_topic,
+			steps = :steps, updated_at = :updated_at, updated_by = :updated_by
+		WHERE domain_id = :domain_id AND id = :id
+		RETURNING %s;
+	`, pipelineColumns)
+
+	return repo.savePipeline(ctx, q, p, repoerr.ErrUpdateEntity)
+}
+
+func (repo *PostgresRepository) UpdatePipelineStatus(ctx context.Context, p re.Pipeline) (re.Pipeline, error) {
+	q := fmt.Sprintf(`
+		UPDATE pipelines
+		SET status = :status, updated_at = :updated_at, updated_by = :updated_by
+		WHERE domain_id = :domain_id AND id = :id
+		RETURNING %s;
+	`, pipelineColumns)
+
+	return repo.savePipeline(ctx, q, p, repoerr.ErrUpdateEntity)
+}
+
+func (repo *PostgresRepository) RemovePipeline(ctx context.Context, domainID, id string) error {
+	q := `DELETE FROM pipelines WHERE domain_id = $1 AND id = $2;`
+	result, err := repo.DB.ExecContext(ctx, q, domainID, id)
+	if err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(repoerr.ErrRemoveEntity, err)
+	}
+	if rowsAffected == 0 {
+		return repoerr.ErrNotFound
+	}
+
+	return nil
+}
+
+func (repo *PostgresRepository) ListPipelines(ctx context.Context, pm re.PipelinePageMeta) (re.PipelinePage, error) {
+	var query []string
+	if pm.DomainID != "" {
+		query = append(query, "domain_id = :domain_id")
+	}
+	if pm.Status != re.AllStatus {
+		query = append(query, "status = :status")
+	}
+	if pm.InputChannel != "" {
+		query = append(query, "input_channel = :input_channel")
+	}
+	if pm.Name != "" {
+		query = append(query, "name ILIKE '%' || :name || '%'")
+	}
+	wq := ""
+	if len(query) > 0 {
+		wq = fmt.Sprintf("WHERE %s", strings.Join(query, " AND "))
+	}
+	pgData := ""
+	if pm.Limit != 0 {
+		pgData = "LIMIT :limit"
+	}
+	if pm.Offset != 0 {
+		pgData += " OFFSET :offset"
+	}
+	q := fmt.Sprintf(`SELECT %s FROM pipelines %s ORDER BY created_at DESC, id %s;`, pipelineColumns, wq, pgData)
+
+	dbpm := dbPipelinePageMeta{
+		Offset:       pm.Offset,
+		Limit:        pm.Limit,
+		Name:         pm.Name,
+		InputChannel: pm.InputChannel,
+		Status:       pm.Status,
+		DomainID:     pm.DomainID,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbpm)
+	if err != nil {
+		return re.PipelinePage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	pipelines := []re.Pipeline{}
+	for rows.Next() {
+		var dbp dbPipeline
+		if err := rows.StructScan(&dbp); err != nil {
+			return re.PipelinePage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		p, err := dbToPipeline(dbp)
+		if err != nil {
+			return re.PipelinePage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		pipelines = append(pipelines, p)
+	}
+	if err := rows.Err(); err != nil {
+		return re.PipelinePage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM pipelines %s;`, wq)
+	total, err := postgres.Total(ctx, repo.DB, cq, dbpm)
+	if err != nil {
+		return re.PipelinePage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return re.PipelinePage{
+		Total:     total,
+		Offset:    pm.Offset,
+		Limit:     pm.Limit,
+		Pipelines: pipelines,
+	}, nil
+}
+
+func (repo *PostgresRepository) savePipeline(ctx context.Context, q string, p re.Pipeline, repoErr error) (re.Pipeline, error) {
+	dbp, err := pipelineToDb(p)
+	if err != nil {
+		return re.Pipeline{}, errors.Wrap(repoErr, err)
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbp)
+	if err != nil {
+		return re.Pipeline{}, postgres.HandleError(repoErr, err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return re.Pipeline{}, postgres.HandleError(repoErr, err)
+		}
+		return re.Pipeline{}, repoerr.ErrNotFound
+	}
+	var ret dbPipeline
+	if err := rows.StructScan(&ret); err != nil {
+		return re.Pipeline{}, errors.Wrap(repoErr, err)
+	}
+	saved, err := dbToPipeline(ret)
+	if err != nil {
+		return re.Pipeline{}, errors.Wrap(repoErr, err)
+	}
+
+	return saved, nil
+}
+
+func pipelineToDb(p re.Pipeline) (dbPipeline, error) {
+	var metadata []byte
+	if p.Metadata != nil {
+		b, err := json.Marshal(p.Metadata)
+		if err != nil {
+			return dbPipeline{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+		metadata = b
+	}
+	steps, err := json.Marshal(p.Steps)
+	if err != nil {
+		return dbPipeline{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var updatedAt sql.NullTime
+	if !p.UpdatedAt.IsZero() {
+		updatedAt = sql.NullTime{Time: p.UpdatedAt, Valid: true}
+	}
+
+	return dbPipeline{
+		ID:           p.ID,
+		Name:         toNullString(p.Name),
+		DomainID:     p.DomainID,
+		Metadata:     metadata,
+		InputChannel: p.InputChannel,
+		InputTopic:   p.InputTopic,
+		Steps:        steps,
+		Status:       p.Status,
+		CreatedAt:    p.CreatedAt,
+		CreatedBy:    toNullString(p.CreatedBy),
+		UpdatedAt:    updatedAt,
+		UpdatedBy:    toNullString(p.UpdatedBy),
+	}, nil
+}
+
+func dbToPipeline(dbp dbPipeline) (re.Pipeline, error) {
+	var metadata re.Metadata
+	if len(dbp.Metadata) > 0 {
+		if err := json.Unmarshal(dbp.Metadata, &metadata); err != nil {
+			return re.Pipeline{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
+	var steps []re.Step
+	if err := json.Unmarshal(dbp.Steps, &steps); err != nil {
+		return re.Pipeline{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	var updatedAt time.Time
+	if dbp.UpdatedAt.Valid {
+		updatedAt = dbp.UpdatedAt.Time.UTC()
+	}
+
+	return re.Pipeline{
+		ID:           dbp.ID,
+		Name:         fromNullString(dbp.Name),
+		DomainID:     dbp.DomainID,
+		Metadata:     metadata,
+		InputChannel: dbp.InputChannel,
+		InputTopic:   dbp.InputTopic,
+		Steps:        steps,
+		Status:       dbp.Status,
+		CreatedAt:    dbp.CreatedAt.UTC(),
+		CreatedBy:    fromNullString(dbp.CreatedBy),
+		UpdatedAt:    updatedAt,
+		UpdatedBy:    fromNullString(dbp.UpdatedBy),
+	}, nil
+}
@@ -0,0 +1,182 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/re"
+	"github.com/absmach/magistrala/re/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPipeline(t *testing.T, domainID string) re.Pipeline {
+	return re.Pipeline{
+		ID:           generateUUID(t),
+		Name:         "pipeline",
+		DomainID:     domainID,
+		Metadata:     re.Metadata{"key": "value"},
+		InputChannel: generateUUID(t),
+		InputTopic:   "sensors/#",
+		Steps: []re.Step{
+			{Name: "check", RuleID: generateUUID(t), Next: []re.Branch{{Step: "notify", When: &re.Condition{Field: "temperature", Operator: re.GtOperator, Value: 30.0}}}},
+			{Name: "notify", RuleID: generateUUID(t)},
+		},
+		Status:    re.EnabledStatus,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		CreatedBy: generateUUID(t),
+	}
+}
+
+func TestPipelineSave(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM pipelines")
+		assert.Nil(t, err, fmt.Sprintf("clean pipelines unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	domainID := generateUUID(t)
+	pipeline := newPipeline(t, domainID)
+
+	saved, err := repo.AddPipeline(context.Background(), pipeline)
+	assert.Nil(t, err, fmt.Sprintf("add pipeline unexpected error: %s", err))
+	assert.Equal(t, pipeline, saved)
+
+	_, err = repo.AddPipeline(context.Background(), pipeline)
+	assert.True(t, errors.Contains(err, repoerr.ErrConflict), fmt.Sprintf("add duplicate pipeline: expected %s got %s", repoerr.ErrConflict, err))
+
+	cases := []struct {
+		desc     string
+		domainID string
+		id       string
+		res      re.Pipeline
+		err      error
+	}{
+		{
+			desc:     "view pipeline",
+			domainID: domainID,
+			id:       pipeline.ID,
+			res:      pipeline,
+		},
+		{
+			desc:     "view pipeline of other domain",
+			domainID: generateUUID(t),
+			id:       pipeline.ID,
+			err:      repoerr.ErrNotFound,
+		},
+		{
+			desc:     "view non-existing pipeline",
+			domainID: domainID,
+			id:       generateUUID(t),
+			err:      repoerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := repo.ViewPipeline(context.Background(), tc.domainID, tc.id)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.res, res)
+		})
+	}
+}
+
+func TestPipelineUpdate(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM pipelines")
+		assert.Nil(t, err, fmt.Sprintf("clean pipelines unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	domainID := generateUUID(t)
+	pipeline, err := repo.AddPipeline(context.Background(), newPipeline(t, domainID))
+	assert.Nil(t, err, fmt.Sprintf("add pipeline unexpected error: %s", err))
+
+	updated := pipeline
+	updated.Name = "updated"
+	updated.Steps = updated.Steps[:1]
+	updated.Steps[0].Next = nil
+	updated.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	updated.UpdatedBy = generateUUID(t)
+	res, err := repo.UpdatePipeline(context.Background(), updated)
+	assert.Nil(t, err, fmt.Sprintf("update pipeline unexpected error: %s", err))
+	assert.Equal(t, updated, res)
+
+	disabled := updated
+	disabled.Status = re.DisabledStatus
+	res, err = repo.UpdatePipelineStatus(context.Background(), disabled)
+	assert.Nil(t, err, fmt.Sprintf("update pipeline status unexpected error: %s", err))
+	assert.Equal(t, re.DisabledStatus, res.Status)
+
+	other := updated
+	other.DomainID = generateUUID(t)
+	_, err = repo.UpdatePipeline(context.Background(), other)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("update pipeline of other domain: expected %s got %s", repoerr.ErrNotFound, err))
+}
+
+func TestPipelineListAndRemove(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM pipelines")
+		assert.Nil(t, err, fmt.Sprintf("clean pipelines unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	domainID := generateUUID(t)
+	enabled, err := repo.AddPipeline(context.Background(), newPipeline(t, domainID))
+	assert.Nil(t, err, fmt.Sprintf("add pipeline unexpected error: %s", err))
+	p := newPipeline(t, domainID)
+	p.Status = re.DisabledStatus
+	disabled, err := repo.AddPipeline(context.Background(), p)
+	assert.Nil(t, err, fmt.Sprintf("add pipeline unexpected error: %s", err))
+	_, err = repo.AddPipeline(context.Background(), newPipeline(t, generateUUID(t)))
+	assert.Nil(t, err, fmt.Sprintf("add pipeline unexpected error: %s", err))
+
+	cases := []struct {
+		desc  string
+		pm    re.PipelinePageMeta
+		total uint64
+	}{
+		{
+			desc:  "list domain pipelines",
+			pm:    re.PipelinePageMeta{DomainID: domainID, Status: re.AllStatus, Limit: 10},
+			total: 2,
+		},
+		{
+			desc:  "list enabled domain pipelines",
+			pm:    re.PipelinePageMeta{DomainID: domainID, Status: re.EnabledStatus, Limit: 10},
+			total: 1,
+		},
+		{
+			desc:  "list pipelines of all domains",
+			pm:    re.PipelinePageMeta{Status: re.AllStatus},
+			total: 3,
+		},
+		{
+			desc:  "list pipelines by input channel",
+			pm:    re.PipelinePageMeta{DomainID: domainID, Status: re.AllStatus, InputChannel: disabled.InputChannel, Limit: 10},
+			total: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := repo.ListPipelines(context.Background(), tc.pm)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.total, page.Total)
+			assert.Len(t, page.Pipelines, int(tc.total))
+		})
+	}
+
+	err = repo.RemovePipeline(context.Background(), generateUUID(t), enabled.ID)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("remove pipeline of other domain: expected %s got %s", repoerr.ErrNotFound, err))
+	err = repo.RemovePipeline(context.Background(), domainID, enabled.ID)
+	assert.Nil(t, err, fmt.Sprintf("remove pipeline unexpected error: %s", err))
+	_, err = repo.ViewPipeline(context.Background(), domainID, enabled.ID)
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("view removed pipeline: expected %s got %s", repoerr.ErrNotFound, err))
+}
@@ -41,41 +41,21 @@ func NewRepository(db postgres.Database) re.Repository {
 func (repo *PostgresRepository) AddRule(ctx context.Context, r re.Rule) (re.Rule, error) {
 	q := `
 	INSERT INTO rules (id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-		outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status)
+		outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision)
 	VALUES (:id, :name, :domain_id, :tags, :metadata, :input_channel, :input_topic, :logic_type, :logic_value,
-		:outputs, :start_datetime, :time, :recurring, :recurring_period, :created_at, :created_by, :updated_at, :updated_by, :status)
+		:outputs, :time_window, :start_datetime, :time, :recurring, :recurring_period, :cron, :timezone, :end_datetime, :max_occurrences, :occurrences, :exclusions, :created_at, :created_by, :updated_at, :updated_by, :status, :revision)
 	RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-		outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+		outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;
 `
-	dbr, err := ruleToDb(r)
-	if err != nil {
-		return re.Rule{}, err
-	}
-	row, err := repo.DB.NamedQueryContext(ctx, q, dbr)
-	if err != nil {
-		return re.Rule{}, postgres.HandleError(repoerr.ErrCreateEntity, err)
-	}
-	defer row.Close()
-
-	var dbRule dbRule
-	if row.Next() {
-		if err := row.StructScan(&dbRule); err != nil {
-			return re.Rule{}, errors.Wrap(repoerr.ErrCreateEntity, err)
-		}
-	}
-
-	rule, err := dbToRule(dbRule)
-	if err != nil {
-		return re.Rule{}, errors.Wrap(repoerr.ErrCreateEntity, err)
-	}
+	r.Revision = 1
 
-	return rule, nil
+	return repo.saveRevision(ctx, r, q, 0, repoerr.ErrCreateEntity)
 }
 
 func (repo *PostgresRepository) ViewRule(ctx context.Context, id string) (re.Rule, error) {
 	q := `
 		SELECT id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value, outputs,
-			start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status
+			time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision
 		FROM rules
 		WHERE id = $1;
 	`
@@ -204,17 +184,25 @@ func (repo *PostgresRepository) RetrieveByIDWithRoles(ctx context.Context, id, m
 		r2.input_channel,
 		r2.input_topic,
 		r2.outputs,
+		r2.time_window,
 		r2.status,
 		r2.logic_type,
 		r2.logic_value,
 		r2.time,
 		r2.recurring,
 		r2.recurring_period,
+		r2.cron,
+		r2.timezone,
+		r2.end_datetime,
+		r2.max_occurrences,
+		r2.occurrences,
+		r2.exclusions,
 		r2.start_datetime,
 		r2.created_at,
 		r2.created_by,
 		r2.updated_at,
 		r2.updated_by,
+		r2.revision,
 		fr.member_id,
 		fr.roles
 	FROM rules r2
@@ -251,7 +239,7 @@ func (repo *PostgresRepository) UpdateRuleStatus(ctx context.Context, r re.Rule)
 	SET status = :status, updated_at = :updated_at, updated_by = :updated_by
 	WHERE id = :id
 	RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-			outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;`
+			outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;`
 
 	return repo.update(ctx, r, q)
 }
@@ -270,6 +258,9 @@ func (repo *PostgresRepository) UpdateRule(ctx context.Context, r re.Rule) (re.R
 	if r.Outputs != nil {
 		query = append(query, "outputs = :outputs, ")
 	}
+	if r.Window != nil {
+		query = append(query, "time_window = :time_window,")
+	}
 	if r.Logic.Value != "" {
 		query = append(query, "logic_type = :logic_type,")
 		query = append(query, "logic_value = :logic_value,")
@@ -281,33 +272,35 @@ func (repo *PostgresRepository) UpdateRule(ctx context.Context, r re.Rule) (re.R
 
 	q := fmt.Sprintf(`
 		UPDATE rules
-		SET %s updated_at = :updated_at, updated_by = :updated_by WHERE id = :id
+		SET %s updated_at = :updated_at, updated_by = :updated_by, revision = revision + 1 WHERE id = :id
 		RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-			outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+			outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;
 	`, upq)
 
-	return repo.update(ctx, r, q)
+	return repo.saveRevision(ctx, r, q, 0, repoerr.ErrUpdateEntity)
 }
 
 func (repo *PostgresRepository) UpdateRuleTags(ctx context.Context, r re.Rule) (re.Rule, error) {
-	q := `UPDATE rules SET tags = :tags, updated_at = :updated_at, updated_by = :updated_by
+	q := `UPDATE rules SET tags = :tags, updated_at = :updated_at, updated_by = :updated_by, revision = revision + 1
 	WHERE id = :id AND status = :status
 	RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-		outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;`
+		outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;`
 	r.Status = re.EnabledStatus
 
-	return repo.update(ctx, r, q)
+	return repo.saveRevision(ctx, r, q, 0, repoerr.ErrUpdateEntity)
 }
 
 func (repo *PostgresRepository) UpdateRuleSchedule(ctx context.Context, r re.Rule) (re.Rule, error) {
 	q := `
 		UPDATE rules
 		SET start_datetime = :start_datetime, time = :time, recurring = :recurring,
-			recurring_period = :recurring_period, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id
+			recurring_period = :recurring_period, cron = :cron, timezone = :timezone,
+			end_datetime = :end_datetime, max_occurrences = :max_occurrences, occurrences = 0, exclusions = :exclusions,
+			updated_at = :updated_at, updated_by = :updated_by, revision = revision + 1 WHERE id = :id
 		RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-			outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+			outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;
 	`
-	return repo.update(ctx, r, q)
+	return repo.saveRevision(ctx, r, q, 0, repoerr.ErrUpdateEntity)
 }
 
 func (repo *PostgresRepository) update(ctx context.Context, r re.Rule, query string) (re.Rule, error) {
@@ -364,7 +357,7 @@ func (repo *PostgresRepository) ListAllRules(ctx context.Context, pm re.PageMeta
 
 	q := fmt.Sprintf(`
 		SELECT id, name, domain_id, tags, input_channel, input_topic, logic_type, logic_value, outputs,
-			start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status
+			time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision
 		FROM rules r %s %s %s;
 	`, pq, orderClause, pgData)
 	rows, err := repo.DB.NamedQueryContext(ctx, q, pm)
@@ -417,8 +410,8 @@ func (repo *PostgresRepository) ListUserRules(ctx context.Context, userID string
 	innerQ := fmt.Sprintf(`
 		WITH direct_rules AS (
 			SELECT r.id, r.name, r.domain_id, r.tags, r.metadata, r.input_channel, r.input_topic,
-				r.logic_type, r.logic_value, r.outputs, r.start_datetime, r.time,
-				r.recurring, r.recurring_period, r.created_at, r.created_by, r.updated_at, r.updated_by, r.status,
+				r.logic_type, r.logic_value, r.outputs, r.time_window, r.start_datetime, r.time,
+				r.recurring, r.recurring_period, r.cron, r.timezone, r.end_datetime, r.max_occurrences, r.occurrences, r.exclusions, r.created_at, r.created_by, r.updated_at, r.updated_by, r.status, r.revision,
 				rr.id AS role_id,
 				rr."name" AS role_name,
 				array_remove(array_agg(DISTINCT rra."action"), NULL) AS actions,
@@ -437,8 +430,8 @@ func (repo *PostgresRepository) ListUserRules(ctx context.Context, userID string
 		),
 		domain_rules AS (
 			SELECT r.id, r.name, r.domain_id, r.tags, r.metadata, r.input_channel, r.input_topic,
-				r.logic_type, r.logic_value, r.outputs, r.start_datetime, r.time,
-				r.recurring, r.recurring_period, r.created_at, r.created_by, r.updated_at, r.updated_by, r.status,
+				r.logic_type, r.logic_value, r.outputs, r.time_window, r.start_datetime, r.time,
+				r.recurring, r.recurring_period, r.cron, r.timezone, r.end_datetime, r.max_occurrences, r.occurrences, r.exclusions, r.created_at, r.created_by, r.updated_at, r.updated_by, r.status, r.revision,
 				'' AS role_id,
 				'' AS role_name,
 				CAST(array[] AS text[]) AS actions,
@@ -503,9 +496,9 @@ func (repo *PostgresRepository) ListUserRules(ctx context.Context, userID string
 func (repo *PostgresRepository) UpdateRuleDue(ctx context.Context, id string, due time.Time) (re.Rule, error) {
 	q := `
 		UPDATE rules
-		SET time = :time, updated_at = :updated_at WHERE id = :id
+		SET time = :time, occurrences = occurrences + 1, updated_at = :updated_at WHERE id = :id
 		RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
-			outputs, start_datetime, time, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+			outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;
 	`
 	dbr := dbRule{
 		ID:        id,
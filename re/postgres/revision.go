@@ -0,0 +1,205 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/re"
+)
+
+type dbRevision struct {
+	RuleID       string    `db:"rule_id"`
+	Revision     uint64    `db:"revision"`
+	Spec         []byte    `db:"spec"`
+	RestoredFrom uint64    `db:"restored_from"`
+	CreatedAt    time.Time `db:"created_at"`
+	CreatedBy    string    `db:"created_by"`
+}
+
+func (repo *PostgresRepository) RollbackRule(ctx context.Context, r re.Rule, revision uint64) (re.Rule, error) {
+	q := `
+		UPDATE rules
+		SET name = :name, tags = :tags, metadata = :metadata, input_channel = :input_channel, input_topic = :input_topic,
+			logic_type = :logic_type, logic_value = :logic_value, outputs = :outputs, time_window = :time_window,
+			start_datetime = :start_datetime, time = :time, recurring = :recurring, recurring_period = :recurring_period,
+			cron = :cron, timezone = :timezone, end_datetime = :end_datetime, max_occurrences = :max_occurrences,
+			occurrences = :occurrences, exclusions = :exclusions,
+			updated_at = :updated_at, updated_by = :updated_by, revision = revision + 1 WHERE id = :id
+		RETURNING id, name, domain_id, tags, metadata, input_channel, input_topic, logic_type, logic_value,
+			outputs, time_window, start_datetime, time, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, revision;
+	`
+
+	return repo.saveRevision(ctx, r, q, revision, repoerr.ErrUpdateEntity)
+}
+
+func (repo *PostgresRepository) ViewRevision(ctx context.Context, ruleID string, revision uint64) (re.Revision, error) {
+	q := `
+		SELECT rule_id, revision, spec, restored_from, created_at, created_by
+		FROM rules_revisions
+		WHERE rule_id = $1 AND revision = $2;
+	`
+	row := repo.DB.QueryRowxContext(ctx, q, ruleID, revision)
+	if err := row.Err(); err != nil {
+		return re.Revision{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	var dbr dbRevision
+	if err := row.StructScan(&dbr); err != nil {
+		return re.Revision{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	return dbToRevision(dbr)
+}
+
+func (repo *PostgresRepository) ListRevisions(ctx context.Context, pm re.RevisionPageMeta) (re.RevisionPage, error) {
+	pgData := ""
+	if pm.Limit != 0 {
+		pgData = "LIMIT :limit"
+	}
+	if pm.Offset != 0 {
+		pgData += " OFFSET :offset"
+	}
+	q := fmt.Sprintf(`
+		SELECT rule_id, revision, spec, restored_from, created_at, created_by
+		FROM rules_revisions WHERE rule_id = :rule_id ORDER BY revision DESC %s;
+	`, pgData)
+
+	params := map[string]any{
+		"rule_id": pm.RuleID,
+		"limit":   pm.Limit,
+		"offset":  pm.Offset,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, params)
+	if err != nil {
+		return re.RevisionPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+	defer rows.Close()
+
+	revs := []re.Revision{}
+	for rows.Next() {
+		var dbr dbRevision
+		if err := rows.StructScan(&dbr); err != nil {
+			return re.RevisionPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		rev, err := dbToRevision(dbr)
+		if err != nil {
+			return re.RevisionPage{}, err
+		}
+		revs = append(revs, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return re.RevisionPage{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	cq := `SELECT COUNT(*) FROM rules_revisions WHERE rule_id = :rule_id;`
+	total, err := postgres.Total(ctx, repo.DB, cq, params)
+	if err != nil {
+		return re.RevisionPage{}, errors.Wrap(repoerr.ErrViewEntity, err)
+	}
+
+	return re.RevisionPage{
+		Total:     total,
+		Offset:    pm.Offset,
+		Limit:     pm.Limit,
+		Revisions: revs,
+	}, nil
+}
+
+// saveRevision runs the query that writes the rule spec and saves the
+// resulting rule as a revision in the same transaction.
+func (repo *PostgresRepository) saveRevision(ctx context.Context, r re.Rule, query string, restoredFrom uint64, repoErr error) (retRule re.Rule, retErr error) {
+	dbr, err := ruleToDb(r)
+	if err != nil {
+		return re.Rule{}, errors.Wrap(repoErr, err)
+	}
+
+	tx, err := repo.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return re.Rule{}, errors.Wrap(repoErr, err)
+	}
+	defer func() {
+		if retErr != nil {
+			if errRollBack := tx.Rollback(); errRollBack != nil {
+				retErr = errors.Wrap(retErr, errors.Wrap(errors.ErrRollbackTx, errRollBack))
+			}
+		}
+	}()
+
+	rows, err := tx.NamedQuery(query, dbr)
+	if err != nil {
+		return re.Rule{}, postgres.HandleError(repoErr, err)
+	}
+	if !rows.Next() {
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return re.Rule{}, postgres.HandleError(repoErr, err)
+		}
+		return re.Rule{}, repoerr.ErrNotFound
+	}
+	var dbRule dbRule
+	err = rows.StructScan(&dbRule)
+	rows.Close()
+	if err != nil {
+		return re.Rule{}, errors.Wrap(repoErr, err)
+	}
+	rule, err := dbToRule(dbRule)
+	if err != nil {
+		return re.Rule{}, errors.Wrap(repoErr, err)
+	}
+
+	dbrev, err := revisionToDb(re.NewRevision(rule, restoredFrom))
+	if err != nil {
+		return re.Rule{}, errors.Wrap(repoErr, err)
+	}
+	q := `
+		INSERT INTO rules_revisions (rule_id, revision, spec, restored_from, created_at, created_by)
+		VALUES (:rule_id, :revision, :spec, :restored_from, :created_at, :created_by);
+	`
+	if _, err := tx.NamedExec(q, dbrev); err != nil {
+		return re.Rule{}, postgres.HandleError(repoErr, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return re.Rule{}, errors.Wrap(repoErr, err)
+	}
+
+	return rule, nil
+}
+
+func revisionToDb(rev re.Revision) (dbRevision, error) {
+	spec, err := json.Marshal(rev.Spec)
+	if err != nil {
+		return dbRevision{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return dbRevision{
+		RuleID:       rev.RuleID,
+		Revision:     rev.Revision,
+		Spec:         spec,
+		RestoredFrom: rev.RestoredFrom,
+		CreatedAt:    rev.CreatedAt,
+		CreatedBy:    rev.CreatedBy,
+	}, nil
+}
+
+func dbToRevision(dbr dbRevision) (re.Revision, error) {
+	var spec re.Spec
+	if err := json.Unmarshal(dbr.Spec, &spec); err != nil {
+		return re.Revision{}, errors.Wrap(errors.ErrMalformedEntity, err)
+	}
+
+	return re.Revision{
+		RuleID:       dbr.RuleID,
+		Revision:     dbr.Revision,
+		Spec:         spec,
+		RestoredFrom: dbr.RestoredFrom,
+		CreatedAt:    dbr.CreatedAt,
+		CreatedBy:    dbr.CreatedBy,
+	}, nil
+}
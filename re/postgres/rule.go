@@ -28,15 +28,23 @@ type dbRule struct {
 	LogicType                 re.ScriptType      `db:"logic_type"`
 	LogicValue                string             `db:"logic_value"`
 	Outputs                   []byte             `db:"outputs"`
+	TimeWindow                []byte             `db:"time_window"`
 	StartDateTime             sql.NullTime       `db:"start_datetime"`
 	Time                      sql.NullTime       `db:"time"`
 	Recurring                 schedule.Recurring `db:"recurring"`
 	RecurringPeriod           uint               `db:"recurring_period"`
+	Cron                      string             `db:"cron"`
+	Timezone                  string             `db:"timezone"`
+	EndDateTime               sql.NullTime       `db:"end_datetime"`
+	MaxOccurrences            uint               `db:"max_occurrences"`
+	Occurrences               uint               `db:"occurrences"`
+	Exclusions                []byte             `db:"exclusions"`
 	Status                    re.Status          `db:"status"`
 	CreatedAt                 time.Time          `db:"created_at"`
 	CreatedBy                 string             `db:"created_by"`
 	UpdatedAt                 time.Time          `db:"updated_at"`
 	UpdatedBy                 string             `db:"updated_by"`
+	Revision                  uint64             `db:"revision"`
 	MemberID                  string             `db:"member_id,omitempty"`
 	RoleID                    string             `db:"role_id,omitempty"`
 	RoleName                  string             `db:"role_name,omitempty"`
@@ -67,6 +75,18 @@ func ruleToDb(r re.Rule) (dbRule, error) {
 	if !r.Schedule.Time.IsZero() {
 		t.Valid = true
 	}
+	end := sql.NullTime{Time: r.Schedule.EndDateTime}
+	if !r.Schedule.EndDateTime.IsZero() {
+		end.Valid = true
+	}
+	var exclusions []byte
+	if len(r.Schedule.Exclusions) > 0 {
+		b, err := json.Marshal(r.Schedule.Exclusions)
+		if err != nil {
+			return dbRule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		exclusions = b
+	}
 	var tags pgtype.TextArray
 	if err := tags.Set(r.Tags); err != nil {
 		return dbRule{}, err
@@ -77,6 +97,14 @@ func ruleToDb(r re.Rule) (dbRule, error) {
 		return dbRule{}, errors.Wrap(errors.ErrMalformedEntity, err)
 	}
 
+	var window []byte
+	if r.Window != nil {
+		window, err = json.Marshal(r.Window)
+		if err != nil {
+			return dbRule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+
 	return dbRule{
 		ID:              r.ID,
 		Name:            r.Name,
@@ -88,15 +116,23 @@ func ruleToDb(r re.Rule) (dbRule, error) {
 		LogicType:       r.Logic.Type,
 		LogicValue:      r.Logic.Value,
 		Outputs:         outputs,
+		TimeWindow:      window,
 		StartDateTime:   start,
 		Time:            t,
 		Recurring:       r.Schedule.Recurring,
 		RecurringPeriod: r.Schedule.RecurringPeriod,
+		Cron:            r.Schedule.Cron,
+		Timezone:        r.Schedule.Timezone,
+		EndDateTime:     end,
+		MaxOccurrences:  r.Schedule.MaxOccurrences,
+		Occurrences:     r.Schedule.Occurrences,
+		Exclusions:      exclusions,
 		Status:          r.Status,
 		CreatedAt:       r.CreatedAt,
 		CreatedBy:       r.CreatedBy,
 		UpdatedAt:       r.UpdatedAt,
 		UpdatedBy:       r.UpdatedBy,
+		Revision:        r.Revision,
 	}, nil
 }
 
@@ -120,6 +156,20 @@ func dbToRule(dto dbRule) (re.Rule, error) {
 		}
 	}
 
+	var window *re.Window
+	if dto.TimeWindow != nil {
+		if err := json.Unmarshal(dto.TimeWindow, &window); err != nil {
+			return re.Rule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+
+	var exclusions []schedule.Exclusion
+	if dto.Exclusions != nil {
+		if err := json.Unmarshal(dto.Exclusions, &exclusions); err != nil {
+			return re.Rule{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+
 	var roles []roles.MemberRoleActions
 	if dto.Roles != nil {
 		if err := json.Unmarshal(dto.Roles, &roles); err != nil {
@@ -140,17 +190,25 @@ func dbToRule(dto dbRule) (re.Rule, error) {
 			Value: dto.LogicValue,
 		},
 		Outputs: outputs,
+		Window:  window,
 		Schedule: schedule.Schedule{
 			StartDateTime:   dto.StartDateTime.Time,
 			Time:            dto.Time.Time,
 			Recurring:       dto.Recurring,
 			RecurringPeriod: dto.RecurringPeriod,
+			Cron:            dto.Cron,
+			Timezone:        dto.Timezone,
+			EndDateTime:     dto.EndDateTime.Time,
+			MaxOccurrences:  dto.MaxOccurrences,
+			Occurrences:     dto.Occurrences,
+			Exclusions:      exclusions,
 		},
 		Status:                    dto.Status,
 		CreatedAt:                 dto.CreatedAt,
 		CreatedBy:                 dto.CreatedBy,
 		UpdatedAt:                 dto.UpdatedAt,
 		UpdatedBy:                 dto.UpdatedBy,
+		Revision:                  dto.Revision,
 		RoleID:                    dto.RoleID,
 		RoleName:                  dto.RoleName,
 		Actions:                   []string(dto.Actions),
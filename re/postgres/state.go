@@ -0,0 +1,185 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/re"
+)
+
+type dbStateEntry struct {
+	RuleID    string       `db:"rule_id"`
+	Key       string       `db:"key"`
+	Value     []byte       `db:"value"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+	UpdatedAt time.Time    `db:"updated_at"`
+}
+
+type dbWindowSample struct {
+	RuleID    string    `db:"rule_id"`
+	Key       string    `db:"key"`
+	Value     float64   `db:"value"`
+	CreatedAt time.Time `db:"created_at"`
+	Start     time.Time `db:"start"`
+}
+
+func (repo *PostgresRepository) SaveState(ctx context.Context, e re.StateEntry) error {
+	q := `
+		INSERT INTO rules_state (rule_id, key, value, expires_at, updated_at)
+		VALUES (:rule_id, :key, :value, :expires_at, :updated_at)
+		ON CONFLICT (rule_id, key) DO UPDATE
+		SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at, updated_at = EXCLUDED.updated_at;
+	`
+	val, err := json.Marshal(e.Value)
+	if err != nil {
+		return errors.Wrap(repoerr.ErrMalformedEntity, err)
+	}
+	dbe := dbStateEntry{
+		RuleID:    e.RuleID,
+		Key:       e.Key,
+		Value:     val,
+		ExpiresAt: toNullTime(e.ExpiresAt),
+		UpdatedAt: e.UpdatedAt,
+	}
+	if _, err := repo.DB.NamedExecContext(ctx, q, dbe); err != nil {
+		return postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *PostgresRepository) RetrieveState(ctx context.Context, ruleID, key string) (re.StateEntry, error) {
+	q := `
+		SELECT rule_id, key, value, expires_at, updated_at FROM rules_state
+		WHERE rule_id = $1 AND key = $2 AND (expires_at IS NULL OR expires_at > $3);
+	`
+	var dbe dbStateEntry
+	if err := repo.DB.QueryRowxContext(ctx, q, ruleID, key, time.Now().UTC()).StructScan(&dbe); err != nil {
+		if err == sql.ErrNoRows {
+			return re.StateEntry{}, repoerr.ErrNotFound
+		}
+		return re.StateEntry{}, postgres.HandleError(repoerr.ErrViewEntity, err)
+	}
+
+	var val any
+	if dbe.Value != nil {
+		if err := json.Unmarshal(dbe.Value, &val); err != nil {
+			return re.StateEntry{}, errors.Wrap(repoerr.ErrMalformedEntity, err)
+		}
+	}
+
+	return re.StateEntry{
+		RuleID:    dbe.RuleID,
+		Key:       dbe.Key,
+		Value:     val,
+		ExpiresAt: dbe.ExpiresAt.Time,
+		UpdatedAt: dbe.UpdatedAt,
+	}, nil
+}
+
+func (repo *PostgresRepository) IncrementState(ctx context.Context, ruleID, key string, delta float64, expiresAt time.Time) (float64, error) {
+	// Expired entries are reset instead of incremented, so counters with TTL start over.
+	q := `
+		INSERT INTO rules_state (rule_id, key, value, expires_at, updated_at)
+		VALUES ($1, $2, to_jsonb($3::DOUBLE PRECISION), $4, $5)
+		ON CONFLICT (rule_id, key) DO UPDATE
+		SET value = CASE
+				WHEN rules_state.expires_at IS NOT NULL AND rules_state.expires_at <= EXCLUDED.updated_at THEN EXCLUDED.value
+				ELSE to_jsonb((rules_state.value #>> '{}')::DOUBLE PRECISION + $3::DOUBLE PRECISION)
+			END,
+			expires_at = EXCLUDED.expires_at,
+			updated_at = EXCLUDED.updated_at
+		RETURNING (value #>> '{}')::DOUBLE PRECISION;
+	`
+	var ret float64
+	if err := repo.DB.QueryRowxContext(ctx, q, ruleID, key, delta, toNullTime(expiresAt), time.Now().UTC()).Scan(&ret); err != nil {
+		return 0, postgres.HandleError(repoerr.ErrUpdateEntity, err)
+	}
+
+	return ret, nil
+}
+
+func (repo *PostgresRepository) RemoveState(ctx context.Context, ruleID, key string) error {
+	q := `DELETE FROM rules_state WHERE rule_id = $1 AND key = $2;`
+	if _, err := repo.DB.ExecContext(ctx, q, ruleID, key); err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *PostgresRepository) RemoveExpiredState(ctx context.Context, before time.Time) error {
+	q := `DELETE FROM rules_state WHERE expires_at IS NOT NULL AND expires_at <= $1;`
+	if _, err := repo.DB.ExecContext(ctx, q, before); err != nil {
+		return postgres.HandleError(repoerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (repo *PostgresRepository) AppendWindowSample(ctx context.Context, s re.WindowSample, start time.Time) ([]re.WindowSample, error) {
+	// Data-modifying CTEs see the same snapshot as the main query,
+	// so the inserted sample is added to the result explicitly.
+	q := `
+		WITH pruned AS (
+			DELETE FROM rules_window_samples
+			WHERE rule_id = :rule_id AND key = :key AND created_at < :start
+		),
+		inserted AS (
+			INSERT INTO rules_window_samples (rule_id, key, value, created_at)
+			VALUES (:rule_id, :key, :value, :created_at)
+			RETURNING rule_id, key, value, created_at
+		)
+		SELECT rule_id, key, value, created_at FROM rules_window_samples
+		WHERE rule_id = :rule_id AND key = :key AND created_at >= :start
+		UNION ALL
+		SELECT rule_id, key, value, created_at FROM inserted
+		ORDER BY created_at;
+	`
+	dbs := dbWindowSample{
+		RuleID:    s.RuleID,
+		Key:       s.Key,
+		Value:     s.Value,
+		CreatedAt: s.CreatedAt,
+		Start:     start,
+	}
+	rows, err := repo.DB.NamedQueryContext(ctx, q, dbs)
+	if err != nil {
+		return nil, postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+	defer rows.Close()
+
+	var samples []re.WindowSample
+	for rows.Next() {
+		var dbs dbWindowSample
+		if err := rows.StructScan(&dbs); err != nil {
+			return nil, errors.Wrap(repoerr.ErrViewEntity, err)
+		}
+		samples = append(samples, re.WindowSample{
+			RuleID:    dbs.RuleID,
+			Key:       dbs.Key,
+			Value:     dbs.Value,
+			CreatedAt: dbs.CreatedAt,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, postgres.HandleError(repoerr.ErrCreateEntity, err)
+	}
+
+	return samples, nil
+}
+
+func toNullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/re"
+	"github.com/absmach/magistrala/re/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStateRule(t *testing.T, repo re.Repository) re.Rule {
+	rule := re.Rule{
+		ID:           generateUUID(t),
+		Name:         namegen.Generate(),
+		DomainID:     generateUUID(t),
+		InputChannel: generateUUID(t),
+		Logic: re.Script{
+			Type:  re.LuaType,
+			Value: "return true",
+		},
+		Window: &re.Window{
+			Type:        re.SlidingWindow,
+			Size:        time.Minute,
+			Field:       "temperature",
+			Aggregation: re.AvgAggregation,
+		},
+		Status:    re.EnabledStatus,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		CreatedBy: generateUUID(t),
+	}
+	rule, err := repo.AddRule(context.Background(), rule)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	return rule
+}
+
+func TestSaveState(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc     string
+		entry    re.StateEntry
+		value    any
+		err      error
+		retrieve error
+	}{
+		{
+			desc: "save numeric state",
+			entry: re.StateEntry{
+				RuleID:    rule.ID,
+				Key:       "counter",
+				Value:     10,
+				UpdatedAt: now,
+			},
+			value: float64(10),
+		},
+		{
+			desc: "replace existing state",
+			entry: re.StateEntry{
+				RuleID:    rule.ID,
+				Key:       "counter",
+				Value:     map[string]any{"last": "value"},
+				UpdatedAt: now,
+			},
+			value: map[string]any{"last": "value"},
+		},
+		{
+			desc: "save expired state",
+			entry: re.StateEntry{
+				RuleID:    rule.ID,
+				Key:       "expired",
+				Value:     true,
+				ExpiresAt: now.Add(-time.Minute),
+				UpdatedAt: now,
+			},
+			retrieve: repoerr.ErrNotFound,
+		},
+		{
+			desc: "save state for non-existing rule",
+			entry: re.StateEntry{
+				RuleID:    generateUUID(t),
+				Key:       "counter",
+				Value:     1,
+				UpdatedAt: now,
+			},
+			err: repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := repo.SaveState(context.Background(), tc.entry)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err != nil {
+				return
+			}
+			e, err := repo.RetrieveState(context.Background(), tc.entry.RuleID, tc.entry.Key)
+			assert.True(t, errors.Contains(err, tc.retrieve), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.retrieve, err))
+			if tc.retrieve == nil {
+				assert.Equal(t, tc.value, e.Value, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.value, e.Value))
+			}
+		})
+	}
+}
+
+func TestIncrementState(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC()
+
+	err := repo.SaveState(context.Background(), re.StateEntry{
+		RuleID:    rule.ID,
+		Key:       "expired",
+		Value:     100,
+		ExpiresAt: now.Add(-time.Minute),
+		UpdatedAt: now,
+	})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc      string
+		key       string
+		delta     float64
+		expiresAt time.Time
+		res       float64
+		err       error
+	}{
+		{
+			desc:  "increment missing state",
+			key:   "counter",
+			delta: 1,
+			res:   1,
+		},
+		{
+			desc:  "increment existing state",
+			key:   "counter",
+			delta: 2.5,
+			res:   3.5,
+		},
+		{
+			desc:      "increment expired state",
+			key:       "expired",
+			delta:     1,
+			expiresAt: now.Add(time.Minute),
+			res:       1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := repo.IncrementState(context.Background(), rule.ID, tc.key, tc.delta, tc.expiresAt)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.res, res))
+		})
+	}
+}
+
+func TestRemoveState(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC()
+
+	for _, key := range []string{"kept", "removed"} {
+		err := repo.SaveState(context.Background(), re.StateEntry{RuleID: rule.ID, Key: key, Value: key, UpdatedAt: now})
+		assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+	err := repo.SaveState(context.Background(), re.StateEntry{RuleID: rule.ID, Key: "expired", Value: 1, ExpiresAt: now.Add(-time.Second), UpdatedAt: now})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	err = repo.RemoveState(context.Background(), rule.ID, "removed")
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = repo.RemoveExpiredState(context.Background(), now)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	var count int
+	err = db.QueryRow("SELECT COUNT(*) FROM rules_state WHERE rule_id = $1", rule.ID).Scan(&count)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, 1, count)
+
+	err = repo.RemoveRule(context.Background(), rule.ID)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	_, err = repo.RetrieveState(context.Background(), rule.ID, "kept")
+	assert.True(t, errors.Contains(err, repoerr.ErrNotFound), fmt.Sprintf("expected %s got %s\n", repoerr.ErrNotFound, err))
+}
+
+func TestAppendWindowSample(t *testing.T) {
+	t.Cleanup(func() {
+		_, err := db.Exec("DELETE FROM rules")
+		assert.Nil(t, err, fmt.Sprintf("clean rules unexpected error: %s", err))
+	})
+
+	repo := postgres.NewRepository(database)
+	rule := createStateRule(t, repo)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	cases := []struct {
+		desc   string
+		sample re.WindowSample
+		start  time.Time
+		values []float64
+		err    error
+	}{
+		{
+			desc:   "append first sample",
+			sample: re.WindowSample{RuleID: rule.ID, Key: "client", Value: 10, CreatedAt: now.Add(-2 * time.Minute)},
+			start:  now.Add(-3 * time.Minute),
+			values: []float64{10},
+		},
+		{
+			desc:   "append sample to other key",
+			sample: re.WindowSample{RuleID: rule.ID, Key: "other", Value: 50, CreatedAt: now.Add(-time.Minute)},
+			start:  now.Add(-3 * time.Minute),
+			values: []float64{50},
+		},
+		{
+			desc:   "append second sample",
+			sample: re.WindowSample{RuleID: rule.ID, Key: "client", Value: 20, CreatedAt: now.Add(-time.Minute)},
+			start:  now.Add(-3 * time.Minute),
+			values: []float64{10, 20},
+		},
+		{
+			desc:   "append sample and prune old samples",
+			sample: re.WindowSample{RuleID: rule.ID, Key: "client", Value: 30, CreatedAt: now},
+			start:  now.Add(-90 * time.Second),
+			values: []float64{20, 30},
+		},
+		{
+			desc:   "append sample for non-existing rule",
+			sample: re.WindowSample{RuleID: generateUUID(t), Key: "client", Value: 30, CreatedAt: now},
+			start:  now.Add(-time.Minute),
+			err:    repoerr.ErrCreateEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			samples, err := repo.AppendWindowSample(context.Background(), tc.sample, tc.start)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+			if tc.err != nil {
+				return
+			}
+			var values []float64
+			for _, s := range samples {
+				values = append(values, s.Value)
+			}
+			assert.Equal(t, tc.values, values, fmt.Sprintf("%s: expected %v got %v\n", tc.desc, tc.values, values))
+		})
+	}
+}
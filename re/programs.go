@@ -0,0 +1,212 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/dop251/goja"
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+)
+
+// programCache keeps the compiled rule logic, so the scripts are not parsed
+// and the Go interpreters are not created while the messages wait. Programs are keyed
+// by the rule ID and the logic version, and the least recently used rules are
+// evicted when the cache is full.
+type programCache struct {
+	sandbox *sandbox
+	mu      sync.Mutex
+	size    int
+	idle    int
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type programEntry struct {
+	ruleID  string
+	version string
+	lua     *lua.FunctionProto
+	// js is shared by the runs, since the compiled programs are immutable.
+	js *goja.Program
+	// golang holds the prepared Go programs. Each run takes its own
+	// program, since the interpreters keep the package variables of the
+	// logic and are not safe for the concurrent use.
+	golang chan *goProgram
+}
+
+// newProgramCache returns the cache of size rules, keeping at most idle
+// prepared Go interpreters per rule.
+func newProgramCache(sb *sandbox, size, idle int) *programCache {
+	return &programCache{
+		sandbox: sb,
+		size:    size,
+		idle:    idle,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// runLua runs the compiled rule logic in the Lua state.
+func (pc *programCache) runLua(l *lua.LState, r Rule) (lua.LValue, error) {
+	e := pc.entry(r)
+	pc.mu.Lock()
+	proto := e.lua
+	pc.mu.Unlock()
+	if proto == nil {
+		var err error
+		if proto, err = compileLua(r); err != nil {
+			return lua.LNil, err
+		}
+		pc.mu.Lock()
+		e.lua = proto
+		pc.mu.Unlock()
+	}
+
+	l.Push(l.NewFunctionFromProto(proto))
+	if err := l.PCall(0, lua.MultRet, nil); err != nil {
+		return lua.LNil, err
+	}
+
+	return l.Get(-1), nil
+}
+
+// runJS runs the compiled rule logic in a new JavaScript runtime.
+func (pc *programCache) runJS(ctx context.Context, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) (any, error) {
+	e := pc.entry(r)
+	pc.mu.Lock()
+	prog := e.js
+	pc.mu.Unlock()
+	if prog == nil {
+		var err error
+		if prog, err = compileJS(r); err != nil {
+			return nil, err
+		}
+		pc.mu.Lock()
+		e.js = prog
+		pc.mu.Unlock()
+	}
+
+	return runJSProgram(ctx, prog, state, r, msg, win)
+}
+
+// runGo runs the rule logic with a prepared Go program, or a new one if
+// none is ready. Each program runs once, so the package variables of the
+// logic don't keep the values of the previous runs. The worker that used
+// the program prepares the next one, so the interpreters being built are
+// bounded by the workers.
+func (pc *programCache) runGo(ctx context.Context, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) (any, error) {
+	e := pc.entry(r)
+	var p *goProgram
+	select {
+	case p = <-e.golang:
+	default:
+		var err error
+		if p, err = newGoProgram(ctx, r.Logic.Value, pc.sandbox.goSymbols); err != nil {
+			return nil, err
+		}
+	}
+	res, err := p.run(ctx, state, r, msg, win)
+	if err != nil {
+		return nil, err
+	}
+	pc.prepareGo(e, r.Logic.Value)
+
+	return res, nil
+}
+
+// prepareGo adds a new program to the prepared ones of the entry, unless
+// there are enough of them already. The workers may prepare a few more
+// programs than kept, which are dropped. The program is built in its own
+// sandbox run, so the limits of the rule run don't apply to it.
+func (pc *programCache) prepareGo(e *programEntry, code string) {
+	if len(e.golang) == cap(e.golang) {
+		return
+	}
+	ctx, stop := pc.sandbox.start(context.Background())
+	p, err := newGoProgram(ctx, code, pc.sandbox.goSymbols)
+	if lerr := stop(); lerr != nil || err != nil {
+		return
+	}
+	select {
+	case e.golang <- p:
+	default:
+	}
+}
+
+// remove removes the programs of the rule.
+func (pc *programCache) remove(ruleID string) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if el, ok := pc.entries[ruleID]; ok {
+		pc.lru.Remove(el)
+		delete(pc.entries, ruleID)
+	}
+}
+
+// len returns the number of the cached rules.
+func (pc *programCache) len() int {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	return pc.lru.Len()
+}
+
+// entry returns the programs of the rule version. The programs of the other
+// versions of the rule are replaced.
+func (pc *programCache) entry(r Rule) *programEntry {
+	version := logicVersion(r.Logic)
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if el, ok := pc.entries[r.ID]; ok {
+		e := el.Value.(*programEntry)
+		if e.version == version {
+			pc.lru.MoveToFront(el)
+			return e
+		}
+		pc.lru.Remove(el)
+		delete(pc.entries, r.ID)
+	}
+	e := &programEntry{
+		ruleID:  r.ID,
+		version: version,
+		golang:  make(chan *goProgram, pc.idle),
+	}
+	pc.entries[r.ID] = pc.lru.PushFront(e)
+	for pc.lru.Len() > pc.size {
+		last := pc.lru.Back()
+		pc.lru.Remove(last)
+		delete(pc.entries, last.Value.(*programEntry).ruleID)
+	}
+
+	return e
+}
+
+// logicVersion identifies the logic, so the updated rules are compiled again.
+func logicVersion(s Script) string {
+	h := sha256.New()
+	h.Write([]byte(strconv.FormatUint(uint64(s.Type), 10)))
+	h.Write([]byte{0})
+	h.Write([]byte(s.Value))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func compileLua(r Rule) (*lua.FunctionProto, error) {
+	// The chunk name matches the one used by DoString, so the errors are the same.
+	chunk, err := parse.Parse(strings.NewReader(r.Logic.Value), "<string>")
+	if err != nil {
+		return nil, err
+	}
+
+	return lua.Compile(chunk, "<string>")
+}
@@ -0,0 +1,154 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func newTestSandbox(t *testing.T) *sandbox {
+	sb, err := newSandbox(SandboxConfig{})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error creating sandbox: %s", err))
+
+	return sb
+}
+
+func TestProgramCacheLua(t *testing.T) {
+	pc := newProgramCache(newTestSandbox(t), 2, 1)
+	r := Rule{ID: "rule", Logic: Script{Type: LuaType, Value: `return message.payload.v * 2`}}
+	msg := &messaging.Message{Payload: []byte(`{"v": 2}`)}
+
+	run := func(r Rule) (lua.LValue, error) {
+		l := pc.sandbox.newLuaState(context.Background(), nil, r, msg, nil)
+		defer l.Close()
+		return pc.runLua(l, r)
+	}
+
+	res, err := run(r)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, lua.LNumber(4), res)
+	proto := pc.entry(r).lua
+	assert.NotNil(t, proto, "expected compiled script to be cached")
+
+	_, err = run(r)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Same(t, proto, pc.entry(r).lua, "expected cached script to be reused")
+
+	r.Logic.Value = `return message.payload.v * 3`
+	res, err = run(r)
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	assert.Equal(t, lua.LNumber(6), res, "expected updated script to be compiled again")
+	assert.Equal(t, 1, pc.len())
+
+	r.Logic.Value = `return message.payload.`
+	_, err = run(r)
+	assert.NotNil(t, err, "expected error running invalid script")
+}
+
+func TestProgramCacheGo(t *testing.T) {
+	pc := newProgramCache(newTestSandbox(t), 2, 1)
+	r := Rule{ID: "rule", Logic: Script{Type: GoType, Value: `package main
+import (
+	m "messaging"
+	"state"
+)
+var runs int
+func logicFunction() any {
+	runs++
+	return map[string]any{"payload": m.message.Payload, "runs": runs, "window": state.Window.Count}
+}`}}
+
+	cases := []struct {
+		desc    string
+		payload string
+		win     *WindowResult
+		res     map[string]any
+	}{
+		{
+			desc:    "run with new interpreter",
+			payload: `1`,
+			win:     &WindowResult{Count: 3},
+			res:     map[string]any{"payload": float64(1), "runs": 1, "window": 3},
+		},
+		{
+			desc:    "run with prepared interpreter",
+			payload: `2`,
+			res:     map[string]any{"payload": float64(2), "runs": 1, "window": 0},
+		},
+		{
+			desc:    "run with another prepared interpreter",
+			payload: `3`,
+			res:     map[string]any{"payload": float64(3), "runs": 1, "window": 0},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &messaging.Message{Payload: []byte(tc.payload)}
+			res, err := pc.runGo(context.Background(), nil, r, msg, tc.win)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected the package variables of the previous runs to be reset", tc.desc))
+			assert.Len(t, pc.entry(r).golang, 1, fmt.Sprintf("%s: expected the used interpreter to be replaced", tc.desc))
+		})
+	}
+}
+
+func TestProgramCacheJS(t *testing.T) {
+	pc := newProgramCache(newTestSandbox(t), 2, 1)
+	r := Rule{ID: "rule", Logic: Script{Type: JSType, Value: `var runs = (typeof runs === "undefined" ? 0 : runs) + 1
+function logicFunction() { return {payload: message.payload, runs: runs} }`}}
+
+	cases := []struct {
+		desc    string
+		payload string
+		res     map[string]any
+	}{
+		{
+			desc:    "run with new program",
+			payload: `1`,
+			res:     map[string]any{"payload": int64(1), "runs": int64(1)},
+		},
+		{
+			desc:    "run with cached program",
+			payload: `2`,
+			res:     map[string]any{"payload": int64(2), "runs": int64(1)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &messaging.Message{Payload: []byte(tc.payload)}
+			res, err := pc.runJS(context.Background(), nil, r, msg, nil)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, tc.res, res)
+			assert.NotNil(t, pc.entry(r).js, fmt.Sprintf("%s: expected compiled script to be cached", tc.desc))
+		})
+	}
+}
+
+func TestProgramCacheEviction(t *testing.T) {
+	pc := newProgramCache(newTestSandbox(t), 2, 1)
+	rules := []Rule{
+		{ID: "rule1", Logic: Script{Value: `return 1`}},
+		{ID: "rule2", Logic: Script{Value: `return 2`}},
+		{ID: "rule3", Logic: Script{Value: `return 3`}},
+	}
+	for _, r := range rules {
+		pc.entry(r)
+	}
+	assert.Equal(t, 2, pc.len())
+	_, ok := pc.entries["rule1"]
+	assert.False(t, ok, "expected least recently used rule to be evicted")
+
+	pc.remove("rule2")
+	assert.Equal(t, 1, pc.len())
+	_, ok = pc.entries["rule2"]
+	assert.False(t, ok, "expected removed rule to be evicted")
+}
@@ -0,0 +1,252 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/schedule"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// ErrInvalidRevision indicates a missing or malformed rule revision number.
+var ErrInvalidRevision = errors.NewRequestError("invalid rule revision")
+
+// Spec is the versioned part of the rule. The due time and the number of
+// scheduled runs are run state, so they're not part of the spec.
+type Spec struct {
+	Name         string            `json:"name"`
+	Metadata     Metadata          `json:"metadata,omitempty"`
+	Tags         []string          `json:"tags,omitempty"`
+	InputChannel string            `json:"input_channel"`
+	InputTopic   string            `json:"input_topic"`
+	Logic        Script            `json:"logic"`
+	Outputs      Outputs           `json:"outputs,omitempty"`
+	Schedule     schedule.Schedule `json:"schedule"`
+	Window       *Window           `json:"window,omitempty"`
+}
+
+// Spec returns the versioned part of the rule.
+func (r Rule) Spec() Spec {
+	sched := r.Schedule
+	sched.Time = time.Time{}
+	sched.Occurrences = 0
+
+	return Spec{
+		Name:         r.Name,
+		Metadata:     r.Metadata,
+		Tags:         r.Tags,
+		InputChannel: r.InputChannel,
+		InputTopic:   r.InputTopic,
+		Logic:        r.Logic,
+		Outputs:      r.Outputs,
+		Schedule:     sched,
+		Window:       r.Window,
+	}
+}
+
+// restore returns the rule with the spec applied. The schedule run state is
+// kept if the schedule is unchanged, otherwise the schedule starts over.
+func (s Spec) restore(r Rule) Rule {
+	sched := s.Schedule
+	if equalValues(r.Spec().Schedule, s.Schedule) {
+		sched.Time = r.Schedule.Time
+		sched.Occurrences = r.Schedule.Occurrences
+	} else {
+		sched.Time = sched.FirstDue()
+	}
+
+	r.Name = s.Name
+	r.Metadata = s.Metadata
+	r.Tags = s.Tags
+	r.InputChannel = s.InputChannel
+	r.InputTopic = s.InputTopic
+	r.Logic = s.Logic
+	r.Outputs = s.Outputs
+	r.Schedule = sched
+	r.Window = s.Window
+
+	return r
+}
+
+// Revision is an immutable snapshot of the rule spec. A revision is saved
+// when the rule is created and on each change of the rule spec.
+type Revision struct {
+	RuleID   string `json:"rule_id"`
+	Revision uint64 `json:"revision"`
+	Spec     Spec   `json:"spec"`
+	// RestoredFrom is the revision the rule was rolled back to, if any.
+	RestoredFrom uint64    `json:"restored_from,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	CreatedBy    string    `json:"created_by"`
+}
+
+// NewRevision returns the revision of the rule as it's saved.
+func NewRevision(r Rule, restoredFrom uint64) Revision {
+	rev := Revision{
+		RuleID:       r.ID,
+		Revision:     r.Revision,
+		Spec:         r.Spec(),
+		RestoredFrom: restoredFrom,
+		CreatedAt:    r.UpdatedAt,
+		CreatedBy:    r.UpdatedBy,
+	}
+	if rev.CreatedAt.IsZero() {
+		rev.CreatedAt = r.CreatedAt
+		rev.CreatedBy = r.CreatedBy
+	}
+
+	return rev
+}
+
+// RevisionPageMeta contains page metadata that helps navigation.
+type RevisionPageMeta struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	RuleID string `json:"rule_id"`
+}
+
+type RevisionPage struct {
+	Offset    uint64     `json:"offset"`
+	Limit     uint64     `json:"limit"`
+	Total     uint64     `json:"total"`
+	Revisions []Revision `json:"revisions"`
+}
+
+// RevisionRepository retrieves the rule revisions. The revisions are saved
+// by the repository together with the rule changes.
+type RevisionRepository interface {
+	// ViewRevision retrieves the rule revision.
+	ViewRevision(ctx context.Context, ruleID string, revision uint64) (Revision, error)
+
+	// ListRevisions retrieves the revisions of a rule, newest first.
+	ListRevisions(ctx context.Context, pm RevisionPageMeta) (RevisionPage, error)
+}
+
+// Change is a change of a single spec field between two revisions. The path
+// uses dots for the object keys and brackets for the list indexes, for
+// example "outputs[0].url". From is nil for the added fields and To is nil
+// for the removed ones.
+type Change struct {
+	Path string `json:"path"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+	// Patch is the unified diff of the multi-line text fields, such as the logic.
+	Patch string `json:"patch,omitempty"`
+}
+
+// RevisionDiff contains the spec changes from one revision to another.
+type RevisionDiff struct {
+	RuleID  string   `json:"rule_id"`
+	From    uint64   `json:"from"`
+	To      uint64   `json:"to"`
+	Changes []Change `json:"changes"`
+}
+
+// diffSpecs compares the JSON documents of the specs, so the paths match the
+// API field names.
+func diffSpecs(from, to Spec) ([]Change, error) {
+	a, err := specDocument(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := specDocument(to)
+	if err != nil {
+		return nil, err
+	}
+	changes := []Change{}
+	diffValues("", a, b, &changes)
+
+	return changes, nil
+}
+
+func specDocument(s Spec) (map[string]any, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func diffValues(path string, a, b any, changes *[]Change) {
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			keys := maps.Clone(av)
+			maps.Copy(keys, bv)
+			for _, k := range slices.Sorted(maps.Keys(keys)) {
+				diffValues(joinPath(path, k), av[k], bv[k], changes)
+			}
+			return
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			for i := range max(len(av), len(bv)) {
+				var x, y any
+				if i < len(av) {
+					x = av[i]
+				}
+				if i < len(bv) {
+					y = bv[i]
+				}
+				diffValues(fmt.Sprintf("%s[%d]", path, i), x, y, changes)
+			}
+			return
+		}
+	}
+	if equalValues(a, b) {
+		return
+	}
+	c := Change{Path: path, From: a, To: b}
+	as, aok := a.(string)
+	bs, bok := b.(string)
+	if aok && bok && (strings.Contains(as, "\n") || strings.Contains(bs, "\n")) {
+		c.Patch = patch(path, as, bs)
+	}
+	*changes = append(*changes, c)
+}
+
+func equalValues(a, b any) bool {
+	ja, erra := json.Marshal(a)
+	jb, errb := json.Marshal(b)
+
+	return erra == nil && errb == nil && string(ja) == string(jb)
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func patch(path, from, to string) string {
+	diff := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "a/" + path,
+		ToFile:   "b/" + path,
+		Context:  3,
+	}
+	text, err := difflib.GetUnifiedDiffString(diff)
+	if err != nil {
+		return ""
+	}
+
+	return text
+}
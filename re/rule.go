@@ -19,13 +19,13 @@ import (
 const (
 	LuaType ScriptType = iota
 	GoType
+	JSType
 )
 
 const TimeLayout = "2006-01-02T15:04:05.999999Z"
 
 type (
-	// ScriptType indicates Runtime type for the future versions
-	// that will support JS or Go runtimes alongside Lua.
+	// ScriptType indicates the runtime of the rule logic: Lua, Go or JavaScript.
 	ScriptType uint
 
 	Metadata map[string]any
@@ -42,6 +42,8 @@ var outputRegistry = map[outputs.OutputType]func() Runnable{
 	outputs.ChannelsType:     func() Runnable { return &outputs.ChannelPublisher{} },
 	outputs.SaveSenMLType:    func() Runnable { return &outputs.SenML{} },
 	outputs.SlackType:        func() Runnable { return &outputs.Slack{} },
+	outputs.WebhookType:      func() Runnable { return &outputs.Webhook{} },
+	outputs.BridgeType:       func() Runnable { return &outputs.Bridge{} },
 }
 
 type Rule struct {
@@ -55,11 +57,13 @@ type Rule struct {
 	Logic        Script            `json:"logic"`
 	Outputs      Outputs           `json:"outputs,omitempty"`
 	Schedule     schedule.Schedule `json:"schedule,omitempty"`
+	Window       *Window           `json:"window,omitempty"`
 	Status       Status            `json:"status"`
 	CreatedAt    time.Time         `json:"created_at"`
 	CreatedBy    string            `json:"created_by"`
 	UpdatedAt    time.Time         `json:"updated_at"`
 	UpdatedBy    string            `json:"updated_by"`
+	Revision     uint64            `json:"revision"` // Active revision of the rule spec.
 	// Extended
 	RoleID                    string                    `json:"role_id,omitempty"`
 	RoleName                  string                    `json:"role_name,omitempty"`
@@ -81,6 +85,7 @@ func (r Rule) EventEncode() (map[string]any, error) {
 		"created_by": r.CreatedBy,
 		"schedule":   r.Schedule.EventEncode(),
 		"status":     r.Status.String(),
+		"revision":   r.Revision,
 	}
 
 	if r.Name != "" {
@@ -115,6 +120,10 @@ func (r Rule) EventEncode() (map[string]any, error) {
 		m["input_topic"] = r.InputTopic
 	}
 
+	if r.Window != nil {
+		m["window"] = r.Window.EventEncode()
+	}
+
 	if r.Logic.Value != "" {
 		m["logic"] = map[string]any{
 			"type":  r.Logic.Type,
@@ -246,6 +255,29 @@ type Service interface {
 	RemoveRule(ctx context.Context, session authn.Session, id string) error
 	EnableRule(ctx context.Context, session authn.Session, id string) (Rule, error)
 	DisableRule(ctx context.Context, session authn.Session, id string) (Rule, error)
+	// DryRunRule runs the rule against the message without any side effects
+	// and returns the logic result and the outputs that would fire.
+	DryRunRule(ctx context.Context, session authn.Session, r Rule, msg *messaging.Message) (DryRunResult, error)
+	// ListExecutions retrieves the execution history of a rule.
+	ListExecutions(ctx context.Context, session authn.Session, pm ExecutionPageMeta) (ExecutionPage, error)
+	// ListRevisions retrieves the revisions of a rule, newest first.
+	ListRevisions(ctx context.Context, session authn.Session, pm RevisionPageMeta) (RevisionPage, error)
+	// DiffRevisions returns the rule spec changes from one revision to another.
+	DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (RevisionDiff, error)
+	// RollbackRule restores the rule spec of the given revision. The restored
+	// spec is saved as a new revision, so the history is kept.
+	RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (Rule, error)
+	// AddPipeline creates the pipeline of the domain rules.
+	AddPipeline(ctx context.Context, session authn.Session, p Pipeline) (Pipeline, error)
+	ViewPipeline(ctx context.Context, session authn.Session, id string) (Pipeline, error)
+	UpdatePipeline(ctx context.Context, session authn.Session, p Pipeline) (Pipeline, error)
+	ListPipelines(ctx context.Context, session authn.Session, pm PipelinePageMeta) (PipelinePage, error)
+	RemovePipeline(ctx context.Context, session authn.Session, id string) error
+	EnablePipeline(ctx context.Context, session authn.Session, id string) (Pipeline, error)
+	DisablePipeline(ctx context.Context, session authn.Session, id string) (Pipeline, error)
+	// ListPipelineExecutions retrieves the step executions of a pipeline. The
+	// steps of the same pipeline run share the correlation ID.
+	ListPipelineExecutions(ctx context.Context, session authn.Session, pm ExecutionPageMeta) (ExecutionPage, error)
 
 	StartScheduler(ctx context.Context) error
 	roles.RoleManager
@@ -263,5 +295,12 @@ type Repository interface {
 	ListAllRules(ctx context.Context, pm PageMeta) (Page, error)
 	ListUserRules(ctx context.Context, userID string, pm PageMeta) (Page, error)
 	UpdateRuleDue(ctx context.Context, id string, due time.Time) (Rule, error)
+	// RollbackRule replaces the rule spec with the spec of the given revision
+	// and saves it as a new revision.
+	RollbackRule(ctx context.Context, r Rule, revision uint64) (Rule, error)
+	StateRepository
+	ExecutionRepository
+	RevisionRepository
+	PipelineRepository
 	roles.Repository
 }
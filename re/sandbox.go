@@ -0,0 +1,367 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"runtime/metrics"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	golang "github.com/traefik/yaegi/interp"
+	"github.com/traefik/yaegi/stdlib"
+	"github.com/vadv/gopher-lua-libs/argparse"
+	"github.com/vadv/gopher-lua-libs/base64"
+	bit "github.com/vadv/gopher-lua-libs/bit"
+	"github.com/vadv/gopher-lua-libs/crypto"
+	"github.com/vadv/gopher-lua-libs/db"
+	"github.com/vadv/gopher-lua-libs/filepath"
+	client "github.com/vadv/gopher-lua-libs/http/client"
+	"github.com/vadv/gopher-lua-libs/ioutil"
+	luajson "github.com/vadv/gopher-lua-libs/json"
+	"github.com/vadv/gopher-lua-libs/regexp"
+	"github.com/vadv/gopher-lua-libs/storage"
+	luastrings "github.com/vadv/gopher-lua-libs/strings"
+	luatime "github.com/vadv/gopher-lua-libs/time"
+	"github.com/vadv/gopher-lua-libs/yaml"
+	lua "github.com/yuin/gopher-lua"
+)
+
+const (
+	defScriptTimeout     = 5 * time.Second
+	defScriptMemoryLimit = 64 << 20
+	memoryCheckInterval  = 10 * time.Millisecond
+	heapMetric           = "/memory/classes/heap/objects:bytes"
+)
+
+var (
+	// ErrScriptTimeout indicates that the rule logic ran longer than allowed.
+	ErrScriptTimeout = errors.New("rule logic exceeded the time limit")
+	// ErrScriptMemoryLimit indicates that the rule logic allocated more memory than allowed.
+	ErrScriptMemoryLimit = errors.New("rule logic exceeded the memory limit")
+	// ErrImportNotAllowed indicates that the Go script imports a package that is not allowed.
+	ErrImportNotAllowed = errors.New("import is not allowed in Go scripts")
+
+	errUnknownGoPackage = errors.New("unknown Go package")
+	errUnknownLuaModule = errors.New("unknown Lua module")
+
+	// defGoPackages are the packages the Go scripts can import.
+	defGoPackages = []string{
+		"bytes", "container/list", "crypto/hmac", "crypto/md5", "crypto/sha1", "crypto/sha256",
+		"encoding/base64", "encoding/binary", "encoding/hex", "encoding/json", "errors", "fmt",
+		"hash/crc32", "maps", "math", "math/bits", "math/rand", "regexp", "slices", "sort",
+		"strconv", "strings", "time", "unicode", "unicode/utf8",
+	}
+	// deniedGoSymbols block the goroutines in the allowed packages, since
+	// they can't be stopped when the script exceeds the limits.
+	deniedGoSymbols = map[string][]string{
+		"time/time": {"After", "AfterFunc", "NewTicker", "NewTimer", "Sleep", "Tick"},
+	}
+	// scriptPackages are exported to each Go script by the engine.
+	scriptPackages = []string{"messaging", "state"}
+
+	// defLuaModules are the modules the Lua scripts can require.
+	defLuaModules = map[string]lua.LGFunction{
+		"argparse": argparse.Loader,
+		"base64":   base64.Loader,
+		"bit":      bit.Loader,
+		"crypto":   crypto.Loader,
+		"json":     luajson.Loader,
+		"regexp":   regexp.Loader,
+		"strings":  luastrings.Loader,
+		"time":     luaTimeLoader,
+		"yaml":     yaml.Loader,
+	}
+	// optLuaModules access the host, so they must be allowed explicitly.
+	optLuaModules = map[string]lua.LGFunction{
+		"db":          db.Loader,
+		"filepath":    filepath.Loader,
+		"http_client": client.Loader,
+		"ioutil":      ioutil.Loader,
+		"storage":     storage.Loader,
+	}
+	// luaOsFuncs are the os functions that don't access the host.
+	luaOsFuncs = []string{"clock", "date", "difftime", "time"}
+)
+
+// SandboxConfig limits what the rule scripts can do.
+type SandboxConfig struct {
+	// Timeout is the maximum run time of the rule logic, 5s by default.
+	Timeout time.Duration
+	// MemoryLimit is the maximum heap growth in bytes while the rule logic runs,
+	// 64MiB by default. The Go runtime doesn't account the memory per goroutine,
+	// so the limit is shared among the scripts running at the same time.
+	MemoryLimit uint64
+	// GoPackages are the packages the Go scripts can import besides the default ones.
+	GoPackages []string
+	// LuaModules are the modules the Lua scripts can require besides the default ones.
+	LuaModules []string
+}
+
+type sandbox struct {
+	timeout     time.Duration
+	memoryLimit uint64
+	goPackages  map[string]bool
+	goSymbols   golang.Exports
+	luaModules  map[string]lua.LGFunction
+
+	mu         sync.Mutex
+	runs       map[*sandboxRun]struct{}
+	monitoring bool
+}
+
+type sandboxRun struct {
+	heap   uint64
+	cancel context.CancelCauseFunc
+}
+
+func newSandbox(cfg SandboxConfig) (*sandbox, error) {
+	s := &sandbox{
+		timeout:     cfg.Timeout,
+		memoryLimit: cfg.MemoryLimit,
+		goPackages:  make(map[string]bool),
+		goSymbols:   make(golang.Exports),
+		luaModules:  make(map[string]lua.LGFunction),
+		runs:        make(map[*sandboxRun]struct{}),
+	}
+	if s.timeout <= 0 {
+		s.timeout = defScriptTimeout
+	}
+	if s.memoryLimit == 0 {
+		s.memoryLimit = defScriptMemoryLimit
+	}
+
+	for _, p := range slices.Concat(defGoPackages, cfg.GoPackages) {
+		s.goPackages[p] = true
+	}
+	found := make(map[string]bool)
+	for key, syms := range stdlib.Symbols {
+		// The interpreter type mappings are not a package.
+		if key == "." {
+			s.goSymbols[key] = syms
+			continue
+		}
+		// The symbols are keyed by the import path and the package name.
+		path := key[:strings.LastIndex(key, "/")]
+		if !s.goPackages[path] {
+			continue
+		}
+		found[path] = true
+		allowed := make(map[string]reflect.Value, len(syms))
+		for name, v := range syms {
+			allowed[name] = v
+		}
+		for _, name := range deniedGoSymbols[key] {
+			delete(allowed, name)
+		}
+		s.goSymbols[key] = allowed
+	}
+	for p := range s.goPackages {
+		if !found[p] {
+			return nil, errors.Wrap(errUnknownGoPackage, errors.New(p))
+		}
+	}
+
+	for name, loader := range defLuaModules {
+		s.luaModules[name] = loader
+	}
+	for _, name := range cfg.LuaModules {
+		loader, ok := optLuaModules[name]
+		if !ok {
+			if _, ok := defLuaModules[name]; ok {
+				continue
+			}
+			return nil, errors.Wrap(errUnknownLuaModule, errors.New(name))
+		}
+		s.luaModules[name] = loader
+	}
+
+	return s, nil
+}
+
+// validate checks that the Go script only imports the allowed packages.
+func (s *sandbox) validate(script Script) error {
+	if script.Type != GoType {
+		return nil
+	}
+	// Syntax errors are reported when the script runs.
+	f, err := parser.ParseFile(token.NewFileSet(), "", script.Value, parser.ImportsOnly)
+	if err != nil {
+		return nil
+	}
+	for _, imp := range f.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return errors.Wrap(ErrImportNotAllowed, err)
+		}
+		if !s.goPackages[path] && !slices.Contains(scriptPackages, path) {
+			return errors.Wrap(ErrImportNotAllowed, errors.New(path))
+		}
+	}
+
+	return nil
+}
+
+// start returns the context of the script run, which is canceled when the
+// script exceeds the limits. The returned function ends the run and returns
+// the exceeded limit, if any.
+func (s *sandbox) start(ctx context.Context) (context.Context, func() error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	tctx, tcancel := context.WithTimeoutCause(ctx, s.timeout, ErrScriptTimeout)
+	run := &sandboxRun{heap: heapBytes(), cancel: cancel}
+	s.watch(run)
+
+	return tctx, func() error {
+		s.unwatch(run)
+		err := context.Cause(tctx)
+		tcancel()
+		cancel(nil)
+		if err == ErrScriptTimeout || err == ErrScriptMemoryLimit {
+			return err
+		}
+		return nil
+	}
+}
+
+func (s *sandbox) watch(run *sandboxRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.runs[run] = struct{}{}
+	if !s.monitoring {
+		s.monitoring = true
+		go s.monitor()
+	}
+}
+
+func (s *sandbox) unwatch(run *sandboxRun) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.runs, run)
+}
+
+// monitor cancels the runs when the heap grows over the memory limit
+// of all the running scripts. It stops when no scripts are running.
+func (s *sandbox) monitor() {
+	tick := time.NewTicker(memoryCheckInterval)
+	defer tick.Stop()
+	for range tick.C {
+		heap := heapBytes()
+		s.mu.Lock()
+		if len(s.runs) == 0 {
+			s.monitoring = false
+			s.mu.Unlock()
+			return
+		}
+		limit := s.memoryLimit * uint64(len(s.runs))
+		for run := range s.runs {
+			if heap > run.heap && heap-run.heap > limit {
+				run.cancel(ErrScriptMemoryLimit)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
+
+func heapBytes() uint64 {
+	sample := []metrics.Sample{{Name: heapMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+
+	return sample[0].Value.Uint64()
+}
+
+// newLuaState creates the Lua state with the allowed libraries and modules,
+// and with the message, state and window globals set.
+func (s *sandbox) newLuaState(ctx context.Context, state StateRepository, r Rule, msg *messaging.Message, win *WindowResult) *lua.LState {
+	l := lua.NewState(lua.Options{SkipOpenLibs: true})
+	openLuaLibs(l)
+	for name, loader := range s.luaModules {
+		l.PreloadModule(name, loader)
+	}
+	l.SetContext(ctx)
+
+	// Set the message object as a Lua global variable.
+	l.SetGlobal("message", prepareMsg(l, msg))
+	l.SetGlobal("state", prepareState(l, ruleState{ctx: ctx, repo: state, ruleID: r.ID}))
+	if win != nil {
+		l.SetGlobal("window", prepareWindow(l, *win))
+	}
+
+	return l
+}
+
+// openLuaLibs opens the standard libraries without the io and debug ones,
+// and removes the functions that access the files and the processes.
+func openLuaLibs(l *lua.LState) {
+	libs := []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.LoadLibName, lua.OpenPackage},
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+		{lua.CoroutineLibName, lua.OpenCoroutine},
+		{lua.OsLibName, lua.OpenOs},
+	}
+	for _, lib := range libs {
+		l.Push(l.NewFunction(lib.open))
+		l.Push(lua.LString(lib.name))
+		l.Call(1, 0)
+	}
+
+	osLib := l.GetGlobal(lua.OsLibName).(*lua.LTable)
+	safeOs := l.NewTable()
+	for _, fn := range luaOsFuncs {
+		safeOs.RawSetString(fn, osLib.RawGetString(fn))
+	}
+	l.SetGlobal(lua.OsLibName, safeOs)
+	l.SetGlobal("dofile", lua.LNil)
+	l.SetGlobal("loadfile", lua.LNil)
+
+	// Keep only the preload loader, so the modules are not loaded from the files.
+	if loaders, ok := l.GetField(l.Get(lua.RegistryIndex), "_LOADERS").(*lua.LTable); ok {
+		for loaders.Len() > 1 {
+			loaders.Remove(loaders.Len())
+		}
+	}
+}
+
+// luaTimeLoader loads the time module with the sleep that is
+// interrupted when the script exceeds the limits.
+func luaTimeLoader(l *lua.LState) int {
+	n := luatime.Loader(l)
+	if mod, ok := l.Get(-1).(*lua.LTable); ok {
+		mod.RawSetString("sleep", l.NewFunction(luaSleep))
+	}
+
+	return n
+}
+
+func luaSleep(l *lua.LState) int {
+	d := time.Duration(float64(l.CheckNumber(1)) * float64(time.Second))
+	t := time.NewTimer(d)
+	defer t.Stop()
+	ctx := l.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+
+	return 0
+}
@@ -0,0 +1,353 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/messaging"
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+)
+
+func runSandboxGo(sb *sandbox, code string) (any, error) {
+	ctx, stop := sb.start(context.Background())
+	res, err := sb.runGo(ctx, newMemoryState(), Rule{ID: "rule", Logic: Script{Type: GoType, Value: code}}, &messaging.Message{}, nil)
+	if lerr := stop(); lerr != nil {
+		return nil, lerr
+	}
+
+	return res, err
+}
+
+func runSandboxLua(sb *sandbox, code string) (lua.LValue, error) {
+	ctx, stop := sb.start(context.Background())
+	l := sb.newLuaState(ctx, newMemoryState(), Rule{ID: "rule"}, &messaging.Message{}, nil)
+	defer l.Close()
+	res, err := runLua(l, code)
+	if lerr := stop(); lerr != nil {
+		return nil, lerr
+	}
+
+	return res, err
+}
+
+func runSandboxJS(sb *sandbox, code string, msg *messaging.Message) (any, error) {
+	ctx, stop := sb.start(context.Background())
+	res, err := sb.runJS(ctx, newMemoryState(), Rule{ID: "rule", Logic: Script{Type: JSType, Value: code}}, msg, &WindowResult{Value: 2, Count: 3})
+	if lerr := stop(); lerr != nil {
+		return nil, lerr
+	}
+
+	return res, err
+}
+
+func TestNewSandbox(t *testing.T) {
+	cases := []struct {
+		desc string
+		cfg  SandboxConfig
+		err  error
+	}{
+		{
+			desc: "create sandbox with default config",
+			cfg:  SandboxConfig{},
+		},
+		{
+			desc: "create sandbox with additional packages and modules",
+			cfg:  SandboxConfig{GoPackages: []string{"net/http"}, LuaModules: []string{"http_client", "json"}},
+		},
+		{
+			desc: "create sandbox with unknown Go package",
+			cfg:  SandboxConfig{GoPackages: []string{"github.com/absmach/magistrala"}},
+			err:  errUnknownGoPackage,
+		},
+		{
+			desc: "create sandbox with unknown Lua module",
+			cfg:  SandboxConfig{LuaModules: []string{"socket"}},
+			err:  errUnknownLuaModule,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := newSandbox(tc.cfg)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestSandboxValidate(t *testing.T) {
+	sb, err := newSandbox(SandboxConfig{GoPackages: []string{"net/url"}})
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		script Script
+		err    error
+	}{
+		{
+			desc:   "validate Go script with allowed imports",
+			script: Script{Type: GoType, Value: "package main\nimport (\n\"strings\"\nm \"messaging\"\n\"state\"\n)"},
+		},
+		{
+			desc:   "validate Go script with additional allowed import",
+			script: Script{Type: GoType, Value: "package main\nimport \"net/url\""},
+		},
+		{
+			desc:   "validate Go script importing os",
+			script: Script{Type: GoType, Value: "package main\nimport \"os\""},
+			err:    ErrImportNotAllowed,
+		},
+		{
+			desc:   "validate Go script importing net/http",
+			script: Script{Type: GoType, Value: "package main\nimport (\n\"fmt\"\n\"net/http\"\n)"},
+			err:    ErrImportNotAllowed,
+		},
+		{
+			desc:   "validate Lua script",
+			script: Script{Type: LuaType, Value: `return require("ioutil")`},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := sb.validate(tc.script)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		})
+	}
+}
+
+func TestSandboxGo(t *testing.T) {
+	cases := []struct {
+		desc string
+		cfg  SandboxConfig
+		code string
+		res  any
+		err  error
+	}{
+		{
+			desc: "run script with allowed package",
+			code: "package main\nimport \"strings\"\nfunc logicFunction() any { return strings.ToUpper(\"ok\") }",
+			res:  "OK",
+		},
+		{
+			desc: "run script with not allowed package",
+			code: "package main\nimport \"os\"\nfunc logicFunction() any { return os.Getpid() }",
+			err:  errors.New("os"),
+		},
+		{
+			desc: "run script with denied symbol",
+			code: "package main\nimport \"time\"\nfunc logicFunction() any { time.Sleep(time.Second); return true }",
+			err:  errors.New("Sleep"),
+		},
+		{
+			desc: "run script exceeding time limit",
+			cfg:  SandboxConfig{Timeout: 50 * time.Millisecond},
+			code: "package main\nfunc logicFunction() any { for {} }",
+			err:  ErrScriptTimeout,
+		},
+		{
+			desc: "run script with init exceeding time limit",
+			cfg:  SandboxConfig{Timeout: 50 * time.Millisecond},
+			code: "package main\nfunc init() { for {} }\nfunc logicFunction() any { return true }",
+			err:  ErrScriptTimeout,
+		},
+		{
+			desc: "run script with variable initializer exceeding time limit",
+			cfg:  SandboxConfig{Timeout: 50 * time.Millisecond},
+			code: "package main\nvar v = loop()\nfunc loop() int { for {} }\nfunc logicFunction() any { return v }",
+			err:  ErrScriptTimeout,
+		},
+		{
+			desc: "run script exceeding memory limit",
+			cfg:  SandboxConfig{MemoryLimit: 16 << 20},
+			code: "package main\nvar s [][]byte\nfunc logicFunction() any { for { s = append(s, make([]byte, 1<<20)) } }",
+			err:  ErrScriptMemoryLimit,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sb, err := newSandbox(tc.cfg)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			res, err := runSandboxGo(sb, tc.code)
+			switch tc.err {
+			case nil:
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				assert.Equal(t, tc.res, res)
+			case ErrScriptTimeout, ErrScriptMemoryLimit:
+				assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			default:
+				assert.ErrorContains(t, err, tc.err.Error(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			}
+		})
+	}
+}
+
+func TestSandboxLua(t *testing.T) {
+	cases := []struct {
+		desc string
+		cfg  SandboxConfig
+		code string
+		res  lua.LValue
+		err  error
+	}{
+		{
+			desc: "run script with allowed module",
+			code: `local json = require("json"); return json.encode({a = 1})`,
+			res:  lua.LString(`{"a":1}`),
+		},
+		{
+			desc: "run script with os.execute",
+			code: `return os.execute("ls")`,
+			err:  errors.New("attempt to call a non-function object"),
+		},
+		{
+			desc: "run script with io library",
+			code: `return io.open("/etc/passwd")`,
+			err:  errors.New("attempt to index a non-table object(nil) with key 'open'"),
+		},
+		{
+			desc: "run script with dofile",
+			code: `return dofile("/etc/passwd")`,
+			err:  errors.New("attempt to call a non-function object"),
+		},
+		{
+			desc: "run script with not allowed module",
+			code: `return require("ioutil")`,
+			err:  errors.New("module ioutil not found"),
+		},
+		{
+			desc: "run script with additional allowed module",
+			cfg:  SandboxConfig{LuaModules: []string{"ioutil"}},
+			code: `return type(require("ioutil").read_file)`,
+			res:  lua.LString("function"),
+		},
+		{
+			desc: "run script exceeding time limit",
+			cfg:  SandboxConfig{Timeout: 50 * time.Millisecond},
+			code: `while true do end`,
+			err:  ErrScriptTimeout,
+		},
+		{
+			desc: "run script sleeping over time limit",
+			cfg:  SandboxConfig{Timeout: 50 * time.Millisecond},
+			code: `local time = require("time"); time.sleep(10); return true`,
+			err:  ErrScriptTimeout,
+		},
+		{
+			desc: "run script exceeding memory limit",
+			cfg:  SandboxConfig{MemoryLimit: 16 << 20},
+			code: `local t = {}; local i = 0; while true do i = i + 1; t[i] = string.rep("x", 1048576) .. i end`,
+			err:  ErrScriptMemoryLimit,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sb, err := newSandbox(tc.cfg)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			start := time.Now()
+			res, err := runSandboxLua(sb, tc.code)
+			switch tc.err {
+			case nil:
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				assert.Equal(t, tc.res, res)
+			case ErrScriptTimeout, ErrScriptMemoryLimit:
+				assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+				assert.Less(t, time.Since(start), 5*time.Second, fmt.Sprintf("%s: expected script to be stopped", tc.desc))
+			default:
+				assert.ErrorContains(t, err, tc.err.Error(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			}
+		})
+	}
+}
+
+func TestSandboxJS(t *testing.T) {
+	msg := &messaging.Message{
+		Channel:  "channel",
+		Subtopic: "temperature",
+		Created:  10,
+		Payload:  []byte(`{"v": 2, "tags": ["a", "b"]}`),
+	}
+
+	cases := []struct {
+		desc string
+		cfg  SandboxConfig
+		code string
+		res  any
+		err  error
+	}{
+		{
+			desc: "run script with message",
+			code: `function logicFunction() { return {channel: message.channel, subtopic: message.subtopic, created: message.created, v: message.payload.v * 2, tags: message.payload.tags.join(",")} }`,
+			res:  map[string]any{"channel": "channel", "subtopic": "temperature", "created": int64(10), "v": int64(4), "tags": "a,b"},
+		},
+		{
+			desc: "run script with state and window",
+			code: `function logicFunction() { state.increment("n"); state.set("last", message.payload.v, 60); return [state.increment("n", 2), state.get("last"), state.get("missing"), window.count] }`,
+			res:  []any{int64(3), int64(2), nil, int64(3)},
+		},
+		{
+			desc: "run script returning false",
+			code: `function logicFunction() { return message.payload.v > 5 }`,
+			res:  false,
+		},
+		{
+			desc: "run script without logic function",
+			code: `message.payload.v`,
+			err:  errInvalidLogicFunction,
+		},
+		{
+			desc: "run script with syntax error",
+			code: `function logicFunction() {`,
+			err:  errors.New("SyntaxError"),
+		},
+		{
+			desc: "run script throwing error",
+			code: `function logicFunction() { throw new Error("invalid payload") }`,
+			err:  errors.New("invalid payload"),
+		},
+		{
+			desc: "run script with require",
+			code: `function logicFunction() { return require("fs") }`,
+			err:  errors.New("require is not defined"),
+		},
+		{
+			desc: "run script exceeding time limit",
+			cfg:  SandboxConfig{Timeout: 50 * time.Millisecond},
+			code: `function logicFunction() { for (;;) {} }`,
+			err:  ErrScriptTimeout,
+		},
+		{
+			desc: "run script exceeding memory limit",
+			cfg:  SandboxConfig{MemoryLimit: 16 << 20},
+			code: `function logicFunction() { const a = []; for (let i = 0; ; i++) { a.push("x".repeat(1 << 20) + i) } }`,
+			err:  ErrScriptMemoryLimit,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			sb, err := newSandbox(tc.cfg)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			start := time.Now()
+			res, err := runSandboxJS(sb, tc.code, msg)
+			switch tc.err {
+			case nil:
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				assert.Equal(t, tc.res, res)
+			case ErrScriptTimeout, ErrScriptMemoryLimit, errInvalidLogicFunction:
+				assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+				assert.Less(t, time.Since(start), 5*time.Second, fmt.Sprintf("%s: expected script to be stopped", tc.desc))
+			default:
+				assert.ErrorContains(t, err, tc.err.Error(), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			}
+		})
+	}
+}
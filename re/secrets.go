@@ -0,0 +1,169 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/re/outputs"
+)
+
+// sealedPrefix marks the encrypted secrets, so the secrets of the rules
+// created before the encryption are still read as plain text.
+const sealedPrefix = "enc:"
+
+var (
+	ErrInvalidEncryptionKey = errors.New("invalid encryption key, must be 16, 24 or 32 bytes long")
+	errDecryptSecret        = errors.New("failed to decrypt output secret")
+)
+
+// SecretOutput is implemented by the outputs with credentials. The credentials
+// are stored encrypted and are never returned by the API.
+type SecretOutput interface {
+	Secrets() []*string
+}
+
+// All the outputs with credentials keep them encrypted.
+var (
+	_ SecretOutput = (*outputs.Postgres)(nil)
+	_ SecretOutput = (*outputs.Slack)(nil)
+	_ SecretOutput = (*outputs.Webhook)(nil)
+	_ SecretOutput = (*outputs.Bridge)(nil)
+)
+
+type secrets struct {
+	aead cipher.AEAD
+}
+
+func newSecrets(key []byte) (secrets, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return secrets{}, errors.Wrap(ErrInvalidEncryptionKey, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return secrets{}, errors.Wrap(ErrInvalidEncryptionKey, err)
+	}
+
+	return secrets{aead: aead}, nil
+}
+
+// seal encrypts the output secrets of the rule.
+func (s secrets) seal(r *Rule) error {
+	for _, o := range r.Outputs {
+		so, ok := o.(SecretOutput)
+		if !ok {
+			continue
+		}
+		for _, sec := range so.Secrets() {
+			if *sec == "" || strings.HasPrefix(*sec, sealedPrefix) {
+				continue
+			}
+			nonce := make([]byte, s.aead.NonceSize())
+			if _, err := rand.Read(nonce); err != nil {
+				return err
+			}
+			ct := s.aead.Seal(nonce, nonce, []byte(*sec), nil)
+			*sec = sealedPrefix + base64.StdEncoding.EncodeToString(ct)
+		}
+	}
+
+	return nil
+}
+
+// open decrypts the secrets of the output.
+func (s secrets) open(o Runnable) error {
+	so, ok := o.(SecretOutput)
+	if !ok {
+		return nil
+	}
+	for _, sec := range so.Secrets() {
+		if !strings.HasPrefix(*sec, sealedPrefix) {
+			continue
+		}
+		ct, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(*sec, sealedPrefix))
+		if err != nil {
+			return errors.Wrap(errDecryptSecret, err)
+		}
+		n := s.aead.NonceSize()
+		if len(ct) < n {
+			return errDecryptSecret
+		}
+		pt, err := s.aead.Open(nil, ct[:n], ct[n:], nil)
+		if err != nil {
+			return errors.Wrap(errDecryptSecret, err)
+		}
+		*sec = string(pt)
+	}
+
+	return nil
+}
+
+// keepSecrets copies the stored secrets left empty in the update. Secrets
+// are never returned, so the updates omit the ones that don't change.
+// Outputs are matched by position and type.
+func keepSecrets(r *Rule, stored Rule) {
+	for i, o := range r.Outputs {
+		if i >= len(stored.Outputs) || outputType(o) != outputType(stored.Outputs[i]) {
+			continue
+		}
+		so, ok := o.(SecretOutput)
+		if !ok {
+			continue
+		}
+		old := stored.Outputs[i].(SecretOutput).Secrets()
+		for j, sec := range so.Secrets() {
+			if *sec == "" {
+				*sec = *old[j]
+			}
+		}
+	}
+}
+
+// hasEmptySecrets reports if any of the rule output secrets is left empty.
+func hasEmptySecrets(r Rule) bool {
+	for _, o := range r.Outputs {
+		if so, ok := o.(SecretOutput); ok {
+			for _, sec := range so.Secrets() {
+				if *sec == "" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// redact removes the output secrets from the rule.
+func redact(r Rule) Rule {
+	for _, o := range r.Outputs {
+		if so, ok := o.(SecretOutput); ok {
+			for _, sec := range so.Secrets() {
+				*sec = ""
+			}
+		}
+	}
+
+	return r
+}
+
+func redactSpec(s Spec) Spec {
+	s.Outputs = redact(Rule{Outputs: s.Outputs}).Outputs
+
+	return s
+}
+
+func redactPage(p Page) Page {
+	for i := range p.Rules {
+		p.Rules[i] = redact(p.Rules[i])
+	}
+
+	return p
+}
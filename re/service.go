@@ -5,6 +5,9 @@ package re
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/absmach/magistrala"
@@ -12,13 +15,17 @@ import (
 	"github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/emailer"
 	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
 	pkglog "github.com/absmach/magistrala/pkg/logger"
 	"github.com/absmach/magistrala/pkg/messaging"
 	"github.com/absmach/magistrala/pkg/policies"
 	"github.com/absmach/magistrala/pkg/roles"
 	"github.com/absmach/magistrala/pkg/ticker"
+	"github.com/absmach/magistrala/pkg/webhook"
 	"github.com/absmach/magistrala/re/operations"
+	"github.com/absmach/magistrala/re/outputs"
+	"github.com/go-kit/kit/metrics/discard"
 )
 
 var (
@@ -28,6 +35,7 @@ var (
 
 type re struct {
 	repo       Repository
+	index      *RuleIndex
 	runInfo    chan pkglog.RunInfo
 	idp        magistrala.IDProvider
 	rePubSub   messaging.PubSub
@@ -36,16 +44,42 @@ type re struct {
 	ticker     ticker.Ticker
 	email      emailer.Emailer
 	readers    grpcReadersV1.ReadersServiceClient
+	history    HistoryConfig
+	sandbox    *sandbox
+	workers    *workerPool
+	// scheduled holds the IDs of the scheduled rules with a queued run.
+	scheduled sync.Map
+	programs  *programCache
+	bridges   *outputs.BridgePool
+	webhooks  *webhook.Pool
+	pgPool    *outputs.PostgresPool
+	secrets   secrets
 	roles.ProvisionManageService
 }
 
-func NewService(repo Repository, runInfo chan pkglog.RunInfo, policy policies.Service, idp magistrala.IDProvider, rePubSub messaging.PubSub, writersPub, alarmsPub messaging.Publisher, tck ticker.Ticker, emailer emailer.Emailer, readers grpcReadersV1.ReadersServiceClient, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
+func NewService(repo Repository, index *RuleIndex, runInfo chan pkglog.RunInfo, policy policies.Service, idp magistrala.IDProvider, rePubSub messaging.PubSub, writersPub, alarmsPub messaging.Publisher, tck ticker.Ticker, emailer emailer.Emailer, readers grpcReadersV1.ReadersServiceClient, history HistoryConfig, workers WorkerConfig, sandboxCfg SandboxConfig, encKey []byte, availableActions []roles.Action, builtInRoles map[roles.BuiltInRoleName][]roles.Action) (Service, error) {
 	rpms, err := roles.NewProvisionManageService(operations.EntityType, repo, policy, idp, availableActions, builtInRoles)
 	if err != nil {
 		return nil, err
 	}
-	return &re{
+	if history.Counter == nil {
+		history.Counter = discard.NewCounter()
+	}
+	if history.Latency == nil {
+		history.Latency = discard.NewHistogram()
+	}
+	sec, err := newSecrets(encKey)
+	if err != nil {
+		return nil, err
+	}
+	sb, err := newSandbox(sandboxCfg)
+	if err != nil {
+		return nil, err
+	}
+	workers = workers.withDefaults()
+	svc := &re{
 		repo:                   repo,
+		index:                  index,
 		idp:                    idp,
 		runInfo:                runInfo,
 		rePubSub:               rePubSub,
@@ -54,16 +88,23 @@ func NewService(repo Repository, runInfo chan pkglog.RunInfo, policy policies.Se
 		ticker:                 tck,
 		email:                  emailer,
 		readers:                readers,
+		history:                history,
+		sandbox:                sb,
+		workers:                newWorkerPool(workers.Workers, workers.QueueSize, workers.DomainQueueSize),
+		programs:               newProgramCache(sb, workers.ProgramCacheSize, workers.Workers),
+		bridges:                outputs.NewBridgePool(nil, 0),
+		webhooks:               webhook.NewPool(0),
+		secrets:                sec,
 		ProvisionManageService: rpms,
-	}, nil
+	}
+	svc.pgPool = outputs.NewPostgresPool(nil, 0, svc.reportBatchError)
+
+	return svc, nil
 }
 
 func (re *re) AddRule(ctx context.Context, session authn.Session, r Rule) (retRule Rule, retRps []roles.RoleProvision, retErr error) {
-	if r.Logic.Type == GoType && goKeywordRegex.MatchString(r.Logic.Value) {
-		return Rule{}, nil, errors.Wrap(svcerr.ErrMalformedEntity, ErrGoroutinesNotAllowed)
-	}
-	if r.Logic.Type == GoType && panicRegex.MatchString(r.Logic.Value) {
-		return Rule{}, nil, errors.Wrap(svcerr.ErrMalformedEntity, ErrPanicNotAllowed)
+	if err := re.validateRule(r); err != nil {
+		return Rule{}, nil, err
 	}
 
 	id, err := re.idp.ID()
@@ -80,8 +121,18 @@ func (re *re) AddRule(ctx context.Context, session authn.Session, r Rule) (retRu
 	if !r.Schedule.StartDateTime.IsZero() {
 		r.Schedule.StartDateTime = now
 	}
-	r.Schedule.Time = r.Schedule.StartDateTime
+	r.Schedule.Occurrences = 0
+	r.Schedule.Time = r.Schedule.FirstDue()
+	// New rules aren't pipeline steps yet, so only their own outputs can loop back.
+	if len(channelPublishers(r.Outputs)) > 0 {
+		if err := re.validateRuleChannels(ctx, r); err != nil {
+			return Rule{}, nil, err
+		}
+	}
 
+	if err := re.secrets.seal(&r); err != nil {
+		return Rule{}, nil, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
 	rule, err := re.repo.AddRule(ctx, r)
 	if err != nil {
 		return Rule{}, nil, errors.Wrap(svcerr.ErrCreateEntity, err)
@@ -114,7 +165,7 @@ func (re *re) AddRule(ctx context.Context, session authn.Session, r Rule) (retRu
 		return Rule{}, nil, errors.Wrap(svcerr.ErrAddPolicies, err)
 	}
 
-	return rule, rps, nil
+	return redact(rule), rps, nil
 }
 
 func (re *re) ViewRule(ctx context.Context, session authn.Session, id string, withRoles bool) (Rule, error) {
@@ -130,25 +181,49 @@ func (re *re) ViewRule(ctx context.Context, session authn.Session, id string, wi
 		return Rule{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
 
-	return rule, nil
+	return redact(rule), nil
 }
 
 func (re *re) UpdateRule(ctx context.Context, session authn.Session, r Rule) (Rule, error) {
-	if r.Logic.Type == GoType && goKeywordRegex.MatchString(r.Logic.Value) {
-		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, ErrGoroutinesNotAllowed)
+	if err := re.validateRule(r); err != nil {
+		return Rule{}, err
 	}
-	if r.Logic.Type == GoType && panicRegex.MatchString(r.Logic.Value) {
-		return Rule{}, errors.Wrap(svcerr.ErrMalformedEntity, ErrPanicNotAllowed)
+	// Rules without channel outputs can't publish back, so the saved rule
+	// is only read for the cycle check if the update changes the channels.
+	if hasEmptySecrets(r) || r.Outputs == nil || len(channelPublishers(r.Outputs)) > 0 {
+		stored, err := re.repo.ViewRule(ctx, r.ID)
+		if err != nil {
+			return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+		}
+		if channelsChanged(r, stored) {
+			check := stored
+			check.DomainID = session.DomainID
+			check.InputChannel = r.InputChannel
+			check.InputTopic = r.InputTopic
+			if r.Outputs != nil {
+				check.Outputs = r.Outputs
+			}
+			if err := re.validateRuleChannels(ctx, check); err != nil {
+				return Rule{}, err
+			}
+		}
+		if hasEmptySecrets(r) {
+			keepSecrets(&r, stored)
+		}
+	}
+	if err := re.secrets.seal(&r); err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-
 	r.UpdatedAt = time.Now().UTC()
 	r.UpdatedBy = session.UserID
 	rule, err := re.repo.UpdateRule(ctx, r)
 	if err != nil {
 		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
+	// Logic is compiled and output connections are reopened with the new settings on the next run.
+	re.releaseRule(ctx, rule.ID)
 
-	return rule, nil
+	return redact(rule), nil
 }
 
 func (re *re) UpdateRuleTags(ctx context.Context, session authn.Session, r Rule) (Rule, error) {
@@ -159,18 +234,21 @@ func (re *re) UpdateRuleTags(ctx context.Context, session authn.Session, r Rule)
 		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
 
-	return rule, nil
+	return redact(rule), nil
 }
 
 func (re *re) UpdateRuleSchedule(ctx context.Context, session authn.Session, r Rule) (Rule, error) {
 	r.UpdatedAt = time.Now().UTC()
 	r.UpdatedBy = session.UserID
+	if r.Schedule.Time.IsZero() {
+		r.Schedule.Time = r.Schedule.FirstDue()
+	}
 	rule, err := re.repo.UpdateRuleSchedule(ctx, r)
 	if err != nil {
 		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
 
-	return rule, nil
+	return redact(rule), nil
 }
 
 func (re *re) ListRules(ctx context.Context, session authn.Session, pm PageMeta) (Page, error) {
@@ -180,19 +258,20 @@ func (re *re) ListRules(ctx context.Context, session authn.Session, pm PageMeta)
 		if err != nil {
 			return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
 		}
-		return page, nil
+		return redactPage(page), nil
 	}
 	page, err := re.repo.ListUserRules(ctx, session.UserID, pm)
 	if err != nil {
 		return Page{}, errors.Wrap(svcerr.ErrViewEntity, err)
 	}
-	return page, nil
+	return redactPage(page), nil
 }
 
 func (re *re) RemoveRule(ctx context.Context, session authn.Session, id string) error {
 	if err := re.repo.RemoveRule(ctx, id); err != nil {
 		return errors.Wrap(svcerr.ErrRemoveEntity, err)
 	}
+	re.releaseRule(ctx, id)
 
 	return nil
 }
@@ -212,7 +291,7 @@ func (re *re) EnableRule(ctx context.Context, session authn.Session, id string)
 	if err != nil {
 		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-	return rule, nil
+	return redact(rule), nil
 }
 
 func (re *re) DisableRule(ctx context.Context, session authn.Session, id string) (Rule, error) {
@@ -230,9 +309,290 @@ func (re *re) DisableRule(ctx context.Context, session authn.Session, id string)
 	if err != nil {
 		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
 	}
-	return rule, nil
+	re.releaseRule(ctx, id)
+
+	return redact(rule), nil
 }
 
-func (re *re) Cancel() error {
+func (re *re) ListExecutions(ctx context.Context, session authn.Session, pm ExecutionPageMeta) (ExecutionPage, error) {
+	pm.DomainID = session.DomainID
+	page, err := re.repo.ListExecutions(ctx, pm)
+	if err != nil {
+		return ExecutionPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (re *re) ListRevisions(ctx context.Context, session authn.Session, pm RevisionPageMeta) (RevisionPage, error) {
+	page, err := re.repo.ListRevisions(ctx, pm)
+	if err != nil {
+		return RevisionPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	for i := range page.Revisions {
+		page.Revisions[i].Spec = redactSpec(page.Revisions[i].Spec)
+	}
+
+	return page, nil
+}
+
+func (re *re) DiffRevisions(ctx context.Context, session authn.Session, id string, from, to uint64) (RevisionDiff, error) {
+	a, err := re.repo.ViewRevision(ctx, id, from)
+	if err != nil {
+		return RevisionDiff{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	b, err := re.repo.ViewRevision(ctx, id, to)
+	if err != nil {
+		return RevisionDiff{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	changes, err := diffSpecs(redactSpec(a.Spec), redactSpec(b.Spec))
+	if err != nil {
+		return RevisionDiff{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return RevisionDiff{
+		RuleID:  id,
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+func (re *re) RollbackRule(ctx context.Context, session authn.Session, id string, revision uint64) (Rule, error) {
+	rev, err := re.repo.ViewRevision(ctx, id, revision)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+	current, err := re.repo.ViewRule(ctx, id)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	// The revision outputs keep the sealed secrets, so they're restored as they were.
+	r := rev.Spec.restore(current)
+	// The restored outputs replace the saved ones, so a rule without
+	// channel outputs can't publish back to its input.
+	if len(channelPublishers(r.Outputs)) > 0 {
+		if err := re.validateRuleChannels(ctx, r); err != nil {
+			return Rule{}, err
+		}
+	}
+	r.UpdatedAt = time.Now().UTC()
+	r.UpdatedBy = session.UserID
+	rule, err := re.repo.RollbackRule(ctx, r, revision)
+	if err != nil {
+		return Rule{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+	re.releaseRule(ctx, rule.ID)
+
+	return redact(rule), nil
+}
+
+func (re *re) AddPipeline(ctx context.Context, session authn.Session, p Pipeline) (Pipeline, error) {
+	p.DomainID = session.DomainID
+	if err := re.validatePipeline(ctx, p); err != nil {
+		return Pipeline{}, err
+	}
+	id, err := re.idp.ID()
+	if err != nil {
+		return Pipeline{}, err
+	}
+	p.ID = id
+	p.CreatedAt = time.Now().UTC()
+	p.CreatedBy = session.UserID
+	p.UpdatedAt = time.Time{}
+	p.UpdatedBy = ""
+	p.Status = EnabledStatus
+	saved, err := re.repo.AddPipeline(ctx, p)
+	if err != nil {
+		return Pipeline{}, errors.Wrap(svcerr.ErrCreateEntity, err)
+	}
+
+	return saved, nil
+}
+
+func (re *re) ViewPipeline(ctx context.Context, session authn.Session, id string) (Pipeline, error) {
+	p, err := re.repo.ViewPipeline(ctx, session.DomainID, id)
+	if err != nil {
+		return Pipeline{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return p, nil
+}
+
+func (re *re) UpdatePipeline(ctx context.Context, session authn.Session, p Pipeline) (Pipeline, error) {
+	p.DomainID = session.DomainID
+	if err := re.validatePipeline(ctx, p); err != nil {
+		return Pipeline{}, err
+	}
+	p.UpdatedAt = time.Now().UTC()
+	p.UpdatedBy = session.UserID
+	saved, err := re.repo.UpdatePipeline(ctx, p)
+	if err != nil {
+		return Pipeline{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return saved, nil
+}
+
+func (re *re) ListPipelines(ctx context.Context, session authn.Session, pm PipelinePageMeta) (PipelinePage, error) {
+	pm.DomainID = session.DomainID
+	page, err := re.repo.ListPipelines(ctx, pm)
+	if err != nil {
+		return PipelinePage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+func (re *re) RemovePipeline(ctx context.Context, session authn.Session, id string) error {
+	if err := re.repo.RemovePipeline(ctx, session.DomainID, id); err != nil {
+		return errors.Wrap(svcerr.ErrRemoveEntity, err)
+	}
+
+	return nil
+}
+
+func (re *re) EnablePipeline(ctx context.Context, session authn.Session, id string) (Pipeline, error) {
+	return re.updatePipelineStatus(ctx, session, id, EnabledStatus)
+}
+
+func (re *re) DisablePipeline(ctx context.Context, session authn.Session, id string) (Pipeline, error) {
+	return re.updatePipelineStatus(ctx, session, id, DisabledStatus)
+}
+
+func (re *re) updatePipelineStatus(ctx context.Context, session authn.Session, id string, status Status) (Pipeline, error) {
+	p := Pipeline{
+		ID:        id,
+		DomainID:  session.DomainID,
+		Status:    status,
+		UpdatedAt: time.Now().UTC(),
+		UpdatedBy: session.UserID,
+	}
+	saved, err := re.repo.UpdatePipelineStatus(ctx, p)
+	if err != nil {
+		return Pipeline{}, errors.Wrap(svcerr.ErrUpdateEntity, err)
+	}
+
+	return saved, nil
+}
+
+func (re *re) ListPipelineExecutions(ctx context.Context, session authn.Session, pm ExecutionPageMeta) (ExecutionPage, error) {
+	pm.DomainID = session.DomainID
+	page, err := re.repo.ListExecutions(ctx, pm)
+	if err != nil {
+		return ExecutionPage{}, errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return page, nil
+}
+
+// validatePipeline checks the steps, and that the step rules are in the
+// pipeline domain and don't publish back to the pipeline input.
+func (re *re) validatePipeline(ctx context.Context, p Pipeline) error {
+	if err := p.Validate(); err != nil {
+		return errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	steps := make(map[string]Rule, len(p.Steps))
+	for _, id := range p.RuleIDs() {
+		r, err := re.repo.ViewRule(ctx, id)
+		switch {
+		case errors.Contains(err, repoerr.ErrNotFound), err == nil && r.DomainID != p.DomainID:
+			return errors.Wrap(svcerr.ErrMalformedEntity, errStepRuleNotFound)
+		case err != nil:
+			return errors.Wrap(svcerr.ErrViewEntity, err)
+		}
+		steps[id] = r
+	}
+	err := re.checkPipelineCycles(ctx, p, steps)
+	switch {
+	case errors.Contains(err, ErrChannelCycle):
+		return errors.Wrap(svcerr.ErrMalformedEntity, err)
+	case err != nil:
+		return errors.Wrap(svcerr.ErrViewEntity, err)
+	}
+
+	return nil
+}
+
+func (re *re) validateRule(r Rule) error {
+	if r.Logic.Type == GoType && goKeywordRegex.MatchString(r.Logic.Value) {
+		return errors.Wrap(svcerr.ErrMalformedEntity, ErrGoroutinesNotAllowed)
+	}
+	if r.Logic.Type == GoType && panicRegex.MatchString(r.Logic.Value) {
+		return errors.Wrap(svcerr.ErrMalformedEntity, ErrPanicNotAllowed)
+	}
+	if err := re.sandbox.validate(r.Logic); err != nil {
+		return errors.Wrap(svcerr.ErrMalformedEntity, err)
+	}
+	// Outputs that can be misconfigured validate themselves.
+	for _, o := range r.Outputs {
+		if v, ok := o.(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return errors.Wrap(svcerr.ErrMalformedEntity, err)
+			}
+		}
+	}
+
 	return nil
 }
+
+// releaseRule drops the compiled logic, closes the bridge connections and
+// inserts the pending PostgreSQL rows of the rule. The errors are only
+// reported since the rule change itself succeeded.
+func (re *re) releaseRule(ctx context.Context, ruleID string) {
+	re.programs.remove(ruleID)
+	if err := re.bridges.Remove(ruleID); err != nil {
+		go func() {
+			re.runInfo <- pkglog.RunInfo{
+				Level:   slog.LevelWarn,
+				Message: fmt.Sprintf("failed to close rule bridge connections: %s", err),
+				Details: []slog.Attr{slog.String("rule_id", ruleID)},
+			}
+		}()
+	}
+	if err := re.pgPool.Remove(ctx, ruleID); err != nil {
+		go re.reportBatchError(ruleID, err)
+	}
+}
+
+// reportBatchError records the failed background insert of the rule batch
+// as a failed execution, since the executions that queued the rows were
+// recorded as successful.
+func (re *re) reportBatchError(ruleID string, err error) {
+	info := pkglog.RunInfo{
+		Level:   slog.LevelError,
+		Message: fmt.Sprintf("failed to insert PostgreSQL batch: %s", err),
+		Details: []slog.Attr{slog.String("rule_id", ruleID)},
+	}
+	ctx := context.Background()
+	exec := Execution{
+		RuleID:    ruleID,
+		Status:    FailedExecution,
+		Error:     info.Message,
+		Outputs:   []string{outputs.SaveRemotePgType.String()},
+		CreatedAt: time.Now().UTC(),
+	}
+	// The rule may be removed already, so only the domain is read.
+	r, err := re.repo.ViewRule(ctx, ruleID)
+	if err == nil {
+		exec.DomainID = r.DomainID
+		err = re.saveExecution(ctx, exec)
+	}
+	if err != nil {
+		info.Details = append(info.Details, slog.String("history_error", err.Error()))
+	}
+	re.runInfo <- info
+}
+
+func (re *re) Cancel() error {
+	// Finish the queued rules before closing their outputs.
+	re.workers.close()
+	re.webhooks.Close()
+	err := re.bridges.Close()
+	if e := re.pgPool.Close(); e != nil {
+		err = errors.Wrap(e, err)
+	}
+
+	return err
+}
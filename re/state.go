@@ -0,0 +1,431 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	repoerr "github.com/absmach/magistrala/pkg/errors/repository"
+	"github.com/absmach/magistrala/pkg/messaging"
+)
+
+const (
+	tumblingWindow = "tumbling"
+	slidingWindow  = "sliding"
+
+	avgAggregation   = "avg"
+	minAggregation   = "min"
+	maxAggregation   = "max"
+	sumAggregation   = "sum"
+	countAggregation = "count"
+
+	groupByClient    = "client"
+	groupBySubtopic  = "subtopic"
+	groupByPublisher = "publisher"
+)
+
+var (
+	ErrInvalidWindowType  = errors.NewRequestError("invalid window type")
+	ErrInvalidAggregation = errors.NewRequestError("invalid window aggregation")
+	ErrInvalidWindowSize  = errors.NewRequestError("window size must be greater than zero")
+	ErrMissingWindowField = errors.NewRequestError("window field is required for the aggregation")
+	ErrInvalidGroupBy     = errors.NewRequestError("invalid window group_by")
+	ErrWindowField        = errors.New("window field is missing or not numeric")
+)
+
+// WindowType is the type of the window used to aggregate rule input.
+type WindowType uint8
+
+const (
+	// TumblingWindow splits time into fixed, non-overlapping intervals.
+	TumblingWindow WindowType = iota
+	// SlidingWindow always covers the last Size of time.
+	SlidingWindow
+)
+
+func (wt WindowType) String() string {
+	switch wt {
+	case SlidingWindow:
+		return slidingWindow
+	default:
+		return tumblingWindow
+	}
+}
+
+func (wt WindowType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(wt.String())
+}
+
+func (wt *WindowType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch strings.ToLower(s) {
+	case "", tumblingWindow:
+		*wt = TumblingWindow
+	case slidingWindow:
+		*wt = SlidingWindow
+	default:
+		return ErrInvalidWindowType
+	}
+
+	return nil
+}
+
+// Aggregation is the function applied to the samples of a window.
+type Aggregation uint8
+
+const (
+	AvgAggregation Aggregation = iota
+	MinAggregation
+	MaxAggregation
+	SumAggregation
+	CountAggregation
+)
+
+func (a Aggregation) String() string {
+	switch a {
+	case MinAggregation:
+		return minAggregation
+	case MaxAggregation:
+		return maxAggregation
+	case SumAggregation:
+		return sumAggregation
+	case CountAggregation:
+		return countAggregation
+	default:
+		return avgAggregation
+	}
+}
+
+func (a Aggregation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+func (a *Aggregation) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	switch strings.ToLower(s) {
+	case "", avgAggregation:
+		*a = AvgAggregation
+	case minAggregation:
+		*a = MinAggregation
+	case maxAggregation:
+		*a = MaxAggregation
+	case sumAggregation:
+		*a = SumAggregation
+	case countAggregation:
+		*a = CountAggregation
+	default:
+		return ErrInvalidAggregation
+	}
+
+	return nil
+}
+
+// Apply aggregates the values. An empty slice aggregates to zero.
+func (a Aggregation) Apply(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	switch a {
+	case CountAggregation:
+		return float64(len(values))
+	case MinAggregation:
+		ret := math.Inf(1)
+		for _, v := range values {
+			ret = math.Min(ret, v)
+		}
+		return ret
+	case MaxAggregation:
+		ret := math.Inf(-1)
+		for _, v := range values {
+			ret = math.Max(ret, v)
+		}
+		return ret
+	default:
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		if a == SumAggregation {
+			return sum
+		}
+		return sum / float64(len(values))
+	}
+}
+
+// Window declares a time window aggregation evaluated before the rule logic.
+// The aggregated value is available to the script as the `window` object.
+type Window struct {
+	Type        WindowType    `json:"type"`
+	Size        time.Duration `json:"size"`
+	Field       string        `json:"field,omitempty"` // Dot-separated path to a numeric value in the JSON payload.
+	Aggregation Aggregation   `json:"aggregation"`
+	GroupBy     string        `json:"group_by,omitempty"` // Keep a separate window per client, subtopic or publisher.
+}
+
+func (w Window) Validate() error {
+	if w.Size <= 0 {
+		return ErrInvalidWindowSize
+	}
+	if w.Field == "" && w.Aggregation != CountAggregation {
+		return ErrMissingWindowField
+	}
+	switch w.GroupBy {
+	case "", groupByClient, groupBySubtopic, groupByPublisher:
+	default:
+		return ErrInvalidGroupBy
+	}
+
+	return nil
+}
+
+func (w Window) MarshalJSON() ([]byte, error) {
+	type Alias Window
+	return json.Marshal(struct {
+		Size string `json:"size"`
+		*Alias
+	}{
+		Size:  w.Size.String(),
+		Alias: (*Alias)(&w),
+	})
+}
+
+func (w *Window) UnmarshalJSON(data []byte) error {
+	type Alias Window
+	temp := struct {
+		Size string `json:"size"`
+		*Alias
+	}{
+		Alias: (*Alias)(w),
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	if temp.Size != "" {
+		size, err := time.ParseDuration(temp.Size)
+		if err != nil {
+			return errors.Wrap(ErrInvalidWindowSize, err)
+		}
+		w.Size = size
+	}
+
+	return nil
+}
+
+// EventEncode converts a Window struct to map[string]any.
+func (w Window) EventEncode() map[string]any {
+	m := map[string]any{
+		"type":        w.Type.String(),
+		"size":        w.Size.String(),
+		"aggregation": w.Aggregation.String(),
+	}
+	if w.Field != "" {
+		m["field"] = w.Field
+	}
+	if w.GroupBy != "" {
+		m["group_by"] = w.GroupBy
+	}
+
+	return m
+}
+
+// key returns the window key of the message, so grouped windows are kept apart.
+func (w Window) key(msg *messaging.Message) string {
+	switch w.GroupBy {
+	case groupByClient:
+		return msg.ClientIdentity()
+	case groupBySubtopic:
+		return msg.Subtopic
+	case groupByPublisher:
+		return msg.Publisher
+	default:
+		return ""
+	}
+}
+
+// start returns the beginning of the window that contains t.
+func (w Window) start(t time.Time) time.Time {
+	if w.Type == SlidingWindow {
+		return t.Add(-w.Size)
+	}
+	return t.Truncate(w.Size)
+}
+
+// value extracts the numeric window field from the JSON payload.
+func (w Window) value(payload []byte) (float64, error) {
+	if w.Field == "" {
+		return 0, nil
+	}
+	var pld any
+	if err := json.Unmarshal(payload, &pld); err != nil {
+		return 0, errors.Wrap(ErrWindowField, err)
+	}
+	for _, k := range strings.Split(w.Field, ".") {
+		switch v := pld.(type) {
+		case map[string]any:
+			pld = v[k]
+		case []any:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(v) {
+				return 0, ErrWindowField
+			}
+			pld = v[i]
+		default:
+			return 0, ErrWindowField
+		}
+	}
+	switch v := pld.(type) {
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, ErrWindowField
+	}
+}
+
+// WindowSample is a single value recorded in a rule window.
+type WindowSample struct {
+	RuleID    string
+	Key       string
+	Value     float64
+	CreatedAt time.Time
+}
+
+// WindowResult is the aggregated window passed to the rule logic.
+type WindowResult struct {
+	Value float64 `json:"value"`
+	Count int     `json:"count"`
+	Start int64   `json:"start"`
+	End   int64   `json:"end"`
+}
+
+// StateEntry is a value kept in the rule state store.
+// A zero ExpiresAt means the entry never expires.
+type StateEntry struct {
+	RuleID    string
+	Key       string
+	Value     any
+	ExpiresAt time.Time
+	UpdatedAt time.Time
+}
+
+// StateRepository persists per-rule state and window samples.
+type StateRepository interface {
+	// SaveState creates or replaces the state entry.
+	SaveState(ctx context.Context, e StateEntry) error
+
+	// RetrieveState retrieves a non-expired state entry.
+	RetrieveState(ctx context.Context, ruleID, key string) (StateEntry, error)
+
+	// IncrementState atomically adds delta to a numeric state entry, creating
+	// it if missing or expired, and returns the new value.
+	IncrementState(ctx context.Context, ruleID, key string, delta float64, expiresAt time.Time) (float64, error)
+
+	// RemoveState removes the state entry.
+	RemoveState(ctx context.Context, ruleID, key string) error
+
+	// RemoveExpiredState removes all the entries that expired before the given time.
+	RemoveExpiredState(ctx context.Context, before time.Time) error
+
+	// AppendWindowSample stores the sample, drops the samples created before
+	// the window start and returns the samples that remain in the window.
+	AppendWindowSample(ctx context.Context, s WindowSample, start time.Time) ([]WindowSample, error)
+}
+
+func evalWindow(ctx context.Context, repo StateRepository, r Rule, msg *messaging.Message) (WindowResult, error) {
+	w := *r.Window
+	val, err := w.value(msg.Payload)
+	if err != nil {
+		return WindowResult{}, err
+	}
+	now := time.Now().UTC()
+	start := w.start(now)
+	s := WindowSample{
+		RuleID:    r.ID,
+		Key:       w.key(msg),
+		Value:     val,
+		CreatedAt: now,
+	}
+	samples, err := repo.AppendWindowSample(ctx, s, start)
+	if err != nil {
+		return WindowResult{}, err
+	}
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = s.Value
+	}
+	end := start.Add(w.Size)
+	if w.Type == SlidingWindow {
+		end = now
+	}
+
+	return WindowResult{
+		Value: w.Aggregation.Apply(values),
+		Count: len(values),
+		Start: start.Unix(),
+		End:   end.Unix(),
+	}, nil
+}
+
+// ruleState is the state store handle passed to the rule scripts.
+type ruleState struct {
+	ctx    context.Context
+	repo   StateRepository
+	ruleID string
+}
+
+func (s ruleState) Get(key string) (any, error) {
+	e, err := s.repo.RetrieveState(s.ctx, s.ruleID, key)
+	switch {
+	case errors.Contains(err, repoerr.ErrNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return e.Value, nil
+}
+
+func (s ruleState) Set(key string, value any, ttl int) error {
+	now := time.Now().UTC()
+	e := StateEntry{
+		RuleID:    s.ruleID,
+		Key:       key,
+		Value:     value,
+		ExpiresAt: expiresAt(now, ttl),
+		UpdatedAt: now,
+	}
+	return s.repo.SaveState(s.ctx, e)
+}
+
+func (s ruleState) Increment(key string, delta float64, ttl int) (float64, error) {
+	return s.repo.IncrementState(s.ctx, s.ruleID, key, delta, expiresAt(time.Now().UTC(), ttl))
+}
+
+func (s ruleState) Delete(key string) error {
+	return s.repo.RemoveState(s.ctx, s.ruleID, key)
+}
+
+// expiresAt converts TTL in seconds to the expiration time. Non-positive
+// TTL means the entry never expires.
+func expiresAt(now time.Time, ttl int) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(time.Duration(ttl) * time.Second)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/re"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregationApply(t *testing.T) {
+	values := []float64{4, 1, 7}
+	cases := []struct {
+		desc        string
+		aggregation re.Aggregation
+		values      []float64
+		res         float64
+	}{
+		{
+			desc:        "average of values",
+			aggregation: re.AvgAggregation,
+			values:      values,
+			res:         4,
+		},
+		{
+			desc:        "minimum of values",
+			aggregation: re.MinAggregation,
+			values:      values,
+			res:         1,
+		},
+		{
+			desc:        "maximum of values",
+			aggregation: re.MaxAggregation,
+			values:      values,
+			res:         7,
+		},
+		{
+			desc:        "sum of values",
+			aggregation: re.SumAggregation,
+			values:      values,
+			res:         12,
+		},
+		{
+			desc:        "count of values",
+			aggregation: re.CountAggregation,
+			values:      values,
+			res:         3,
+		},
+		{
+			desc:        "average of empty values",
+			aggregation: re.AvgAggregation,
+			values:      []float64{},
+			res:         0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.res, tc.aggregation.Apply(tc.values))
+		})
+	}
+}
+
+func TestWindowUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		desc string
+		data string
+		res  re.Window
+		err  error
+	}{
+		{
+			desc: "unmarshal sliding window",
+			data: `{"type": "sliding", "size": "5m", "field": "temperature", "aggregation": "avg", "group_by": "client"}`,
+			res: re.Window{
+				Type:        re.SlidingWindow,
+				Size:        5 * time.Minute,
+				Field:       "temperature",
+				Aggregation: re.AvgAggregation,
+				GroupBy:     "client",
+			},
+		},
+		{
+			desc: "unmarshal tumbling window",
+			data: `{"type": "tumbling", "size": "1h", "aggregation": "count"}`,
+			res: re.Window{
+				Type:        re.TumblingWindow,
+				Size:        time.Hour,
+				Aggregation: re.CountAggregation,
+			},
+		},
+		{
+			desc: "unmarshal window with invalid type",
+			data: `{"type": "hopping", "size": "1h"}`,
+			err:  re.ErrInvalidWindowType,
+		},
+		{
+			desc: "unmarshal window with invalid aggregation",
+			data: `{"size": "1h", "aggregation": "median"}`,
+			err:  re.ErrInvalidAggregation,
+		},
+		{
+			desc: "unmarshal window with invalid size",
+			data: `{"size": "five minutes"}`,
+			err:  re.ErrInvalidWindowSize,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var w re.Window
+			err := json.Unmarshal([]byte(tc.data), &w)
+			assert.True(t, errors.Contains(err, tc.err), "expected error %v got %v", tc.err, err)
+			if tc.err == nil {
+				assert.Equal(t, tc.res, w)
+			}
+		})
+	}
+}
+
+func TestWindowMarshalJSON(t *testing.T) {
+	w := re.Window{
+		Type:        re.SlidingWindow,
+		Size:        90 * time.Second,
+		Field:       "temperature",
+		Aggregation: re.MaxAggregation,
+	}
+	data, err := json.Marshal(w)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "sliding", "size": "1m30s", "field": "temperature", "aggregation": "max"}`, string(data))
+}
+
+func TestWindowValidate(t *testing.T) {
+	cases := []struct {
+		desc   string
+		window re.Window
+		err    error
+	}{
+		{
+			desc:   "validate valid window",
+			window: re.Window{Size: time.Minute, Field: "temperature"},
+		},
+		{
+			desc:   "validate count window without field",
+			window: re.Window{Size: time.Minute, Aggregation: re.CountAggregation},
+		},
+		{
+			desc:   "validate window with zero size",
+			window: re.Window{Field: "temperature"},
+			err:    re.ErrInvalidWindowSize,
+		},
+		{
+			desc:   "validate average window without field",
+			window: re.Window{Size: time.Minute},
+			err:    re.ErrMissingWindowField,
+		},
+		{
+			desc:   "validate window with invalid group by",
+			window: re.Window{Size: time.Minute, Field: "temperature", GroupBy: "domain"},
+			err:    re.ErrInvalidGroupBy,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.window.Validate()
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
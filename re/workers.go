@@ -0,0 +1,163 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/absmach/magistrala/pkg/errors"
+)
+
+const (
+	defQueueSize        = 10000
+	defDomainQueueSize  = 1000
+	defProgramCacheSize = 1000
+)
+
+var (
+	// ErrQueueFull indicates that the rule executions queue is full.
+	ErrQueueFull = errors.New("rule executions queue is full")
+	// ErrDomainQueueFull indicates that the domain has too many queued rule executions.
+	ErrDomainQueueFull = errors.New("domain rule executions queue is full")
+
+	errWorkersClosed = errors.New("rule workers are closed")
+)
+
+// WorkerConfig configures the rule execution workers.
+type WorkerConfig struct {
+	// Workers is the number of the rules run concurrently, 4 per CPU by default.
+	Workers int
+	// QueueSize is the maximum number of the queued rule executions.
+	QueueSize int
+	// DomainQueueSize is the maximum number of the queued rule executions of a single domain.
+	DomainQueueSize int
+	// ProgramCacheSize is the number of the rules with the compiled logic kept in memory.
+	ProgramCacheSize int
+}
+
+func (cfg WorkerConfig) withDefaults() WorkerConfig {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4 * runtime.NumCPU()
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defQueueSize
+	}
+	if cfg.DomainQueueSize <= 0 || cfg.DomainQueueSize > cfg.QueueSize {
+		cfg.DomainQueueSize = min(defDomainQueueSize, cfg.QueueSize)
+	}
+	if cfg.ProgramCacheSize <= 0 {
+		cfg.ProgramCacheSize = defProgramCacheSize
+	}
+
+	return cfg
+}
+
+// workerPool runs the rules with a fixed number of workers. Each domain has
+// its own queue and the workers take the jobs from the domains in turns, so
+// a busy domain doesn't delay the rules of the others.
+type workerPool struct {
+	mu         sync.Mutex
+	cond       *sync.Cond
+	queues     map[string][]func()
+	domains    []string
+	next       int
+	queued     int
+	size       int
+	domainSize int
+	closed     bool
+	wg         sync.WaitGroup
+}
+
+func newWorkerPool(workers, size, domainSize int) *workerPool {
+	wp := &workerPool{
+		queues:     make(map[string][]func()),
+		size:       size,
+		domainSize: domainSize,
+	}
+	wp.cond = sync.NewCond(&wp.mu)
+	wp.wg.Add(workers)
+	for range workers {
+		go wp.work()
+	}
+
+	return wp
+}
+
+// submit queues all the jobs of the domain, or none of them if
+// there is no room for all of them.
+func (wp *workerPool) submit(domain string, jobs ...func()) error {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+
+	switch {
+	case wp.closed:
+		return errWorkersClosed
+	case wp.queued+len(jobs) > wp.size:
+		return ErrQueueFull
+	case len(wp.queues[domain])+len(jobs) > wp.domainSize:
+		return ErrDomainQueueFull
+	}
+	if _, ok := wp.queues[domain]; !ok {
+		wp.domains = append(wp.domains, domain)
+	}
+	wp.queues[domain] = append(wp.queues[domain], jobs...)
+	wp.queued += len(jobs)
+	for range jobs {
+		wp.cond.Signal()
+	}
+
+	return nil
+}
+
+// close stops accepting the jobs and waits for the queued ones to finish.
+func (wp *workerPool) close() {
+	wp.mu.Lock()
+	wp.closed = true
+	wp.cond.Broadcast()
+	wp.mu.Unlock()
+	wp.wg.Wait()
+}
+
+func (wp *workerPool) work() {
+	defer wp.wg.Done()
+	for {
+		job, ok := wp.take()
+		if !ok {
+			return
+		}
+		job()
+	}
+}
+
+// take waits for the job of the next domain in turn.
+func (wp *workerPool) take() (func(), bool) {
+	wp.mu.Lock()
+	defer wp.mu.Unlock()
+
+	for wp.queued == 0 {
+		if wp.closed {
+			return nil, false
+		}
+		wp.cond.Wait()
+	}
+	if wp.next >= len(wp.domains) {
+		wp.next = 0
+	}
+	domain := wp.domains[wp.next]
+	queue := wp.queues[domain]
+	job := queue[0]
+	queue[0] = nil
+	if len(queue) == 1 {
+		// The next domain takes the place of the removed one.
+		delete(wp.queues, domain)
+		wp.domains = append(wp.domains[:wp.next], wp.domains[wp.next+1:]...)
+	} else {
+		wp.queues[domain] = queue[1:]
+		wp.next++
+	}
+	wp.queued--
+
+	return job, true
+}
@@ -0,0 +1,166 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package re
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	pkglog "github.com/absmach/magistrala/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPoolSubmit(t *testing.T) {
+	cases := []struct {
+		desc   string
+		queued map[string]int
+		domain string
+		jobs   int
+		err    error
+	}{
+		{
+			desc:   "submit jobs",
+			domain: "domain",
+			jobs:   2,
+		},
+		{
+			desc:   "submit jobs with full queue",
+			queued: map[string]int{"domain1": 2, "domain2": 2},
+			domain: "domain3",
+			jobs:   1,
+			err:    ErrQueueFull,
+		},
+		{
+			desc:   "submit jobs with full domain queue",
+			queued: map[string]int{"domain": 3},
+			domain: "domain",
+			jobs:   1,
+			err:    ErrDomainQueueFull,
+		},
+		{
+			desc:   "submit jobs that don't fit in the queue",
+			queued: map[string]int{"domain1": 2},
+			domain: "domain2",
+			jobs:   3,
+			err:    ErrQueueFull,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Without the workers the jobs stay queued.
+			wp := newWorkerPool(0, 4, 3)
+			for domain, n := range tc.queued {
+				for range n {
+					err := wp.submit(domain, func() {})
+					assert.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+				}
+			}
+			queued := wp.queued
+			jobs := make([]func(), tc.jobs)
+			for i := range jobs {
+				jobs[i] = func() {}
+			}
+			err := wp.submit(tc.domain, jobs...)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if tc.err != nil {
+				assert.Equal(t, queued, wp.queued, fmt.Sprintf("%s: expected no jobs to be queued", tc.desc))
+			}
+		})
+	}
+}
+
+func TestWorkerPoolFairness(t *testing.T) {
+	wp := newWorkerPool(0, 100, 100)
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+	job := func(domain string) func() {
+		return func() {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, domain)
+		}
+	}
+	for range 4 {
+		err := wp.submit("busy", job("busy"))
+		assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+	err := wp.submit("quiet1", job("quiet1"))
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	err = wp.submit("quiet2", job("quiet2"), job("quiet2"))
+	assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+
+	for wp.queued > 0 {
+		j, ok := wp.take()
+		assert.True(t, ok, "expected queued job")
+		j()
+	}
+	assert.Equal(t, []string{"busy", "quiet1", "quiet2", "busy", "quiet2", "busy", "busy"}, order)
+}
+
+func TestWorkerPoolClose(t *testing.T) {
+	wp := newWorkerPool(2, 10, 10)
+	var (
+		mu  sync.Mutex
+		ran int
+	)
+	release := make(chan struct{})
+	for range 5 {
+		err := wp.submit("domain", func() {
+			<-release
+			mu.Lock()
+			defer mu.Unlock()
+			ran++
+		})
+		assert.Nil(t, err, fmt.Sprintf("unexpected error: %s", err))
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		wp.close()
+		close(closed)
+	}()
+	close(release)
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for workers to finish")
+	}
+	assert.Equal(t, 5, ran, "expected queued jobs to finish on close")
+
+	err := wp.submit("domain", func() {})
+	assert.True(t, errors.Contains(err, errWorkersClosed), fmt.Sprintf("expected %s got %s", errWorkersClosed, err))
+}
+
+func TestScheduleRule(t *testing.T) {
+	// Without the workers the scheduled runs stay queued.
+	runInfo := make(chan pkglog.RunInfo, 10)
+	svc := &re{workers: newWorkerPool(0, 4, 1), runInfo: runInfo}
+	due := time.Now().UTC()
+
+	svc.schedule(context.Background(), Rule{ID: "rule1", DomainID: "domain"}, due)
+	assert.Equal(t, 1, svc.workers.queued)
+
+	svc.schedule(context.Background(), Rule{ID: "rule1", DomainID: "domain"}, due)
+	assert.Equal(t, 1, svc.workers.queued, "expected the queued rule not to be queued again")
+	assert.Empty(t, runInfo)
+
+	svc.schedule(context.Background(), Rule{ID: "rule2", DomainID: "domain"}, due)
+	assert.Equal(t, 1, svc.workers.queued, "expected the rule over the domain queue not to be queued")
+	info := <-runInfo
+	assert.Equal(t, slog.LevelWarn, info.Level)
+	assert.Contains(t, info.Message, ErrDomainQueueFull.Error())
+	_, queued := svc.scheduled.Load("rule2")
+	assert.False(t, queued, "expected the postponed rule to be scheduled on the next tick")
+
+	svc.schedule(context.Background(), Rule{ID: "rule3", DomainID: "other"}, due)
+	assert.Equal(t, 2, svc.workers.queued)
+}
@@ -28,6 +28,7 @@ var _ grpcReadersV1.ReadersServiceClient = (*readersGrpcClient)(nil)
 
 type readersGrpcClient struct {
 	readMessages endpoint.Endpoint
+	readSeries   endpoint.Endpoint
 	timeout      time.Duration
 }
 
@@ -42,6 +43,14 @@ func NewReadersClient(conn *grpc.ClientConn, timeout time.Duration) grpcReadersV
 			decodeReadMessagesResponse,
 			grpcReadersV1.ReadMessagesRes{},
 		).Endpoint(),
+		readSeries: kitgrpc.NewClient(
+			conn,
+			readersSvcName,
+			"ReadSeries",
+			encodeReadSeriesRequest,
+			decodeReadSeriesResponse,
+			grpcReadersV1.ReadSeriesRes{},
+		).Endpoint(),
 		timeout: timeout,
 	}
 }
@@ -70,6 +79,8 @@ func (client readersGrpcClient) ReadMessages(ctx context.Context, in *grpcReader
 			StringValue: in.GetPageMetadata().GetStringValue(),
 			DataValue:   in.GetPageMetadata().GetDataValue(),
 			Format:      in.GetPageMetadata().GetFormat(),
+			Domain:      in.GetPageMetadata().GetDomain(),
+			ClientID:    in.GetPageMetadata().GetClientId(),
 		},
 	})
 	if err != nil {
@@ -125,10 +136,79 @@ func encodeReadMessagesRequest(_ context.Context, grpcReq any) (any, error) {
 			Format:      req.pageMeta.Format,
 			Order:       req.pageMeta.Order,
 			Dir:         req.pageMeta.Dir,
+			Domain:      req.pageMeta.Domain,
+			ClientId:    req.pageMeta.ClientID,
 		},
 	}, nil
 }
 
+func (client readersGrpcClient) ReadSeries(ctx context.Context, in *grpcReadersV1.ReadSeriesReq, opts ...grpc.CallOption) (*grpcReadersV1.ReadSeriesRes, error) {
+	ctx, cancel := context.WithTimeout(ctx, client.timeout)
+	defer cancel()
+
+	res, err := client.readSeries(ctx, readSeriesReq{
+		domain:  in.GetDomainId(),
+		chanIDs: in.GetChannelIds(),
+		groupID: in.GetGroupId(),
+		query: readers.SeriesQuery{
+			Names:       in.GetQuery().GetNames(),
+			Subtopic:    in.GetQuery().GetSubtopic(),
+			Publisher:   in.GetQuery().GetPublisher(),
+			Protocol:    in.GetQuery().GetProtocol(),
+			From:        in.GetQuery().GetFrom(),
+			To:          in.GetQuery().GetTo(),
+			Aggregation: stringifyAggregation(in.GetQuery().GetAggregation()),
+			Interval:    in.GetQuery().GetInterval(),
+			Fill:        in.GetQuery().GetFill(),
+			Limit:       in.GetQuery().GetLimit(),
+		},
+	})
+	if err != nil {
+		return &grpcReadersV1.ReadSeriesRes{}, decodeError(err)
+	}
+
+	return &grpcReadersV1.ReadSeriesRes{Series: toResponseSeries(res.(readSeriesRes).series)}, nil
+}
+
+func encodeReadSeriesRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(readSeriesReq)
+	return &grpcReadersV1.ReadSeriesReq{
+		DomainId:   req.domain,
+		ChannelIds: req.chanIDs,
+		GroupId:    req.groupID,
+		Query: &grpcReadersV1.SeriesQuery{
+			Names:       req.query.Names,
+			Subtopic:    req.query.Subtopic,
+			Publisher:   req.query.Publisher,
+			Protocol:    req.query.Protocol,
+			From:        req.query.From,
+			To:          req.query.To,
+			Aggregation: parseAggregation(req.query.Aggregation),
+			Interval:    req.query.Interval,
+			Fill:        req.query.Fill,
+			Limit:       req.query.Limit,
+		},
+	}, nil
+}
+
+func decodeReadSeriesResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(*grpcReadersV1.ReadSeriesRes)
+	series := make([]readers.Series, 0, len(res.GetSeries()))
+	for _, s := range res.GetSeries() {
+		points := make([]readers.Point, 0, len(s.GetPoints()))
+		for _, p := range s.GetPoints() {
+			points = append(points, readers.Point{Time: p.GetTime(), Value: p.Value})
+		}
+		series = append(series, readers.Series{
+			Channel: s.GetChannel(),
+			Name:    s.GetName(),
+			Unit:    s.GetUnit(),
+			Points:  points,
+		})
+	}
+	return readSeriesRes{series: series}, nil
+}
+
 func fromResponseMessages(protoMessages []*grpcReadersV1.Message) []readers.Message {
 	var messages []readers.Message
 	for _, m := range protoMessages {
@@ -138,6 +218,8 @@ func fromResponseMessages(protoMessages []*grpcReadersV1.Message) []readers.Mess
 			base := s.GetBase()
 			typed := senml.Message{
 				Channel:     base.GetChannel(),
+				Domain:      base.GetDomain(),
+				ClientID:    base.GetClientId(),
 				Subtopic:    base.GetSubtopic(),
 				Publisher:   base.GetPublisher(),
 				Protocol:    base.GetProtocol(),
@@ -161,6 +243,8 @@ func fromResponseMessages(protoMessages []*grpcReadersV1.Message) []readers.Mess
 			}
 			messages = append(messages, map[string]any{
 				"channel":   base.GetChannel(),
+				"domain":    base.GetDomain(),
+				"client_id": base.GetClientId(),
 				"created":   j.GetCreated(),
 				"subtopic":  base.GetSubtopic(),
 				"publisher": base.GetPublisher(),
@@ -6,6 +6,9 @@ package grpc
 import (
 	"context"
 
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
+	apiutil "github.com/absmach/magistrala/api/http/util"
+	"github.com/absmach/magistrala/pkg/errors"
 	readers "github.com/absmach/magistrala/readers"
 	"github.com/go-kit/kit/endpoint"
 )
@@ -29,3 +32,34 @@ func readMessagesEndpoint(svc readers.MessageRepository) endpoint.Endpoint {
 		}, nil
 	}
 }
+
+func readSeriesEndpoint(svc readers.MessageRepository, groups grpcGroupsV1.GroupsServiceClient) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(readSeriesReq)
+		if err := req.validate(); err != nil {
+			return readSeriesRes{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+
+		chanIDs := req.chanIDs
+		if req.groupID != "" {
+			res, err := groups.RetrieveChannelIDs(ctx, &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id:       req.groupID,
+				DomainId: req.domain,
+			})
+			if err != nil {
+				return readSeriesRes{}, err
+			}
+			chanIDs = res.GetIds()
+			if len(chanIDs) > maxSeriesChannels {
+				return readSeriesRes{}, errors.Wrap(errors.ErrMalformedEntity, apiutil.ErrTooManySeries)
+			}
+		}
+
+		page, err := svc.ReadSeries(chanIDs, req.query)
+		if err != nil {
+			return readSeriesRes{}, err
+		}
+
+		return readSeriesRes{series: page.Series}, nil
+	}
+}
@@ -11,9 +11,11 @@ import (
 	"testing"
 	"time"
 
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
 	grpcReadersV1 "github.com/absmach/magistrala/api/grpc/readers/v1"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	"github.com/absmach/magistrala/pkg/errors"
+	svcerr "github.com/absmach/magistrala/pkg/errors/service"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/absmach/magistrala/readers"
 	grpcapi "github.com/absmach/magistrala/readers/api/grpc"
@@ -37,10 +39,10 @@ const (
 
 var authAddr = fmt.Sprintf("localhost:%d", port)
 
-func startGRPCServer(svc readers.MessageRepository, port int) *grpc.Server {
+func startGRPCServer(svc readers.MessageRepository, groups grpcGroupsV1.GroupsServiceClient, port int) *grpc.Server {
 	listener, _ := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	server := grpc.NewServer()
-	grpcReadersV1.RegisterReadersServiceServer(server, grpcapi.NewReadersServer(svc))
+	grpcReadersV1.RegisterReadersServiceServer(server, grpcapi.NewReadersServer(svc, groups))
 	go func() {
 		err := server.Serve(listener)
 		assert.Nil(&testing.T{}, err, fmt.Sprintf(`"Unexpected error creating reader server %s"`, err))
@@ -217,6 +219,159 @@ func TestReadMessages(t *testing.T) {
 	}
 }
 
+func TestReadSeries(t *testing.T) {
+	conn, err := grpc.NewClient(authAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, fmt.Sprintf("Unexpected error creating client connection %s", err))
+	grpcClient := grpcapi.NewReadersClient(conn, time.Second)
+
+	now := float64(time.Now().UnixNano())
+	from := now - float64(time.Hour)
+	series := []readers.Series{
+		{
+			Channel: channelID,
+			Name:    "temperature",
+			Unit:    "C",
+			Points:  []readers.Point{{Time: from, Value: float64Ptr(22.5)}, {Time: from + float64(time.Minute)}},
+		},
+	}
+
+	cases := []struct {
+		desc     string
+		req      *grpcReadersV1.ReadSeriesReq
+		chanIDs  []string
+		query    readers.SeriesQuery
+		groupIDs []string
+		groupErr error
+		svcErr   error
+		res      []readers.Series
+		err      error
+	}{
+		{
+			desc: "read series of channels",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId:   domain,
+				ChannelIds: []string{channelID},
+				Query:      &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			},
+			chanIDs: []string{channelID},
+			query:   readers.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			res:     series,
+		},
+		{
+			desc: "read aggregated series of group channels",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId: domain,
+				GroupId:  validID,
+				Query: &grpcReadersV1.SeriesQuery{
+					Names:       []string{"temperature"},
+					From:        from,
+					To:          now,
+					Aggregation: grpcReadersV1.Aggregation_AGGREGATION_MAX,
+					Interval:    "1m",
+					Fill:        readers.FillLinear,
+				},
+			},
+			chanIDs:  []string{channelID},
+			groupIDs: []string{channelID},
+			query: readers.SeriesQuery{
+				Names:       []string{"temperature"},
+				From:        from,
+				To:          now,
+				Aggregation: "MAX",
+				Interval:    "1m",
+				Fill:        readers.FillLinear,
+			},
+			res: series,
+		},
+		{
+			desc: "read series of non-existing group",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId: domain,
+				GroupId:  validID,
+				Query:    &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			},
+			groupErr: svcerr.ErrNotFound,
+			err:      svcerr.ErrNotFound,
+		},
+		{
+			desc: "read series without domain",
+			req: &grpcReadersV1.ReadSeriesReq{
+				ChannelIds: []string{channelID},
+				Query:      &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "read series without channels and group",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId: domain,
+				Query:    &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "read series without names",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId:   domain,
+				ChannelIds: []string{channelID},
+				Query:      &grpcReadersV1.SeriesQuery{Limit: testLimit},
+			},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "read series with fill and without interval",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId:   domain,
+				ChannelIds: []string{channelID},
+				Query:      &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit, Fill: readers.FillLOCF},
+			},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "read series with too many points",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId:   domain,
+				ChannelIds: []string{channelID},
+				Query:      &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, From: from, To: now, Interval: "1ms"},
+			},
+			err: errors.ErrMalformedEntity,
+		},
+		{
+			desc: "read series with repository error",
+			req: &grpcReadersV1.ReadSeriesReq{
+				DomainId:   domain,
+				ChannelIds: []string{channelID},
+				Query:      &grpcReadersV1.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			},
+			chanIDs: []string{channelID},
+			query:   readers.SeriesQuery{Names: []string{"temperature"}, Limit: testLimit},
+			svcErr:  svcerr.ErrNotFound,
+			err:     svcerr.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		groupsCall := groups.On("RetrieveChannelIDs", mock.Anything, mock.Anything).Return(&grpcGroupsV1.RetrieveChannelIDsRes{Ids: tc.groupIDs}, tc.groupErr)
+		repoCall := svc.On("ReadSeries", tc.chanIDs, tc.query).Return(readers.SeriesPage{SeriesQuery: tc.query, Series: tc.res}, tc.svcErr)
+		res, err := grpcClient.ReadSeries(context.Background(), tc.req)
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s\n", tc.desc, tc.err, err))
+		if assert.Len(t, res.GetSeries(), len(tc.res), fmt.Sprintf("%s: unexpected number of series", tc.desc)) {
+			for i, s := range res.GetSeries() {
+				assert.Equal(t, tc.res[i].Channel, s.GetChannel(), fmt.Sprintf("%s: expected channel %s got %s", tc.desc, tc.res[i].Channel, s.GetChannel()))
+				assert.Equal(t, tc.res[i].Name, s.GetName(), fmt.Sprintf("%s: expected name %s got %s", tc.desc, tc.res[i].Name, s.GetName()))
+				assert.Equal(t, tc.res[i].Unit, s.GetUnit(), fmt.Sprintf("%s: expected unit %s got %s", tc.desc, tc.res[i].Unit, s.GetUnit()))
+				points := make([]readers.Point, 0, len(s.GetPoints()))
+				for _, p := range s.GetPoints() {
+					points = append(points, readers.Point{Time: p.GetTime(), Value: p.Value})
+				}
+				assert.Equal(t, tc.res[i].Points, points, fmt.Sprintf("%s: got incorrect points", tc.desc))
+			}
+		}
+		groupsCall.Unset()
+		repoCall.Unset()
+	}
+}
+
 func float64Ptr(v float64) *float64 {
 	return &v
 }
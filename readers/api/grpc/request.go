@@ -12,7 +12,12 @@ import (
 	"github.com/absmach/magistrala/readers"
 )
 
-const maxLimitSize = 1000
+const (
+	maxLimitSize      = 1000
+	maxSeriesChannels = 100
+	maxSeriesNames    = 20
+	maxSeriesPoints   = 10000
+)
 
 var validAggregations = []string{"MAX", "MIN", "AVG", "SUM", "COUNT"}
 
@@ -67,3 +72,83 @@ func (req readMessagesReq) validate() error {
 
 	return nil
 }
+
+type readSeriesReq struct {
+	domain  string
+	chanIDs []string
+	groupID string
+	query   readers.SeriesQuery
+}
+
+func (req readSeriesReq) validate() error {
+	if req.domain == "" {
+		return apiutil.ErrMissingDomainID
+	}
+
+	switch {
+	case len(req.chanIDs) == 0 && req.groupID == "":
+		return apiutil.ErrMissingChannelID
+	case len(req.chanIDs) > 0 && req.groupID != "":
+		return apiutil.ErrMultipleEntitiesFilter
+	case len(req.chanIDs) > maxSeriesChannels:
+		return apiutil.ErrTooManySeries
+	}
+	for _, id := range req.chanIDs {
+		if id == "" {
+			return apiutil.ErrMissingChannelID
+		}
+	}
+
+	if len(req.query.Names) == 0 {
+		return apiutil.ErrMissingMeasurementNames
+	}
+	if len(req.query.Names) > maxSeriesNames {
+		return apiutil.ErrTooManySeries
+	}
+	for _, name := range req.query.Names {
+		if name == "" {
+			return apiutil.ErrMissingMeasurementNames
+		}
+	}
+
+	switch req.query.Fill {
+	case "", readers.FillNone, readers.FillLOCF, readers.FillLinear:
+	default:
+		return apiutil.ErrInvalidFill
+	}
+
+	if agg := strings.ToUpper(req.query.Aggregation); agg != "" && !slices.Contains(validAggregations, agg) {
+		return apiutil.ErrInvalidAggregation
+	}
+
+	if req.query.From != 0 && req.query.To != 0 && req.query.To <= req.query.From {
+		return apiutil.ErrInvalidTimeRange
+	}
+
+	if req.query.Interval == "" {
+		// Raw values can't be aligned, so they are neither aggregated nor filled.
+		if req.query.Aggregation != "" || (req.query.Fill != "" && req.query.Fill != readers.FillNone) {
+			return apiutil.ErrInvalidInterval
+		}
+		if req.query.Limit < 1 || req.query.Limit > maxLimitSize {
+			return apiutil.ErrLimitSize
+		}
+		return nil
+	}
+
+	interval, err := time.ParseDuration(req.query.Interval)
+	if err != nil || interval <= 0 {
+		return apiutil.ErrInvalidInterval
+	}
+	if req.query.From == 0 {
+		return apiutil.ErrMissingFrom
+	}
+	if req.query.To == 0 {
+		return apiutil.ErrMissingTo
+	}
+	if (req.query.To-req.query.From)/float64(interval.Nanoseconds()) > maxSeriesPoints {
+		return apiutil.ErrTooManyPoints
+	}
+
+	return nil
+}
@@ -14,3 +14,7 @@ type readMessagesRes struct {
 }
 
 type Message any
+
+type readSeriesRes struct {
+	series []readers.Series
+}
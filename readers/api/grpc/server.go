@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
 	grpcReadersV1 "github.com/absmach/magistrala/api/grpc/readers/v1"
 	grpcapi "github.com/absmach/magistrala/auth/api/grpc"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
@@ -19,15 +20,21 @@ var _ grpcReadersV1.ReadersServiceServer = (*readersGrpcServer)(nil)
 type readersGrpcServer struct {
 	grpcReadersV1.UnimplementedReadersServiceServer
 	readMessages kitgrpc.Handler
+	readSeries   kitgrpc.Handler
 }
 
-func NewReadersServer(svc readers.MessageRepository) grpcReadersV1.ReadersServiceServer {
+func NewReadersServer(svc readers.MessageRepository, groups grpcGroupsV1.GroupsServiceClient) grpcReadersV1.ReadersServiceServer {
 	return &readersGrpcServer{
 		readMessages: kitgrpc.NewServer(
 			(readMessagesEndpoint(svc)),
 			decodeReadMessagesRequest,
 			encodeReadMessagesResponse,
 		),
+		readSeries: kitgrpc.NewServer(
+			readSeriesEndpoint(svc, groups),
+			decodeReadSeriesRequest,
+			encodeReadSeriesResponse,
+		),
 	}
 }
 
@@ -55,6 +62,8 @@ func decodeReadMessagesRequest(_ context.Context, grpcReq any) (any, error) {
 			Format:      req.GetPageMetadata().GetFormat(),
 			Order:       req.GetPageMetadata().GetOrder(),
 			Dir:         req.GetPageMetadata().GetDir(),
+			Domain:      req.GetPageMetadata().GetDomain(),
+			ClientID:    req.GetPageMetadata().GetClientId(),
 		},
 	}, nil
 }
@@ -83,6 +92,57 @@ func (s *readersGrpcServer) ReadMessages(ctx context.Context, req *grpcReadersV1
 	return res.(*grpcReadersV1.ReadMessagesRes), nil
 }
 
+func decodeReadSeriesRequest(_ context.Context, grpcReq any) (any, error) {
+	req := grpcReq.(*grpcReadersV1.ReadSeriesReq)
+	return readSeriesReq{
+		domain:  req.GetDomainId(),
+		chanIDs: req.GetChannelIds(),
+		groupID: req.GetGroupId(),
+		query: readers.SeriesQuery{
+			Names:       req.GetQuery().GetNames(),
+			Subtopic:    req.GetQuery().GetSubtopic(),
+			Publisher:   req.GetQuery().GetPublisher(),
+			Protocol:    req.GetQuery().GetProtocol(),
+			From:        req.GetQuery().GetFrom(),
+			To:          req.GetQuery().GetTo(),
+			Aggregation: stringifyAggregation(req.GetQuery().GetAggregation()),
+			Interval:    req.GetQuery().GetInterval(),
+			Fill:        req.GetQuery().GetFill(),
+			Limit:       req.GetQuery().GetLimit(),
+		},
+	}, nil
+}
+
+func encodeReadSeriesResponse(_ context.Context, grpcRes any) (any, error) {
+	res := grpcRes.(readSeriesRes)
+	return &grpcReadersV1.ReadSeriesRes{Series: toResponseSeries(res.series)}, nil
+}
+
+func (s *readersGrpcServer) ReadSeries(ctx context.Context, req *grpcReadersV1.ReadSeriesReq) (*grpcReadersV1.ReadSeriesRes, error) {
+	_, res, err := s.readSeries.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, grpcapi.EncodeError(err)
+	}
+	return res.(*grpcReadersV1.ReadSeriesRes), nil
+}
+
+func toResponseSeries(series []readers.Series) []*grpcReadersV1.Series {
+	res := make([]*grpcReadersV1.Series, 0, len(series))
+	for _, s := range series {
+		points := make([]*grpcReadersV1.Point, 0, len(s.Points))
+		for _, p := range s.Points {
+			points = append(points, &grpcReadersV1.Point{Time: p.Time, Value: p.Value})
+		}
+		res = append(res, &grpcReadersV1.Series{
+			Channel: s.Channel,
+			Name:    s.Name,
+			Unit:    s.Unit,
+			Points:  points,
+		})
+	}
+	return res
+}
+
 func toResponseMessages(messages []readers.Message) []*grpcReadersV1.Message {
 	var res []*grpcReadersV1.Message
 	for _, m := range messages {
@@ -93,6 +153,8 @@ func toResponseMessages(messages []readers.Message) []*grpcReadersV1.Message {
 					Senml: &grpcReadersV1.SenMLMessage{
 						Base: &grpcReadersV1.BaseMessage{
 							Channel:   typed.Channel,
+							Domain:    typed.Domain,
+							ClientId:  typed.ClientID,
 							Subtopic:  typed.Subtopic,
 							Publisher: typed.Publisher,
 							Protocol:  typed.Protocol,
@@ -120,6 +182,8 @@ func toResponseMessages(messages []readers.Message) []*grpcReadersV1.Message {
 					Json: &grpcReadersV1.JsonMessage{
 						Base: &grpcReadersV1.BaseMessage{
 							Channel:   safeString(typed["channel"]),
+							Domain:    safeString(typed["domain"]),
+							ClientId:  safeString(typed["client_id"]),
 							Subtopic:  safeString(typed["subtopic"]),
 							Publisher: safeString(typed["publisher"]),
 							Protocol:  safeString(typed["protocol"]),
@@ -7,14 +7,19 @@ import (
 	"os"
 	"testing"
 
+	gpmocks "github.com/absmach/magistrala/groups/mocks"
 	"github.com/absmach/magistrala/readers/mocks"
 )
 
-var svc *mocks.MessageRepository
+var (
+	svc    *mocks.MessageRepository
+	groups *gpmocks.GroupsServiceClient
+)
 
 func TestMain(m *testing.M) {
 	svc = new(mocks.MessageRepository)
-	server := startGRPCServer(svc, port)
+	groups = new(gpmocks.GroupsServiceClient)
+	server := startGRPCServer(svc, groups, port)
 
 	code := m.Run()
 
@@ -8,6 +8,7 @@ import (
 
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	"github.com/absmach/magistrala/pkg/errors"
@@ -23,19 +24,91 @@ func listMessagesEndpoint(svc readers.MessageRepository, authn smqauthn.Authenti
 			return nil, errors.Wrap(apiutil.ErrValidation, err)
 		}
 
-		if err := authnAuthz(ctx, req, authn, clients, channels); err != nil {
+		if err := authnAuthz(ctx, req.domain, req.token, req.key, req.chanID, authn, clients, channels); err != nil {
 			return nil, errors.Wrap(svcerr.ErrAuthorization, err)
 		}
 
 		page, err := svc.ReadAll(req.chanID, req.pageMeta)
 		if err != nil {
+			// The cursor is opaque, so its keys are checked by the repository.
+			if errors.Contains(err, readers.ErrInvalidCursor) {
+				return nil, errors.Wrap(apiutil.ErrValidation, err)
+			}
 			return nil, err
 		}
 
 		return pageRes{
 			PageMetadata: page.PageMetadata,
 			Total:        page.Total,
+			NextCursor:   page.NextCursor,
 			Messages:     page.Messages,
 		}, nil
 	}
 }
+
+func exportMessagesEndpoint(svc readers.MessageRepository, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(exportMessagesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		if err := authnAuthz(ctx, req.domain, req.token, req.key, req.chanID, authn, clients, channels); err != nil {
+			return nil, errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+
+		return exportRes{
+			chanID:  req.chanID,
+			output:  req.output,
+			isSenml: req.pageMeta.Format == "" || req.pageMeta.Format == defFormat,
+			export: func(fn func(readers.Message) error) error {
+				return svc.ExportAll(ctx, req.chanID, req.pageMeta, fn)
+			},
+		}, nil
+	}
+}
+
+func readSeriesEndpoint(svc readers.MessageRepository, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient) endpoint.Endpoint {
+	return func(ctx context.Context, request any) (any, error) {
+		req := request.(readSeriesReq)
+		if err := req.validate(); err != nil {
+			return nil, errors.Wrap(apiutil.ErrValidation, err)
+		}
+
+		clientID, clientType, err := authenticate(ctx, req.domain, req.token, req.key, authn, clients)
+		if err != nil {
+			return nil, errors.Wrap(svcerr.ErrAuthorization, err)
+		}
+
+		chanIDs := req.ChannelIDs
+		if req.GroupID != "" {
+			res, err := groups.RetrieveChannelIDs(ctx, &grpcGroupsV1.RetrieveChannelIDsReq{
+				Id:       req.GroupID,
+				DomainId: req.domain,
+			})
+			if err != nil {
+				return nil, err
+			}
+			chanIDs = res.GetIds()
+			if len(chanIDs) > maxSeriesChannels {
+				return nil, errors.Wrap(apiutil.ErrValidation, apiutil.ErrTooManySeries)
+			}
+		}
+
+		for _, chanID := range chanIDs {
+			if err := authorize(ctx, clientID, clientType, chanID, req.domain, channels); err != nil {
+				return nil, errors.Wrap(svcerr.ErrAuthorization, err)
+			}
+		}
+
+		page, err := svc.ReadSeries(chanIDs, req.SeriesQuery)
+		if err != nil {
+			return nil, err
+		}
+
+		return seriesPageRes{
+			SeriesQuery: page.SeriesQuery,
+			Series:      page.Series,
+		}, nil
+	}
+}
@@ -4,32 +4,41 @@
 package http_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	chmocks "github.com/absmach/magistrala/channels/mocks"
 	climocks "github.com/absmach/magistrala/clients/mocks"
+	gpmocks "github.com/absmach/magistrala/groups/mocks"
 	"github.com/absmach/magistrala/internal/testsutil"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
 	authnmocks "github.com/absmach/magistrala/pkg/authn/mocks"
+	"github.com/absmach/magistrala/pkg/errors"
 	svcerr "github.com/absmach/magistrala/pkg/errors/service"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/absmach/magistrala/readers"
 	customhttp "github.com/absmach/magistrala/readers/api/http"
 	"github.com/absmach/magistrala/readers/mocks"
+	"github.com/parquet-go/parquet-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 const (
 	svcName       = "test-service"
+	contentType   = "application/json"
 	clientToken   = "1"
 	userToken     = "token"
 	invalidToken  = "invalid"
@@ -52,24 +61,33 @@ var (
 	validSession         = smqauthn.Session{UserID: testsutil.GenerateUUID(&testing.T{})}
 )
 
-func newServer(repo *mocks.MessageRepository, authn *authnmocks.Authentication, clients *climocks.ClientsServiceClient, channels *chmocks.ChannelsServiceClient) *httptest.Server {
-	mux := customhttp.MakeHandler(repo, authn, clients, channels, svcName, instanceID)
+func newServer(repo *mocks.MessageRepository, authn *authnmocks.Authentication, clients *climocks.ClientsServiceClient, channels *chmocks.ChannelsServiceClient, groups *gpmocks.GroupsServiceClient) *httptest.Server {
+	mux := customhttp.MakeHandler(repo, authn, clients, channels, groups, svcName, instanceID)
 	return httptest.NewServer(mux)
 }
 
 type testRequest struct {
-	client *http.Client
-	method string
-	url    string
-	token  string
-	key    string
+	client      *http.Client
+	method      string
+	url         string
+	token       string
+	key         string
+	contentType string
+	body        io.Reader
 }
 
 func (tr testRequest) make() (*http.Response, error) {
-	req, err := http.NewRequest(tr.method, tr.url, http.NoBody)
+	body := tr.body
+	if body == nil {
+		body = http.NoBody
+	}
+	req, err := http.NewRequest(tr.method, tr.url, body)
 	if err != nil {
 		return nil, err
 	}
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
 	if tr.token != "" {
 		req.Header.Set("Authorization", apiutil.BearerPrefix+tr.token)
 	}
@@ -84,6 +102,7 @@ func TestReadAll(t *testing.T) {
 	chanID := testsutil.GenerateUUID(t)
 	pubID := testsutil.GenerateUUID(t)
 	pubID2 := testsutil.GenerateUUID(t)
+	clientID := testsutil.GenerateUUID(t)
 
 	now := time.Now().Unix()
 
@@ -123,6 +142,7 @@ func TestReadAll(t *testing.T) {
 			msg.Subtopic = subtopic
 			msg.Protocol = httpProt
 			msg.Publisher = pubID2
+			msg.ClientID = clientID
 			msg.Name = msgName
 			queryMsgs = append(queryMsgs, msg)
 		}
@@ -130,11 +150,15 @@ func TestReadAll(t *testing.T) {
 		messages = append(messages, msg)
 	}
 
+	cursor := readers.EncodeCursor(strconv.FormatFloat(messages[9].Time, 'g', -1, 64), testsutil.GenerateUUID(t))
+	nextCursor := readers.EncodeCursor(strconv.FormatFloat(messages[19].Time, 'g', -1, 64), testsutil.GenerateUUID(t))
+
 	repo := new(mocks.MessageRepository)
 	authn := new(authnmocks.Authentication)
 	clients := new(climocks.ClientsServiceClient)
 	channels := new(chmocks.ChannelsServiceClient)
-	ts := newServer(repo, authn, clients, channels)
+	groups := new(gpmocks.GroupsServiceClient)
+	ts := newServer(repo, authn, clients, channels, groups)
 	defer ts.Close()
 
 	cases := []struct {
@@ -148,6 +172,7 @@ func TestReadAll(t *testing.T) {
 		authnErr error
 		authzRes *grpcChannelsV1.AuthzRes
 		authzErr error
+		repoErr  error
 		err      error
 	}{
 		{
@@ -294,6 +319,17 @@ func TestReadAll(t *testing.T) {
 				Messages:     queryMsgs[0:10],
 			},
 		},
+		{
+			desc:   "read page with client id as client",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?client_id=%s", ts.URL, domainID, chanID, clientID),
+			key:    clientToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: 10, Format: "messages", ClientID: clientID, Order: "time", Dir: "desc"},
+				Total:        uint64(len(queryMsgs)),
+				Messages:     queryMsgs[0:10],
+			},
+		},
 		{
 			desc:   "read page with protocol as client",
 			url:    fmt.Sprintf("%s/%s/channels/%s/messages?protocol=http", ts.URL, domainID, chanID),
@@ -913,6 +949,50 @@ func TestReadAll(t *testing.T) {
 			key:    userToken,
 			status: http.StatusBadRequest,
 		},
+		{
+			desc:   "read page with cursor as user",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?limit=10&cursor=%s", ts.URL, domainID, chanID, cursor),
+			token:  userToken,
+			status: http.StatusOK,
+			res: pageRes{
+				PageMetadata: readers.PageMetadata{Limit: 10, Format: "messages", Order: "time", Dir: "desc", Cursor: cursor},
+				Total:        uint64(len(messages)),
+				NextCursor:   nextCursor,
+				Messages:     messages[10:20],
+			},
+		},
+		{
+			desc:    "read page with cursor not matching the messages as user",
+			url:     fmt.Sprintf("%s/%s/channels/%s/messages?limit=10&cursor=%s", ts.URL, domainID, chanID, cursor),
+			token:   userToken,
+			status:  http.StatusBadRequest,
+			res:     pageRes{PageMetadata: readers.PageMetadata{Limit: 10, Format: "messages", Order: "time", Dir: "desc", Cursor: cursor}},
+			repoErr: errors.Wrap(readers.ErrReadMessages, readers.ErrInvalidCursor),
+		},
+		{
+			desc:   "read page with invalid cursor as user",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?limit=10&cursor=abc", ts.URL, domainID, chanID),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with cursor and offset as user",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?offset=10&limit=10&cursor=%s", ts.URL, domainID, chanID, cursor),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with cursor and aggregation as user",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?aggregation=MAX&interval=10h&from=%f&to=%f&cursor=%s", ts.URL, domainID, chanID, messages[19].Time, messages[4].Time, cursor),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "read page with cursor and order by name as user",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages?order=name&cursor=%s", ts.URL, domainID, chanID, cursor),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range cases {
@@ -927,7 +1007,7 @@ func TestReadAll(t *testing.T) {
 				tc.authzRes = &grpcChannelsV1.AuthzRes{Authorized: true}
 			}
 			authzCall := channels.On("Authorize", mock.Anything, mock.Anything).Return(tc.authzRes, tc.authzErr)
-			repoCall := repo.On("ReadAll", chanID, tc.res.PageMetadata).Return(readers.MessagesPage{Total: tc.res.Total, Messages: fromSenml(tc.res.Messages)}, nil)
+			repoCall := repo.On("ReadAll", chanID, tc.res.PageMetadata).Return(readers.MessagesPage{Total: tc.res.Total, NextCursor: tc.res.NextCursor, Messages: fromSenml(tc.res.Messages)}, tc.repoErr)
 			req := testRequest{
 				client: ts.Client(),
 				method: http.MethodGet,
@@ -944,6 +1024,7 @@ func TestReadAll(t *testing.T) {
 			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
 			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
 			assert.Equal(t, tc.res.Total, page.Total, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.res.Total, page.Total))
+			assert.Equal(t, tc.res.NextCursor, page.NextCursor, fmt.Sprintf("%s: expected next cursor %s got %s", tc.desc, tc.res.NextCursor, page.NextCursor))
 			assert.ElementsMatch(t, tc.res.Messages, page.Messages, fmt.Sprintf("%s: got incorrect body from response", tc.desc))
 			authzCall.Unset()
 			authnCall.Unset()
@@ -952,10 +1033,409 @@ func TestReadAll(t *testing.T) {
 	}
 }
 
+func TestExportMessages(t *testing.T) {
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+	now := float64(time.Now().Truncate(time.Second).UnixNano())
+	step := float64(time.Second.Nanoseconds())
+
+	repo := new(mocks.MessageRepository)
+	authn := new(authnmocks.Authentication)
+	clients := new(climocks.ClientsServiceClient)
+	channels := new(chmocks.ChannelsServiceClient)
+	groups := new(gpmocks.GroupsServiceClient)
+	ts := newServer(repo, authn, clients, channels, groups)
+	defer ts.Close()
+
+	messages := []senml.Message{
+		{Channel: chanID, Publisher: pubID, Protocol: mqttProt, Name: msgName, Time: now, Value: &v},
+		{Channel: chanID, Publisher: pubID, Protocol: mqttProt, Name: msgName, Time: now + step, BoolValue: &vb},
+		{Channel: chanID, Publisher: pubID, Protocol: httpProt, Name: msgName, Time: now + 2*step, StringValue: &vs, Subtopic: subtopic},
+	}
+
+	ndjson := new(strings.Builder)
+	for _, msg := range messages {
+		err := json.NewEncoder(ndjson).Encode(msg)
+		assert.Nil(t, err, fmt.Sprintf("unexpected error while encoding message: %s", err))
+	}
+	csv := strings.Join([]string{
+		"channel,domain,client_id,subtopic,publisher,protocol,name,unit,time,update_time,value,string_value,bool_value,data_value,sum",
+		fmt.Sprintf("%s,,,,%s,%s,%s,,%s,0,5,,,,", chanID, pubID, mqttProt, msgName, strconv.FormatFloat(now, 'f', -1, 64)),
+		fmt.Sprintf("%s,,,,%s,%s,%s,,%s,0,,,true,,", chanID, pubID, mqttProt, msgName, strconv.FormatFloat(now+step, 'f', -1, 64)),
+		fmt.Sprintf("%s,,,%s,%s,%s,%s,,%s,0,,value,,,", chanID, subtopic, pubID, httpProt, msgName, strconv.FormatFloat(now+2*step, 'f', -1, 64)),
+	}, "\n") + "\n"
+
+	cases := []struct {
+		desc        string
+		url         string
+		token       string
+		key         string
+		pageMeta    readers.PageMetadata
+		status      int
+		contentType string
+		body        string
+		authnErr    error
+		authzRes    *grpcChannelsV1.AuthzRes
+		authzErr    error
+		repoErr     error
+	}{
+		{
+			desc:        "export messages as csv with default output",
+			url:         fmt.Sprintf("%s/%s/channels/%s/messages/export", ts.URL, domainID, chanID),
+			token:       userToken,
+			pageMeta:    readers.PageMetadata{Format: "messages"},
+			status:      http.StatusOK,
+			contentType: "text/csv",
+			body:        csv,
+		},
+		{
+			desc:        "export messages as ndjson",
+			url:         fmt.Sprintf("%s/%s/channels/%s/messages/export?output=ndjson", ts.URL, domainID, chanID),
+			token:       userToken,
+			pageMeta:    readers.PageMetadata{Format: "messages"},
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson",
+			body:        ndjson.String(),
+		},
+		{
+			desc:        "export messages as parquet as client",
+			url:         fmt.Sprintf("%s/%s/channels/%s/messages/export?output=parquet", ts.URL, domainID, chanID),
+			key:         clientToken,
+			pageMeta:    readers.PageMetadata{Format: "messages"},
+			status:      http.StatusOK,
+			contentType: "application/vnd.apache.parquet",
+		},
+		{
+			desc:        "export messages with filters ignoring paging",
+			url:         fmt.Sprintf("%s/%s/channels/%s/messages/export?output=ndjson&offset=10&limit=5&publisher=%s&from=%f&to=%f", ts.URL, domainID, chanID, pubID, now, now+3*step),
+			token:       userToken,
+			pageMeta:    readers.PageMetadata{Format: "messages", Publisher: pubID, From: now, To: now + 3*step},
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson",
+			body:        ndjson.String(),
+		},
+		{
+			desc:   "export messages with invalid output",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages/export?output=xml", ts.URL, domainID, chanID),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export messages with invalid time range",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages/export?from=%f&to=%f", ts.URL, domainID, chanID, now+3*step, now),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export messages with invalid comparator",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages/export?comparator=invalid", ts.URL, domainID, chanID),
+			token:  userToken,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "export messages with empty token",
+			url:    fmt.Sprintf("%s/%s/channels/%s/messages/export", ts.URL, domainID, chanID),
+			status: http.StatusUnauthorized,
+		},
+		{
+			desc:     "export messages with invalid token",
+			url:      fmt.Sprintf("%s/%s/channels/%s/messages/export", ts.URL, domainID, chanID),
+			token:    invalidToken,
+			authnErr: svcerr.ErrAuthentication,
+			status:   http.StatusUnauthorized,
+		},
+		{
+			desc:     "export messages with unauthorized user",
+			url:      fmt.Sprintf("%s/%s/channels/%s/messages/export", ts.URL, domainID, chanID),
+			token:    userToken,
+			authzRes: &grpcChannelsV1.AuthzRes{Authorized: false},
+			authzErr: svcerr.ErrAuthorization,
+			status:   http.StatusForbidden,
+		},
+		{
+			desc:     "export messages with failed read",
+			url:      fmt.Sprintf("%s/%s/channels/%s/messages/export", ts.URL, domainID, chanID),
+			token:    userToken,
+			pageMeta: readers.PageMetadata{Format: "messages"},
+			repoErr:  readers.ErrReadMessages,
+			status:   http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			if tc.key != "" {
+				authnCall = clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{
+					Token: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, tc.key),
+				}).Return(&grpcClientsV1.AuthnRes{Id: testsutil.GenerateUUID(t), Authenticated: true}, tc.authnErr)
+			}
+			if tc.authzRes == nil {
+				tc.authzRes = &grpcChannelsV1.AuthzRes{Authorized: true}
+			}
+			authzCall := channels.On("Authorize", mock.Anything, mock.Anything).Return(tc.authzRes, tc.authzErr)
+			repoCall := repo.On("ExportAll", mock.Anything, chanID, tc.pageMeta, mock.Anything).Run(func(args mock.Arguments) {
+				if tc.repoErr != nil {
+					return
+				}
+				fn := args.Get(3).(func(readers.Message) error)
+				for _, msg := range messages {
+					if err := fn(msg); err != nil {
+						return
+					}
+				}
+			}).Return(tc.repoErr)
+			req := testRequest{
+				client: ts.Client(),
+				method: http.MethodGet,
+				url:    tc.url,
+				token:  tc.token,
+				key:    tc.key,
+			}
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+			body, err := io.ReadAll(res.Body)
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while reading response body: %s", tc.desc, err))
+			if tc.status == http.StatusOK {
+				assert.Equal(t, tc.contentType, res.Header.Get("Content-Type"), fmt.Sprintf("%s: got incorrect content type", tc.desc))
+				assert.Contains(t, res.Header.Get("Content-Disposition"), fmt.Sprintf("%s.", chanID), fmt.Sprintf("%s: got incorrect content disposition", tc.desc))
+				switch tc.body {
+				case "":
+					rows, err := parquet.Read[senmlParquetRow](bytes.NewReader(body), int64(len(body)))
+					assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while reading parquet: %s", tc.desc, err))
+					assert.Len(t, rows, len(messages), fmt.Sprintf("%s: got incorrect number of rows", tc.desc))
+					for i, row := range rows {
+						assert.Equal(t, messages[i].Time, row.Time, fmt.Sprintf("%s: got incorrect row time", tc.desc))
+						assert.Equal(t, messages[i].Protocol, row.Protocol, fmt.Sprintf("%s: got incorrect row protocol", tc.desc))
+					}
+				default:
+					assert.Equal(t, tc.body, string(body), fmt.Sprintf("%s: got incorrect body from response", tc.desc))
+				}
+			}
+			authzCall.Unset()
+			authnCall.Unset()
+			repoCall.Unset()
+		})
+	}
+}
+
+func TestReadSeries(t *testing.T) {
+	chanID := testsutil.GenerateUUID(t)
+	chanID2 := testsutil.GenerateUUID(t)
+	groupID := testsutil.GenerateUUID(t)
+	now := float64(time.Now().UnixNano())
+
+	repo := new(mocks.MessageRepository)
+	authn := new(authnmocks.Authentication)
+	clients := new(climocks.ClientsServiceClient)
+	channels := new(chmocks.ChannelsServiceClient)
+	groups := new(gpmocks.GroupsServiceClient)
+	ts := newServer(repo, authn, clients, channels, groups)
+	defer ts.Close()
+
+	series := []readers.Series{
+		{Channel: chanID, Name: msgName, Points: []readers.Point{{Time: now, Value: &v}}},
+		{Channel: chanID2, Name: msgName, Points: []readers.Point{}},
+	}
+
+	cases := []struct {
+		desc        string
+		body        string
+		contentType string
+		token       string
+		key         string
+		chanIDs     []string
+		query       readers.SeriesQuery
+		groupIDs    []string
+		groupErr    error
+		authnErr    error
+		authzRes    *grpcChannelsV1.AuthzRes
+		status      int
+		res         []readers.Series
+	}{
+		{
+			desc:        "read series of channels",
+			body:        fmt.Sprintf(`{"channel_ids":["%s","%s"],"names":["%s"]}`, chanID, chanID2, msgName),
+			contentType: contentType,
+			token:       userToken,
+			chanIDs:     []string{chanID, chanID2},
+			query:       readers.SeriesQuery{Names: []string{msgName}, Limit: 100},
+			status:      http.StatusOK,
+			res:         series,
+		},
+		{
+			desc:        "read series of channels as client",
+			body:        fmt.Sprintf(`{"channel_ids":["%s","%s"],"names":["%s"],"limit":5}`, chanID, chanID2, msgName),
+			contentType: contentType,
+			key:         clientToken,
+			chanIDs:     []string{chanID, chanID2},
+			query:       readers.SeriesQuery{Names: []string{msgName}, Limit: 5},
+			status:      http.StatusOK,
+			res:         series,
+		},
+		{
+			desc:        "read series of group channels",
+			body:        fmt.Sprintf(`{"group_id":"%s","names":["%s"],"from":%f,"to":%f,"interval":"1m","aggregation":"max","fill":"locf"}`, groupID, msgName, now-float64(time.Hour), now),
+			contentType: contentType,
+			token:       userToken,
+			chanIDs:     []string{chanID, chanID2},
+			groupIDs:    []string{chanID, chanID2},
+			query:       readers.SeriesQuery{Names: []string{msgName}, From: now - float64(time.Hour), To: now, Interval: "1m", Aggregation: "max", Fill: readers.FillLOCF},
+			status:      http.StatusOK,
+			res:         series,
+		},
+		{
+			desc:        "read series of non-existing group",
+			body:        fmt.Sprintf(`{"group_id":"%s","names":["%s"]}`, groupID, msgName),
+			contentType: contentType,
+			token:       userToken,
+			groupErr:    svcerr.ErrNotFound,
+			status:      http.StatusNotFound,
+		},
+		{
+			desc:        "read series with invalid token",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"]}`, chanID, msgName),
+			contentType: contentType,
+			token:       invalidToken,
+			authnErr:    svcerr.ErrAuthentication,
+			status:      http.StatusUnauthorized,
+		},
+		{
+			desc:        "read series of unauthorized channel",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"]}`, chanID, msgName),
+			contentType: contentType,
+			token:       userToken,
+			authzRes:    &grpcChannelsV1.AuthzRes{Authorized: false},
+			status:      http.StatusForbidden,
+		},
+		{
+			desc:        "read series with invalid content type",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"]}`, chanID, msgName),
+			contentType: "text/plain",
+			token:       userToken,
+			status:      http.StatusUnsupportedMediaType,
+		},
+		{
+			desc:        "read series with malformed body",
+			body:        `{"channel_ids":`,
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series without channels and group",
+			body:        fmt.Sprintf(`{"names":["%s"]}`, msgName),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series with both channels and group",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"group_id":"%s","names":["%s"]}`, chanID, groupID, msgName),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series without names",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"]}`, chanID),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series with invalid fill",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"],"from":%f,"to":%f,"interval":"1m","fill":"invalid"}`, chanID, msgName, now-float64(time.Hour), now),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series with fill and without interval",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"],"fill":"linear"}`, chanID, msgName),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series with interval and without time range",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"],"interval":"1m"}`, chanID, msgName),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series with invalid time range",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"],"from":%f,"to":%f,"interval":"1m"}`, chanID, msgName, now, now-float64(time.Hour)),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+		{
+			desc:        "read series with too many points",
+			body:        fmt.Sprintf(`{"channel_ids":["%s"],"names":["%s"],"from":%f,"to":%f,"interval":"1ms"}`, chanID, msgName, now-float64(time.Hour), now),
+			contentType: contentType,
+			token:       userToken,
+			status:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			authnCall := authn.On("Authenticate", mock.Anything, tc.token).Return(validSession, tc.authnErr)
+			if tc.key != "" {
+				authnCall = clients.On("Authenticate", mock.Anything, &grpcClientsV1.AuthnReq{
+					Token: smqauthn.AuthPack(smqauthn.DomainAuth, domainID, tc.key),
+				}).Return(&grpcClientsV1.AuthnRes{Id: testsutil.GenerateUUID(t), Authenticated: true}, tc.authnErr)
+			}
+			if tc.authzRes == nil {
+				tc.authzRes = &grpcChannelsV1.AuthzRes{Authorized: true}
+			}
+			authzCall := channels.On("Authorize", mock.Anything, mock.Anything).Return(tc.authzRes, nil)
+			groupsCall := groups.On("RetrieveChannelIDs", mock.Anything, &grpcGroupsV1.RetrieveChannelIDsReq{Id: groupID, DomainId: domainID}).Return(&grpcGroupsV1.RetrieveChannelIDsRes{Ids: tc.groupIDs}, tc.groupErr)
+			repoCall := repo.On("ReadSeries", tc.chanIDs, tc.query).Return(readers.SeriesPage{SeriesQuery: tc.query, Series: tc.res}, nil)
+			req := testRequest{
+				client:      ts.Client(),
+				method:      http.MethodPost,
+				url:         fmt.Sprintf("%s/%s/messages/series", ts.URL, domainID),
+				token:       tc.token,
+				key:         tc.key,
+				contentType: tc.contentType,
+				body:        strings.NewReader(tc.body),
+			}
+			res, err := req.make()
+			assert.Nil(t, err, fmt.Sprintf("%s: unexpected error %s", tc.desc, err))
+			assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.status, res.StatusCode))
+			if tc.status == http.StatusOK {
+				var page seriesPageRes
+				err = json.NewDecoder(res.Body).Decode(&page)
+				assert.Nil(t, err, fmt.Sprintf("%s: unexpected error while decoding response body: %s", tc.desc, err))
+				assert.Equal(t, tc.res, page.Series, fmt.Sprintf("%s: got incorrect body from response", tc.desc))
+			}
+			authzCall.Unset()
+			authnCall.Unset()
+			groupsCall.Unset()
+			repoCall.Unset()
+		})
+	}
+}
+
 type pageRes struct {
 	readers.PageMetadata
-	Total    uint64          `json:"total"`
-	Messages []senml.Message `json:"messages"`
+	Total      uint64          `json:"total"`
+	NextCursor string          `json:"next_cursor"`
+	Messages   []senml.Message `json:"messages"`
+}
+
+type senmlParquetRow struct {
+	Protocol string  `parquet:"protocol"`
+	Time     float64 `parquet:"time"`
+}
+
+type seriesPageRes struct {
+	readers.SeriesQuery
+	Series []readers.Series `json:"series"`
 }
 
 func fromSenml(in []senml.Message) []readers.Message {
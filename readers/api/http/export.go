@@ -0,0 +1,283 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/absmach/magistrala/readers"
+	"github.com/parquet-go/parquet-go"
+)
+
+const (
+	csvOutput     = "csv"
+	ndjsonOutput  = "ndjson"
+	parquetOutput = "parquet"
+
+	// parquetRowGroupSize bounds the number of rows buffered before
+	// the row group is written to the response.
+	parquetRowGroupSize = 10000
+)
+
+var (
+	errUnsupportedMessage = errors.New("unsupported message type")
+
+	outputContentTypes = map[string]string{
+		csvOutput:     "text/csv",
+		ndjsonOutput:  "application/x-ndjson",
+		parquetOutput: "application/vnd.apache.parquet",
+	}
+
+	senmlColumns = []string{"channel", "domain", "client_id", "subtopic", "publisher", "protocol", "name", "unit", "time", "update_time", "value", "string_value", "bool_value", "data_value", "sum"}
+	jsonColumns  = []string{"channel", "domain", "client_id", "created", "subtopic", "publisher", "protocol", "payload"}
+)
+
+// messageEncoder writes the exported messages in the requested output format.
+type messageEncoder interface {
+	encode(msg readers.Message) error
+	close() error
+}
+
+func newMessageEncoder(output string, isSenml bool, w io.Writer) messageEncoder {
+	switch output {
+	case ndjsonOutput:
+		return ndjsonEncoder{enc: json.NewEncoder(w)}
+	case parquetOutput:
+		if isSenml {
+			return newParquetEncoder(w, toSenMLRow)
+		}
+		return newParquetEncoder(w, toJSONRow)
+	default:
+		if isSenml {
+			return &csvEncoder{w: csv.NewWriter(w), header: senmlColumns, record: toSenMLRecord}
+		}
+		return &csvEncoder{w: csv.NewWriter(w), header: jsonColumns, record: toJSONRecord}
+	}
+}
+
+type ndjsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (e ndjsonEncoder) encode(msg readers.Message) error {
+	return e.enc.Encode(msg)
+}
+
+func (e ndjsonEncoder) close() error {
+	return nil
+}
+
+type csvEncoder struct {
+	w      *csv.Writer
+	header []string
+	record func(readers.Message) ([]string, error)
+	opened bool
+}
+
+func (e *csvEncoder) encode(msg readers.Message) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	rec, err := e.record(msg)
+	if err != nil {
+		return err
+	}
+
+	return e.w.Write(rec)
+}
+
+func (e *csvEncoder) close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+func (e *csvEncoder) writeHeader() error {
+	if e.opened {
+		return nil
+	}
+	e.opened = true
+
+	return e.w.Write(e.header)
+}
+
+type parquetEncoder[T any] struct {
+	w   *parquet.GenericWriter[T]
+	row func(readers.Message) (T, error)
+}
+
+func newParquetEncoder[T any](w io.Writer, row func(readers.Message) (T, error)) *parquetEncoder[T] {
+	return &parquetEncoder[T]{
+		w:   parquet.NewGenericWriter[T](w, parquet.MaxRowsPerRowGroup(parquetRowGroupSize)),
+		row: row,
+	}
+}
+
+func (e *parquetEncoder[T]) encode(msg readers.Message) error {
+	row, err := e.row(msg)
+	if err != nil {
+		return err
+	}
+	_, err = e.w.Write([]T{row})
+
+	return err
+}
+
+func (e *parquetEncoder[T]) close() error {
+	return e.w.Close()
+}
+
+type senmlRow struct {
+	Channel     string   `parquet:"channel"`
+	Domain      string   `parquet:"domain"`
+	ClientID    string   `parquet:"client_id"`
+	Subtopic    string   `parquet:"subtopic"`
+	Publisher   string   `parquet:"publisher"`
+	Protocol    string   `parquet:"protocol"`
+	Name        string   `parquet:"name"`
+	Unit        string   `parquet:"unit"`
+	Time        float64  `parquet:"time"`
+	UpdateTime  float64  `parquet:"update_time"`
+	Value       *float64 `parquet:"value,optional"`
+	StringValue *string  `parquet:"string_value,optional"`
+	BoolValue   *bool    `parquet:"bool_value,optional"`
+	DataValue   *string  `parquet:"data_value,optional"`
+	Sum         *float64 `parquet:"sum,optional"`
+}
+
+type jsonRow struct {
+	Channel   string `parquet:"channel"`
+	Domain    string `parquet:"domain"`
+	ClientID  string `parquet:"client_id"`
+	Created   int64  `parquet:"created"`
+	Subtopic  string `parquet:"subtopic"`
+	Publisher string `parquet:"publisher"`
+	Protocol  string `parquet:"protocol"`
+	Payload   string `parquet:"payload"`
+}
+
+func toSenMLRow(msg readers.Message) (senmlRow, error) {
+	m, ok := msg.(senml.Message)
+	if !ok {
+		return senmlRow{}, errUnsupportedMessage
+	}
+
+	return senmlRow{
+		Channel:     m.Channel,
+		Domain:      m.Domain,
+		ClientID:    m.ClientID,
+		Subtopic:    m.Subtopic,
+		Publisher:   m.Publisher,
+		Protocol:    m.Protocol,
+		Name:        m.Name,
+		Unit:        m.Unit,
+		Time:        m.Time,
+		UpdateTime:  m.UpdateTime,
+		Value:       m.Value,
+		StringValue: m.StringValue,
+		BoolValue:   m.BoolValue,
+		DataValue:   m.DataValue,
+		Sum:         m.Sum,
+	}, nil
+}
+
+func toJSONRow(msg readers.Message) (jsonRow, error) {
+	m, ok := msg.(map[string]any)
+	if !ok {
+		return jsonRow{}, errUnsupportedMessage
+	}
+	payload, err := json.Marshal(m["payload"])
+	if err != nil {
+		return jsonRow{}, err
+	}
+	created, _ := m["created"].(int64)
+
+	return jsonRow{
+		Channel:   stringField(m, "channel"),
+		Domain:    stringField(m, "domain"),
+		ClientID:  stringField(m, "client_id"),
+		Created:   created,
+		Subtopic:  stringField(m, "subtopic"),
+		Publisher: stringField(m, "publisher"),
+		Protocol:  stringField(m, "protocol"),
+		Payload:   string(payload),
+	}, nil
+}
+
+func toSenMLRecord(msg readers.Message) ([]string, error) {
+	row, err := toSenMLRow(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		row.Channel,
+		row.Domain,
+		row.ClientID,
+		row.Subtopic,
+		row.Publisher,
+		row.Protocol,
+		row.Name,
+		row.Unit,
+		formatFloat(&row.Time),
+		formatFloat(&row.UpdateTime),
+		formatFloat(row.Value),
+		stringValue(row.StringValue),
+		formatBool(row.BoolValue),
+		stringValue(row.DataValue),
+		formatFloat(row.Sum),
+	}, nil
+}
+
+func toJSONRecord(msg readers.Message) ([]string, error) {
+	row, err := toJSONRow(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		row.Channel,
+		row.Domain,
+		row.ClientID,
+		strconv.FormatInt(row.Created, 10),
+		row.Subtopic,
+		row.Publisher,
+		row.Protocol,
+		row.Payload,
+	}, nil
+}
+
+func stringField(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func formatBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
@@ -12,7 +12,12 @@ import (
 	"github.com/absmach/magistrala/readers"
 )
 
-const maxLimitSize = 1000
+const (
+	maxLimitSize      = 1000
+	maxSeriesChannels = 100
+	maxSeriesNames    = 20
+	maxSeriesPoints   = 10000
+)
 
 var validAggregations = []string{"MAX", "MIN", "AVG", "SUM", "COUNT"}
 
@@ -37,15 +42,22 @@ func (req listMessagesReq) validate() error {
 		return apiutil.ErrLimitSize
 	}
 
-	if req.pageMeta.Comparator != "" &&
-		req.pageMeta.Comparator != readers.EqualKey &&
-		req.pageMeta.Comparator != readers.LowerThanKey &&
-		req.pageMeta.Comparator != readers.LowerThanEqualKey &&
-		req.pageMeta.Comparator != readers.GreaterThanKey &&
-		req.pageMeta.Comparator != readers.GreaterThanEqualKey {
+	if !validComparator(req.pageMeta.Comparator) {
 		return apiutil.ErrInvalidComparator
 	}
 
+	if req.pageMeta.Cursor != "" {
+		if req.pageMeta.Offset != 0 || req.pageMeta.Aggregation != "" {
+			return apiutil.ErrInvalidCursor
+		}
+		if req.pageMeta.Order != "time" && req.pageMeta.Order != "created" {
+			return apiutil.ErrInvalidCursor
+		}
+		if _, err := readers.DecodeCursor(req.pageMeta.Cursor); err != nil {
+			return err
+		}
+	}
+
 	if req.pageMeta.Aggregation != "" {
 		if req.pageMeta.From == 0 {
 			return apiutil.ErrMissingFrom
@@ -66,3 +78,132 @@ func (req listMessagesReq) validate() error {
 
 	return nil
 }
+
+type exportMessagesReq struct {
+	chanID   string
+	token    string
+	domain   string
+	key      string
+	output   string
+	pageMeta readers.PageMetadata
+}
+
+func (req exportMessagesReq) validate() error {
+	if req.token == "" && req.key == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	if req.chanID == "" {
+		return apiutil.ErrMissingID
+	}
+
+	if _, ok := outputContentTypes[req.output]; !ok {
+		return apiutil.ErrInvalidExportOutput
+	}
+
+	if !validComparator(req.pageMeta.Comparator) {
+		return apiutil.ErrInvalidComparator
+	}
+
+	if req.pageMeta.From != 0 && req.pageMeta.To != 0 && req.pageMeta.To <= req.pageMeta.From {
+		return apiutil.ErrInvalidTimeRange
+	}
+
+	return nil
+}
+
+type readSeriesReq struct {
+	token      string
+	domain     string
+	key        string
+	ChannelIDs []string `json:"channel_ids,omitempty"`
+	GroupID    string   `json:"group_id,omitempty"`
+	readers.SeriesQuery
+}
+
+func (req readSeriesReq) validate() error {
+	if req.token == "" && req.key == "" {
+		return apiutil.ErrBearerToken
+	}
+
+	switch {
+	case len(req.ChannelIDs) == 0 && req.GroupID == "":
+		return apiutil.ErrMissingChannelID
+	case len(req.ChannelIDs) > 0 && req.GroupID != "":
+		return apiutil.ErrMultipleEntitiesFilter
+	case len(req.ChannelIDs) > maxSeriesChannels:
+		return apiutil.ErrTooManySeries
+	}
+	for _, id := range req.ChannelIDs {
+		if id == "" {
+			return apiutil.ErrMissingChannelID
+		}
+	}
+
+	if len(req.Names) == 0 {
+		return apiutil.ErrMissingMeasurementNames
+	}
+	if len(req.Names) > maxSeriesNames {
+		return apiutil.ErrTooManySeries
+	}
+	for _, name := range req.Names {
+		if name == "" {
+			return apiutil.ErrMissingMeasurementNames
+		}
+	}
+
+	switch req.Fill {
+	case "", readers.FillNone, readers.FillLOCF, readers.FillLinear:
+	default:
+		return apiutil.ErrInvalidFill
+	}
+
+	if agg := strings.ToUpper(req.Aggregation); agg != "" && !slices.Contains(validAggregations, agg) {
+		return apiutil.ErrInvalidAggregation
+	}
+
+	if req.From != 0 && req.To != 0 && req.To <= req.From {
+		return apiutil.ErrInvalidTimeRange
+	}
+
+	if req.Interval == "" {
+		// Raw values can't be aligned, so they are neither aggregated nor filled.
+		if req.Aggregation != "" || (req.Fill != "" && req.Fill != readers.FillNone) {
+			return apiutil.ErrInvalidInterval
+		}
+		if req.Limit < 1 || req.Limit > maxLimitSize {
+			return apiutil.ErrLimitSize
+		}
+		return nil
+	}
+
+	interval, err := time.ParseDuration(req.Interval)
+	if err != nil || interval <= 0 {
+		return apiutil.ErrInvalidInterval
+	}
+	if req.From == 0 {
+		return apiutil.ErrMissingFrom
+	}
+	if req.To == 0 {
+		return apiutil.ErrMissingTo
+	}
+	if (req.To-req.From)/float64(interval.Nanoseconds()) > maxSeriesPoints {
+		return apiutil.ErrTooManyPoints
+	}
+
+	return nil
+}
+
+func validComparator(comparator string) bool {
+	switch comparator {
+	case "",
+		readers.EqualKey,
+		readers.LowerThanKey,
+		readers.LowerThanEqualKey,
+		readers.GreaterThanKey,
+		readers.GreaterThanEqualKey:
+		return true
+	default:
+		return false
+	}
+}
@@ -10,12 +10,16 @@ import (
 	"github.com/absmach/magistrala/readers"
 )
 
-var _ magistrala.Response = (*pageRes)(nil)
+var (
+	_ magistrala.Response = (*pageRes)(nil)
+	_ magistrala.Response = (*seriesPageRes)(nil)
+)
 
 type pageRes struct {
 	readers.PageMetadata
-	Total    uint64            `json:"total"`
-	Messages []readers.Message `json:"messages"`
+	Total      uint64            `json:"total"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	Messages   []readers.Message `json:"messages"`
 }
 
 func (res pageRes) Headers() map[string]string {
@@ -29,3 +33,29 @@ func (res pageRes) Code() int {
 func (res pageRes) Empty() bool {
 	return false
 }
+
+type seriesPageRes struct {
+	readers.SeriesQuery
+	Series []readers.Series `json:"series"`
+}
+
+func (res seriesPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res seriesPageRes) Code() int {
+	return http.StatusOK
+}
+
+func (res seriesPageRes) Empty() bool {
+	return false
+}
+
+// exportRes streams the exported messages to the response, so it's written
+// by the export encoder instead of being encoded as JSON.
+type exportRes struct {
+	chanID  string
+	output  string
+	isSenml bool
+	export  func(fn func(readers.Message) error) error
+}
@@ -6,11 +6,16 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/absmach/magistrala"
 	grpcChannelsV1 "github.com/absmach/magistrala/api/grpc/channels/v1"
 	grpcClientsV1 "github.com/absmach/magistrala/api/grpc/clients/v1"
+	grpcGroupsV1 "github.com/absmach/magistrala/api/grpc/groups/v1"
 	api "github.com/absmach/magistrala/api/http"
 	apiutil "github.com/absmach/magistrala/api/http/util"
 	smqauthn "github.com/absmach/magistrala/pkg/authn"
@@ -32,6 +37,7 @@ const (
 	subtopicKey    = "subtopic"
 	publisherKey   = "publisher"
 	protocolKey    = "protocol"
+	clientIDKey    = "client_id"
 	nameKey        = "name"
 	valueKey       = "v"
 	stringValueKey = "vs"
@@ -42,14 +48,18 @@ const (
 	toKey          = "to"
 	aggregationKey = "aggregation"
 	intervalKey    = "interval"
+	cursorKey      = "cursor"
+	outputKey      = "output"
 	defInterval    = "1s"
 	defLimit       = 10
+	defSeriesLimit = 100
 	defOffset      = 0
 	defFormat      = "messages"
+	defOutput      = csvOutput
 )
 
 // MakeHandler returns a HTTP handler for API endpoints.
-func MakeHandler(svc readers.MessageRepository, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, svcName, instanceID string) http.Handler {
+func MakeHandler(svc readers.MessageRepository, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient, groups grpcGroupsV1.GroupsServiceClient, svcName, instanceID string) http.Handler {
 	opts := []kithttp.ServerOption{
 		kithttp.ServerErrorEncoder(api.EncodeError),
 	}
@@ -62,6 +72,20 @@ func MakeHandler(svc readers.MessageRepository, authn smqauthn.Authentication, c
 		opts...,
 	).ServeHTTP)
 
+	mux.Get("/{domainID}/channels/{chanID}/messages/export", kithttp.NewServer(
+		exportMessagesEndpoint(svc, authn, clients, channels),
+		decodeExport,
+		encodeExportResponse,
+		opts...,
+	).ServeHTTP)
+
+	mux.Post("/{domainID}/messages/series", kithttp.NewServer(
+		readSeriesEndpoint(svc, authn, clients, channels, groups),
+		decodeReadSeries,
+		encodeResponse,
+		opts...,
+	).ServeHTTP)
+
 	mux.Get("/health", magistrala.Health(svcName, instanceID))
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -99,6 +123,11 @@ func decodeList(_ context.Context, r *http.Request) (any, error) {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
 	}
 
+	clientID, err := apiutil.ReadStringQuery(r, clientIDKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
 	name, err := apiutil.ReadStringQuery(r, nameKey, "")
 	if err != nil {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
@@ -154,6 +183,11 @@ func decodeList(_ context.Context, r *http.Request) (any, error) {
 		return nil, errors.Wrap(apiutil.ErrValidation, err)
 	}
 
+	cursor, err := apiutil.ReadStringQuery(r, cursorKey, "")
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
 	var interval string
 	if aggregation != "" {
 		interval, err = apiutil.ReadStringQuery(r, intervalKey, defInterval)
@@ -174,6 +208,7 @@ func decodeList(_ context.Context, r *http.Request) (any, error) {
 			Subtopic:    subtopic,
 			Publisher:   publisher,
 			Protocol:    protocol,
+			ClientID:    clientID,
 			Name:        name,
 			Value:       v,
 			Comparator:  comparator,
@@ -186,11 +221,61 @@ func decodeList(_ context.Context, r *http.Request) (any, error) {
 			Interval:    interval,
 			Order:       order,
 			Dir:         dir,
+			Cursor:      cursor,
 		},
 	}
 	return req, nil
 }
 
+func decodeExport(ctx context.Context, r *http.Request) (any, error) {
+	output, err := apiutil.ReadStringQuery(r, outputKey, defOutput)
+	if err != nil {
+		return nil, errors.Wrap(apiutil.ErrValidation, err)
+	}
+
+	req, err := decodeList(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	lr := req.(listMessagesReq)
+
+	// All the messages matching the filters are exported oldest first,
+	// so the paging, ordering and aggregation parameters are dropped.
+	pm := lr.pageMeta
+	pm.Offset, pm.Limit, pm.Cursor = 0, 0, ""
+	pm.Order, pm.Dir = "", ""
+	pm.Aggregation, pm.Interval = "", ""
+
+	return exportMessagesReq{
+		chanID:   lr.chanID,
+		token:    lr.token,
+		domain:   lr.domain,
+		key:      lr.key,
+		output:   strings.ToLower(output),
+		pageMeta: pm,
+	}, nil
+}
+
+func decodeReadSeries(_ context.Context, r *http.Request) (any, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, apiutil.ErrUnsupportedContentType
+	}
+
+	req := readSeriesReq{
+		token:  apiutil.ExtractBearerToken(r),
+		domain: chi.URLParam(r, "domainID"),
+		key:    apiutil.ExtractClientSecret(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, errors.Wrap(apiutil.ErrMalformedRequestBody, err)
+	}
+	if req.Interval == "" && req.Limit == 0 {
+		req.Limit = defSeriesLimit
+	}
+
+	return req, nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response any) error {
 	w.Header().Set("Content-Type", contentType)
 
@@ -209,21 +294,60 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response any) erro
 	return json.NewEncoder(w).Encode(response)
 }
 
-func authnAuthz(ctx context.Context, req listMessagesReq, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) error {
-	clientID, clientType, err := authenticate(ctx, req, authn, clients)
+func encodeExportResponse(_ context.Context, w http.ResponseWriter, response any) error {
+	res := response.(exportRes)
+
+	// Exports of the large datasets outlast the server write timeout.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", outputContentTypes[res.output])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, res.chanID, res.output))
+
+	ew := &exportWriter{w: w}
+	enc := newMessageEncoder(res.output, res.isSenml, ew)
+	err := res.export(enc.encode)
+	if err == nil {
+		err = enc.close()
+	}
+	if err != nil {
+		if !ew.written {
+			w.Header().Del("Content-Disposition")
+			return err
+		}
+		// The status is already sent, so the response is aborted to let
+		// the client know the export is incomplete.
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
+
+// exportWriter tracks whether any of the export is written to the response.
+type exportWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (ew *exportWriter) Write(p []byte) (int, error) {
+	ew.written = true
+	return ew.w.Write(p)
+}
+
+func authnAuthz(ctx context.Context, domain, token, key, chanID string, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient, channels grpcChannelsV1.ChannelsServiceClient) error {
+	clientID, clientType, err := authenticate(ctx, domain, token, key, authn, clients)
 	if err != nil {
 		return err
 	}
-	if err := authorize(ctx, clientID, clientType, req.chanID, req.domain, channels); err != nil {
+	if err := authorize(ctx, clientID, clientType, chanID, domain, channels); err != nil {
 		return err
 	}
 	return nil
 }
 
-func authenticate(ctx context.Context, req listMessagesReq, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient) (clientID string, clientType string, err error) {
+func authenticate(ctx context.Context, domain, token, key string, authn smqauthn.Authentication, clients grpcClientsV1.ClientsServiceClient) (clientID string, clientType string, err error) {
 	switch {
-	case req.token != "":
-		session, err := authn.Authenticate(ctx, req.token)
+	case token != "":
+		session, err := authn.Authenticate(ctx, token)
 		if err != nil {
 			return "", "", err
 		}
@@ -231,10 +355,10 @@ func authenticate(ctx context.Context, req listMessagesReq, authn smqauthn.Authe
 			return session.UserID, policies.UserType, nil
 		}
 
-		return policies.EncodeDomainUserID(req.domain, session.UserID), policies.UserType, nil
-	case req.key != "":
+		return policies.EncodeDomainUserID(domain, session.UserID), policies.UserType, nil
+	case key != "":
 		res, err := clients.Authenticate(ctx, &grpcClientsV1.AuthnReq{
-			Token: smqauthn.AuthPack(smqauthn.DomainAuth, req.domain, req.key),
+			Token: smqauthn.AuthPack(smqauthn.DomainAuth, domain, key),
 		})
 		if err != nil {
 			return "", "", err
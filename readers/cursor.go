@@ -0,0 +1,37 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalidCursor indicates the malformed page cursor.
+var ErrInvalidCursor = errors.New("invalid page cursor")
+
+// EncodeCursor returns the opaque page cursor made of the sort key values of
+// the last message in the page. The values are kept as strings, so the
+// nanosecond timestamps are compared exactly.
+func EncodeCursor(keys ...string) string {
+	// Marshaling the strings never fails.
+	data, _ := json.Marshal(keys)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor returns the sort key values of the page cursor.
+func DecodeCursor(cursor string) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var keys []string
+	if err := json.Unmarshal(data, &keys); err != nil || len(keys) == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return keys, nil
+}
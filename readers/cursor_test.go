@@ -0,0 +1,55 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/absmach/magistrala/readers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	cases := []struct {
+		desc   string
+		cursor string
+		keys   []string
+		err    error
+	}{
+		{
+			desc:   "decode cursor with time and id",
+			cursor: readers.EncodeCursor("1709218556069000001", "5de9b29a-feb9-11ed-be56-0242ac120002"),
+			keys:   []string{"1709218556069000001", "5de9b29a-feb9-11ed-be56-0242ac120002"},
+		},
+		{
+			desc:   "decode cursor with empty keys",
+			cursor: readers.EncodeCursor("1709218556069000001", "", "temperature"),
+			keys:   []string{"1709218556069000001", "", "temperature"},
+		},
+		{
+			desc:   "decode cursor without keys",
+			cursor: readers.EncodeCursor(),
+			err:    readers.ErrInvalidCursor,
+		},
+		{
+			desc:   "decode malformed cursor",
+			cursor: "1709218556069000001",
+			err:    readers.ErrInvalidCursor,
+		},
+		{
+			desc:   "decode cursor with invalid encoding",
+			cursor: "invalid cursor",
+			err:    readers.ErrInvalidCursor,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			keys, err := readers.DecodeCursor(tc.cursor)
+			assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.keys, keys, fmt.Sprintf("%s: expected keys %v got %v", tc.desc, tc.keys, keys))
+		})
+	}
+}
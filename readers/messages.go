@@ -3,7 +3,10 @@
 
 package readers
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 const (
 	// EqualKey represents the equal comparison operator key.
@@ -26,17 +29,30 @@ type MessageRepository interface {
 	// ReadAll skips given number of messages for given channel and returns next
 	// limited number of messages.
 	ReadAll(chanID string, pm PageMetadata) (MessagesPage, error)
+
+	// ExportAll calls the given function for each of the channel messages
+	// matching the given page metadata, oldest first, ignoring the limit and
+	// offset. The messages are streamed from the database and the export
+	// stops on the first error returned by the function or when the context
+	// is canceled.
+	ExportAll(ctx context.Context, chanID string, pm PageMetadata, fn func(Message) error) error
+
+	// ReadSeries returns the time series of the SenML values of the queried
+	// measurements, one per each of the given channels and measurements.
+	ReadSeries(chanIDs []string, sq SeriesQuery) (SeriesPage, error)
 }
 
 // Message represents any message format.
 type Message any
 
 // MessagesPage contains page related metadata as well as list of messages that
-// belong to this page.
+// belong to this page. NextCursor is the position of the last message of a
+// full page, used as the cursor to read the next page.
 type MessagesPage struct {
 	PageMetadata
-	Total    uint64
-	Messages []Message
+	Total      uint64
+	NextCursor string
+	Messages   []Message
 }
 
 // PageMetadata represents the parameters used to create database queries.
@@ -45,6 +61,8 @@ type PageMetadata struct {
 	Limit       uint64  `json:"limit"`
 	Order       string  `json:"order,omitempty"`
 	Dir         string  `json:"dir,omitempty"`
+	Domain      string  `json:"domain,omitempty"`
+	ClientID    string  `json:"client_id,omitempty"`
 	Subtopic    string  `json:"subtopic,omitempty"`
 	Publisher   string  `json:"publisher,omitempty"`
 	Protocol    string  `json:"protocol,omitempty"`
@@ -59,6 +77,7 @@ type PageMetadata struct {
 	Format      string  `json:"format,omitempty"`
 	Aggregation string  `json:"aggregation,omitempty"`
 	Interval    string  `json:"interval,omitempty"`
+	Cursor      string  `json:"cursor,omitempty"`
 }
 
 // ParseValueComparator convert comparison operator keys into mathematic anotation.
@@ -6,6 +6,7 @@
 package middleware
 
 import (
+	"context"
 	"log/slog"
 	"time"
 
@@ -54,3 +55,59 @@ func (lm *loggingMiddleware) ReadAll(chanID string, rpm readers.PageMetadata) (p
 
 	return lm.svc.ReadAll(chanID, rpm)
 }
+
+func (lm *loggingMiddleware) ExportAll(ctx context.Context, chanID string, rpm readers.PageMetadata, fn func(readers.Message) error) (err error) {
+	var count uint64
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.String("channel_id", chanID),
+			slog.Uint64("count", count),
+		}
+		if rpm.Format != "" {
+			args = append(args, slog.String("format", rpm.Format))
+		}
+		if rpm.From != 0 || rpm.To != 0 {
+			args = append(args,
+				slog.Float64("from", rpm.From),
+				slog.Float64("to", rpm.To),
+			)
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Export all failed", args...)
+			return
+		}
+		lm.logger.Info("Export all completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.ExportAll(ctx, chanID, rpm, func(msg readers.Message) error {
+		count++
+		return fn(msg)
+	})
+}
+
+func (lm *loggingMiddleware) ReadSeries(chanIDs []string, sq readers.SeriesQuery) (page readers.SeriesPage, err error) {
+	defer func(begin time.Time) {
+		args := []any{
+			slog.String("duration", time.Since(begin).String()),
+			slog.Any("channel_ids", chanIDs),
+			slog.Any("names", sq.Names),
+		}
+		if sq.Interval != "" {
+			args = append(args,
+				slog.String("interval", sq.Interval),
+				slog.String("aggregation", sq.Aggregation),
+				slog.String("fill", sq.Fill),
+			)
+		}
+		if err != nil {
+			args = append(args, slog.Any("error", err))
+			lm.logger.Warn("Read series failed", args...)
+			return
+		}
+		lm.logger.Info("Read series completed successfully", args...)
+	}(time.Now())
+
+	return lm.svc.ReadSeries(chanIDs, sq)
+}
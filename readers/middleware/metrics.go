@@ -6,6 +6,7 @@
 package middleware
 
 import (
+	"context"
 	"time"
 
 	"github.com/absmach/magistrala/readers"
@@ -37,3 +38,21 @@ func (mm *metricsMiddleware) ReadAll(chanID string, rpm readers.PageMetadata) (r
 
 	return mm.svc.ReadAll(chanID, rpm)
 }
+
+func (mm *metricsMiddleware) ExportAll(ctx context.Context, chanID string, rpm readers.PageMetadata, fn func(readers.Message) error) error {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "export_all").Add(1)
+		mm.latency.With("method", "export_all").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.ExportAll(ctx, chanID, rpm, fn)
+}
+
+func (mm *metricsMiddleware) ReadSeries(chanIDs []string, sq readers.SeriesQuery) (readers.SeriesPage, error) {
+	defer func(begin time.Time) {
+		mm.counter.With("method", "read_series").Add(1)
+		mm.latency.With("method", "read_series").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return mm.svc.ReadSeries(chanIDs, sq)
+}
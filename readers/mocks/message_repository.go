@@ -9,6 +9,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/absmach/magistrala/readers"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -40,6 +42,75 @@ func (_m *MessageRepository) EXPECT() *MessageRepository_Expecter {
 	return &MessageRepository_Expecter{mock: &_m.Mock}
 }
 
+// ExportAll provides a mock function for the type MessageRepository
+func (_mock *MessageRepository) ExportAll(ctx context.Context, chanID string, pm readers.PageMetadata, fn func(readers.Message) error) error {
+	ret := _mock.Called(ctx, chanID, pm, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, readers.PageMetadata, func(readers.Message) error) error); ok {
+		r0 = returnFunc(ctx, chanID, pm, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MessageRepository_ExportAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportAll'
+type MessageRepository_ExportAll_Call struct {
+	*mock.Call
+}
+
+// ExportAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chanID string
+//   - pm readers.PageMetadata
+//   - fn func(readers.Message) error
+func (_e *MessageRepository_Expecter) ExportAll(ctx interface{}, chanID interface{}, pm interface{}, fn interface{}) *MessageRepository_ExportAll_Call {
+	return &MessageRepository_ExportAll_Call{Call: _e.mock.On("ExportAll", ctx, chanID, pm, fn)}
+}
+
+func (_c *MessageRepository_ExportAll_Call) Run(run func(ctx context.Context, chanID string, pm readers.PageMetadata, fn func(readers.Message) error)) *MessageRepository_ExportAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 readers.PageMetadata
+		if args[2] != nil {
+			arg2 = args[2].(readers.PageMetadata)
+		}
+		var arg3 func(readers.Message) error
+		if args[3] != nil {
+			arg3 = args[3].(func(readers.Message) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MessageRepository_ExportAll_Call) Return(err error) *MessageRepository_ExportAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MessageRepository_ExportAll_Call) RunAndReturn(run func(ctx context.Context, chanID string, pm readers.PageMetadata, fn func(readers.Message) error) error) *MessageRepository_ExportAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadAll provides a mock function for the type MessageRepository
 func (_mock *MessageRepository) ReadAll(chanID string, pm readers.PageMetadata) (readers.MessagesPage, error) {
 	ret := _mock.Called(chanID, pm)
@@ -105,3 +176,69 @@ func (_c *MessageRepository_ReadAll_Call) RunAndReturn(run func(chanID string, p
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReadSeries provides a mock function for the type MessageRepository
+func (_mock *MessageRepository) ReadSeries(chanIDs []string, sq readers.SeriesQuery) (readers.SeriesPage, error) {
+	ret := _mock.Called(chanIDs, sq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSeries")
+	}
+
+	var r0 readers.SeriesPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string, readers.SeriesQuery) (readers.SeriesPage, error)); ok {
+		return returnFunc(chanIDs, sq)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string, readers.SeriesQuery) readers.SeriesPage); ok {
+		r0 = returnFunc(chanIDs, sq)
+	} else {
+		r0 = ret.Get(0).(readers.SeriesPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string, readers.SeriesQuery) error); ok {
+		r1 = returnFunc(chanIDs, sq)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MessageRepository_ReadSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSeries'
+type MessageRepository_ReadSeries_Call struct {
+	*mock.Call
+}
+
+// ReadSeries is a helper method to define mock.On call
+//   - chanIDs []string
+//   - sq readers.SeriesQuery
+func (_e *MessageRepository_Expecter) ReadSeries(chanIDs interface{}, sq interface{}) *MessageRepository_ReadSeries_Call {
+	return &MessageRepository_ReadSeries_Call{Call: _e.mock.On("ReadSeries", chanIDs, sq)}
+}
+
+func (_c *MessageRepository_ReadSeries_Call) Run(run func(chanIDs []string, sq readers.SeriesQuery)) *MessageRepository_ReadSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		var arg1 readers.SeriesQuery
+		if args[1] != nil {
+			arg1 = args[1].(readers.SeriesQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MessageRepository_ReadSeries_Call) Return(seriesPage readers.SeriesPage, err error) *MessageRepository_ReadSeries_Call {
+	_c.Call.Return(seriesPage, err)
+	return _c
+}
+
+func (_c *MessageRepository_ReadSeries_Call) RunAndReturn(run func(chanIDs []string, sq readers.SeriesQuery) (readers.SeriesPage, error)) *MessageRepository_ReadSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -125,3 +125,86 @@ func (_c *ReadersServiceClient_ReadMessages_Call) RunAndReturn(run func(ctx cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// ReadSeries provides a mock function for the type ReadersServiceClient
+func (_mock *ReadersServiceClient) ReadSeries(ctx context.Context, in *v1.ReadSeriesReq, opts ...grpc.CallOption) (*v1.ReadSeriesRes, error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, in, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, in)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadSeries")
+	}
+
+	var r0 *v1.ReadSeriesRes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ReadSeriesReq, ...grpc.CallOption) (*v1.ReadSeriesRes, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1.ReadSeriesReq, ...grpc.CallOption) *v1.ReadSeriesRes); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ReadSeriesRes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1.ReadSeriesReq, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ReadersServiceClient_ReadSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadSeries'
+type ReadersServiceClient_ReadSeries_Call struct {
+	*mock.Call
+}
+
+// ReadSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *v1.ReadSeriesReq
+//   - opts ...grpc.CallOption
+func (_e *ReadersServiceClient_Expecter) ReadSeries(ctx interface{}, in interface{}, opts ...interface{}) *ReadersServiceClient_ReadSeries_Call {
+	return &ReadersServiceClient_ReadSeries_Call{Call: _e.mock.On("ReadSeries",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *ReadersServiceClient_ReadSeries_Call) Run(run func(ctx context.Context, in *v1.ReadSeriesReq, opts ...grpc.CallOption)) *ReadersServiceClient_ReadSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1.ReadSeriesReq
+		if args[1] != nil {
+			arg1 = args[1].(*v1.ReadSeriesReq)
+		}
+		var arg2 []grpc.CallOption
+		var variadicArgs []grpc.CallOption
+		if len(args) > 2 {
+			variadicArgs = args[2].([]grpc.CallOption)
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ReadersServiceClient_ReadSeries_Call) Return(readSeriesRes *v1.ReadSeriesRes, err error) *ReadersServiceClient_ReadSeries_Call {
+	_c.Call.Return(readSeriesRes, err)
+	return _c
+}
+
+func (_c *ReadersServiceClient_ReadSeries_Call) RunAndReturn(run func(ctx context.Context, in *v1.ReadSeriesReq, opts ...grpc.CallOption) (*v1.ReadSeriesRes, error)) *ReadersServiceClient_ReadSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	pgclient "github.com/absmach/magistrala/pkg/postgres"
+	"github.com/absmach/magistrala/readers"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+)
+
+const exportBatchSize = 1000
+
+func (tr postgresRepository) ExportAll(ctx context.Context, chanID string, rpm readers.PageMetadata, fn func(readers.Message) error) error {
+	order := "time"
+	format := defTable
+	if rpm.Format != "" && rpm.Format != defTable {
+		order = "created"
+		format = rpm.Format
+	}
+	cond := fmtCondition(chanID, rpm)
+
+	q := fmt.Sprintf(`SELECT * FROM %s WHERE %s ORDER BY %s ASC`, format, cond, order)
+
+	return pgclient.QueryCursor(ctx, tr.db, q, fmtParams(chanID, rpm), exportBatchSize, handleExportError, func(rows *sqlx.Rows) error {
+		msg, err := scanMessage(rows, format)
+		if err != nil {
+			return errors.Wrap(readers.ErrReadMessages, err)
+		}
+		return fn(msg)
+	})
+}
+
+// handleExportError ends the export without messages if the format table
+// doesn't exist yet.
+func handleExportError(err error) error {
+	if preErr, ok := err.(*pgconn.PrepareError); ok {
+		err = preErr.Unwrap()
+	}
+	if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == pgerrcode.UndefinedTable {
+		return nil
+	}
+
+	return errors.Wrap(readers.ErrReadMessages, err)
+}
+
+func scanMessage(rows *sqlx.Rows, format string) (readers.Message, error) {
+	switch format {
+	case defTable:
+		m := senmlMessage{}
+		if err := rows.StructScan(&m); err != nil {
+			return nil, err
+		}
+		return m.Message, nil
+	default:
+		m := jsonMessage{}
+		if err := rows.StructScan(&m); err != nil {
+			return nil, err
+		}
+		return m.toMap()
+	}
+}
@@ -72,6 +72,17 @@ func migrateDB(db *sqlx.DB) error {
 					"DROP TABLE messages",
 				},
 			},
+			{
+				Id: "messages_2",
+				Up: []string{
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS domain VARCHAR(254) NOT NULL DEFAULT ''`,
+					`ALTER TABLE messages ADD COLUMN IF NOT EXISTS client_id VARCHAR(254) NOT NULL DEFAULT ''`,
+				},
+				Down: []string{
+					`ALTER TABLE messages DROP COLUMN IF EXISTS client_id`,
+					`ALTER TABLE messages DROP COLUMN IF EXISTS domain`,
+				},
+			},
 		},
 	}
 
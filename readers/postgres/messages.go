@@ -6,6 +6,9 @@ package postgres
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
@@ -15,6 +18,15 @@ import (
 	"github.com/jmoiron/sqlx"
 )
 
+// timeDivisor converts SenML time in nanoseconds to seconds.
+const timeDivisor = 1000000000
+
+var (
+	errInvalidAggregation = errors.New("invalid aggregation")
+
+	aggregations = []string{"MAX", "MIN", "AVG", "SUM", "COUNT"}
+)
+
 var _ readers.MessageRepository = (*postgresRepository)(nil)
 
 type postgresRepository struct {
@@ -37,26 +49,57 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 		format = rpm.Format
 	}
 	cond := fmtCondition(chanID, rpm)
+	isAggregated := format == defTable && rpm.Aggregation != "" && rpm.Interval != ""
+
+	params := fmtParams(chanID, rpm)
+
+	// Messages are ordered by time and ID descending, so the next page starts
+	// right after the last message of the previous one, even if the following
+	// messages share its time.
+	pageCond := cond
+	if rpm.Cursor != "" && !isAggregated {
+		t, id, err := parseCursor(rpm.Cursor, format)
+		if err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		pageCond = fmt.Sprintf(`%s AND (%s, id) < (:cursor_time, :cursor_id)`, cond, order)
+		params["cursor_time"] = t
+		params["cursor_id"] = id
+	}
 
 	q := fmt.Sprintf(`SELECT * FROM %s
-    WHERE %s ORDER BY %s DESC
-	LIMIT :limit OFFSET :offset;`, format, cond, order)
+    WHERE %s ORDER BY %s DESC, id DESC
+	LIMIT :limit OFFSET :offset;`, format, pageCond, order)
+	totalQuery := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, format, cond)
 
-	params := map[string]any{
-		"channel":      chanID,
-		"limit":        rpm.Limit,
-		"offset":       rpm.Offset,
-		"subtopic":     rpm.Subtopic,
-		"publisher":    rpm.Publisher,
-		"name":         rpm.Name,
-		"protocol":     rpm.Protocol,
-		"value":        rpm.Value,
-		"bool_value":   rpm.BoolValue,
-		"string_value": rpm.StringValue,
-		"data_value":   rpm.DataValue,
-		"from":         rpm.From,
-		"to":           rpm.To,
+	if isAggregated {
+		agg := strings.ToUpper(rpm.Aggregation)
+		if !slices.Contains(aggregations, agg) {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, errInvalidAggregation)
+		}
+		// SenML time is stored in nanoseconds, so it's converted to timestamp
+		// to be binned in the interval long buckets starting at Unix epoch.
+		bucket := fmt.Sprintf(`date_bin(CAST(:interval AS INTERVAL), to_timestamp(time / %d), TIMESTAMPTZ 'epoch')`, timeDivisor)
+		q = fmt.Sprintf(`
+			SELECT
+				CAST(EXTRACT(epoch FROM %s) * %d AS DOUBLE PRECISION) AS time,
+				%s(value) AS value,
+				(ARRAY_AGG(publisher ORDER BY time))[1] AS publisher,
+				(ARRAY_AGG(protocol ORDER BY time))[1] AS protocol,
+				(ARRAY_AGG(subtopic ORDER BY time))[1] AS subtopic,
+				(ARRAY_AGG(name ORDER BY time))[1] AS name,
+				(ARRAY_AGG(unit ORDER BY time))[1] AS unit
+			FROM
+				%s
+			WHERE
+				%s
+			GROUP BY 1
+			ORDER BY time DESC
+			LIMIT :limit OFFSET :offset;
+			`, bucket, timeDivisor, agg, format, cond)
+		totalQuery = fmt.Sprintf(`SELECT COUNT(DISTINCT %s) FROM %s WHERE %s;`, bucket, format, cond)
 	}
+
 	rows, err := tr.db.NamedQuery(q, params)
 	if err != nil {
 		if preErr, ok := err.(*pgconn.PrepareError); ok {
@@ -75,6 +118,7 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 		PageMetadata: rpm,
 		Messages:     []readers.Message{},
 	}
+	var last []string
 	switch format {
 	case defTable:
 		for rows.Next() {
@@ -84,6 +128,7 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 			}
 
 			page.Messages = append(page.Messages, msg.Message)
+			last = []string{strconv.FormatFloat(msg.Time, 'g', -1, 64), msg.ID}
 		}
 	default:
 		for rows.Next() {
@@ -96,11 +141,14 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
 			page.Messages = append(page.Messages, m)
+			last = []string{strconv.FormatInt(msg.Created, 10), msg.ID}
 		}
 	}
+	if !isAggregated && rpm.Limit > 0 && uint64(len(page.Messages)) == rpm.Limit {
+		page.NextCursor = readers.EncodeCursor(last...)
+	}
 
-	q = fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, format, cond)
-	rows, err = tr.db.NamedQuery(q, params)
+	rows, err = tr.db.NamedQuery(totalQuery, params)
 	if err != nil {
 		return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 	}
@@ -117,6 +165,53 @@ func (tr postgresRepository) ReadAll(chanID string, rpm readers.PageMetadata) (r
 	return page, nil
 }
 
+func fmtParams(chanID string, rpm readers.PageMetadata) map[string]any {
+	return map[string]any{
+		"channel":      chanID,
+		"domain":       rpm.Domain,
+		"client_id":    rpm.ClientID,
+		"limit":        rpm.Limit,
+		"offset":       rpm.Offset,
+		"subtopic":     rpm.Subtopic,
+		"publisher":    rpm.Publisher,
+		"name":         rpm.Name,
+		"protocol":     rpm.Protocol,
+		"value":        rpm.Value,
+		"bool_value":   rpm.BoolValue,
+		"string_value": rpm.StringValue,
+		"data_value":   rpm.DataValue,
+		"from":         rpm.From,
+		"to":           rpm.To,
+		"interval":     rpm.Interval,
+	}
+}
+
+// parseCursor returns the time and the ID of the message the page cursor
+// points to. SenML time is a float, and JSON messages creation time is
+// an integer.
+func parseCursor(cursor, format string) (any, string, error) {
+	keys, err := readers.DecodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(keys) != 2 {
+		return nil, "", readers.ErrInvalidCursor
+	}
+	if format == defTable {
+		t, err := strconv.ParseFloat(keys[0], 64)
+		if err != nil {
+			return nil, "", readers.ErrInvalidCursor
+		}
+		return t, keys[1], nil
+	}
+	t, err := strconv.ParseInt(keys[0], 10, 64)
+	if err != nil {
+		return nil, "", readers.ErrInvalidCursor
+	}
+
+	return t, keys[1], nil
+}
+
 func fmtCondition(chanID string, rpm readers.PageMetadata) string {
 	condition := `channel = :channel`
 
@@ -135,7 +230,9 @@ func fmtCondition(chanID string, rpm readers.PageMetadata) string {
 			"subtopic",
 			"publisher",
 			"name",
-			"protocol":
+			"protocol",
+			"domain",
+			"client_id":
 			condition = fmt.Sprintf(`%s AND %s = :%s`, condition, name, name)
 		case "v":
 			comparator := readers.ParseValueComparator(query)
@@ -176,6 +273,8 @@ type senmlMessage struct {
 type jsonMessage struct {
 	ID        string `db:"id"`
 	Channel   string `db:"channel"`
+	Domain    string `db:"domain"`
+	ClientID  string `db:"client_id"`
 	Created   int64  `db:"created"`
 	Subtopic  string `db:"subtopic"`
 	Publisher string `db:"publisher"`
@@ -187,6 +286,8 @@ func (msg jsonMessage) toMap() (map[string]any, error) {
 	ret := map[string]any{
 		"id":        msg.ID,
 		"channel":   msg.Channel,
+		"domain":    msg.Domain,
+		"client_id": msg.ClientID,
 		"created":   msg.Created,
 		"subtopic":  msg.Subtopic,
 		"publisher": msg.Publisher,
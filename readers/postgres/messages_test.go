@@ -11,6 +11,7 @@ import (
 
 	pwriter "github.com/absmach/magistrala/consumers/writers/postgres"
 	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/transformers/json"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/absmach/magistrala/readers"
@@ -47,9 +48,14 @@ func TestReadSenml(t *testing.T) {
 	pubID := testsutil.GenerateUUID(t)
 	pubID2 := testsutil.GenerateUUID(t)
 	wrongID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	clientID := testsutil.GenerateUUID(t)
+	clientID2 := testsutil.GenerateUUID(t)
 
 	m := senml.Message{
 		Channel:   chanID,
+		Domain:    domainID,
+		ClientID:  clientID,
 		Publisher: pubID,
 		Protocol:  mqttProt,
 	}
@@ -86,6 +92,7 @@ func TestReadSenml(t *testing.T) {
 			msg.Subtopic = subtopic
 			msg.Protocol = httpProt
 			msg.Publisher = pubID2
+			msg.ClientID = clientID2
 			msg.Name = msgName
 			queryMsgs = append(queryMsgs, msg)
 		}
@@ -180,6 +187,44 @@ func TestReadSenml(t *testing.T) {
 				Messages: fromSenml(queryMsgs),
 			},
 		},
+		{
+			desc:   "read message with client",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset:   0,
+				Limit:    uint64(len(queryMsgs)),
+				ClientID: clientID2,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(queryMsgs)),
+				Messages: fromSenml(queryMsgs),
+			},
+		},
+		{
+			desc:   "read message with domain",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset: 0,
+				Limit:  msgsNum,
+				Domain: domainID,
+			},
+			page: readers.MessagesPage{
+				Total:    msgsNum,
+				Messages: fromSenml(messages),
+			},
+		},
+		{
+			desc:   "read message with wrong domain",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset: 0,
+				Limit:  msgsNum,
+				Domain: wrongID,
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
 		{
 			desc:   "read message with wrong format",
 			chanID: chanID,
@@ -518,6 +563,236 @@ func TestReadSenml(t *testing.T) {
 	}
 }
 
+func TestReadSenmlWithCursor(t *testing.T) {
+	writer := pwriter.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+
+	// Records of the same SenML pack share the time, so the page
+	// boundaries fall between the messages with the same time.
+	const packs, records = 5, 3
+	now := float64(time.Now().UnixNano())
+	step := float64(time.Microsecond.Nanoseconds())
+	messages := []senml.Message{}
+	for i := 0; i < packs; i++ {
+		for j := 0; j < records; j++ {
+			messages = append(messages, senml.Message{
+				Channel:   chanID,
+				Publisher: pubID,
+				Protocol:  mqttProt,
+				Name:      fmt.Sprintf("%s-%d", msgName, j),
+				Time:      now - float64(i)*step,
+				Value:     &v,
+			})
+		}
+	}
+
+	err := writer.ConsumeBlocking(context.TODO(), messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	reader := preader.New(db)
+
+	cases := []struct {
+		desc  string
+		limit uint64
+		pages int
+	}{
+		{
+			desc:  "read messages by pages smaller than the pack",
+			limit: 2,
+			pages: 8,
+		},
+		{
+			desc:  "read messages by pages larger than the pack",
+			limit: 4,
+			pages: 4,
+		},
+		{
+			desc:  "read messages by pages of all the messages",
+			limit: packs * records,
+			pages: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var res []readers.Message
+			pm := readers.PageMetadata{Limit: tc.limit}
+			pages := 0
+			for {
+				page, err := reader.ReadAll(chanID, pm)
+				require.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+				assert.Equal(t, uint64(len(messages)), page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, len(messages), page.Total))
+				res = append(res, page.Messages...)
+				pages++
+				if page.NextCursor == "" {
+					break
+				}
+				pm.Cursor = page.NextCursor
+			}
+			assert.Equal(t, tc.pages, pages, fmt.Sprintf("%s: expected %d pages got %d", tc.desc, tc.pages, pages))
+			assert.ElementsMatch(t, fromSenml(messages), res, fmt.Sprintf("%s: got incorrect list of senml Messages from ReadAll()", tc.desc))
+		})
+	}
+
+	_, err = reader.ReadAll(chanID, readers.PageMetadata{Limit: limit, Cursor: "invalid"})
+	assert.True(t, errors.Contains(err, readers.ErrInvalidCursor), fmt.Sprintf("read with invalid cursor: expected error %s got %s", readers.ErrInvalidCursor, err))
+}
+
+func TestReadMessagesWithAggregation(t *testing.T) {
+	writer := pwriter.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+	messages := []senml.Message{}
+
+	// Messages are sent once per second, starting at the beginning of an hour
+	// and the value changes every 10 seconds, so each 10 seconds long bucket
+	// holds 10 messages with the same value.
+	start := float64(time.Now().Add(-time.Hour).Truncate(time.Hour).UnixNano())
+	second := float64(time.Second)
+	values := []float64{}
+	for i := 0; i < msgsNum; i++ {
+		if i%10 == 0 {
+			values = append(values, float64(len(values)+1)*10)
+		}
+		v := values[len(values)-1]
+		msg := senml.Message{
+			Channel:   chanID,
+			Publisher: pubID,
+			Time:      start + float64(i)*second,
+			Value:     &v,
+			Protocol:  mqttProt,
+		}
+		messages = append(messages, msg)
+	}
+
+	err := writer.ConsumeBlocking(context.TODO(), messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	reader := preader.New(db)
+
+	buckets := func(aggregate func(v float64) float64) []readers.Message {
+		ret := []readers.Message{}
+		for i, v := range values {
+			val := aggregate(v)
+			ret = append(ret, senml.Message{
+				Publisher: pubID,
+				Protocol:  mqttProt,
+				Time:      start + float64(i*10)*second,
+				Value:     &val,
+			})
+		}
+		return ret
+	}
+	same := func(v float64) float64 { return v }
+
+	cases := []struct {
+		desc     string
+		pageMeta readers.PageMetadata
+		page     readers.MessagesPage
+		err      error
+	}{
+		{
+			desc: "read messages with AVG aggregation",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "AVG",
+				Interval:    "10s",
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(values)),
+				Messages: buckets(same),
+			},
+		},
+		{
+			desc: "read messages with MAX aggregation",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "MAX",
+				Interval:    "10 seconds",
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(values)),
+				Messages: buckets(same),
+			},
+		},
+		{
+			desc: "read messages with MIN aggregation",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "min",
+				Interval:    "10s",
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(values)),
+				Messages: buckets(same),
+			},
+		},
+		{
+			desc: "read messages with SUM aggregation",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "SUM",
+				Interval:    "10s",
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(values)),
+				Messages: buckets(func(v float64) float64 { return v * 10 }),
+			},
+		},
+		{
+			desc: "read messages with COUNT aggregation",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "COUNT",
+				Interval:    "10s",
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(values)),
+				Messages: buckets(func(float64) float64 { return 10 }),
+			},
+		},
+		{
+			desc: "read messages with COUNT aggregation over a minute",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "COUNT",
+				Interval:    "1m",
+			},
+			page: readers.MessagesPage{
+				Total: 2,
+				Messages: []readers.Message{
+					senml.Message{Publisher: pubID, Protocol: mqttProt, Time: start, Value: &[]float64{60}[0]},
+					senml.Message{Publisher: pubID, Protocol: mqttProt, Time: start + 60*second, Value: &[]float64{40}[0]},
+				},
+			},
+		},
+		{
+			desc: "read messages with invalid aggregation",
+			pageMeta: readers.PageMetadata{
+				Limit:       limit,
+				Aggregation: "invalid",
+				Interval:    "10s",
+			},
+			err: readers.ErrReadMessages,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			result, err := reader.ReadAll(chanID, tc.pageMeta)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+			if tc.err != nil {
+				return
+			}
+			assert.ElementsMatch(t, tc.page.Messages, result.Messages, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.page.Messages, result.Messages))
+			assert.Equal(t, tc.page.Total, result.Total, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.page.Total, result.Total))
+		})
+	}
+}
+
 func TestReadJSON(t *testing.T) {
 	writer := pwriter.New(db)
 
@@ -0,0 +1,152 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/readers"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const defSeriesAggregation = "AVG"
+
+var errInvalidInterval = errors.New("invalid aggregation interval")
+
+func (tr postgresRepository) ReadSeries(chanIDs []string, sq readers.SeriesQuery) (readers.SeriesPage, error) {
+	page := readers.SeriesPage{
+		SeriesQuery: sq,
+		Series:      readers.AlignSeries(chanIDs, sq, nil),
+	}
+	if len(chanIDs) == 0 || len(sq.Names) == 0 {
+		return page, nil
+	}
+
+	cond := fmtSeriesCondition(sq)
+	params := map[string]any{
+		"channels":  chanIDs,
+		"names":     sq.Names,
+		"subtopic":  sq.Subtopic,
+		"publisher": sq.Publisher,
+		"protocol":  sq.Protocol,
+		"from":      sq.From,
+		"to":        sq.To,
+		"limit":     sq.Limit,
+	}
+
+	var q string
+	switch sq.Interval {
+	case "":
+		limit := ""
+		if sq.Limit > 0 {
+			limit = "WHERE row_num <= :limit"
+		}
+		q = fmt.Sprintf(`
+			SELECT channel, name, unit, time, value FROM (
+				SELECT channel, name, unit, time, value,
+					ROW_NUMBER() OVER (PARTITION BY channel, name ORDER BY time DESC) AS row_num
+				FROM %s
+				WHERE %s
+			) AS series
+			%s
+			ORDER BY channel, name, time;`, defTable, cond, limit)
+	default:
+		agg := strings.ToUpper(sq.Aggregation)
+		if agg == "" {
+			agg = defSeriesAggregation
+		}
+		if !slices.Contains(aggregations, agg) {
+			return readers.SeriesPage{}, errors.Wrap(readers.ErrReadMessages, errInvalidAggregation)
+		}
+		d, err := time.ParseDuration(sq.Interval)
+		if err != nil || d <= 0 {
+			return readers.SeriesPage{}, errors.Wrap(readers.ErrReadMessages, errInvalidInterval)
+		}
+		// SenML time is stored in nanoseconds, so the values are downsampled
+		// to the interval long buckets starting at Unix epoch.
+		params["interval"] = float64(d.Nanoseconds())
+		q = fmt.Sprintf(`
+			SELECT
+				channel,
+				name,
+				MAX(unit) AS unit,
+				FLOOR(time / :interval) * :interval AS time,
+				%s(value) AS value
+			FROM %s
+			WHERE %s
+			GROUP BY channel, name, 4
+			ORDER BY channel, name, time;`, agg, defTable, cond)
+	}
+
+	rows, err := tr.db.NamedQuery(q, params)
+	if err != nil {
+		if preErr, ok := err.(*pgconn.PrepareError); ok {
+			err = preErr.Unwrap()
+		}
+		if pgErr, ok := err.(*pgconn.PgError); ok {
+			if pgErr.Code == pgerrcode.UndefinedTable {
+				return page, nil
+			}
+		}
+		return readers.SeriesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	defer rows.Close()
+
+	var series []readers.Series
+	for rows.Next() {
+		var p seriesPoint
+		if err := rows.StructScan(&p); err != nil {
+			return readers.SeriesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		if n := len(series); n == 0 || series[n-1].Channel != p.Channel || series[n-1].Name != p.Name {
+			series = append(series, readers.Series{Channel: p.Channel, Name: p.Name, Unit: p.Unit})
+		}
+		s := &series[len(series)-1]
+		s.Points = append(s.Points, readers.Point{Time: p.Time, Value: p.Value})
+	}
+	if err := rows.Err(); err != nil {
+		return readers.SeriesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+	}
+	page.Series = readers.AlignSeries(chanIDs, sq, series)
+
+	return page, nil
+}
+
+func fmtSeriesCondition(sq readers.SeriesQuery) string {
+	conditions := []string{
+		"channel = ANY(:channels)",
+		"name = ANY(:names)",
+		"value IS NOT NULL",
+	}
+	if sq.Subtopic != "" {
+		conditions = append(conditions, "subtopic = :subtopic")
+	}
+	if sq.Publisher != "" {
+		conditions = append(conditions, "publisher = :publisher")
+	}
+	if sq.Protocol != "" {
+		conditions = append(conditions, "protocol = :protocol")
+	}
+	if sq.From != 0 {
+		conditions = append(conditions, "time >= :from")
+	}
+	if sq.To != 0 {
+		conditions = append(conditions, "time < :to")
+	}
+
+	return strings.Join(conditions, " AND ")
+}
+
+type seriesPoint struct {
+	Channel string   `db:"channel"`
+	Name    string   `db:"name"`
+	Unit    string   `db:"unit"`
+	Time    float64  `db:"time"`
+	Value   *float64 `db:"value"`
+}
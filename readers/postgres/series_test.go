@@ -0,0 +1,153 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pwriter "github.com/absmach/magistrala/consumers/writers/postgres"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/absmach/magistrala/readers"
+	preader "github.com/absmach/magistrala/readers/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSeries(t *testing.T) {
+	writer := pwriter.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	chanID2 := testsutil.GenerateUUID(t)
+	chanID3 := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+
+	// The first channel receives 30 and the second one 10 temperature
+	// messages, once per second, starting at the beginning of an hour.
+	start := float64(time.Now().Add(-time.Hour).Truncate(time.Hour).UnixNano())
+	second := float64(time.Second)
+	messages := []senml.Message{}
+	for i := 0; i < 30; i++ {
+		val := float64(i)
+		msg := senml.Message{
+			Channel:   chanID,
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Unit:      "C",
+			Time:      start + float64(i)*second,
+			Value:     &val,
+		}
+		messages = append(messages, msg)
+		if i < 10 {
+			msg.Channel = chanID2
+			messages = append(messages, msg)
+		}
+	}
+	humidity := senml.Message{
+		Channel:   chanID,
+		Publisher: pubID,
+		Protocol:  mqttProt,
+		Name:      "humidity",
+		Time:      start,
+		Value:     &v,
+	}
+	messages = append(messages, humidity)
+
+	err := writer.ConsumeBlocking(context.TODO(), messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	reader := preader.New(db)
+
+	points := func(from, to int) []readers.Point {
+		ret := []readers.Point{}
+		for i := from; i < to; i++ {
+			val := float64(i)
+			ret = append(ret, readers.Point{Time: start + float64(i)*second, Value: &val})
+		}
+		return ret
+	}
+	buckets := func(values ...*float64) []readers.Point {
+		ret := []readers.Point{}
+		for i, val := range values {
+			ret = append(ret, readers.Point{Time: start + float64(i*10)*second, Value: val})
+		}
+		return ret
+	}
+	value := func(v float64) *float64 { return &v }
+
+	cases := []struct {
+		desc    string
+		chanIDs []string
+		query   readers.SeriesQuery
+		series  []readers.Series
+		err     error
+	}{
+		{
+			desc:    "read latest raw values of channels",
+			chanIDs: []string{chanID, chanID2},
+			query:   readers.SeriesQuery{Names: []string{msgName}, Limit: 5},
+			series: []readers.Series{
+				{Channel: chanID, Name: msgName, Unit: "C", Points: points(25, 30)},
+				{Channel: chanID2, Name: msgName, Unit: "C", Points: points(5, 10)},
+			},
+		},
+		{
+			desc:    "read raw values of channels in time range",
+			chanIDs: []string{chanID, chanID2},
+			query:   readers.SeriesQuery{Names: []string{msgName}, From: start + 8*second, To: start + 12*second, Limit: limit},
+			series: []readers.Series{
+				{Channel: chanID, Name: msgName, Unit: "C", Points: points(8, 12)},
+				{Channel: chanID2, Name: msgName, Unit: "C", Points: points(8, 10)},
+			},
+		},
+		{
+			desc:    "read raw values of multiple measurements",
+			chanIDs: []string{chanID, chanID3},
+			query:   readers.SeriesQuery{Names: []string{msgName, "humidity"}, Limit: 1},
+			series: []readers.Series{
+				{Channel: chanID, Name: msgName, Unit: "C", Points: points(29, 30)},
+				{Channel: chanID, Name: "humidity", Points: []readers.Point{{Time: start, Value: &v}}},
+				{Channel: chanID3, Name: msgName, Points: []readers.Point{}},
+				{Channel: chanID3, Name: "humidity", Points: []readers.Point{}},
+			},
+		},
+		{
+			desc:    "read downsampled values of channels",
+			chanIDs: []string{chanID, chanID2},
+			query:   readers.SeriesQuery{Names: []string{msgName}, From: start, To: start + 40*second, Aggregation: "MAX", Interval: "10s"},
+			series: []readers.Series{
+				{Channel: chanID, Name: msgName, Unit: "C", Points: buckets(value(9), value(19), value(29), nil)},
+				{Channel: chanID2, Name: msgName, Unit: "C", Points: buckets(value(9), nil, nil, nil)},
+			},
+		},
+		{
+			desc:    "read downsampled values of channels with last observed value fill",
+			chanIDs: []string{chanID, chanID2},
+			query:   readers.SeriesQuery{Names: []string{msgName}, From: start, To: start + 40*second, Aggregation: "MIN", Interval: "10s", Fill: readers.FillLOCF},
+			series: []readers.Series{
+				{Channel: chanID, Name: msgName, Unit: "C", Points: buckets(value(0), value(10), value(20), value(20))},
+				{Channel: chanID2, Name: msgName, Unit: "C", Points: buckets(value(0), value(0), value(0), value(0))},
+			},
+		},
+		{
+			desc:    "read downsampled values with invalid aggregation",
+			chanIDs: []string{chanID},
+			query:   readers.SeriesQuery{Names: []string{msgName}, From: start, To: start + 40*second, Aggregation: "invalid", Interval: "10s"},
+			err:     readers.ErrReadMessages,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page, err := reader.ReadSeries(tc.chanIDs, tc.query)
+			assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected error %s, got %s", tc.desc, tc.err, err))
+			assert.Equal(t, tc.series, page.Series, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.series, page.Series))
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// FillNone leaves the buckets without values empty.
+	FillNone = "none"
+	// FillLOCF fills the empty buckets with the last observed value.
+	FillLOCF = "locf"
+	// FillLinear fills the empty buckets by the linear interpolation of
+	// the surrounding values.
+	FillLinear = "linear"
+)
+
+// SeriesQuery represents the parameters used to query time series of the
+// SenML values across channels and measurements.
+type SeriesQuery struct {
+	Names       []string `json:"names"`
+	Subtopic    string   `json:"subtopic,omitempty"`
+	Publisher   string   `json:"publisher,omitempty"`
+	Protocol    string   `json:"protocol,omitempty"`
+	From        float64  `json:"from,omitempty"`
+	To          float64  `json:"to,omitempty"`
+	Aggregation string   `json:"aggregation,omitempty"`
+	Interval    string   `json:"interval,omitempty"`
+	Fill        string   `json:"fill,omitempty"`
+	Limit       uint64   `json:"limit,omitempty"`
+}
+
+// Point represents a single value of the time series. Value is nil for
+// the buckets without the values, unless the gaps are filled.
+type Point struct {
+	Time  float64  `json:"time"`
+	Value *float64 `json:"value"`
+}
+
+// Series represents the values of a measurement sent to a channel.
+type Series struct {
+	Channel string  `json:"channel"`
+	Name    string  `json:"name"`
+	Unit    string  `json:"unit,omitempty"`
+	Points  []Point `json:"points"`
+}
+
+// SeriesPage contains the query used to read the time series as well as
+// the list of series, one per each queried channel and measurement.
+type SeriesPage struct {
+	SeriesQuery
+	Series []Series
+}
+
+// AlignSeries returns a series for each of the given channels and queried
+// measurements, in that order. If the query has the interval, the points of
+// all the series are aligned to the same interval long buckets between
+// the query from and to time, and the empty buckets are filled using
+// the query fill method. The series points must be sorted by time and
+// aggregated in the buckets starting at Unix epoch.
+func AlignSeries(chanIDs []string, sq SeriesQuery, series []Series) []Series {
+	type key struct{ channel, name string }
+	found := make(map[key]Series, len(series))
+	for _, s := range series {
+		found[key{s.Channel, s.Name}] = s
+	}
+
+	var step, start float64
+	var buckets int
+	if d, err := time.ParseDuration(sq.Interval); err == nil && d > 0 && sq.To > sq.From {
+		step = float64(d.Nanoseconds())
+		start = math.Floor(sq.From/step) * step
+		buckets = int(math.Ceil((sq.To - start) / step))
+	}
+
+	ret := make([]Series, 0, len(chanIDs)*len(sq.Names))
+	for _, ch := range chanIDs {
+		for _, name := range sq.Names {
+			s, ok := found[key{ch, name}]
+			if !ok {
+				s = Series{Channel: ch, Name: name}
+			}
+			if buckets > 0 {
+				s.Points = alignPoints(s.Points, start, step, buckets, sq.Fill)
+			}
+			if s.Points == nil {
+				s.Points = []Point{}
+			}
+			ret = append(ret, s)
+		}
+	}
+
+	return ret
+}
+
+func alignPoints(points []Point, start, step float64, buckets int, fill string) []Point {
+	aligned := make([]Point, buckets)
+	for i := range aligned {
+		aligned[i].Time = start + float64(i)*step
+	}
+	for _, p := range points {
+		i := int(math.Round((p.Time - start) / step))
+		if i < 0 || i >= buckets || p.Value == nil {
+			continue
+		}
+		v := *p.Value
+		aligned[i].Value = &v
+	}
+
+	switch fill {
+	case FillLOCF:
+		var last *float64
+		for i := range aligned {
+			switch {
+			case aligned[i].Value != nil:
+				last = aligned[i].Value
+			case last != nil:
+				v := *last
+				aligned[i].Value = &v
+			}
+		}
+	case FillLinear:
+		prev := -1
+		for i := range aligned {
+			if aligned[i].Value == nil {
+				continue
+			}
+			if prev >= 0 && i-prev > 1 {
+				from, to := *aligned[prev].Value, *aligned[i].Value
+				for j := prev + 1; j < i; j++ {
+					v := from + (to-from)*float64(j-prev)/float64(i-prev)
+					aligned[j].Value = &v
+				}
+			}
+			prev = i
+		}
+	}
+
+	return aligned
+}
@@ -0,0 +1,129 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package readers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/absmach/magistrala/readers"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	chanID  = "chanID"
+	chanID2 = "chanID2"
+	name    = "temperature"
+	name2   = "humidity"
+)
+
+var step = float64(time.Minute.Nanoseconds())
+
+func value(v float64) *float64 {
+	return &v
+}
+
+func TestAlignSeries(t *testing.T) {
+	from := 1000 * step
+	to := from + 4*step
+
+	cases := []struct {
+		desc    string
+		chanIDs []string
+		query   readers.SeriesQuery
+		series  []readers.Series
+		res     []readers.Series
+	}{
+		{
+			desc:    "return raw series in order of channels and names",
+			chanIDs: []string{chanID, chanID2},
+			query:   readers.SeriesQuery{Names: []string{name, name2}},
+			series: []readers.Series{
+				{Channel: chanID2, Name: name, Points: []readers.Point{{Time: from, Value: value(1)}}},
+				{Channel: chanID, Name: name2, Unit: "%", Points: []readers.Point{{Time: from + 1, Value: value(2)}}},
+			},
+			res: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{}},
+				{Channel: chanID, Name: name2, Unit: "%", Points: []readers.Point{{Time: from + 1, Value: value(2)}}},
+				{Channel: chanID2, Name: name, Points: []readers.Point{{Time: from, Value: value(1)}}},
+				{Channel: chanID2, Name: name2, Points: []readers.Point{}},
+			},
+		},
+		{
+			desc:    "align series without fill",
+			chanIDs: []string{chanID},
+			query:   readers.SeriesQuery{Names: []string{name}, From: from + 10, To: to, Interval: "1m"},
+			series: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{{Time: from + step, Value: value(1)}, {Time: from + 3*step, Value: value(3)}}},
+			},
+			res: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{
+					{Time: from},
+					{Time: from + step, Value: value(1)},
+					{Time: from + 2*step},
+					{Time: from + 3*step, Value: value(3)},
+				}},
+			},
+		},
+		{
+			desc:    "align series with last observed value fill",
+			chanIDs: []string{chanID},
+			query:   readers.SeriesQuery{Names: []string{name}, From: from, To: to, Interval: "1m", Fill: readers.FillLOCF},
+			series: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{{Time: from + step, Value: value(1)}}},
+			},
+			res: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{
+					{Time: from},
+					{Time: from + step, Value: value(1)},
+					{Time: from + 2*step, Value: value(1)},
+					{Time: from + 3*step, Value: value(1)},
+				}},
+			},
+		},
+		{
+			desc:    "align series with linear fill",
+			chanIDs: []string{chanID},
+			query:   readers.SeriesQuery{Names: []string{name}, From: from, To: to, Interval: "1m", Fill: readers.FillLinear},
+			series: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{{Time: from, Value: value(1)}, {Time: from + 3*step, Value: value(4)}}},
+			},
+			res: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{
+					{Time: from, Value: value(1)},
+					{Time: from + step, Value: value(2)},
+					{Time: from + 2*step, Value: value(3)},
+					{Time: from + 3*step, Value: value(4)},
+				}},
+			},
+		},
+		{
+			desc:    "align missing series",
+			chanIDs: []string{chanID},
+			query:   readers.SeriesQuery{Names: []string{name}, From: from, To: from + 2*step, Interval: "1m", Fill: readers.FillLinear},
+			res: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{{Time: from}, {Time: from + step}}},
+			},
+		},
+		{
+			desc:    "align series dropping points out of time range",
+			chanIDs: []string{chanID},
+			query:   readers.SeriesQuery{Names: []string{name}, From: from, To: from + 2*step, Interval: "1m"},
+			series: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{{Time: from - step, Value: value(1)}, {Time: from + 2*step, Value: value(2)}}},
+			},
+			res: []readers.Series{
+				{Channel: chanID, Name: name, Points: []readers.Point{{Time: from}, {Time: from + step}}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			res := readers.AlignSeries(tc.chanIDs, tc.query, tc.series)
+			assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, res))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Abstract Machines
+// SPDX-License-Identifier: Apache-2.0
+
+package timescale_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	twriter "github.com/absmach/magistrala/consumers/writers/timescale"
+	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
+	"github.com/absmach/magistrala/pkg/transformers/senml"
+	"github.com/absmach/magistrala/readers"
+	treader "github.com/absmach/magistrala/readers/timescale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The cursor batches are tested with the cursor, so the export is read in a single fetch.
+const exportMsgsNum = 100
+
+func TestExportAll(t *testing.T) {
+	writer := twriter.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+	wrongID := testsutil.GenerateUUID(t)
+
+	messages := []senml.Message{}
+	now := float64(time.Now().Unix())
+	for i := 0; i < exportMsgsNum; i++ {
+		messages = append(messages, senml.Message{
+			Channel:   chanID,
+			Publisher: pubID,
+			Protocol:  mqttProt,
+			Name:      msgName,
+			Time:      now - float64(i),
+			Value:     &v,
+		})
+	}
+
+	err := writer.ConsumeBlocking(context.TODO(), messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	// Messages are exported oldest first.
+	exported := slices.Clone(messages)
+	slices.Reverse(exported)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	reader := treader.New(db)
+
+	cases := []struct {
+		desc     string
+		ctx      context.Context
+		chanID   string
+		pageMeta readers.PageMetadata
+		res      []readers.Message
+		err      error
+	}{
+		{
+			desc:   "export all messages for existing channel",
+			chanID: chanID,
+			res:    fromSenml(exported),
+		},
+		{
+			desc:   "export messages ignoring offset and limit",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset: 10,
+				Limit:  limit,
+			},
+			res: fromSenml(exported),
+		},
+		{
+			desc:   "export messages with from/to",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				From: messages[20].Time,
+				To:   messages[10].Time,
+			},
+			res: fromSenml(exported[exportMsgsNum-21 : exportMsgsNum-11]),
+		},
+		{
+			desc:   "export messages with non-existent publisher",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Publisher: wrongID,
+			},
+		},
+		{
+			desc:   "export messages for non-existent channel",
+			chanID: wrongID,
+		},
+		{
+			desc:   "export messages with non-existent format",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Format: "nonexistent",
+			},
+		},
+		{
+			desc:   "export messages with canceled context",
+			ctx:    canceled,
+			chanID: chanID,
+			err:    readers.ErrReadMessages,
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.ctx == nil {
+			tc.ctx = context.Background()
+		}
+		var res []readers.Message
+		err := reader.ExportAll(tc.ctx, tc.chanID, tc.pageMeta, func(msg readers.Message) error {
+			res = append(res, msg)
+			return nil
+		})
+		assert.True(t, errors.Contains(err, tc.err), fmt.Sprintf("%s: expected error %s got %s", tc.desc, tc.err, err))
+		assert.Equal(t, tc.res, res, fmt.Sprintf("%s: got incorrect list of exported messages", tc.desc))
+	}
+}
@@ -6,6 +6,7 @@ package timescale
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	api "github.com/absmach/magistrala/api/http"
@@ -77,6 +78,19 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 	var q string
 	totalQuery := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE %s;`, format, where)
 
+	params := fmtParams(chanID, rpm)
+
+	// The next page starts right after the last message of the previous one,
+	// in the order of the message time and the rest of the primary key.
+	pageWhere := where
+	if rpm.Cursor != "" && !isAggregated {
+		cond, err := cursorCondition(rpm, isSenml, params)
+		if err != nil {
+			return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
+		}
+		pageWhere = fmt.Sprintf(`%s AND %s`, where, cond)
+	}
+
 	if isAggregated {
 		q = fmt.Sprintf(`
 			SELECT
@@ -99,23 +113,13 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 
 		totalQuery = fmt.Sprintf(`SELECT COUNT(*) FROM (SELECT EXTRACT(epoch FROM time_bucket('%s', to_timestamp(time/%d))) AS time, %s(value) AS value FROM %s WHERE %s GROUP BY 1) AS subquery;`, rpm.Interval, timeDivisor, rpm.Aggregation, format, where)
 	} else {
-		q = fmt.Sprintf(`SELECT * FROM %s WHERE %s %s %s;`, format, where, orderClause, pgData)
-	}
-
-	params := map[string]any{
-		"channel":      chanID,
-		"limit":        rpm.Limit,
-		"offset":       rpm.Offset,
-		"subtopic":     rpm.Subtopic,
-		"publisher":    rpm.Publisher,
-		"name":         rpm.Name,
-		"protocol":     rpm.Protocol,
-		"value":        rpm.Value,
-		"bool_value":   rpm.BoolValue,
-		"string_value": rpm.StringValue,
-		"data_value":   rpm.DataValue,
-		"from":         rpm.From,
-		"to":           rpm.To,
+		// SenML time is scanned as a float, so the exact time is selected
+		// for the page cursor.
+		cols := "*"
+		if isSenml {
+			cols = "*, time AS cursor_time"
+		}
+		q = fmt.Sprintf(`SELECT %s FROM %s WHERE %s %s %s;`, cols, format, pageWhere, orderClause, pgData)
 	}
 
 	rows, err := tr.db.NamedQuery(q, params)
@@ -137,6 +141,7 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 		Messages:     []readers.Message{},
 	}
 
+	var last []string
 	switch format {
 	case defTable:
 		for rows.Next() {
@@ -146,6 +151,7 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 			}
 
 			page.Messages = append(page.Messages, msg.Message)
+			last = []string{strconv.FormatInt(msg.CursorTime, 10), msg.Subtopic, msg.Protocol, msg.Publisher, msg.Name}
 		}
 	default:
 		for rows.Next() {
@@ -158,8 +164,12 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 				return readers.MessagesPage{}, errors.Wrap(readers.ErrReadMessages, err)
 			}
 			page.Messages = append(page.Messages, m)
+			last = []string{strconv.FormatInt(msg.Created, 10), msg.Publisher, msg.Subtopic}
 		}
 	}
+	if !isAggregated && rpm.Limit > 0 && uint64(len(page.Messages)) == rpm.Limit {
+		page.NextCursor = readers.EncodeCursor(last...)
+	}
 
 	rows, err = tr.db.NamedQuery(totalQuery, params)
 	if err != nil {
@@ -178,6 +188,68 @@ func (tr timescaleRepository) ReadAll(chanID string, rpm readers.PageMetadata) (
 	return page, nil
 }
 
+func fmtParams(chanID string, rpm readers.PageMetadata) map[string]any {
+	return map[string]any{
+		"channel":      chanID,
+		"domain":       rpm.Domain,
+		"client_id":    rpm.ClientID,
+		"limit":        rpm.Limit,
+		"offset":       rpm.Offset,
+		"subtopic":     rpm.Subtopic,
+		"publisher":    rpm.Publisher,
+		"name":         rpm.Name,
+		"protocol":     rpm.Protocol,
+		"value":        rpm.Value,
+		"bool_value":   rpm.BoolValue,
+		"string_value": rpm.StringValue,
+		"data_value":   rpm.DataValue,
+		"from":         rpm.From,
+		"to":           rpm.To,
+	}
+}
+
+// cursorColumns returns the primary key columns the messages are paged by.
+// The channel is left out since the messages are always read by the channel.
+func cursorColumns(isSenml bool) []string {
+	if isSenml {
+		return []string{orderByTime, "subtopic", "protocol", "publisher", "name"}
+	}
+
+	return []string{orderByCreated, "publisher", "subtopic"}
+}
+
+// cursorCondition returns the condition of the messages after the one the page
+// cursor points to, and adds the cursor values to the query parameters.
+func cursorCondition(pm readers.PageMetadata, isSenml bool, params map[string]any) (string, error) {
+	keys, err := readers.DecodeCursor(pm.Cursor)
+	if err != nil {
+		return "", err
+	}
+	cols := cursorColumns(isSenml)
+	if len(keys) != len(cols) {
+		return "", readers.ErrInvalidCursor
+	}
+	t, err := strconv.ParseInt(keys[0], 10, 64)
+	if err != nil {
+		return "", readers.ErrInvalidCursor
+	}
+
+	names := make([]string, len(cols))
+	for i, key := range keys {
+		name := fmt.Sprintf("cursor_%d", i)
+		names[i] = ":" + name
+		params[name] = key
+	}
+	params["cursor_0"] = t
+
+	op := "<"
+	if pm.Dir == api.AscDir {
+		op = ">"
+	}
+
+	return fmt.Sprintf(" (%s) %s (%s) ", strings.Join(cols, ", "), op, strings.Join(names, ", ")), nil
+}
+
 func fmtCondition(rpm readers.PageMetadata) string {
 	// Indexed columns conditions based on indices order.
 	chCondition := " channel = :channel "
@@ -193,6 +265,10 @@ func fmtCondition(rpm readers.PageMetadata) string {
 
 	conditions := []string{chCondition}
 
+	if _, ok := query["domain"]; ok {
+		conditions = append(conditions, " domain = :domain ")
+	}
+
 	if _, ok := query["subtopic"]; ok {
 		conditions = append(conditions, " subtopic = :subtopic ")
 	}
@@ -218,6 +294,10 @@ func fmtCondition(rpm readers.PageMetadata) string {
 		conditions = append(conditions, " protocol = :protocol ")
 	}
 
+	if _, ok := query["client_id"]; ok {
+		conditions = append(conditions, " client_id = :client_id ")
+	}
+
 	for name := range query {
 		switch name {
 		case "v":
@@ -249,12 +329,15 @@ func fmtCondition(rpm readers.PageMetadata) string {
 }
 
 type senmlMessage struct {
-	ID string `db:"id"`
+	ID         string `db:"id"`
+	CursorTime int64  `db:"cursor_time"`
 	senml.Message
 }
 
 type jsonMessage struct {
 	Channel   string `db:"channel"`
+	Domain    string `db:"domain"`
+	ClientID  string `db:"client_id"`
 	Created   int64  `db:"created"`
 	Subtopic  string `db:"subtopic"`
 	Publisher string `db:"publisher"`
@@ -265,6 +348,8 @@ type jsonMessage struct {
 func (msg jsonMessage) toMap() (map[string]any, error) {
 	ret := map[string]any{
 		"channel":   msg.Channel,
+		"domain":    msg.Domain,
+		"client_id": msg.ClientID,
 		"created":   msg.Created,
 		"subtopic":  msg.Subtopic,
 		"publisher": msg.Publisher,
@@ -311,13 +396,15 @@ func applyOrdering(pm readers.PageMetadata, isAggregated bool, isSenml bool) str
 		"name":         true,
 		"protocol":     true,
 		"channel":      true,
+		"domain":       true,
+		"client_id":    true,
 		"subtopic":     true,
 		"unit":         true,
 	}
 
 	jsonCols := map[string]bool{
 		orderByCreated: true, "publisher": true, "protocol": true,
-		"channel": true, "subtopic": true,
+		"channel": true, "subtopic": true, "domain": true, "client_id": true,
 	}
 
 	if isAggregated {
@@ -345,8 +432,14 @@ func applyOrdering(pm readers.PageMetadata, isAggregated bool, isSenml bool) str
 
 	secondary := fmt.Sprintf("%s DESC", timeCol)
 
+	// Messages with the same time are ordered by the rest of the primary
+	// key, so the pages read with the cursor don't skip any of them.
 	if col == timeCol {
-		return fmt.Sprintf("ORDER BY %s %s", col, dir)
+		cols := cursorColumns(isSenml)
+		for i, c := range cols {
+			cols[i] = fmt.Sprintf("%s %s", c, dir)
+		}
+		return "ORDER BY " + strings.Join(cols, ", ")
 	}
 	return fmt.Sprintf("ORDER BY %s %s, %s", col, dir, secondary)
 }
@@ -11,6 +11,7 @@ import (
 
 	twriter "github.com/absmach/magistrala/consumers/writers/timescale"
 	"github.com/absmach/magistrala/internal/testsutil"
+	"github.com/absmach/magistrala/pkg/errors"
 	"github.com/absmach/magistrala/pkg/transformers/json"
 	"github.com/absmach/magistrala/pkg/transformers/senml"
 	"github.com/absmach/magistrala/readers"
@@ -47,9 +48,14 @@ func TestReadSenml(t *testing.T) {
 	pubID := testsutil.GenerateUUID(t)
 	pubID2 := testsutil.GenerateUUID(t)
 	wrongID := testsutil.GenerateUUID(t)
+	domainID := testsutil.GenerateUUID(t)
+	clientID := testsutil.GenerateUUID(t)
+	clientID2 := testsutil.GenerateUUID(t)
 
 	m := senml.Message{
 		Channel:   chanID,
+		Domain:    domainID,
+		ClientID:  clientID,
 		Publisher: pubID,
 		Protocol:  mqttProt,
 	}
@@ -86,6 +92,7 @@ func TestReadSenml(t *testing.T) {
 			msg.Subtopic = subtopic
 			msg.Protocol = httpProt
 			msg.Publisher = pubID2
+			msg.ClientID = clientID2
 			msg.Name = msgName
 			queryMsgs = append(queryMsgs, msg)
 		}
@@ -180,6 +187,44 @@ func TestReadSenml(t *testing.T) {
 				Messages: fromSenml(queryMsgs),
 			},
 		},
+		{
+			desc:   "read message with client",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset:   0,
+				Limit:    uint64(len(queryMsgs)),
+				ClientID: clientID2,
+			},
+			page: readers.MessagesPage{
+				Total:    uint64(len(queryMsgs)),
+				Messages: fromSenml(queryMsgs),
+			},
+		},
+		{
+			desc:   "read message with domain",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset: 0,
+				Limit:  msgsNum,
+				Domain: domainID,
+			},
+			page: readers.MessagesPage{
+				Total:    msgsNum,
+				Messages: fromSenml(messages),
+			},
+		},
+		{
+			desc:   "read message with wrong domain",
+			chanID: chanID,
+			pageMeta: readers.PageMetadata{
+				Offset: 0,
+				Limit:  msgsNum,
+				Domain: wrongID,
+			},
+			page: readers.MessagesPage{
+				Messages: []readers.Message{},
+			},
+		},
 		{
 			desc:   "read message with wrong format",
 			chanID: chanID,
@@ -518,6 +563,83 @@ func TestReadSenml(t *testing.T) {
 	}
 }
 
+func TestReadSenmlWithCursor(t *testing.T) {
+	writer := twriter.New(db)
+
+	chanID := testsutil.GenerateUUID(t)
+	pubID := testsutil.GenerateUUID(t)
+
+	// Records of the same SenML pack share the time, so the page
+	// boundaries fall between the messages with the same time.
+	const packs, records = 5, 3
+	now := float64(time.Now().UnixNano())
+	step := float64(time.Microsecond.Nanoseconds())
+	messages := []senml.Message{}
+	for i := 0; i < packs; i++ {
+		for j := 0; j < records; j++ {
+			messages = append(messages, senml.Message{
+				Channel:   chanID,
+				Publisher: pubID,
+				Protocol:  mqttProt,
+				Name:      fmt.Sprintf("%s-%d", msgName, j),
+				Time:      now - float64(i)*step,
+				Value:     &v,
+			})
+		}
+	}
+
+	err := writer.ConsumeBlocking(context.TODO(), messages)
+	require.Nil(t, err, fmt.Sprintf("expected no error got %s\n", err))
+
+	reader := treader.New(db)
+
+	cases := []struct {
+		desc  string
+		limit uint64
+		pages int
+	}{
+		{
+			desc:  "read messages by pages smaller than the pack",
+			limit: 2,
+			pages: 8,
+		},
+		{
+			desc:  "read messages by pages larger than the pack",
+			limit: 4,
+			pages: 4,
+		},
+		{
+			desc:  "read messages by pages of all the messages",
+			limit: packs * records,
+			pages: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var res []readers.Message
+			pm := readers.PageMetadata{Limit: tc.limit}
+			pages := 0
+			for {
+				page, err := reader.ReadAll(chanID, pm)
+				require.Nil(t, err, fmt.Sprintf("%s: expected no error got %s", tc.desc, err))
+				assert.Equal(t, uint64(len(messages)), page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, len(messages), page.Total))
+				res = append(res, page.Messages...)
+				pages++
+				if page.NextCursor == "" {
+					break
+				}
+				pm.Cursor = page.NextCursor
+			}
+			assert.Equal(t, tc.pages, pages, fmt.Sprintf("%s: expected %d pages got %d", tc.desc, tc.pages, pages))
+			assert.ElementsMatch(t, fromSenml(messages), res, fmt.Sprintf("%s: got incorrect list of senml Messages from ReadAll()", tc.desc))
+		})
+	}
+
+	_, err = reader.ReadAll(chanID, readers.PageMetadata{Limit: limit, Cursor: "invalid"})
+	assert.True(t, errors.Contains(err, readers.ErrInvalidCursor), fmt.Sprintf("read with invalid cursor: expected error %s got %s", readers.ErrInvalidCursor, err))
+}
+
 func TestReadMessagesWithAggregation(t *testing.T) {
 	writer := twriter.New(db)
 
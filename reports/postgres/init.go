@@ -101,6 +101,27 @@ func Migration() (*migrate.MemoryMigrationSource, error) {
 						WHERE jsonb_typeof(rc.metrics) = 'array'`,
 				},
 			},
+			{
+				Id: "reports_04",
+				Up: []string{
+					`ALTER TABLE report_config
+						ADD COLUMN cron            TEXT NOT NULL DEFAULT '',
+						ADD COLUMN timezone        VARCHAR(64) NOT NULL DEFAULT '',
+						ADD COLUMN end_datetime    TIMESTAMP,
+						ADD COLUMN max_occurrences INTEGER NOT NULL DEFAULT 0 CHECK (max_occurrences >= 0),
+						ADD COLUMN occurrences     INTEGER NOT NULL DEFAULT 0 CHECK (occurrences >= 0),
+						ADD COLUMN exclusions      JSONB`,
+				},
+				Down: []string{
+					`ALTER TABLE report_config
+						DROP COLUMN cron,
+						DROP COLUMN timezone,
+						DROP COLUMN end_datetime,
+						DROP COLUMN max_occurrences,
+						DROP COLUMN occurrences,
+						DROP COLUMN exclusions`,
+				},
+			},
 		},
 	}
 
@@ -25,6 +25,12 @@ type dbReport struct {
 	Due                       sql.NullTime           `db:"due"`
 	Recurring                 schedule.Recurring     `db:"recurring"`
 	RecurringPeriod           uint                   `db:"recurring_period"`
+	Cron                      string                 `db:"cron"`
+	Timezone                  string                 `db:"timezone"`
+	EndDateTime               sql.NullTime           `db:"end_datetime"`
+	MaxOccurrences            uint                   `db:"max_occurrences"`
+	Occurrences               uint                   `db:"occurrences"`
+	Exclusions                []byte                 `db:"exclusions"`
 	Status                    reports.Status         `db:"status"`
 	CreatedAt                 time.Time              `db:"created_at"`
 	CreatedBy                 string                 `db:"created_by"`
@@ -81,6 +87,18 @@ func reportToDb(r reports.ReportConfig) (dbReport, error) {
 	if !r.Schedule.Time.IsZero() {
 		t.Valid = true
 	}
+	end := sql.NullTime{Time: r.Schedule.EndDateTime}
+	if !r.Schedule.EndDateTime.IsZero() {
+		end.Valid = true
+	}
+	var exclusions []byte
+	if len(r.Schedule.Exclusions) > 0 {
+		b, err := json.Marshal(r.Schedule.Exclusions)
+		if err != nil {
+			return dbReport{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+		exclusions = b
+	}
 
 	return dbReport{
 		ID:              r.ID,
@@ -91,6 +109,12 @@ func reportToDb(r reports.ReportConfig) (dbReport, error) {
 		Due:             t,
 		Recurring:       r.Schedule.Recurring,
 		RecurringPeriod: r.Schedule.RecurringPeriod,
+		Cron:            r.Schedule.Cron,
+		Timezone:        r.Schedule.Timezone,
+		EndDateTime:     end,
+		MaxOccurrences:  r.Schedule.MaxOccurrences,
+		Occurrences:     r.Schedule.Occurrences,
+		Exclusions:      exclusions,
 		Status:          r.Status,
 		CreatedAt:       r.CreatedAt,
 		CreatedBy:       r.CreatedBy,
@@ -125,6 +149,13 @@ func dbToReport(dto dbReport) (reports.ReportConfig, error) {
 		}
 	}
 
+	var exclusions []schedule.Exclusion
+	if dto.Exclusions != nil {
+		if err := json.Unmarshal(dto.Exclusions, &exclusions); err != nil {
+			return reports.ReportConfig{}, errors.Wrap(errors.ErrMalformedEntity, err)
+		}
+	}
+
 	var roles []roles.MemberRoleActions
 	if dto.Roles != nil {
 		if err := json.Unmarshal(dto.Roles, &roles); err != nil {
@@ -144,6 +175,12 @@ func dbToReport(dto dbReport) (reports.ReportConfig, error) {
 			Time:            dto.Due.Time,
 			Recurring:       dto.Recurring,
 			RecurringPeriod: dto.RecurringPeriod,
+			Cron:            dto.Cron,
+			Timezone:        dto.Timezone,
+			EndDateTime:     dto.EndDateTime.Time,
+			MaxOccurrences:  dto.MaxOccurrences,
+			Occurrences:     dto.Occurrences,
+			Exclusions:      exclusions,
 		},
 		Email:                     &email,
 		Status:                    dto.Status,
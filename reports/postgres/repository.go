@@ -46,11 +46,11 @@ func NewRepository(db postgres.Database) reports.Repository {
 func (repo *PostgresRepository) AddReportConfig(ctx context.Context, cfg reports.ReportConfig) (reports.ReportConfig, error) {
 	q := `
 		INSERT INTO report_config (id, name, description, domain_id, config, metrics,
-			email, start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status, report_template)
+			email, start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, report_template)
 		VALUES (:id, :name, :description, :domain_id, :config, :metrics,
-			:email, :start_datetime, :due, :recurring, :recurring_period, :created_at, :created_by, :updated_at, :updated_by, :status, :report_template)
+			:email, :start_datetime, :due, :recurring, :recurring_period, :cron, :timezone, :end_datetime, :max_occurrences, :occurrences, :exclusions, :created_at, :created_by, :updated_at, :updated_by, :status, :report_template)
 		RETURNING id, name, description, domain_id, config, metrics,
-			email, start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status, report_template;
+			email, start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status, report_template;
 	`
 	dbr, err := reportToDb(cfg)
 	if err != nil {
@@ -80,7 +80,7 @@ func (repo *PostgresRepository) AddReportConfig(ctx context.Context, cfg reports
 func (repo *PostgresRepository) ViewReportConfig(ctx context.Context, id string) (reports.ReportConfig, error) {
 	q := `
 		SELECT id, name, description, domain_id, config, metrics, report_template,
-			email, start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status
+			email, start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status
 		FROM report_config
 		WHERE id = $1;
 	`
@@ -216,6 +216,12 @@ func (repo *PostgresRepository) RetrieveByIDWithRoles(ctx context.Context, id, m
 		r2.due,
 		r2.recurring,
 		r2.recurring_period,
+		r2.cron,
+		r2.timezone,
+		r2.end_datetime,
+		r2.max_occurrences,
+		r2.occurrences,
+		r2.exclusions,
 		r2.start_datetime,
 		r2.config,
 		r2.email,
@@ -256,7 +262,7 @@ func (repo *PostgresRepository) UpdateReportConfigStatus(ctx context.Context, cf
 	q := `UPDATE report_config SET status = :status, updated_at = :updated_at, updated_by = :updated_by
 		WHERE id = :id
         RETURNING id, name, description, domain_id, metrics, email, config,
-			start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;`
+			start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status;`
 
 	dbRpt, err := reportToDb(cfg)
 	if err != nil {
@@ -319,7 +325,7 @@ func (repo *PostgresRepository) UpdateReportConfig(ctx context.Context, cfg repo
 			updated_at = :updated_at, updated_by = :updated_by
 		WHERE id = :id
 		RETURNING id, name, description, domain_id, config, metrics,
-			email, start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+			email, start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status;
 		`, q)
 
 	dbr, err := reportToDb(cfg)
@@ -354,9 +360,11 @@ func (repo *PostgresRepository) UpdateReportSchedule(ctx context.Context, cfg re
 	q := `
 		UPDATE report_config
 		SET start_datetime = :start_datetime, due = :due, recurring = :recurring,
-			recurring_period = :recurring_period, updated_at = :updated_at, updated_by = :updated_by WHERE id = :id
+			recurring_period = :recurring_period, cron = :cron, timezone = :timezone,
+			end_datetime = :end_datetime, max_occurrences = :max_occurrences, occurrences = 0, exclusions = :exclusions,
+			updated_at = :updated_at, updated_by = :updated_by WHERE id = :id
 		RETURNING id, name, description, domain_id, config, metrics,
-			email, start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+			email, start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status;
 	`
 
 	dbr, err := reportToDb(cfg)
@@ -408,7 +416,7 @@ func (repo *PostgresRepository) RemoveReportConfig(ctx context.Context, id strin
 func (repo *PostgresRepository) ListAllReportsConfig(ctx context.Context, pm reports.PageMeta) (reports.ReportConfigPage, error) {
 	listReportsQuery := `
 		SELECT id, name, description, domain_id, metrics, email, config,
-			start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status
+			start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status
 		FROM report_config rc %s %s %s;
 	`
 
@@ -466,7 +474,7 @@ func (repo *PostgresRepository) ListUserReportsConfig(ctx context.Context, userI
 	innerQ := fmt.Sprintf(`
 		WITH direct_reports AS (
 			SELECT rc.id, rc.name, rc.description, rc.domain_id, rc.metrics, rc.email, rc.config,
-				rc.start_datetime, rc.due, rc.recurring, rc.recurring_period,
+				rc.start_datetime, rc.due, rc.recurring, rc.recurring_period, rc.cron, rc.timezone, rc.end_datetime, rc.max_occurrences, rc.occurrences, rc.exclusions,
 				rc.created_at, rc.created_by, rc.updated_at, rc.updated_by, rc.status,
 				rr.id AS role_id,
 				rr."name" AS role_name,
@@ -486,7 +494,7 @@ func (repo *PostgresRepository) ListUserReportsConfig(ctx context.Context, userI
 		),
 		domain_reports AS (
 			SELECT rc.id, rc.name, rc.description, rc.domain_id, rc.metrics, rc.email, rc.config,
-				rc.start_datetime, rc.due, rc.recurring, rc.recurring_period,
+				rc.start_datetime, rc.due, rc.recurring, rc.recurring_period, rc.cron, rc.timezone, rc.end_datetime, rc.max_occurrences, rc.occurrences, rc.exclusions,
 				rc.created_at, rc.created_by, rc.updated_at, rc.updated_by, rc.status,
 				'' AS role_id,
 				'' AS role_name,
@@ -551,9 +559,9 @@ func (repo *PostgresRepository) ListUserReportsConfig(ctx context.Context, userI
 func (repo *PostgresRepository) UpdateReportDue(ctx context.Context, id string, due time.Time) (reports.ReportConfig, error) {
 	q := `
 		UPDATE report_config
-		SET due = :due, updated_at = :updated_at WHERE id = :id
+		SET due = :due, occurrences = occurrences + 1, updated_at = :updated_at WHERE id = :id
 		RETURNING id, name, description, domain_id, config, metrics,
-			email, start_datetime, due, recurring, recurring_period, created_at, created_by, updated_at, updated_by, status;
+			email, start_datetime, due, recurring, recurring_period, cron, timezone, end_datetime, max_occurrences, occurrences, exclusions, created_at, created_by, updated_at, updated_by, status;
 	`
 
 	dbr := dbReport{
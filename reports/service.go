@@ -73,7 +73,8 @@ func (r *report) AddReportConfig(ctx context.Context, session authn.Session, cfg
 	if cfg.Schedule.StartDateTime.IsZero() {
 		cfg.Schedule.StartDateTime = now
 	}
-	cfg.Schedule.Time = cfg.Schedule.StartDateTime
+	cfg.Schedule.Occurrences = 0
+	cfg.Schedule.Time = cfg.Schedule.FirstDue()
 
 	reportConfig, err := r.repo.AddReportConfig(ctx, cfg)
 	if err != nil {
@@ -140,7 +141,7 @@ func (r *report) UpdateReportConfig(ctx context.Context, session authn.Session,
 func (r *report) UpdateReportSchedule(ctx context.Context, session authn.Session, cfg ReportConfig) (ReportConfig, error) {
 	cfg.UpdatedAt = time.Now().UTC()
 	cfg.UpdatedBy = session.UserID
-	cfg.Schedule.Time = cfg.Schedule.StartDateTime
+	cfg.Schedule.Time = cfg.Schedule.FirstDue()
 	c, err := r.repo.UpdateReportSchedule(ctx, cfg)
 	if err != nil {
 		return ReportConfig{}, errors.Wrap(svcerr.ErrUpdateEntity, err)